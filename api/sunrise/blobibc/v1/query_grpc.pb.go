@@ -0,0 +1,232 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: sunrise/blobibc/v1/query.proto
+
+package blobibcv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName       = "/sunrise.blobibc.v1.Query/Params"
+	Query_PendingBlob_FullMethodName  = "/sunrise.blobibc.v1.Query/PendingBlob"
+	Query_PendingBlobs_FullMethodName = "/sunrise.blobibc.v1.Query/PendingBlobs"
+	Query_FeePayer_FullMethodName     = "/sunrise.blobibc.v1.Query/FeePayer"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PendingBlob queries a received blob waiting to be included.
+	PendingBlob(ctx context.Context, in *QueryPendingBlobRequest, opts ...grpc.CallOption) (*QueryPendingBlobResponse, error)
+	// PendingBlobs queries the received blobs waiting to be included, in the
+	// order of the queue.
+	PendingBlobs(ctx context.Context, in *QueryPendingBlobsRequest, opts ...grpc.CallOption) (*QueryPendingBlobsResponse, error)
+	// FeePayer queries the account paying the fees of the blobs posted by a
+	// sender over a channel.
+	FeePayer(ctx context.Context, in *QueryFeePayerRequest, opts ...grpc.CallOption) (*QueryFeePayerResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingBlob(ctx context.Context, in *QueryPendingBlobRequest, opts ...grpc.CallOption) (*QueryPendingBlobResponse, error) {
+	out := new(QueryPendingBlobResponse)
+	err := c.cc.Invoke(ctx, Query_PendingBlob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingBlobs(ctx context.Context, in *QueryPendingBlobsRequest, opts ...grpc.CallOption) (*QueryPendingBlobsResponse, error) {
+	out := new(QueryPendingBlobsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingBlobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePayer(ctx context.Context, in *QueryFeePayerRequest, opts ...grpc.CallOption) (*QueryFeePayerResponse, error) {
+	out := new(QueryFeePayerResponse)
+	err := c.cc.Invoke(ctx, Query_FeePayer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PendingBlob queries a received blob waiting to be included.
+	PendingBlob(context.Context, *QueryPendingBlobRequest) (*QueryPendingBlobResponse, error)
+	// PendingBlobs queries the received blobs waiting to be included, in the
+	// order of the queue.
+	PendingBlobs(context.Context, *QueryPendingBlobsRequest) (*QueryPendingBlobsResponse, error)
+	// FeePayer queries the account paying the fees of the blobs posted by a
+	// sender over a channel.
+	FeePayer(context.Context, *QueryFeePayerRequest) (*QueryFeePayerResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) PendingBlob(context.Context, *QueryPendingBlobRequest) (*QueryPendingBlobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingBlob not implemented")
+}
+func (UnimplementedQueryServer) PendingBlobs(context.Context, *QueryPendingBlobsRequest) (*QueryPendingBlobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingBlobs not implemented")
+}
+func (UnimplementedQueryServer) FeePayer(context.Context, *QueryFeePayerRequest) (*QueryFeePayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePayer not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingBlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingBlob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingBlob(ctx, req.(*QueryPendingBlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingBlobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingBlobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingBlobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingBlobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingBlobs(ctx, req.(*QueryPendingBlobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeePayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePayer(ctx, req.(*QueryFeePayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sunrise.blobibc.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PendingBlob",
+			Handler:    _Query_PendingBlob_Handler,
+		},
+		{
+			MethodName: "PendingBlobs",
+			Handler:    _Query_PendingBlobs_Handler,
+		},
+		{
+			MethodName: "FeePayer",
+			Handler:    _Query_FeePayer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sunrise/blobibc/v1/query.proto",
+}
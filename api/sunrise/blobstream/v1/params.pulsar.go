@@ -4,25 +4,33 @@ package blobstreamv1
 import (
 	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
 var (
-	md_Params                        protoreflect.MessageDescriptor
-	fd_Params_data_commitment_window protoreflect.FieldDescriptor
+	md_Params                                        protoreflect.MessageDescriptor
+	fd_Params_data_commitment_window                 protoreflect.FieldDescriptor
+	fd_Params_significant_power_difference_threshold protoreflect.FieldDescriptor
+	fd_Params_attestation_expiry_time                protoreflect.FieldDescriptor
+	fd_Params_max_pruned_attestations_per_block      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_blobstream_v1_params_proto_init()
 	md_Params = File_sunrise_blobstream_v1_params_proto.Messages().ByName("Params")
 	fd_Params_data_commitment_window = md_Params.Fields().ByName("data_commitment_window")
+	fd_Params_significant_power_difference_threshold = md_Params.Fields().ByName("significant_power_difference_threshold")
+	fd_Params_attestation_expiry_time = md_Params.Fields().ByName("attestation_expiry_time")
+	fd_Params_max_pruned_attestations_per_block = md_Params.Fields().ByName("max_pruned_attestations_per_block")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -96,6 +104,24 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.SignificantPowerDifferenceThreshold != "" {
+		value := protoreflect.ValueOfString(x.SignificantPowerDifferenceThreshold)
+		if !f(fd_Params_significant_power_difference_threshold, value) {
+			return
+		}
+	}
+	if x.AttestationExpiryTime != nil {
+		value := protoreflect.ValueOfMessage(x.AttestationExpiryTime.ProtoReflect())
+		if !f(fd_Params_attestation_expiry_time, value) {
+			return
+		}
+	}
+	if x.MaxPrunedAttestationsPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPrunedAttestationsPerBlock)
+		if !f(fd_Params_max_pruned_attestations_per_block, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -113,6 +139,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "sunrise.blobstream.v1.Params.data_commitment_window":
 		return x.DataCommitmentWindow != uint64(0)
+	case "sunrise.blobstream.v1.Params.significant_power_difference_threshold":
+		return x.SignificantPowerDifferenceThreshold != ""
+	case "sunrise.blobstream.v1.Params.attestation_expiry_time":
+		return x.AttestationExpiryTime != nil
+	case "sunrise.blobstream.v1.Params.max_pruned_attestations_per_block":
+		return x.MaxPrunedAttestationsPerBlock != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.Params"))
@@ -131,6 +163,12 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "sunrise.blobstream.v1.Params.data_commitment_window":
 		x.DataCommitmentWindow = uint64(0)
+	case "sunrise.blobstream.v1.Params.significant_power_difference_threshold":
+		x.SignificantPowerDifferenceThreshold = ""
+	case "sunrise.blobstream.v1.Params.attestation_expiry_time":
+		x.AttestationExpiryTime = nil
+	case "sunrise.blobstream.v1.Params.max_pruned_attestations_per_block":
+		x.MaxPrunedAttestationsPerBlock = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.Params"))
@@ -150,6 +188,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "sunrise.blobstream.v1.Params.data_commitment_window":
 		value := x.DataCommitmentWindow
 		return protoreflect.ValueOfUint64(value)
+	case "sunrise.blobstream.v1.Params.significant_power_difference_threshold":
+		value := x.SignificantPowerDifferenceThreshold
+		return protoreflect.ValueOfString(value)
+	case "sunrise.blobstream.v1.Params.attestation_expiry_time":
+		value := x.AttestationExpiryTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.blobstream.v1.Params.max_pruned_attestations_per_block":
+		value := x.MaxPrunedAttestationsPerBlock
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.Params"))
@@ -172,6 +219,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "sunrise.blobstream.v1.Params.data_commitment_window":
 		x.DataCommitmentWindow = value.Uint()
+	case "sunrise.blobstream.v1.Params.significant_power_difference_threshold":
+		x.SignificantPowerDifferenceThreshold = value.Interface().(string)
+	case "sunrise.blobstream.v1.Params.attestation_expiry_time":
+		x.AttestationExpiryTime = value.Message().Interface().(*durationpb.Duration)
+	case "sunrise.blobstream.v1.Params.max_pruned_attestations_per_block":
+		x.MaxPrunedAttestationsPerBlock = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.Params"))
@@ -192,8 +245,17 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.Params.attestation_expiry_time":
+		if x.AttestationExpiryTime == nil {
+			x.AttestationExpiryTime = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.AttestationExpiryTime.ProtoReflect())
 	case "sunrise.blobstream.v1.Params.data_commitment_window":
 		panic(fmt.Errorf("field data_commitment_window of message sunrise.blobstream.v1.Params is not mutable"))
+	case "sunrise.blobstream.v1.Params.significant_power_difference_threshold":
+		panic(fmt.Errorf("field significant_power_difference_threshold of message sunrise.blobstream.v1.Params is not mutable"))
+	case "sunrise.blobstream.v1.Params.max_pruned_attestations_per_block":
+		panic(fmt.Errorf("field max_pruned_attestations_per_block of message sunrise.blobstream.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.Params"))
@@ -209,6 +271,13 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "sunrise.blobstream.v1.Params.data_commitment_window":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.blobstream.v1.Params.significant_power_difference_threshold":
+		return protoreflect.ValueOfString("")
+	case "sunrise.blobstream.v1.Params.attestation_expiry_time":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.blobstream.v1.Params.max_pruned_attestations_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.Params"))
@@ -281,6 +350,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.DataCommitmentWindow != 0 {
 			n += 1 + runtime.Sov(uint64(x.DataCommitmentWindow))
 		}
+		l = len(x.SignificantPowerDifferenceThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AttestationExpiryTime != nil {
+			l = options.Size(x.AttestationExpiryTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxPrunedAttestationsPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPrunedAttestationsPerBlock))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -310,6 +390,32 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxPrunedAttestationsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPrunedAttestationsPerBlock))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AttestationExpiryTime != nil {
+			encoded, err := options.Marshal(x.AttestationExpiryTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SignificantPowerDifferenceThreshold) > 0 {
+			i -= len(x.SignificantPowerDifferenceThreshold)
+			copy(dAtA[i:], x.SignificantPowerDifferenceThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignificantPowerDifferenceThreshold)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if x.DataCommitmentWindow != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.DataCommitmentWindow))
 			i--
@@ -383,6 +489,93 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignificantPowerDifferenceThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignificantPowerDifferenceThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AttestationExpiryTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AttestationExpiryTime == nil {
+					x.AttestationExpiryTime = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AttestationExpiryTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedAttestationsPerBlock", wireType)
+				}
+				x.MaxPrunedAttestationsPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPrunedAttestationsPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -438,6 +631,15 @@ type Params struct {
 	unknownFields protoimpl.UnknownFields
 
 	DataCommitmentWindow uint64 `protobuf:"varint,1,opt,name=data_commitment_window,json=dataCommitmentWindow,proto3" json:"data_commitment_window,omitempty"`
+	// significant_power_difference_threshold is the threshold of change in the
+	// validator set power that triggers the creation of a new valset request.
+	SignificantPowerDifferenceThreshold string `protobuf:"bytes,2,opt,name=significant_power_difference_threshold,json=significantPowerDifferenceThreshold,proto3" json:"significant_power_difference_threshold,omitempty"`
+	// attestation_expiry_time is the time after which an attestation is pruned
+	// from state.
+	AttestationExpiryTime *durationpb.Duration `protobuf:"bytes,3,opt,name=attestation_expiry_time,json=attestationExpiryTime,proto3" json:"attestation_expiry_time,omitempty"`
+	// max_pruned_attestations_per_block bounds the number of expired
+	// attestations pruned in a single block.
+	MaxPrunedAttestationsPerBlock uint64 `protobuf:"varint,4,opt,name=max_pruned_attestations_per_block,json=maxPrunedAttestationsPerBlock,proto3" json:"max_pruned_attestations_per_block,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -467,6 +669,27 @@ func (x *Params) GetDataCommitmentWindow() uint64 {
 	return 0
 }
 
+func (x *Params) GetSignificantPowerDifferenceThreshold() string {
+	if x != nil {
+		return x.SignificantPowerDifferenceThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetAttestationExpiryTime() *durationpb.Duration {
+	if x != nil {
+		return x.AttestationExpiryTime
+	}
+	return nil
+}
+
+func (x *Params) GetMaxPrunedAttestationsPerBlock() uint64 {
+	if x != nil {
+		return x.MaxPrunedAttestationsPerBlock
+	}
+	return 0
+}
+
 var File_sunrise_blobstream_v1_params_proto protoreflect.FileDescriptor
 
 var file_sunrise_blobstream_v1_params_proto_rawDesc = []byte{
@@ -476,27 +699,50 @@ var file_sunrise_blobstream_v1_params_proto_rawDesc = []byte{
 	0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69,
 	0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
 	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x64, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x34,
-	0x0a, 0x16, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14,
-	0x64, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x3a, 0x24, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x73,
-	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x78, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xd3, 0x01, 0x0a, 0x19, 0x63,
-	0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
-	0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x3b,
-	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53,
-	0x42, 0x58, 0xaa, 0x02, 0x15, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x42, 0x6c, 0x6f,
-	0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x53, 0x75, 0x6e,
-	0x72, 0x69, 0x73, 0x65, 0x5c, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x21, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x42, 0x6c, 0x6f,
-	0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
-	0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x9e, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x64, 0x61, 0x74, 0x61,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x8b, 0x01, 0x0a, 0x26, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x66, 0x69, 0x63, 0x61, 0x6e, 0x74,
+	0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x23, 0x73, 0x69, 0x67, 0x6e, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x44, 0x69, 0x66, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x60,
+	0x0a, 0x17, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00,
+	0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x48, 0x0a, 0x21, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1d, 0x6d, 0x61, 0x78,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x24, 0xe8, 0xa0, 0x1f, 0x01,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x78, 0x2f, 0x62,
+	0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0xd3, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0b,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x42, 0x58, 0xaa, 0x02, 0x15, 0x53, 0x75, 0x6e, 0x72, 0x69,
+	0x73, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x15, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x42, 0x6c, 0x6f, 0x62, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x53, 0x75, 0x6e, 0x72, 0x69,
+	0x73, 0x65, 0x5c, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x53,
+	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -513,14 +759,16 @@ func file_sunrise_blobstream_v1_params_proto_rawDescGZIP() []byte {
 
 var file_sunrise_blobstream_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_sunrise_blobstream_v1_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: sunrise.blobstream.v1.Params
+	(*Params)(nil),              // 0: sunrise.blobstream.v1.Params
+	(*durationpb.Duration)(nil), // 1: google.protobuf.Duration
 }
 var file_sunrise_blobstream_v1_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: sunrise.blobstream.v1.Params.attestation_expiry_time:type_name -> google.protobuf.Duration
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_sunrise_blobstream_v1_params_proto_init() }
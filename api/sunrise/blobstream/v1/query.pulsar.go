@@ -1649,23 +1649,25 @@ func (x *fastReflection_QueryAttestationRequestByNonceResponse) ProtoMethods() *
 }
 
 var (
-	md_QueryLatestAttestationNonceRequest protoreflect.MessageDescriptor
+	md_QuerySignedAttestationRequest       protoreflect.MessageDescriptor
+	fd_QuerySignedAttestationRequest_nonce protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_blobstream_v1_query_proto_init()
-	md_QueryLatestAttestationNonceRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestAttestationNonceRequest")
+	md_QuerySignedAttestationRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QuerySignedAttestationRequest")
+	fd_QuerySignedAttestationRequest_nonce = md_QuerySignedAttestationRequest.Fields().ByName("nonce")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryLatestAttestationNonceRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QuerySignedAttestationRequest)(nil)
 
-type fastReflection_QueryLatestAttestationNonceRequest QueryLatestAttestationNonceRequest
+type fastReflection_QuerySignedAttestationRequest QuerySignedAttestationRequest
 
-func (x *QueryLatestAttestationNonceRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryLatestAttestationNonceRequest)(x)
+func (x *QuerySignedAttestationRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySignedAttestationRequest)(x)
 }
 
-func (x *QueryLatestAttestationNonceRequest) slowProtoReflect() protoreflect.Message {
+func (x *QuerySignedAttestationRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1677,43 +1679,43 @@ func (x *QueryLatestAttestationNonceRequest) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryLatestAttestationNonceRequest_messageType fastReflection_QueryLatestAttestationNonceRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryLatestAttestationNonceRequest_messageType{}
+var _fastReflection_QuerySignedAttestationRequest_messageType fastReflection_QuerySignedAttestationRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySignedAttestationRequest_messageType{}
 
-type fastReflection_QueryLatestAttestationNonceRequest_messageType struct{}
+type fastReflection_QuerySignedAttestationRequest_messageType struct{}
 
-func (x fastReflection_QueryLatestAttestationNonceRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryLatestAttestationNonceRequest)(nil)
+func (x fastReflection_QuerySignedAttestationRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySignedAttestationRequest)(nil)
 }
-func (x fastReflection_QueryLatestAttestationNonceRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestAttestationNonceRequest)
+func (x fastReflection_QuerySignedAttestationRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySignedAttestationRequest)
 }
-func (x fastReflection_QueryLatestAttestationNonceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestAttestationNonceRequest
+func (x fastReflection_QuerySignedAttestationRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySignedAttestationRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestAttestationNonceRequest
+func (x *fastReflection_QuerySignedAttestationRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySignedAttestationRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryLatestAttestationNonceRequest_messageType
+func (x *fastReflection_QuerySignedAttestationRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySignedAttestationRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestAttestationNonceRequest)
+func (x *fastReflection_QuerySignedAttestationRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySignedAttestationRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryLatestAttestationNonceRequest)(x)
+func (x *fastReflection_QuerySignedAttestationRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySignedAttestationRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1721,7 +1723,13 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QuerySignedAttestationRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_QuerySignedAttestationRequest_nonce, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1735,13 +1743,15 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QuerySignedAttestationRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QuerySignedAttestationRequest.nonce":
+		return x.Nonce != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QuerySignedAttestationRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QuerySignedAttestationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1751,13 +1761,15 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QuerySignedAttestationRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QuerySignedAttestationRequest.nonce":
+		x.Nonce = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QuerySignedAttestationRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QuerySignedAttestationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1767,13 +1779,16 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySignedAttestationRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "sunrise.blobstream.v1.QuerySignedAttestationRequest.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QuerySignedAttestationRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QuerySignedAttestationRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1787,13 +1802,15 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QuerySignedAttestationRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QuerySignedAttestationRequest.nonce":
+		x.Nonce = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QuerySignedAttestationRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QuerySignedAttestationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1807,36 +1824,40 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySignedAttestationRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QuerySignedAttestationRequest.nonce":
+		panic(fmt.Errorf("field nonce of message sunrise.blobstream.v1.QuerySignedAttestationRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QuerySignedAttestationRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QuerySignedAttestationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySignedAttestationRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QuerySignedAttestationRequest.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QuerySignedAttestationRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QuerySignedAttestationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QuerySignedAttestationRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestAttestationNonceRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QuerySignedAttestationRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1844,7 +1865,7 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QuerySignedAttestationRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1855,7 +1876,7 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QuerySignedAttestationRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1867,7 +1888,7 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) IsValid() bool {
+func (x *fastReflection_QuerySignedAttestationRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1877,9 +1898,9 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryLatestAttestationNonceRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QuerySignedAttestationRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryLatestAttestationNonceRequest)
+		x := input.Message.Interface().(*QuerySignedAttestationRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1891,6 +1912,9 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1901,7 +1925,7 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestAttestationNonceRequest)
+		x := input.Message.Interface().(*QuerySignedAttestationRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1920,6 +1944,11 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1931,7 +1960,7 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestAttestationNonceRequest)
+		x := input.Message.Interface().(*QuerySignedAttestationRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1963,12 +1992,31 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestAttestationNonceRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignedAttestationRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignedAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2004,26 +2052,81 @@ func (x *fastReflection_QueryLatestAttestationNonceRequest) ProtoMethods() *prot
 	}
 }
 
+var _ protoreflect.List = (*_QuerySignedAttestationResponse_3_list)(nil)
+
+type _QuerySignedAttestationResponse_3_list struct {
+	list *[]*AttestationSignature
+}
+
+func (x *_QuerySignedAttestationResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySignedAttestationResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySignedAttestationResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AttestationSignature)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySignedAttestationResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AttestationSignature)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySignedAttestationResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(AttestationSignature)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySignedAttestationResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySignedAttestationResponse_3_list) NewElement() protoreflect.Value {
+	v := new(AttestationSignature)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySignedAttestationResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryLatestAttestationNonceResponse       protoreflect.MessageDescriptor
-	fd_QueryLatestAttestationNonceResponse_nonce protoreflect.FieldDescriptor
+	md_QuerySignedAttestationResponse                      protoreflect.MessageDescriptor
+	fd_QuerySignedAttestationResponse_attestation          protoreflect.FieldDescriptor
+	fd_QuerySignedAttestationResponse_data_root_tuple_root protoreflect.FieldDescriptor
+	fd_QuerySignedAttestationResponse_signatures           protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_blobstream_v1_query_proto_init()
-	md_QueryLatestAttestationNonceResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestAttestationNonceResponse")
-	fd_QueryLatestAttestationNonceResponse_nonce = md_QueryLatestAttestationNonceResponse.Fields().ByName("nonce")
+	md_QuerySignedAttestationResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QuerySignedAttestationResponse")
+	fd_QuerySignedAttestationResponse_attestation = md_QuerySignedAttestationResponse.Fields().ByName("attestation")
+	fd_QuerySignedAttestationResponse_data_root_tuple_root = md_QuerySignedAttestationResponse.Fields().ByName("data_root_tuple_root")
+	fd_QuerySignedAttestationResponse_signatures = md_QuerySignedAttestationResponse.Fields().ByName("signatures")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryLatestAttestationNonceResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QuerySignedAttestationResponse)(nil)
 
-type fastReflection_QueryLatestAttestationNonceResponse QueryLatestAttestationNonceResponse
+type fastReflection_QuerySignedAttestationResponse QuerySignedAttestationResponse
 
-func (x *QueryLatestAttestationNonceResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryLatestAttestationNonceResponse)(x)
+func (x *QuerySignedAttestationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySignedAttestationResponse)(x)
 }
 
-func (x *QueryLatestAttestationNonceResponse) slowProtoReflect() protoreflect.Message {
+func (x *QuerySignedAttestationResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2035,43 +2138,43 @@ func (x *QueryLatestAttestationNonceResponse) slowProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryLatestAttestationNonceResponse_messageType fastReflection_QueryLatestAttestationNonceResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryLatestAttestationNonceResponse_messageType{}
+var _fastReflection_QuerySignedAttestationResponse_messageType fastReflection_QuerySignedAttestationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySignedAttestationResponse_messageType{}
 
-type fastReflection_QueryLatestAttestationNonceResponse_messageType struct{}
+type fastReflection_QuerySignedAttestationResponse_messageType struct{}
 
-func (x fastReflection_QueryLatestAttestationNonceResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryLatestAttestationNonceResponse)(nil)
+func (x fastReflection_QuerySignedAttestationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySignedAttestationResponse)(nil)
 }
-func (x fastReflection_QueryLatestAttestationNonceResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestAttestationNonceResponse)
+func (x fastReflection_QuerySignedAttestationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySignedAttestationResponse)
 }
-func (x fastReflection_QueryLatestAttestationNonceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestAttestationNonceResponse
+func (x fastReflection_QuerySignedAttestationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySignedAttestationResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestAttestationNonceResponse
+func (x *fastReflection_QuerySignedAttestationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySignedAttestationResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryLatestAttestationNonceResponse_messageType
+func (x *fastReflection_QuerySignedAttestationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySignedAttestationResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestAttestationNonceResponse)
+func (x *fastReflection_QuerySignedAttestationResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySignedAttestationResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryLatestAttestationNonceResponse)(x)
+func (x *fastReflection_QuerySignedAttestationResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySignedAttestationResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2079,10 +2182,22 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) Interface() protore
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Nonce != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Nonce)
-		if !f(fd_QueryLatestAttestationNonceResponse_nonce, value) {
+func (x *fastReflection_QuerySignedAttestationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Attestation != nil {
+		value := protoreflect.ValueOfMessage(x.Attestation.ProtoReflect())
+		if !f(fd_QuerySignedAttestationResponse_attestation, value) {
+			return
+		}
+	}
+	if len(x.DataRootTupleRoot) != 0 {
+		value := protoreflect.ValueOfBytes(x.DataRootTupleRoot)
+		if !f(fd_QuerySignedAttestationResponse_data_root_tuple_root, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySignedAttestationResponse_3_list{list: &x.Signatures})
+		if !f(fd_QuerySignedAttestationResponse_signatures, value) {
 			return
 		}
 	}
@@ -2099,15 +2214,19 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) Range(f func(protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QuerySignedAttestationResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestAttestationNonceResponse.nonce":
-		return x.Nonce != uint64(0)
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.attestation":
+		return x.Attestation != nil
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.data_root_tuple_root":
+		return len(x.DataRootTupleRoot) != 0
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.signatures":
+		return len(x.Signatures) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QuerySignedAttestationResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QuerySignedAttestationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2117,15 +2236,19 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) Has(fd protoreflect
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QuerySignedAttestationResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestAttestationNonceResponse.nonce":
-		x.Nonce = uint64(0)
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.attestation":
+		x.Attestation = nil
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.data_root_tuple_root":
+		x.DataRootTupleRoot = nil
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.signatures":
+		x.Signatures = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QuerySignedAttestationResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QuerySignedAttestationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2135,16 +2258,25 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) Clear(fd protorefle
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySignedAttestationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestAttestationNonceResponse.nonce":
-		value := x.Nonce
-		return protoreflect.ValueOfUint64(value)
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.attestation":
+		value := x.Attestation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.data_root_tuple_root":
+		value := x.DataRootTupleRoot
+		return protoreflect.ValueOfBytes(value)
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_QuerySignedAttestationResponse_3_list{})
+		}
+		listValue := &_QuerySignedAttestationResponse_3_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QuerySignedAttestationResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QuerySignedAttestationResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2158,15 +2290,21 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) Get(descriptor prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QuerySignedAttestationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestAttestationNonceResponse.nonce":
-		x.Nonce = value.Uint()
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.attestation":
+		x.Attestation = value.Message().Interface().(*anypb.Any)
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.data_root_tuple_root":
+		x.DataRootTupleRoot = value.Bytes()
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.signatures":
+		lv := value.List()
+		clv := lv.(*_QuerySignedAttestationResponse_3_list)
+		x.Signatures = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QuerySignedAttestationResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QuerySignedAttestationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2180,40 +2318,57 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) Set(fd protoreflect
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySignedAttestationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestAttestationNonceResponse.nonce":
-		panic(fmt.Errorf("field nonce of message sunrise.blobstream.v1.QueryLatestAttestationNonceResponse is not mutable"))
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.attestation":
+		if x.Attestation == nil {
+			x.Attestation = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Attestation.ProtoReflect())
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*AttestationSignature{}
+		}
+		value := &_QuerySignedAttestationResponse_3_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.data_root_tuple_root":
+		panic(fmt.Errorf("field data_root_tuple_root of message sunrise.blobstream.v1.QuerySignedAttestationResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QuerySignedAttestationResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QuerySignedAttestationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySignedAttestationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestAttestationNonceResponse.nonce":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.attestation":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.data_root_tuple_root":
+		return protoreflect.ValueOfBytes(nil)
+	case "sunrise.blobstream.v1.QuerySignedAttestationResponse.signatures":
+		list := []*AttestationSignature{}
+		return protoreflect.ValueOfList(&_QuerySignedAttestationResponse_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QuerySignedAttestationResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QuerySignedAttestationResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QuerySignedAttestationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestAttestationNonceResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QuerySignedAttestationResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2221,7 +2376,7 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) WhichOneof(d protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QuerySignedAttestationResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2232,7 +2387,7 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) GetUnknown() protor
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QuerySignedAttestationResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2244,7 +2399,7 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) SetUnknown(fields p
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) IsValid() bool {
+func (x *fastReflection_QuerySignedAttestationResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2254,9 +2409,9 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryLatestAttestationNonceResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QuerySignedAttestationResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryLatestAttestationNonceResponse)
+		x := input.Message.Interface().(*QuerySignedAttestationResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2268,8 +2423,19 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) ProtoMethods() *pro
 		var n int
 		var l int
 		_ = l
-		if x.Nonce != 0 {
-			n += 1 + runtime.Sov(uint64(x.Nonce))
+		if x.Attestation != nil {
+			l = options.Size(x.Attestation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DataRootTupleRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2281,7 +2447,7 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) ProtoMethods() *pro
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestAttestationNonceResponse)
+		x := input.Message.Interface().(*QuerySignedAttestationResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2300,10 +2466,42 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) ProtoMethods() *pro
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Nonce != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.DataRootTupleRoot) > 0 {
+			i -= len(x.DataRootTupleRoot)
+			copy(dAtA[i:], x.DataRootTupleRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DataRootTupleRoot)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x12
+		}
+		if x.Attestation != nil {
+			encoded, err := options.Marshal(x.Attestation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2316,7 +2514,7 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) ProtoMethods() *pro
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestAttestationNonceResponse)
+		x := input.Message.Interface().(*QuerySignedAttestationResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2348,17 +2546,17 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) ProtoMethods() *pro
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestAttestationNonceResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignedAttestationResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySignedAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
 				}
-				x.Nonce = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2368,25 +2566,110 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) ProtoMethods() *pro
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Nonce |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.Attestation == nil {
+					x.Attestation = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Attestation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataRootTupleRoot", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DataRootTupleRoot = append(x.DataRootTupleRoot[:0], dAtA[iNdEx:postIndex]...)
+				if x.DataRootTupleRoot == nil {
+					x.DataRootTupleRoot = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &AttestationSignature{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -2409,23 +2692,29 @@ func (x *fastReflection_QueryLatestAttestationNonceResponse) ProtoMethods() *pro
 }
 
 var (
-	md_QueryEarliestAttestationNonceRequest protoreflect.MessageDescriptor
+	md_QueryAttestationsRequest             protoreflect.MessageDescriptor
+	fd_QueryAttestationsRequest_from_nonce  protoreflect.FieldDescriptor
+	fd_QueryAttestationsRequest_limit       protoreflect.FieldDescriptor
+	fd_QueryAttestationsRequest_type_filter protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_blobstream_v1_query_proto_init()
-	md_QueryEarliestAttestationNonceRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryEarliestAttestationNonceRequest")
+	md_QueryAttestationsRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryAttestationsRequest")
+	fd_QueryAttestationsRequest_from_nonce = md_QueryAttestationsRequest.Fields().ByName("from_nonce")
+	fd_QueryAttestationsRequest_limit = md_QueryAttestationsRequest.Fields().ByName("limit")
+	fd_QueryAttestationsRequest_type_filter = md_QueryAttestationsRequest.Fields().ByName("type_filter")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryEarliestAttestationNonceRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAttestationsRequest)(nil)
 
-type fastReflection_QueryEarliestAttestationNonceRequest QueryEarliestAttestationNonceRequest
+type fastReflection_QueryAttestationsRequest QueryAttestationsRequest
 
-func (x *QueryEarliestAttestationNonceRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryEarliestAttestationNonceRequest)(x)
+func (x *QueryAttestationsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAttestationsRequest)(x)
 }
 
-func (x *QueryEarliestAttestationNonceRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryAttestationsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2437,43 +2726,4403 @@ func (x *QueryEarliestAttestationNonceRequest) slowProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryEarliestAttestationNonceRequest_messageType fastReflection_QueryEarliestAttestationNonceRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryEarliestAttestationNonceRequest_messageType{}
+var _fastReflection_QueryAttestationsRequest_messageType fastReflection_QueryAttestationsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAttestationsRequest_messageType{}
 
-type fastReflection_QueryEarliestAttestationNonceRequest_messageType struct{}
+type fastReflection_QueryAttestationsRequest_messageType struct{}
+
+func (x fastReflection_QueryAttestationsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAttestationsRequest)(nil)
+}
+func (x fastReflection_QueryAttestationsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAttestationsRequest)
+}
+func (x fastReflection_QueryAttestationsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAttestationsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAttestationsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAttestationsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAttestationsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAttestationsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAttestationsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAttestationsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAttestationsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAttestationsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAttestationsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FromNonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FromNonce)
+		if !f(fd_QueryAttestationsRequest_from_nonce, value) {
+			return
+		}
+	}
+	if x.Limit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Limit)
+		if !f(fd_QueryAttestationsRequest_limit, value) {
+			return
+		}
+	}
+	if x.TypeFilter != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TypeFilter))
+		if !f(fd_QueryAttestationsRequest_type_filter, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAttestationsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.from_nonce":
+		return x.FromNonce != uint64(0)
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.limit":
+		return x.Limit != uint64(0)
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.type_filter":
+		return x.TypeFilter != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationsRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.from_nonce":
+		x.FromNonce = uint64(0)
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.limit":
+		x.Limit = uint64(0)
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.type_filter":
+		x.TypeFilter = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationsRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAttestationsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.from_nonce":
+		value := x.FromNonce
+		return protoreflect.ValueOfUint64(value)
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.limit":
+		value := x.Limit
+		return protoreflect.ValueOfUint64(value)
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.type_filter":
+		value := x.TypeFilter
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationsRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.from_nonce":
+		x.FromNonce = value.Uint()
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.limit":
+		x.Limit = value.Uint()
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.type_filter":
+		x.TypeFilter = (AttestationType)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationsRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.from_nonce":
+		panic(fmt.Errorf("field from_nonce of message sunrise.blobstream.v1.QueryAttestationsRequest is not mutable"))
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.limit":
+		panic(fmt.Errorf("field limit of message sunrise.blobstream.v1.QueryAttestationsRequest is not mutable"))
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.type_filter":
+		panic(fmt.Errorf("field type_filter of message sunrise.blobstream.v1.QueryAttestationsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationsRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAttestationsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.from_nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.blobstream.v1.QueryAttestationsRequest.type_filter":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationsRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAttestationsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryAttestationsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAttestationsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAttestationsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAttestationsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAttestationsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.FromNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromNonce))
+		}
+		if x.Limit != 0 {
+			n += 1 + runtime.Sov(uint64(x.Limit))
+		}
+		if x.TypeFilter != 0 {
+			n += 1 + runtime.Sov(uint64(x.TypeFilter))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAttestationsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TypeFilter != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TypeFilter))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Limit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.FromNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromNonce))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAttestationsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAttestationsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromNonce", wireType)
+				}
+				x.FromNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FromNonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				}
+				x.Limit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Limit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TypeFilter", wireType)
+				}
+				x.TypeFilter = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TypeFilter |= AttestationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryAttestationsResponse_1_list)(nil)
+
+type _QueryAttestationsResponse_1_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_QueryAttestationsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAttestationsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAttestationsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAttestationsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAttestationsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAttestationsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAttestationsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAttestationsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAttestationsResponse              protoreflect.MessageDescriptor
+	fd_QueryAttestationsResponse_attestations protoreflect.FieldDescriptor
+	fd_QueryAttestationsResponse_next_nonce   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_blobstream_v1_query_proto_init()
+	md_QueryAttestationsResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryAttestationsResponse")
+	fd_QueryAttestationsResponse_attestations = md_QueryAttestationsResponse.Fields().ByName("attestations")
+	fd_QueryAttestationsResponse_next_nonce = md_QueryAttestationsResponse.Fields().ByName("next_nonce")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAttestationsResponse)(nil)
+
+type fastReflection_QueryAttestationsResponse QueryAttestationsResponse
+
+func (x *QueryAttestationsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAttestationsResponse)(x)
+}
+
+func (x *QueryAttestationsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAttestationsResponse_messageType fastReflection_QueryAttestationsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAttestationsResponse_messageType{}
+
+type fastReflection_QueryAttestationsResponse_messageType struct{}
+
+func (x fastReflection_QueryAttestationsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAttestationsResponse)(nil)
+}
+func (x fastReflection_QueryAttestationsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAttestationsResponse)
+}
+func (x fastReflection_QueryAttestationsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAttestationsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAttestationsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAttestationsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAttestationsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAttestationsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAttestationsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAttestationsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAttestationsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAttestationsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAttestationsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Attestations) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAttestationsResponse_1_list{list: &x.Attestations})
+		if !f(fd_QueryAttestationsResponse_attestations, value) {
+			return
+		}
+	}
+	if x.NextNonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextNonce)
+		if !f(fd_QueryAttestationsResponse_next_nonce, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAttestationsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationsResponse.attestations":
+		return len(x.Attestations) != 0
+	case "sunrise.blobstream.v1.QueryAttestationsResponse.next_nonce":
+		return x.NextNonce != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationsResponse.attestations":
+		x.Attestations = nil
+	case "sunrise.blobstream.v1.QueryAttestationsResponse.next_nonce":
+		x.NextNonce = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAttestationsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationsResponse.attestations":
+		if len(x.Attestations) == 0 {
+			return protoreflect.ValueOfList(&_QueryAttestationsResponse_1_list{})
+		}
+		listValue := &_QueryAttestationsResponse_1_list{list: &x.Attestations}
+		return protoreflect.ValueOfList(listValue)
+	case "sunrise.blobstream.v1.QueryAttestationsResponse.next_nonce":
+		value := x.NextNonce
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationsResponse.attestations":
+		lv := value.List()
+		clv := lv.(*_QueryAttestationsResponse_1_list)
+		x.Attestations = *clv.list
+	case "sunrise.blobstream.v1.QueryAttestationsResponse.next_nonce":
+		x.NextNonce = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationsResponse.attestations":
+		if x.Attestations == nil {
+			x.Attestations = []*anypb.Any{}
+		}
+		value := &_QueryAttestationsResponse_1_list{list: &x.Attestations}
+		return protoreflect.ValueOfList(value)
+	case "sunrise.blobstream.v1.QueryAttestationsResponse.next_nonce":
+		panic(fmt.Errorf("field next_nonce of message sunrise.blobstream.v1.QueryAttestationsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAttestationsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationsResponse.attestations":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_QueryAttestationsResponse_1_list{list: &list})
+	case "sunrise.blobstream.v1.QueryAttestationsResponse.next_nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationsResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAttestationsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryAttestationsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAttestationsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAttestationsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAttestationsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAttestationsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Attestations) > 0 {
+			for _, e := range x.Attestations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextNonce))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAttestationsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NextNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextNonce))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Attestations) > 0 {
+			for iNdEx := len(x.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Attestations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAttestationsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAttestationsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attestations = append(x.Attestations, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Attestations[len(x.Attestations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextNonce", wireType)
+				}
+				x.NextNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextNonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAttestationNonceForHeightRequest        protoreflect.MessageDescriptor
+	fd_QueryAttestationNonceForHeightRequest_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_blobstream_v1_query_proto_init()
+	md_QueryAttestationNonceForHeightRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryAttestationNonceForHeightRequest")
+	fd_QueryAttestationNonceForHeightRequest_height = md_QueryAttestationNonceForHeightRequest.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAttestationNonceForHeightRequest)(nil)
+
+type fastReflection_QueryAttestationNonceForHeightRequest QueryAttestationNonceForHeightRequest
+
+func (x *QueryAttestationNonceForHeightRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAttestationNonceForHeightRequest)(x)
+}
+
+func (x *QueryAttestationNonceForHeightRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAttestationNonceForHeightRequest_messageType fastReflection_QueryAttestationNonceForHeightRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAttestationNonceForHeightRequest_messageType{}
+
+type fastReflection_QueryAttestationNonceForHeightRequest_messageType struct{}
+
+func (x fastReflection_QueryAttestationNonceForHeightRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAttestationNonceForHeightRequest)(nil)
+}
+func (x fastReflection_QueryAttestationNonceForHeightRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAttestationNonceForHeightRequest)
+}
+func (x fastReflection_QueryAttestationNonceForHeightRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAttestationNonceForHeightRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAttestationNonceForHeightRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAttestationNonceForHeightRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAttestationNonceForHeightRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAttestationNonceForHeightRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_QueryAttestationNonceForHeightRequest_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest.height":
+		return x.Height != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest.height":
+		x.Height = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest.height":
+		x.Height = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest.height":
+		panic(fmt.Errorf("field height of message sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAttestationNonceForHeightRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAttestationNonceForHeightRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAttestationNonceForHeightRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAttestationNonceForHeightRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAttestationNonceForHeightRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAttestationNonceForHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAttestationNonceForHeightResponse       protoreflect.MessageDescriptor
+	fd_QueryAttestationNonceForHeightResponse_nonce protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_blobstream_v1_query_proto_init()
+	md_QueryAttestationNonceForHeightResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryAttestationNonceForHeightResponse")
+	fd_QueryAttestationNonceForHeightResponse_nonce = md_QueryAttestationNonceForHeightResponse.Fields().ByName("nonce")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAttestationNonceForHeightResponse)(nil)
+
+type fastReflection_QueryAttestationNonceForHeightResponse QueryAttestationNonceForHeightResponse
+
+func (x *QueryAttestationNonceForHeightResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAttestationNonceForHeightResponse)(x)
+}
+
+func (x *QueryAttestationNonceForHeightResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAttestationNonceForHeightResponse_messageType fastReflection_QueryAttestationNonceForHeightResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAttestationNonceForHeightResponse_messageType{}
+
+type fastReflection_QueryAttestationNonceForHeightResponse_messageType struct{}
+
+func (x fastReflection_QueryAttestationNonceForHeightResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAttestationNonceForHeightResponse)(nil)
+}
+func (x fastReflection_QueryAttestationNonceForHeightResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAttestationNonceForHeightResponse)
+}
+func (x fastReflection_QueryAttestationNonceForHeightResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAttestationNonceForHeightResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAttestationNonceForHeightResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAttestationNonceForHeightResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAttestationNonceForHeightResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAttestationNonceForHeightResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_QueryAttestationNonceForHeightResponse_nonce, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse.nonce":
+		return x.Nonce != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse.nonce":
+		x.Nonce = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse.nonce":
+		x.Nonce = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse.nonce":
+		panic(fmt.Errorf("field nonce of message sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAttestationNonceForHeightResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAttestationNonceForHeightResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAttestationNonceForHeightResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAttestationNonceForHeightResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAttestationNonceForHeightResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAttestationNonceForHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryValsetsInRangeRequest            protoreflect.MessageDescriptor
+	fd_QueryValsetsInRangeRequest_from_nonce protoreflect.FieldDescriptor
+	fd_QueryValsetsInRangeRequest_to_nonce   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_blobstream_v1_query_proto_init()
+	md_QueryValsetsInRangeRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryValsetsInRangeRequest")
+	fd_QueryValsetsInRangeRequest_from_nonce = md_QueryValsetsInRangeRequest.Fields().ByName("from_nonce")
+	fd_QueryValsetsInRangeRequest_to_nonce = md_QueryValsetsInRangeRequest.Fields().ByName("to_nonce")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValsetsInRangeRequest)(nil)
+
+type fastReflection_QueryValsetsInRangeRequest QueryValsetsInRangeRequest
+
+func (x *QueryValsetsInRangeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValsetsInRangeRequest)(x)
+}
+
+func (x *QueryValsetsInRangeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValsetsInRangeRequest_messageType fastReflection_QueryValsetsInRangeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValsetsInRangeRequest_messageType{}
+
+type fastReflection_QueryValsetsInRangeRequest_messageType struct{}
+
+func (x fastReflection_QueryValsetsInRangeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValsetsInRangeRequest)(nil)
+}
+func (x fastReflection_QueryValsetsInRangeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValsetsInRangeRequest)
+}
+func (x fastReflection_QueryValsetsInRangeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValsetsInRangeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValsetsInRangeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValsetsInRangeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValsetsInRangeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValsetsInRangeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValsetsInRangeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValsetsInRangeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValsetsInRangeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValsetsInRangeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValsetsInRangeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FromNonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FromNonce)
+		if !f(fd_QueryValsetsInRangeRequest_from_nonce, value) {
+			return
+		}
+	}
+	if x.ToNonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ToNonce)
+		if !f(fd_QueryValsetsInRangeRequest_to_nonce, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValsetsInRangeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryValsetsInRangeRequest.from_nonce":
+		return x.FromNonce != uint64(0)
+	case "sunrise.blobstream.v1.QueryValsetsInRangeRequest.to_nonce":
+		return x.ToNonce != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryValsetsInRangeRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryValsetsInRangeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValsetsInRangeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryValsetsInRangeRequest.from_nonce":
+		x.FromNonce = uint64(0)
+	case "sunrise.blobstream.v1.QueryValsetsInRangeRequest.to_nonce":
+		x.ToNonce = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryValsetsInRangeRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryValsetsInRangeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValsetsInRangeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.blobstream.v1.QueryValsetsInRangeRequest.from_nonce":
+		value := x.FromNonce
+		return protoreflect.ValueOfUint64(value)
+	case "sunrise.blobstream.v1.QueryValsetsInRangeRequest.to_nonce":
+		value := x.ToNonce
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryValsetsInRangeRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryValsetsInRangeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValsetsInRangeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryValsetsInRangeRequest.from_nonce":
+		x.FromNonce = value.Uint()
+	case "sunrise.blobstream.v1.QueryValsetsInRangeRequest.to_nonce":
+		x.ToNonce = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryValsetsInRangeRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryValsetsInRangeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValsetsInRangeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryValsetsInRangeRequest.from_nonce":
+		panic(fmt.Errorf("field from_nonce of message sunrise.blobstream.v1.QueryValsetsInRangeRequest is not mutable"))
+	case "sunrise.blobstream.v1.QueryValsetsInRangeRequest.to_nonce":
+		panic(fmt.Errorf("field to_nonce of message sunrise.blobstream.v1.QueryValsetsInRangeRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryValsetsInRangeRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryValsetsInRangeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValsetsInRangeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryValsetsInRangeRequest.from_nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.blobstream.v1.QueryValsetsInRangeRequest.to_nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryValsetsInRangeRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryValsetsInRangeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValsetsInRangeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryValsetsInRangeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValsetsInRangeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValsetsInRangeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValsetsInRangeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValsetsInRangeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValsetsInRangeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.FromNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromNonce))
+		}
+		if x.ToNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.ToNonce))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValsetsInRangeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ToNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ToNonce))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.FromNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromNonce))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValsetsInRangeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValsetsInRangeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValsetsInRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromNonce", wireType)
+				}
+				x.FromNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FromNonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToNonce", wireType)
+				}
+				x.ToNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ToNonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryValsetsInRangeResponse_1_list)(nil)
+
+type _QueryValsetsInRangeResponse_1_list struct {
+	list *[]*Valset
+}
+
+func (x *_QueryValsetsInRangeResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryValsetsInRangeResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryValsetsInRangeResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Valset)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryValsetsInRangeResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Valset)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryValsetsInRangeResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Valset)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryValsetsInRangeResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryValsetsInRangeResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Valset)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryValsetsInRangeResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryValsetsInRangeResponse            protoreflect.MessageDescriptor
+	fd_QueryValsetsInRangeResponse_valsets    protoreflect.FieldDescriptor
+	fd_QueryValsetsInRangeResponse_next_nonce protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_blobstream_v1_query_proto_init()
+	md_QueryValsetsInRangeResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryValsetsInRangeResponse")
+	fd_QueryValsetsInRangeResponse_valsets = md_QueryValsetsInRangeResponse.Fields().ByName("valsets")
+	fd_QueryValsetsInRangeResponse_next_nonce = md_QueryValsetsInRangeResponse.Fields().ByName("next_nonce")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValsetsInRangeResponse)(nil)
+
+type fastReflection_QueryValsetsInRangeResponse QueryValsetsInRangeResponse
+
+func (x *QueryValsetsInRangeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValsetsInRangeResponse)(x)
+}
+
+func (x *QueryValsetsInRangeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValsetsInRangeResponse_messageType fastReflection_QueryValsetsInRangeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValsetsInRangeResponse_messageType{}
+
+type fastReflection_QueryValsetsInRangeResponse_messageType struct{}
+
+func (x fastReflection_QueryValsetsInRangeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValsetsInRangeResponse)(nil)
+}
+func (x fastReflection_QueryValsetsInRangeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValsetsInRangeResponse)
+}
+func (x fastReflection_QueryValsetsInRangeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValsetsInRangeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValsetsInRangeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValsetsInRangeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValsetsInRangeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValsetsInRangeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValsetsInRangeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValsetsInRangeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValsetsInRangeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValsetsInRangeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValsetsInRangeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Valsets) != 0 {
+		value := protoreflect.ValueOfList(&_QueryValsetsInRangeResponse_1_list{list: &x.Valsets})
+		if !f(fd_QueryValsetsInRangeResponse_valsets, value) {
+			return
+		}
+	}
+	if x.NextNonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextNonce)
+		if !f(fd_QueryValsetsInRangeResponse_next_nonce, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValsetsInRangeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryValsetsInRangeResponse.valsets":
+		return len(x.Valsets) != 0
+	case "sunrise.blobstream.v1.QueryValsetsInRangeResponse.next_nonce":
+		return x.NextNonce != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryValsetsInRangeResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryValsetsInRangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValsetsInRangeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryValsetsInRangeResponse.valsets":
+		x.Valsets = nil
+	case "sunrise.blobstream.v1.QueryValsetsInRangeResponse.next_nonce":
+		x.NextNonce = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryValsetsInRangeResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryValsetsInRangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValsetsInRangeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.blobstream.v1.QueryValsetsInRangeResponse.valsets":
+		if len(x.Valsets) == 0 {
+			return protoreflect.ValueOfList(&_QueryValsetsInRangeResponse_1_list{})
+		}
+		listValue := &_QueryValsetsInRangeResponse_1_list{list: &x.Valsets}
+		return protoreflect.ValueOfList(listValue)
+	case "sunrise.blobstream.v1.QueryValsetsInRangeResponse.next_nonce":
+		value := x.NextNonce
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryValsetsInRangeResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryValsetsInRangeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValsetsInRangeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryValsetsInRangeResponse.valsets":
+		lv := value.List()
+		clv := lv.(*_QueryValsetsInRangeResponse_1_list)
+		x.Valsets = *clv.list
+	case "sunrise.blobstream.v1.QueryValsetsInRangeResponse.next_nonce":
+		x.NextNonce = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryValsetsInRangeResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryValsetsInRangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValsetsInRangeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryValsetsInRangeResponse.valsets":
+		if x.Valsets == nil {
+			x.Valsets = []*Valset{}
+		}
+		value := &_QueryValsetsInRangeResponse_1_list{list: &x.Valsets}
+		return protoreflect.ValueOfList(value)
+	case "sunrise.blobstream.v1.QueryValsetsInRangeResponse.next_nonce":
+		panic(fmt.Errorf("field next_nonce of message sunrise.blobstream.v1.QueryValsetsInRangeResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryValsetsInRangeResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryValsetsInRangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValsetsInRangeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryValsetsInRangeResponse.valsets":
+		list := []*Valset{}
+		return protoreflect.ValueOfList(&_QueryValsetsInRangeResponse_1_list{list: &list})
+	case "sunrise.blobstream.v1.QueryValsetsInRangeResponse.next_nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryValsetsInRangeResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryValsetsInRangeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValsetsInRangeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryValsetsInRangeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValsetsInRangeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValsetsInRangeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValsetsInRangeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValsetsInRangeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValsetsInRangeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Valsets) > 0 {
+			for _, e := range x.Valsets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextNonce))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValsetsInRangeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NextNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextNonce))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Valsets) > 0 {
+			for iNdEx := len(x.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Valsets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValsetsInRangeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValsetsInRangeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValsetsInRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Valsets = append(x.Valsets, &Valset{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Valsets[len(x.Valsets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextNonce", wireType)
+				}
+				x.NextNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextNonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryLatestAttestationNonceRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_sunrise_blobstream_v1_query_proto_init()
+	md_QueryLatestAttestationNonceRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestAttestationNonceRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLatestAttestationNonceRequest)(nil)
+
+type fastReflection_QueryLatestAttestationNonceRequest QueryLatestAttestationNonceRequest
+
+func (x *QueryLatestAttestationNonceRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLatestAttestationNonceRequest)(x)
+}
+
+func (x *QueryLatestAttestationNonceRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLatestAttestationNonceRequest_messageType fastReflection_QueryLatestAttestationNonceRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLatestAttestationNonceRequest_messageType{}
+
+type fastReflection_QueryLatestAttestationNonceRequest_messageType struct{}
+
+func (x fastReflection_QueryLatestAttestationNonceRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLatestAttestationNonceRequest)(nil)
+}
+func (x fastReflection_QueryLatestAttestationNonceRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestAttestationNonceRequest)
+}
+func (x fastReflection_QueryLatestAttestationNonceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestAttestationNonceRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestAttestationNonceRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLatestAttestationNonceRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestAttestationNonceRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryLatestAttestationNonceRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestAttestationNonceRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLatestAttestationNonceRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLatestAttestationNonceRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLatestAttestationNonceRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLatestAttestationNonceRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestAttestationNonceRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryLatestAttestationNonceResponse       protoreflect.MessageDescriptor
+	fd_QueryLatestAttestationNonceResponse_nonce protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_blobstream_v1_query_proto_init()
+	md_QueryLatestAttestationNonceResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestAttestationNonceResponse")
+	fd_QueryLatestAttestationNonceResponse_nonce = md_QueryLatestAttestationNonceResponse.Fields().ByName("nonce")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLatestAttestationNonceResponse)(nil)
+
+type fastReflection_QueryLatestAttestationNonceResponse QueryLatestAttestationNonceResponse
+
+func (x *QueryLatestAttestationNonceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLatestAttestationNonceResponse)(x)
+}
+
+func (x *QueryLatestAttestationNonceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLatestAttestationNonceResponse_messageType fastReflection_QueryLatestAttestationNonceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLatestAttestationNonceResponse_messageType{}
+
+type fastReflection_QueryLatestAttestationNonceResponse_messageType struct{}
+
+func (x fastReflection_QueryLatestAttestationNonceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLatestAttestationNonceResponse)(nil)
+}
+func (x fastReflection_QueryLatestAttestationNonceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestAttestationNonceResponse)
+}
+func (x fastReflection_QueryLatestAttestationNonceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestAttestationNonceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestAttestationNonceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLatestAttestationNonceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestAttestationNonceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryLatestAttestationNonceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_QueryLatestAttestationNonceResponse_nonce, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryLatestAttestationNonceResponse.nonce":
+		return x.Nonce != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryLatestAttestationNonceResponse.nonce":
+		x.Nonce = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.blobstream.v1.QueryLatestAttestationNonceResponse.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryLatestAttestationNonceResponse.nonce":
+		x.Nonce = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryLatestAttestationNonceResponse.nonce":
+		panic(fmt.Errorf("field nonce of message sunrise.blobstream.v1.QueryLatestAttestationNonceResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryLatestAttestationNonceResponse.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestAttestationNonceResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestAttestationNonceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestAttestationNonceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryLatestAttestationNonceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryLatestAttestationNonceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLatestAttestationNonceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryLatestAttestationNonceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestAttestationNonceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryEarliestAttestationNonceRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_sunrise_blobstream_v1_query_proto_init()
+	md_QueryEarliestAttestationNonceRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryEarliestAttestationNonceRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEarliestAttestationNonceRequest)(nil)
+
+type fastReflection_QueryEarliestAttestationNonceRequest QueryEarliestAttestationNonceRequest
+
+func (x *QueryEarliestAttestationNonceRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEarliestAttestationNonceRequest)(x)
+}
+
+func (x *QueryEarliestAttestationNonceRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEarliestAttestationNonceRequest_messageType fastReflection_QueryEarliestAttestationNonceRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEarliestAttestationNonceRequest_messageType{}
+
+type fastReflection_QueryEarliestAttestationNonceRequest_messageType struct{}
+
+func (x fastReflection_QueryEarliestAttestationNonceRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEarliestAttestationNonceRequest)(nil)
+}
+func (x fastReflection_QueryEarliestAttestationNonceRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEarliestAttestationNonceRequest)
+}
+func (x fastReflection_QueryEarliestAttestationNonceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEarliestAttestationNonceRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEarliestAttestationNonceRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEarliestAttestationNonceRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEarliestAttestationNonceRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEarliestAttestationNonceRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEarliestAttestationNonceRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEarliestAttestationNonceRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEarliestAttestationNonceRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEarliestAttestationNonceRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryEarliestAttestationNonceResponse       protoreflect.MessageDescriptor
+	fd_QueryEarliestAttestationNonceResponse_nonce protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_blobstream_v1_query_proto_init()
+	md_QueryEarliestAttestationNonceResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryEarliestAttestationNonceResponse")
+	fd_QueryEarliestAttestationNonceResponse_nonce = md_QueryEarliestAttestationNonceResponse.Fields().ByName("nonce")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEarliestAttestationNonceResponse)(nil)
+
+type fastReflection_QueryEarliestAttestationNonceResponse QueryEarliestAttestationNonceResponse
+
+func (x *QueryEarliestAttestationNonceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEarliestAttestationNonceResponse)(x)
+}
+
+func (x *QueryEarliestAttestationNonceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEarliestAttestationNonceResponse_messageType fastReflection_QueryEarliestAttestationNonceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEarliestAttestationNonceResponse_messageType{}
+
+type fastReflection_QueryEarliestAttestationNonceResponse_messageType struct{}
+
+func (x fastReflection_QueryEarliestAttestationNonceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEarliestAttestationNonceResponse)(nil)
+}
+func (x fastReflection_QueryEarliestAttestationNonceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEarliestAttestationNonceResponse)
+}
+func (x fastReflection_QueryEarliestAttestationNonceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEarliestAttestationNonceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEarliestAttestationNonceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEarliestAttestationNonceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEarliestAttestationNonceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEarliestAttestationNonceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_QueryEarliestAttestationNonceResponse_nonce, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse.nonce":
+		return x.Nonce != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse.nonce":
+		x.Nonce = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse.nonce":
+		x.Nonce = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse.nonce":
+		panic(fmt.Errorf("field nonce of message sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse"))
+		}
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEarliestAttestationNonceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEarliestAttestationNonceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEarliestAttestationNonceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEarliestAttestationNonceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryLatestValsetRequestBeforeNonceRequest       protoreflect.MessageDescriptor
+	fd_QueryLatestValsetRequestBeforeNonceRequest_nonce protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_blobstream_v1_query_proto_init()
+	md_QueryLatestValsetRequestBeforeNonceRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestValsetRequestBeforeNonceRequest")
+	fd_QueryLatestValsetRequestBeforeNonceRequest_nonce = md_QueryLatestValsetRequestBeforeNonceRequest.Fields().ByName("nonce")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryLatestValsetRequestBeforeNonceRequest)(nil)
+
+type fastReflection_QueryLatestValsetRequestBeforeNonceRequest QueryLatestValsetRequestBeforeNonceRequest
+
+func (x *QueryLatestValsetRequestBeforeNonceRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLatestValsetRequestBeforeNonceRequest)(x)
+}
+
+func (x *QueryLatestValsetRequestBeforeNonceRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType{}
+
+type fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType struct{}
 
-func (x fastReflection_QueryEarliestAttestationNonceRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryEarliestAttestationNonceRequest)(nil)
+func (x fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLatestValsetRequestBeforeNonceRequest)(nil)
 }
-func (x fastReflection_QueryEarliestAttestationNonceRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryEarliestAttestationNonceRequest)
+func (x fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestValsetRequestBeforeNonceRequest)
 }
-func (x fastReflection_QueryEarliestAttestationNonceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryEarliestAttestationNonceRequest
+func (x fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestValsetRequestBeforeNonceRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryEarliestAttestationNonceRequest
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestValsetRequestBeforeNonceRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryEarliestAttestationNonceRequest_messageType
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryEarliestAttestationNonceRequest)
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestValsetRequestBeforeNonceRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryEarliestAttestationNonceRequest)(x)
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryLatestValsetRequestBeforeNonceRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2481,7 +7130,13 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) Interface() protor
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_QueryLatestValsetRequestBeforeNonceRequest_nonce, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2495,13 +7150,15 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) Range(f func(proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest.nonce":
+		return x.Nonce != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2511,13 +7168,15 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) Has(fd protoreflec
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest.nonce":
+		x.Nonce = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2527,13 +7186,16 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) Clear(fd protorefl
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2547,13 +7209,15 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) Get(descriptor pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest.nonce":
+		x.Nonce = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2567,36 +7231,40 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) Set(fd protoreflec
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest.nonce":
+		panic(fmt.Errorf("field nonce of message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2604,7 +7272,7 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) WhichOneof(d proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2615,7 +7283,7 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) GetUnknown() proto
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2627,7 +7295,7 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) IsValid() bool {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2637,9 +7305,9 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryEarliestAttestationNonceRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryEarliestAttestationNonceRequest)
+		x := input.Message.Interface().(*QueryLatestValsetRequestBeforeNonceRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2651,6 +7319,9 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) ProtoMethods() *pr
 		var n int
 		var l int
 		_ = l
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2661,7 +7332,7 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) ProtoMethods() *pr
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryEarliestAttestationNonceRequest)
+		x := input.Message.Interface().(*QueryLatestValsetRequestBeforeNonceRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2680,6 +7351,11 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) ProtoMethods() *pr
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2691,7 +7367,7 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) ProtoMethods() *pr
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryEarliestAttestationNonceRequest)
+		x := input.Message.Interface().(*QueryLatestValsetRequestBeforeNonceRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2723,12 +7399,31 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) ProtoMethods() *pr
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2765,26 +7460,26 @@ func (x *fastReflection_QueryEarliestAttestationNonceRequest) ProtoMethods() *pr
 }
 
 var (
-	md_QueryEarliestAttestationNonceResponse       protoreflect.MessageDescriptor
-	fd_QueryEarliestAttestationNonceResponse_nonce protoreflect.FieldDescriptor
+	md_QueryLatestValsetRequestBeforeNonceResponse        protoreflect.MessageDescriptor
+	fd_QueryLatestValsetRequestBeforeNonceResponse_valset protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_blobstream_v1_query_proto_init()
-	md_QueryEarliestAttestationNonceResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryEarliestAttestationNonceResponse")
-	fd_QueryEarliestAttestationNonceResponse_nonce = md_QueryEarliestAttestationNonceResponse.Fields().ByName("nonce")
+	md_QueryLatestValsetRequestBeforeNonceResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestValsetRequestBeforeNonceResponse")
+	fd_QueryLatestValsetRequestBeforeNonceResponse_valset = md_QueryLatestValsetRequestBeforeNonceResponse.Fields().ByName("valset")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryEarliestAttestationNonceResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryLatestValsetRequestBeforeNonceResponse)(nil)
 
-type fastReflection_QueryEarliestAttestationNonceResponse QueryEarliestAttestationNonceResponse
+type fastReflection_QueryLatestValsetRequestBeforeNonceResponse QueryLatestValsetRequestBeforeNonceResponse
 
-func (x *QueryEarliestAttestationNonceResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryEarliestAttestationNonceResponse)(x)
+func (x *QueryLatestValsetRequestBeforeNonceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLatestValsetRequestBeforeNonceResponse)(x)
 }
 
-func (x *QueryEarliestAttestationNonceResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[7]
+func (x *QueryLatestValsetRequestBeforeNonceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2795,43 +7490,43 @@ func (x *QueryEarliestAttestationNonceResponse) slowProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryEarliestAttestationNonceResponse_messageType fastReflection_QueryEarliestAttestationNonceResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryEarliestAttestationNonceResponse_messageType{}
+var _fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType{}
 
-type fastReflection_QueryEarliestAttestationNonceResponse_messageType struct{}
+type fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType struct{}
 
-func (x fastReflection_QueryEarliestAttestationNonceResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryEarliestAttestationNonceResponse)(nil)
+func (x fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLatestValsetRequestBeforeNonceResponse)(nil)
 }
-func (x fastReflection_QueryEarliestAttestationNonceResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryEarliestAttestationNonceResponse)
+func (x fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestValsetRequestBeforeNonceResponse)
 }
-func (x fastReflection_QueryEarliestAttestationNonceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryEarliestAttestationNonceResponse
+func (x fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestValsetRequestBeforeNonceResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryEarliestAttestationNonceResponse
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestValsetRequestBeforeNonceResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryEarliestAttestationNonceResponse_messageType
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryEarliestAttestationNonceResponse)
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestValsetRequestBeforeNonceResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryEarliestAttestationNonceResponse)(x)
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryLatestValsetRequestBeforeNonceResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2839,10 +7534,10 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) Interface() proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Nonce != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Nonce)
-		if !f(fd_QueryEarliestAttestationNonceResponse_nonce, value) {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Valset != nil {
+		value := protoreflect.ValueOfMessage(x.Valset.ProtoReflect())
+		if !f(fd_QueryLatestValsetRequestBeforeNonceResponse_valset, value) {
 			return
 		}
 	}
@@ -2859,15 +7554,15 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) Range(f func(prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse.nonce":
-		return x.Nonce != uint64(0)
+	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse.valset":
+		return x.Valset != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2877,15 +7572,15 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) Has(fd protorefle
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse.nonce":
-		x.Nonce = uint64(0)
+	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse.valset":
+		x.Valset = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2895,16 +7590,16 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) Clear(fd protoref
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse.nonce":
-		value := x.Nonce
-		return protoreflect.ValueOfUint64(value)
+	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse.valset":
+		value := x.Valset
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2918,15 +7613,15 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) Get(descriptor pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse.nonce":
-		x.Nonce = value.Uint()
+	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse.valset":
+		x.Valset = value.Message().Interface().(*Valset)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2940,40 +7635,44 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) Set(fd protorefle
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse.nonce":
-		panic(fmt.Errorf("field nonce of message sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse is not mutable"))
+	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse.valset":
+		if x.Valset == nil {
+			x.Valset = new(Valset)
+		}
+		return protoreflect.ValueOfMessage(x.Valset.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse.nonce":
-		return protoreflect.ValueOfUint64(uint64(0))
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse.valset":
+		m := new(Valset)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2981,7 +7680,7 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) WhichOneof(d prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2992,7 +7691,7 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) GetUnknown() prot
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3004,7 +7703,7 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) IsValid() bool {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3014,9 +7713,9 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryEarliestAttestationNonceResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryEarliestAttestationNonceResponse)
+		x := input.Message.Interface().(*QueryLatestValsetRequestBeforeNonceResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3028,8 +7727,9 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) ProtoMethods() *p
 		var n int
 		var l int
 		_ = l
-		if x.Nonce != 0 {
-			n += 1 + runtime.Sov(uint64(x.Nonce))
+		if x.Valset != nil {
+			l = options.Size(x.Valset)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3041,7 +7741,7 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) ProtoMethods() *p
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryEarliestAttestationNonceResponse)
+		x := input.Message.Interface().(*QueryLatestValsetRequestBeforeNonceResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3060,10 +7760,19 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) ProtoMethods() *p
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Nonce != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+		if x.Valset != nil {
+			encoded, err := options.Marshal(x.Valset)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3076,7 +7785,7 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) ProtoMethods() *p
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryEarliestAttestationNonceResponse)
+		x := input.Message.Interface().(*QueryLatestValsetRequestBeforeNonceResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3108,17 +7817,17 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) ProtoMethods() *p
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
 				}
-				x.Nonce = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3128,11 +7837,28 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) ProtoMethods() *p
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Nonce |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Valset == nil {
+					x.Valset = &Valset{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Valset); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3169,26 +7895,24 @@ func (x *fastReflection_QueryEarliestAttestationNonceResponse) ProtoMethods() *p
 }
 
 var (
-	md_QueryLatestValsetRequestBeforeNonceRequest       protoreflect.MessageDescriptor
-	fd_QueryLatestValsetRequestBeforeNonceRequest_nonce protoreflect.FieldDescriptor
+	md_QueryLatestUnbondingHeightRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_sunrise_blobstream_v1_query_proto_init()
-	md_QueryLatestValsetRequestBeforeNonceRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestValsetRequestBeforeNonceRequest")
-	fd_QueryLatestValsetRequestBeforeNonceRequest_nonce = md_QueryLatestValsetRequestBeforeNonceRequest.Fields().ByName("nonce")
+	md_QueryLatestUnbondingHeightRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestUnbondingHeightRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryLatestValsetRequestBeforeNonceRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryLatestUnbondingHeightRequest)(nil)
 
-type fastReflection_QueryLatestValsetRequestBeforeNonceRequest QueryLatestValsetRequestBeforeNonceRequest
+type fastReflection_QueryLatestUnbondingHeightRequest QueryLatestUnbondingHeightRequest
 
-func (x *QueryLatestValsetRequestBeforeNonceRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryLatestValsetRequestBeforeNonceRequest)(x)
+func (x *QueryLatestUnbondingHeightRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLatestUnbondingHeightRequest)(x)
 }
 
-func (x *QueryLatestValsetRequestBeforeNonceRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[8]
+func (x *QueryLatestUnbondingHeightRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3199,43 +7923,43 @@ func (x *QueryLatestValsetRequestBeforeNonceRequest) slowProtoReflect() protoref
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType{}
+var _fastReflection_QueryLatestUnbondingHeightRequest_messageType fastReflection_QueryLatestUnbondingHeightRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLatestUnbondingHeightRequest_messageType{}
 
-type fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType struct{}
+type fastReflection_QueryLatestUnbondingHeightRequest_messageType struct{}
 
-func (x fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryLatestValsetRequestBeforeNonceRequest)(nil)
+func (x fastReflection_QueryLatestUnbondingHeightRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLatestUnbondingHeightRequest)(nil)
 }
-func (x fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestValsetRequestBeforeNonceRequest)
+func (x fastReflection_QueryLatestUnbondingHeightRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestUnbondingHeightRequest)
 }
-func (x fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestValsetRequestBeforeNonceRequest
+func (x fastReflection_QueryLatestUnbondingHeightRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestUnbondingHeightRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestValsetRequestBeforeNonceRequest
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestUnbondingHeightRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryLatestValsetRequestBeforeNonceRequest_messageType
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLatestUnbondingHeightRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestValsetRequestBeforeNonceRequest)
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestUnbondingHeightRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryLatestValsetRequestBeforeNonceRequest)(x)
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryLatestUnbondingHeightRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3243,13 +7967,7 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Interface()
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Nonce != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Nonce)
-		if !f(fd_QueryLatestValsetRequestBeforeNonceRequest_nonce, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3263,15 +7981,13 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Range(f func
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest.nonce":
-		return x.Nonce != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3281,15 +7997,13 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Has(fd proto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest.nonce":
-		x.Nonce = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3299,16 +8013,13 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Clear(fd pro
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest.nonce":
-		value := x.Nonce
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3322,15 +8033,13 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Get(descript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest.nonce":
-		x.Nonce = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3344,40 +8053,36 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Set(fd proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest.nonce":
-		panic(fmt.Errorf("field nonce of message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest.nonce":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3385,7 +8090,7 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) WhichOneof(d
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3396,7 +8101,7 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) GetUnknown()
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3408,7 +8113,7 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) SetUnknown(f
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) IsValid() bool {
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3418,9 +8123,9 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) IsValid() bo
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryLatestUnbondingHeightRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryLatestValsetRequestBeforeNonceRequest)
+		x := input.Message.Interface().(*QueryLatestUnbondingHeightRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3432,9 +8137,6 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) ProtoMethods
 		var n int
 		var l int
 		_ = l
-		if x.Nonce != 0 {
-			n += 1 + runtime.Sov(uint64(x.Nonce))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3445,7 +8147,7 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) ProtoMethods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestValsetRequestBeforeNonceRequest)
+		x := input.Message.Interface().(*QueryLatestUnbondingHeightRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3464,11 +8166,6 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) ProtoMethods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Nonce != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3480,7 +8177,7 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) ProtoMethods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestValsetRequestBeforeNonceRequest)
+		x := input.Message.Interface().(*QueryLatestUnbondingHeightRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3512,31 +8209,12 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) ProtoMethods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestUnbondingHeightRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestUnbondingHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-				}
-				x.Nonce = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Nonce |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3573,26 +8251,26 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceRequest) ProtoMethods
 }
 
 var (
-	md_QueryLatestValsetRequestBeforeNonceResponse        protoreflect.MessageDescriptor
-	fd_QueryLatestValsetRequestBeforeNonceResponse_valset protoreflect.FieldDescriptor
+	md_QueryLatestUnbondingHeightResponse        protoreflect.MessageDescriptor
+	fd_QueryLatestUnbondingHeightResponse_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_blobstream_v1_query_proto_init()
-	md_QueryLatestValsetRequestBeforeNonceResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestValsetRequestBeforeNonceResponse")
-	fd_QueryLatestValsetRequestBeforeNonceResponse_valset = md_QueryLatestValsetRequestBeforeNonceResponse.Fields().ByName("valset")
+	md_QueryLatestUnbondingHeightResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestUnbondingHeightResponse")
+	fd_QueryLatestUnbondingHeightResponse_height = md_QueryLatestUnbondingHeightResponse.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryLatestValsetRequestBeforeNonceResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryLatestUnbondingHeightResponse)(nil)
 
-type fastReflection_QueryLatestValsetRequestBeforeNonceResponse QueryLatestValsetRequestBeforeNonceResponse
+type fastReflection_QueryLatestUnbondingHeightResponse QueryLatestUnbondingHeightResponse
 
-func (x *QueryLatestValsetRequestBeforeNonceResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryLatestValsetRequestBeforeNonceResponse)(x)
+func (x *QueryLatestUnbondingHeightResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLatestUnbondingHeightResponse)(x)
 }
 
-func (x *QueryLatestValsetRequestBeforeNonceResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[9]
+func (x *QueryLatestUnbondingHeightResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3603,43 +8281,43 @@ func (x *QueryLatestValsetRequestBeforeNonceResponse) slowProtoReflect() protore
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType{}
+var _fastReflection_QueryLatestUnbondingHeightResponse_messageType fastReflection_QueryLatestUnbondingHeightResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLatestUnbondingHeightResponse_messageType{}
 
-type fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType struct{}
+type fastReflection_QueryLatestUnbondingHeightResponse_messageType struct{}
 
-func (x fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryLatestValsetRequestBeforeNonceResponse)(nil)
+func (x fastReflection_QueryLatestUnbondingHeightResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLatestUnbondingHeightResponse)(nil)
 }
-func (x fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestValsetRequestBeforeNonceResponse)
+func (x fastReflection_QueryLatestUnbondingHeightResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestUnbondingHeightResponse)
 }
-func (x fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestValsetRequestBeforeNonceResponse
+func (x fastReflection_QueryLatestUnbondingHeightResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestUnbondingHeightResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestValsetRequestBeforeNonceResponse
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestUnbondingHeightResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryLatestValsetRequestBeforeNonceResponse_messageType
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLatestUnbondingHeightResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestValsetRequestBeforeNonceResponse)
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestUnbondingHeightResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryLatestValsetRequestBeforeNonceResponse)(x)
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryLatestUnbondingHeightResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3647,10 +8325,10 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Interface()
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Valset != nil {
-		value := protoreflect.ValueOfMessage(x.Valset.ProtoReflect())
-		if !f(fd_QueryLatestValsetRequestBeforeNonceResponse_valset, value) {
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_QueryLatestUnbondingHeightResponse_height, value) {
 			return
 		}
 	}
@@ -3667,15 +8345,15 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Range(f fun
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse.valset":
-		return x.Valset != nil
+	case "sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse.height":
+		return x.Height != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3685,15 +8363,15 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Has(fd prot
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse.valset":
-		x.Valset = nil
+	case "sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse.height":
+		x.Height = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3703,16 +8381,16 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Clear(fd pr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse.valset":
-		value := x.Valset
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3726,15 +8404,15 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Get(descrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse.valset":
-		x.Valset = value.Message().Interface().(*Valset)
+	case "sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse.height":
+		x.Height = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3748,44 +8426,40 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Set(fd prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse.valset":
-		if x.Valset == nil {
-			x.Valset = new(Valset)
-		}
-		return protoreflect.ValueOfMessage(x.Valset.ProtoReflect())
+	case "sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse.height":
+		panic(fmt.Errorf("field height of message sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse.valset":
-		m := new(Valset)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse.height":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3793,7 +8467,7 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) WhichOneof(
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3804,7 +8478,7 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) GetUnknown(
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3816,7 +8490,7 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) SetUnknown(
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) IsValid() bool {
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3826,9 +8500,9 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) IsValid() b
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryLatestUnbondingHeightResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryLatestValsetRequestBeforeNonceResponse)
+		x := input.Message.Interface().(*QueryLatestUnbondingHeightResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3840,9 +8514,8 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) ProtoMethod
 		var n int
 		var l int
 		_ = l
-		if x.Valset != nil {
-			l = options.Size(x.Valset)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3854,7 +8527,7 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) ProtoMethod
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestValsetRequestBeforeNonceResponse)
+		x := input.Message.Interface().(*QueryLatestUnbondingHeightResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3873,19 +8546,10 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) ProtoMethod
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Valset != nil {
-			encoded, err := options.Marshal(x.Valset)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3898,7 +8562,7 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) ProtoMethod
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestValsetRequestBeforeNonceResponse)
+		x := input.Message.Interface().(*QueryLatestUnbondingHeightResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3930,17 +8594,17 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) ProtoMethod
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestUnbondingHeightResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestUnbondingHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				var msglen int
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3950,28 +8614,11 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) ProtoMethod
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Height |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Valset == nil {
-					x.Valset = &Valset{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Valset); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4008,24 +8655,24 @@ func (x *fastReflection_QueryLatestValsetRequestBeforeNonceResponse) ProtoMethod
 }
 
 var (
-	md_QueryLatestUnbondingHeightRequest protoreflect.MessageDescriptor
+	md_QueryLatestDataCommitmentRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_sunrise_blobstream_v1_query_proto_init()
-	md_QueryLatestUnbondingHeightRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestUnbondingHeightRequest")
+	md_QueryLatestDataCommitmentRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestDataCommitmentRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryLatestUnbondingHeightRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryLatestDataCommitmentRequest)(nil)
 
-type fastReflection_QueryLatestUnbondingHeightRequest QueryLatestUnbondingHeightRequest
+type fastReflection_QueryLatestDataCommitmentRequest QueryLatestDataCommitmentRequest
 
-func (x *QueryLatestUnbondingHeightRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryLatestUnbondingHeightRequest)(x)
+func (x *QueryLatestDataCommitmentRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLatestDataCommitmentRequest)(x)
 }
 
-func (x *QueryLatestUnbondingHeightRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[10]
+func (x *QueryLatestDataCommitmentRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4036,43 +8683,43 @@ func (x *QueryLatestUnbondingHeightRequest) slowProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryLatestUnbondingHeightRequest_messageType fastReflection_QueryLatestUnbondingHeightRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryLatestUnbondingHeightRequest_messageType{}
+var _fastReflection_QueryLatestDataCommitmentRequest_messageType fastReflection_QueryLatestDataCommitmentRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLatestDataCommitmentRequest_messageType{}
 
-type fastReflection_QueryLatestUnbondingHeightRequest_messageType struct{}
+type fastReflection_QueryLatestDataCommitmentRequest_messageType struct{}
 
-func (x fastReflection_QueryLatestUnbondingHeightRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryLatestUnbondingHeightRequest)(nil)
+func (x fastReflection_QueryLatestDataCommitmentRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLatestDataCommitmentRequest)(nil)
 }
-func (x fastReflection_QueryLatestUnbondingHeightRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestUnbondingHeightRequest)
+func (x fastReflection_QueryLatestDataCommitmentRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestDataCommitmentRequest)
 }
-func (x fastReflection_QueryLatestUnbondingHeightRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestUnbondingHeightRequest
+func (x fastReflection_QueryLatestDataCommitmentRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestDataCommitmentRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestUnbondingHeightRequest
+func (x *fastReflection_QueryLatestDataCommitmentRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestDataCommitmentRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryLatestUnbondingHeightRequest_messageType
+func (x *fastReflection_QueryLatestDataCommitmentRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLatestDataCommitmentRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestUnbondingHeightRequest)
+func (x *fastReflection_QueryLatestDataCommitmentRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestDataCommitmentRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryLatestUnbondingHeightRequest)(x)
+func (x *fastReflection_QueryLatestDataCommitmentRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryLatestDataCommitmentRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4080,7 +8727,7 @@ func (x *fastReflection_QueryLatestUnbondingHeightRequest) Interface() protorefl
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryLatestDataCommitmentRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -4094,13 +8741,13 @@ func (x *fastReflection_QueryLatestUnbondingHeightRequest) Range(f func(protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryLatestDataCommitmentRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4110,13 +8757,13 @@ func (x *fastReflection_QueryLatestUnbondingHeightRequest) Has(fd protoreflect.F
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryLatestDataCommitmentRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4126,13 +8773,13 @@ func (x *fastReflection_QueryLatestUnbondingHeightRequest) Clear(fd protoreflect
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestDataCommitmentRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4146,13 +8793,13 @@ func (x *fastReflection_QueryLatestUnbondingHeightRequest) Get(descriptor protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryLatestDataCommitmentRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4166,36 +8813,36 @@ func (x *fastReflection_QueryLatestUnbondingHeightRequest) Set(fd protoreflect.F
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestDataCommitmentRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestDataCommitmentRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryLatestDataCommitmentRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestDataCommitmentRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4203,7 +8850,7 @@ func (x *fastReflection_QueryLatestUnbondingHeightRequest) WhichOneof(d protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryLatestDataCommitmentRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4214,7 +8861,7 @@ func (x *fastReflection_QueryLatestUnbondingHeightRequest) GetUnknown() protoref
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryLatestDataCommitmentRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4226,7 +8873,7 @@ func (x *fastReflection_QueryLatestUnbondingHeightRequest) SetUnknown(fields pro
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) IsValid() bool {
+func (x *fastReflection_QueryLatestDataCommitmentRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4236,9 +8883,9 @@ func (x *fastReflection_QueryLatestUnbondingHeightRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryLatestUnbondingHeightRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryLatestDataCommitmentRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryLatestUnbondingHeightRequest)
+		x := input.Message.Interface().(*QueryLatestDataCommitmentRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4260,7 +8907,7 @@ func (x *fastReflection_QueryLatestUnbondingHeightRequest) ProtoMethods() *proto
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestUnbondingHeightRequest)
+		x := input.Message.Interface().(*QueryLatestDataCommitmentRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4290,7 +8937,7 @@ func (x *fastReflection_QueryLatestUnbondingHeightRequest) ProtoMethods() *proto
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestUnbondingHeightRequest)
+		x := input.Message.Interface().(*QueryLatestDataCommitmentRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4322,10 +8969,10 @@ func (x *fastReflection_QueryLatestUnbondingHeightRequest) ProtoMethods() *proto
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestUnbondingHeightRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestDataCommitmentRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestUnbondingHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestDataCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -4364,26 +9011,26 @@ func (x *fastReflection_QueryLatestUnbondingHeightRequest) ProtoMethods() *proto
 }
 
 var (
-	md_QueryLatestUnbondingHeightResponse        protoreflect.MessageDescriptor
-	fd_QueryLatestUnbondingHeightResponse_height protoreflect.FieldDescriptor
+	md_QueryLatestDataCommitmentResponse                 protoreflect.MessageDescriptor
+	fd_QueryLatestDataCommitmentResponse_data_commitment protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_blobstream_v1_query_proto_init()
-	md_QueryLatestUnbondingHeightResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestUnbondingHeightResponse")
-	fd_QueryLatestUnbondingHeightResponse_height = md_QueryLatestUnbondingHeightResponse.Fields().ByName("height")
+	md_QueryLatestDataCommitmentResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestDataCommitmentResponse")
+	fd_QueryLatestDataCommitmentResponse_data_commitment = md_QueryLatestDataCommitmentResponse.Fields().ByName("data_commitment")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryLatestUnbondingHeightResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryLatestDataCommitmentResponse)(nil)
 
-type fastReflection_QueryLatestUnbondingHeightResponse QueryLatestUnbondingHeightResponse
+type fastReflection_QueryLatestDataCommitmentResponse QueryLatestDataCommitmentResponse
 
-func (x *QueryLatestUnbondingHeightResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryLatestUnbondingHeightResponse)(x)
+func (x *QueryLatestDataCommitmentResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLatestDataCommitmentResponse)(x)
 }
 
-func (x *QueryLatestUnbondingHeightResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[11]
+func (x *QueryLatestDataCommitmentResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4394,43 +9041,43 @@ func (x *QueryLatestUnbondingHeightResponse) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryLatestUnbondingHeightResponse_messageType fastReflection_QueryLatestUnbondingHeightResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryLatestUnbondingHeightResponse_messageType{}
+var _fastReflection_QueryLatestDataCommitmentResponse_messageType fastReflection_QueryLatestDataCommitmentResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLatestDataCommitmentResponse_messageType{}
 
-type fastReflection_QueryLatestUnbondingHeightResponse_messageType struct{}
+type fastReflection_QueryLatestDataCommitmentResponse_messageType struct{}
 
-func (x fastReflection_QueryLatestUnbondingHeightResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryLatestUnbondingHeightResponse)(nil)
+func (x fastReflection_QueryLatestDataCommitmentResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLatestDataCommitmentResponse)(nil)
 }
-func (x fastReflection_QueryLatestUnbondingHeightResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestUnbondingHeightResponse)
+func (x fastReflection_QueryLatestDataCommitmentResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestDataCommitmentResponse)
 }
-func (x fastReflection_QueryLatestUnbondingHeightResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestUnbondingHeightResponse
+func (x fastReflection_QueryLatestDataCommitmentResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestDataCommitmentResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestUnbondingHeightResponse
+func (x *fastReflection_QueryLatestDataCommitmentResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLatestDataCommitmentResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryLatestUnbondingHeightResponse_messageType
+func (x *fastReflection_QueryLatestDataCommitmentResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLatestDataCommitmentResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestUnbondingHeightResponse)
+func (x *fastReflection_QueryLatestDataCommitmentResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryLatestDataCommitmentResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryLatestUnbondingHeightResponse)(x)
+func (x *fastReflection_QueryLatestDataCommitmentResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryLatestDataCommitmentResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4438,10 +9085,10 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Height != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Height)
-		if !f(fd_QueryLatestUnbondingHeightResponse_height, value) {
+func (x *fastReflection_QueryLatestDataCommitmentResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DataCommitment != nil {
+		value := protoreflect.ValueOfMessage(x.DataCommitment.ProtoReflect())
+		if !f(fd_QueryLatestDataCommitmentResponse_data_commitment, value) {
 			return
 		}
 	}
@@ -4458,15 +9105,15 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryLatestDataCommitmentResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse.height":
-		return x.Height != uint64(0)
+	case "sunrise.blobstream.v1.QueryLatestDataCommitmentResponse.data_commitment":
+		return x.DataCommitment != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4476,15 +9123,15 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryLatestDataCommitmentResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse.height":
-		x.Height = uint64(0)
+	case "sunrise.blobstream.v1.QueryLatestDataCommitmentResponse.data_commitment":
+		x.DataCommitment = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4494,16 +9141,16 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestDataCommitmentResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse.height":
-		value := x.Height
-		return protoreflect.ValueOfUint64(value)
+	case "sunrise.blobstream.v1.QueryLatestDataCommitmentResponse.data_commitment":
+		value := x.DataCommitment
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4517,15 +9164,15 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryLatestDataCommitmentResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse.height":
-		x.Height = value.Uint()
+	case "sunrise.blobstream.v1.QueryLatestDataCommitmentResponse.data_commitment":
+		x.DataCommitment = value.Message().Interface().(*DataCommitment)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4539,40 +9186,44 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestDataCommitmentResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse.height":
-		panic(fmt.Errorf("field height of message sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse is not mutable"))
+	case "sunrise.blobstream.v1.QueryLatestDataCommitmentResponse.data_commitment":
+		if x.DataCommitment == nil {
+			x.DataCommitment = new(DataCommitment)
+		}
+		return protoreflect.ValueOfMessage(x.DataCommitment.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLatestDataCommitmentResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse.height":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.blobstream.v1.QueryLatestDataCommitmentResponse.data_commitment":
+		m := new(DataCommitment)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryLatestDataCommitmentResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestDataCommitmentResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4580,7 +9231,7 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryLatestDataCommitmentResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4591,7 +9242,7 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryLatestDataCommitmentResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4603,7 +9254,7 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) IsValid() bool {
+func (x *fastReflection_QueryLatestDataCommitmentResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4613,9 +9264,9 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryLatestUnbondingHeightResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryLatestDataCommitmentResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryLatestUnbondingHeightResponse)
+		x := input.Message.Interface().(*QueryLatestDataCommitmentResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4627,8 +9278,9 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		if x.Height != 0 {
-			n += 1 + runtime.Sov(uint64(x.Height))
+		if x.DataCommitment != nil {
+			l = options.Size(x.DataCommitment)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4640,7 +9292,7 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestUnbondingHeightResponse)
+		x := input.Message.Interface().(*QueryLatestDataCommitmentResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4659,10 +9311,19 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Height != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+		if x.DataCommitment != nil {
+			encoded, err := options.Marshal(x.DataCommitment)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4675,7 +9336,7 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestUnbondingHeightResponse)
+		x := input.Message.Interface().(*QueryLatestDataCommitmentResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4707,17 +9368,17 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestUnbondingHeightResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestDataCommitmentResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestUnbondingHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestDataCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataCommitment", wireType)
 				}
-				x.Height = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4727,11 +9388,28 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) ProtoMethods() *prot
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Height |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DataCommitment == nil {
+					x.DataCommitment = &DataCommitment{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DataCommitment); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4768,24 +9446,26 @@ func (x *fastReflection_QueryLatestUnbondingHeightResponse) ProtoMethods() *prot
 }
 
 var (
-	md_QueryLatestDataCommitmentRequest protoreflect.MessageDescriptor
+	md_QueryDataCommitmentRangeForHeightRequest        protoreflect.MessageDescriptor
+	fd_QueryDataCommitmentRangeForHeightRequest_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_blobstream_v1_query_proto_init()
-	md_QueryLatestDataCommitmentRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestDataCommitmentRequest")
+	md_QueryDataCommitmentRangeForHeightRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryDataCommitmentRangeForHeightRequest")
+	fd_QueryDataCommitmentRangeForHeightRequest_height = md_QueryDataCommitmentRangeForHeightRequest.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryLatestDataCommitmentRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryDataCommitmentRangeForHeightRequest)(nil)
 
-type fastReflection_QueryLatestDataCommitmentRequest QueryLatestDataCommitmentRequest
+type fastReflection_QueryDataCommitmentRangeForHeightRequest QueryDataCommitmentRangeForHeightRequest
 
-func (x *QueryLatestDataCommitmentRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryLatestDataCommitmentRequest)(x)
+func (x *QueryDataCommitmentRangeForHeightRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDataCommitmentRangeForHeightRequest)(x)
 }
 
-func (x *QueryLatestDataCommitmentRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[12]
+func (x *QueryDataCommitmentRangeForHeightRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4796,43 +9476,43 @@ func (x *QueryLatestDataCommitmentRequest) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryLatestDataCommitmentRequest_messageType fastReflection_QueryLatestDataCommitmentRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryLatestDataCommitmentRequest_messageType{}
+var _fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType{}
 
-type fastReflection_QueryLatestDataCommitmentRequest_messageType struct{}
+type fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType struct{}
 
-func (x fastReflection_QueryLatestDataCommitmentRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryLatestDataCommitmentRequest)(nil)
+func (x fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDataCommitmentRangeForHeightRequest)(nil)
 }
-func (x fastReflection_QueryLatestDataCommitmentRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestDataCommitmentRequest)
+func (x fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDataCommitmentRangeForHeightRequest)
 }
-func (x fastReflection_QueryLatestDataCommitmentRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestDataCommitmentRequest
+func (x fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataCommitmentRangeForHeightRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestDataCommitmentRequest
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataCommitmentRangeForHeightRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryLatestDataCommitmentRequest_messageType
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestDataCommitmentRequest)
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDataCommitmentRangeForHeightRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryLatestDataCommitmentRequest)(x)
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDataCommitmentRangeForHeightRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4840,7 +9520,13 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_QueryDataCommitmentRangeForHeightRequest_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4854,13 +9540,15 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest.height":
+		return x.Height != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4870,13 +9558,15 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest.height":
+		x.Height = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4886,13 +9576,16 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4906,13 +9599,15 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest.height":
+		x.Height = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4926,36 +9621,40 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest.height":
+		panic(fmt.Errorf("field height of message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest.height":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestDataCommitmentRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4963,7 +9662,7 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4974,7 +9673,7 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4986,7 +9685,7 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) IsValid() bool {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4996,9 +9695,9 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryLatestDataCommitmentRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryLatestDataCommitmentRequest)
+		x := input.Message.Interface().(*QueryDataCommitmentRangeForHeightRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5010,6 +9709,9 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5020,7 +9722,7 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestDataCommitmentRequest)
+		x := input.Message.Interface().(*QueryDataCommitmentRangeForHeightRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5039,6 +9741,11 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -5050,7 +9757,7 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestDataCommitmentRequest)
+		x := input.Message.Interface().(*QueryDataCommitmentRangeForHeightRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5082,12 +9789,31 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestDataCommitmentRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataCommitmentRangeForHeightRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestDataCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataCommitmentRangeForHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5124,26 +9850,26 @@ func (x *fastReflection_QueryLatestDataCommitmentRequest) ProtoMethods() *protoi
 }
 
 var (
-	md_QueryLatestDataCommitmentResponse                 protoreflect.MessageDescriptor
-	fd_QueryLatestDataCommitmentResponse_data_commitment protoreflect.FieldDescriptor
+	md_QueryDataCommitmentRangeForHeightResponse                 protoreflect.MessageDescriptor
+	fd_QueryDataCommitmentRangeForHeightResponse_data_commitment protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_blobstream_v1_query_proto_init()
-	md_QueryLatestDataCommitmentResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryLatestDataCommitmentResponse")
-	fd_QueryLatestDataCommitmentResponse_data_commitment = md_QueryLatestDataCommitmentResponse.Fields().ByName("data_commitment")
+	md_QueryDataCommitmentRangeForHeightResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryDataCommitmentRangeForHeightResponse")
+	fd_QueryDataCommitmentRangeForHeightResponse_data_commitment = md_QueryDataCommitmentRangeForHeightResponse.Fields().ByName("data_commitment")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryLatestDataCommitmentResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryDataCommitmentRangeForHeightResponse)(nil)
 
-type fastReflection_QueryLatestDataCommitmentResponse QueryLatestDataCommitmentResponse
+type fastReflection_QueryDataCommitmentRangeForHeightResponse QueryDataCommitmentRangeForHeightResponse
 
-func (x *QueryLatestDataCommitmentResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryLatestDataCommitmentResponse)(x)
+func (x *QueryDataCommitmentRangeForHeightResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDataCommitmentRangeForHeightResponse)(x)
 }
 
-func (x *QueryLatestDataCommitmentResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[13]
+func (x *QueryDataCommitmentRangeForHeightResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5154,43 +9880,43 @@ func (x *QueryLatestDataCommitmentResponse) slowProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryLatestDataCommitmentResponse_messageType fastReflection_QueryLatestDataCommitmentResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryLatestDataCommitmentResponse_messageType{}
+var _fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType{}
 
-type fastReflection_QueryLatestDataCommitmentResponse_messageType struct{}
+type fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType struct{}
 
-func (x fastReflection_QueryLatestDataCommitmentResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryLatestDataCommitmentResponse)(nil)
+func (x fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDataCommitmentRangeForHeightResponse)(nil)
 }
-func (x fastReflection_QueryLatestDataCommitmentResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestDataCommitmentResponse)
+func (x fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDataCommitmentRangeForHeightResponse)
 }
-func (x fastReflection_QueryLatestDataCommitmentResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestDataCommitmentResponse
+func (x fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataCommitmentRangeForHeightResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryLatestDataCommitmentResponse
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDataCommitmentRangeForHeightResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryLatestDataCommitmentResponse_messageType
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryLatestDataCommitmentResponse)
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDataCommitmentRangeForHeightResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryLatestDataCommitmentResponse)(x)
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDataCommitmentRangeForHeightResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5198,10 +9924,10 @@ func (x *fastReflection_QueryLatestDataCommitmentResponse) Interface() protorefl
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.DataCommitment != nil {
 		value := protoreflect.ValueOfMessage(x.DataCommitment.ProtoReflect())
-		if !f(fd_QueryLatestDataCommitmentResponse_data_commitment, value) {
+		if !f(fd_QueryDataCommitmentRangeForHeightResponse_data_commitment, value) {
 			return
 		}
 	}
@@ -5218,15 +9944,15 @@ func (x *fastReflection_QueryLatestDataCommitmentResponse) Range(f func(protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestDataCommitmentResponse.data_commitment":
+	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse.data_commitment":
 		return x.DataCommitment != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5236,15 +9962,15 @@ func (x *fastReflection_QueryLatestDataCommitmentResponse) Has(fd protoreflect.F
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestDataCommitmentResponse.data_commitment":
+	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse.data_commitment":
 		x.DataCommitment = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5254,16 +9980,16 @@ func (x *fastReflection_QueryLatestDataCommitmentResponse) Clear(fd protoreflect
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestDataCommitmentResponse.data_commitment":
+	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse.data_commitment":
 		value := x.DataCommitment
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5277,15 +10003,15 @@ func (x *fastReflection_QueryLatestDataCommitmentResponse) Get(descriptor protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestDataCommitmentResponse.data_commitment":
+	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse.data_commitment":
 		x.DataCommitment = value.Message().Interface().(*DataCommitment)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5299,44 +10025,44 @@ func (x *fastReflection_QueryLatestDataCommitmentResponse) Set(fd protoreflect.F
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestDataCommitmentResponse.data_commitment":
+	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse.data_commitment":
 		if x.DataCommitment == nil {
 			x.DataCommitment = new(DataCommitment)
 		}
 		return protoreflect.ValueOfMessage(x.DataCommitment.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryLatestDataCommitmentResponse.data_commitment":
+	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse.data_commitment":
 		m := new(DataCommitment)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryLatestDataCommitmentResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryLatestDataCommitmentResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5344,7 +10070,7 @@ func (x *fastReflection_QueryLatestDataCommitmentResponse) WhichOneof(d protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5355,7 +10081,7 @@ func (x *fastReflection_QueryLatestDataCommitmentResponse) GetUnknown() protoref
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5367,7 +10093,7 @@ func (x *fastReflection_QueryLatestDataCommitmentResponse) SetUnknown(fields pro
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) IsValid() bool {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5377,9 +10103,9 @@ func (x *fastReflection_QueryLatestDataCommitmentResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryLatestDataCommitmentResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryLatestDataCommitmentResponse)
+		x := input.Message.Interface().(*QueryDataCommitmentRangeForHeightResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5405,7 +10131,7 @@ func (x *fastReflection_QueryLatestDataCommitmentResponse) ProtoMethods() *proto
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestDataCommitmentResponse)
+		x := input.Message.Interface().(*QueryDataCommitmentRangeForHeightResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5449,7 +10175,7 @@ func (x *fastReflection_QueryLatestDataCommitmentResponse) ProtoMethods() *proto
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryLatestDataCommitmentResponse)
+		x := input.Message.Interface().(*QueryDataCommitmentRangeForHeightResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5481,10 +10207,10 @@ func (x *fastReflection_QueryLatestDataCommitmentResponse) ProtoMethods() *proto
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestDataCommitmentResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataCommitmentRangeForHeightResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLatestDataCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataCommitmentRangeForHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5559,26 +10285,26 @@ func (x *fastReflection_QueryLatestDataCommitmentResponse) ProtoMethods() *proto
 }
 
 var (
-	md_QueryDataCommitmentRangeForHeightRequest        protoreflect.MessageDescriptor
-	fd_QueryDataCommitmentRangeForHeightRequest_height protoreflect.FieldDescriptor
+	md_QueryEvmAddressRequest                   protoreflect.MessageDescriptor
+	fd_QueryEvmAddressRequest_validator_address protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_blobstream_v1_query_proto_init()
-	md_QueryDataCommitmentRangeForHeightRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryDataCommitmentRangeForHeightRequest")
-	fd_QueryDataCommitmentRangeForHeightRequest_height = md_QueryDataCommitmentRangeForHeightRequest.Fields().ByName("height")
+	md_QueryEvmAddressRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryEvmAddressRequest")
+	fd_QueryEvmAddressRequest_validator_address = md_QueryEvmAddressRequest.Fields().ByName("validator_address")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryDataCommitmentRangeForHeightRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryEvmAddressRequest)(nil)
 
-type fastReflection_QueryDataCommitmentRangeForHeightRequest QueryDataCommitmentRangeForHeightRequest
+type fastReflection_QueryEvmAddressRequest QueryEvmAddressRequest
 
-func (x *QueryDataCommitmentRangeForHeightRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryDataCommitmentRangeForHeightRequest)(x)
+func (x *QueryEvmAddressRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEvmAddressRequest)(x)
 }
 
-func (x *QueryDataCommitmentRangeForHeightRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[14]
+func (x *QueryEvmAddressRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5589,43 +10315,43 @@ func (x *QueryDataCommitmentRangeForHeightRequest) slowProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType{}
+var _fastReflection_QueryEvmAddressRequest_messageType fastReflection_QueryEvmAddressRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEvmAddressRequest_messageType{}
 
-type fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType struct{}
+type fastReflection_QueryEvmAddressRequest_messageType struct{}
 
-func (x fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryDataCommitmentRangeForHeightRequest)(nil)
+func (x fastReflection_QueryEvmAddressRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEvmAddressRequest)(nil)
 }
-func (x fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryDataCommitmentRangeForHeightRequest)
+func (x fastReflection_QueryEvmAddressRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEvmAddressRequest)
 }
-func (x fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDataCommitmentRangeForHeightRequest
+func (x fastReflection_QueryEvmAddressRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEvmAddressRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDataCommitmentRangeForHeightRequest
+func (x *fastReflection_QueryEvmAddressRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEvmAddressRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryDataCommitmentRangeForHeightRequest_messageType
+func (x *fastReflection_QueryEvmAddressRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEvmAddressRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryDataCommitmentRangeForHeightRequest)
+func (x *fastReflection_QueryEvmAddressRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEvmAddressRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryDataCommitmentRangeForHeightRequest)(x)
+func (x *fastReflection_QueryEvmAddressRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEvmAddressRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5633,10 +10359,10 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Interface() pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Height != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Height)
-		if !f(fd_QueryDataCommitmentRangeForHeightRequest_height, value) {
+func (x *fastReflection_QueryEvmAddressRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_QueryEvmAddressRequest_validator_address, value) {
 			return
 		}
 	}
@@ -5653,15 +10379,15 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Range(f func(p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryEvmAddressRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest.height":
-		return x.Height != uint64(0)
+	case "sunrise.blobstream.v1.QueryEvmAddressRequest.validator_address":
+		return x.ValidatorAddress != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5671,15 +10397,15 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Has(fd protore
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryEvmAddressRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest.height":
-		x.Height = uint64(0)
+	case "sunrise.blobstream.v1.QueryEvmAddressRequest.validator_address":
+		x.ValidatorAddress = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5689,16 +10415,16 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Clear(fd proto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEvmAddressRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest.height":
-		value := x.Height
-		return protoreflect.ValueOfUint64(value)
+	case "sunrise.blobstream.v1.QueryEvmAddressRequest.validator_address":
+		value := x.ValidatorAddress
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5712,15 +10438,15 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Get(descriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryEvmAddressRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest.height":
-		x.Height = value.Uint()
+	case "sunrise.blobstream.v1.QueryEvmAddressRequest.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5734,40 +10460,40 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Set(fd protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEvmAddressRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest.height":
-		panic(fmt.Errorf("field height of message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest is not mutable"))
+	case "sunrise.blobstream.v1.QueryEvmAddressRequest.validator_address":
+		panic(fmt.Errorf("field validator_address of message sunrise.blobstream.v1.QueryEvmAddressRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEvmAddressRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest.height":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "sunrise.blobstream.v1.QueryEvmAddressRequest.validator_address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryEvmAddressRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryEvmAddressRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5775,7 +10501,7 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) WhichOneof(d p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryEvmAddressRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5786,7 +10512,7 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) GetUnknown() p
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryEvmAddressRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5798,7 +10524,7 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) SetUnknown(fie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) IsValid() bool {
+func (x *fastReflection_QueryEvmAddressRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5808,9 +10534,9 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) IsValid() bool
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryEvmAddressRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryDataCommitmentRangeForHeightRequest)
+		x := input.Message.Interface().(*QueryEvmAddressRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5822,8 +10548,9 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) ProtoMethods()
 		var n int
 		var l int
 		_ = l
-		if x.Height != 0 {
-			n += 1 + runtime.Sov(uint64(x.Height))
+		l = len(x.ValidatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5835,7 +10562,7 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) ProtoMethods()
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDataCommitmentRangeForHeightRequest)
+		x := input.Message.Interface().(*QueryEvmAddressRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5854,10 +10581,12 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) ProtoMethods()
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Height != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5870,7 +10599,7 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) ProtoMethods()
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDataCommitmentRangeForHeightRequest)
+		x := input.Message.Interface().(*QueryEvmAddressRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5902,17 +10631,17 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) ProtoMethods()
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataCommitmentRangeForHeightRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEvmAddressRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataCommitmentRangeForHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEvmAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 				}
-				x.Height = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5922,11 +10651,24 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) ProtoMethods()
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Height |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5963,26 +10705,26 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightRequest) ProtoMethods()
 }
 
 var (
-	md_QueryDataCommitmentRangeForHeightResponse                 protoreflect.MessageDescriptor
-	fd_QueryDataCommitmentRangeForHeightResponse_data_commitment protoreflect.FieldDescriptor
+	md_QueryEvmAddressResponse             protoreflect.MessageDescriptor
+	fd_QueryEvmAddressResponse_evm_address protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_blobstream_v1_query_proto_init()
-	md_QueryDataCommitmentRangeForHeightResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryDataCommitmentRangeForHeightResponse")
-	fd_QueryDataCommitmentRangeForHeightResponse_data_commitment = md_QueryDataCommitmentRangeForHeightResponse.Fields().ByName("data_commitment")
+	md_QueryEvmAddressResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryEvmAddressResponse")
+	fd_QueryEvmAddressResponse_evm_address = md_QueryEvmAddressResponse.Fields().ByName("evm_address")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryDataCommitmentRangeForHeightResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryEvmAddressResponse)(nil)
 
-type fastReflection_QueryDataCommitmentRangeForHeightResponse QueryDataCommitmentRangeForHeightResponse
+type fastReflection_QueryEvmAddressResponse QueryEvmAddressResponse
 
-func (x *QueryDataCommitmentRangeForHeightResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryDataCommitmentRangeForHeightResponse)(x)
+func (x *QueryEvmAddressResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEvmAddressResponse)(x)
 }
 
-func (x *QueryDataCommitmentRangeForHeightResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[15]
+func (x *QueryEvmAddressResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5993,43 +10735,43 @@ func (x *QueryDataCommitmentRangeForHeightResponse) slowProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType{}
+var _fastReflection_QueryEvmAddressResponse_messageType fastReflection_QueryEvmAddressResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEvmAddressResponse_messageType{}
 
-type fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType struct{}
+type fastReflection_QueryEvmAddressResponse_messageType struct{}
 
-func (x fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryDataCommitmentRangeForHeightResponse)(nil)
+func (x fastReflection_QueryEvmAddressResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEvmAddressResponse)(nil)
 }
-func (x fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryDataCommitmentRangeForHeightResponse)
+func (x fastReflection_QueryEvmAddressResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEvmAddressResponse)
 }
-func (x fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDataCommitmentRangeForHeightResponse
+func (x fastReflection_QueryEvmAddressResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEvmAddressResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryDataCommitmentRangeForHeightResponse
+func (x *fastReflection_QueryEvmAddressResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEvmAddressResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryDataCommitmentRangeForHeightResponse_messageType
+func (x *fastReflection_QueryEvmAddressResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEvmAddressResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryDataCommitmentRangeForHeightResponse)
+func (x *fastReflection_QueryEvmAddressResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEvmAddressResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryDataCommitmentRangeForHeightResponse)(x)
+func (x *fastReflection_QueryEvmAddressResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEvmAddressResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6037,10 +10779,10 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Interface() p
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.DataCommitment != nil {
-		value := protoreflect.ValueOfMessage(x.DataCommitment.ProtoReflect())
-		if !f(fd_QueryDataCommitmentRangeForHeightResponse_data_commitment, value) {
+func (x *fastReflection_QueryEvmAddressResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EvmAddress != "" {
+		value := protoreflect.ValueOfString(x.EvmAddress)
+		if !f(fd_QueryEvmAddressResponse_evm_address, value) {
 			return
 		}
 	}
@@ -6057,15 +10799,15 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Range(f func(
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryEvmAddressResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse.data_commitment":
-		return x.DataCommitment != nil
+	case "sunrise.blobstream.v1.QueryEvmAddressResponse.evm_address":
+		return x.EvmAddress != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6075,15 +10817,15 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Has(fd protor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryEvmAddressResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse.data_commitment":
-		x.DataCommitment = nil
+	case "sunrise.blobstream.v1.QueryEvmAddressResponse.evm_address":
+		x.EvmAddress = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6093,16 +10835,16 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Clear(fd prot
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEvmAddressResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse.data_commitment":
-		value := x.DataCommitment
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "sunrise.blobstream.v1.QueryEvmAddressResponse.evm_address":
+		value := x.EvmAddress
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6116,15 +10858,15 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Get(descripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryEvmAddressResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse.data_commitment":
-		x.DataCommitment = value.Message().Interface().(*DataCommitment)
+	case "sunrise.blobstream.v1.QueryEvmAddressResponse.evm_address":
+		x.EvmAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6138,44 +10880,40 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Set(fd protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEvmAddressResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse.data_commitment":
-		if x.DataCommitment == nil {
-			x.DataCommitment = new(DataCommitment)
-		}
-		return protoreflect.ValueOfMessage(x.DataCommitment.ProtoReflect())
+	case "sunrise.blobstream.v1.QueryEvmAddressResponse.evm_address":
+		panic(fmt.Errorf("field evm_address of message sunrise.blobstream.v1.QueryEvmAddressResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEvmAddressResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse.data_commitment":
-		m := new(DataCommitment)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "sunrise.blobstream.v1.QueryEvmAddressResponse.evm_address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryEvmAddressResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryEvmAddressResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6183,7 +10921,7 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) WhichOneof(d
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryEvmAddressResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6194,7 +10932,7 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) GetUnknown()
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryEvmAddressResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6206,7 +10944,7 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) SetUnknown(fi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) IsValid() bool {
+func (x *fastReflection_QueryEvmAddressResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6216,9 +10954,9 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) IsValid() boo
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryEvmAddressResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryDataCommitmentRangeForHeightResponse)
+		x := input.Message.Interface().(*QueryEvmAddressResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6230,8 +10968,8 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) ProtoMethods(
 		var n int
 		var l int
 		_ = l
-		if x.DataCommitment != nil {
-			l = options.Size(x.DataCommitment)
+		l = len(x.EvmAddress)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6244,7 +10982,7 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) ProtoMethods(
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDataCommitmentRangeForHeightResponse)
+		x := input.Message.Interface().(*QueryEvmAddressResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6263,17 +11001,10 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) ProtoMethods(
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.DataCommitment != nil {
-			encoded, err := options.Marshal(x.DataCommitment)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.EvmAddress) > 0 {
+			i -= len(x.EvmAddress)
+			copy(dAtA[i:], x.EvmAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmAddress)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6288,7 +11019,7 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) ProtoMethods(
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryDataCommitmentRangeForHeightResponse)
+		x := input.Message.Interface().(*QueryEvmAddressResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6320,17 +11051,17 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) ProtoMethods(
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataCommitmentRangeForHeightResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEvmAddressResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDataCommitmentRangeForHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEvmAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataCommitment", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6340,27 +11071,23 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) ProtoMethods(
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.DataCommitment == nil {
-					x.DataCommitment = &DataCommitment{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DataCommitment); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.EvmAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6398,26 +11125,28 @@ func (x *fastReflection_QueryDataCommitmentRangeForHeightResponse) ProtoMethods(
 }
 
 var (
-	md_QueryEvmAddressRequest                   protoreflect.MessageDescriptor
-	fd_QueryEvmAddressRequest_validator_address protoreflect.FieldDescriptor
+	md_QueryEvmAddressForNonceRequest                   protoreflect.MessageDescriptor
+	fd_QueryEvmAddressForNonceRequest_validator_address protoreflect.FieldDescriptor
+	fd_QueryEvmAddressForNonceRequest_nonce             protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_blobstream_v1_query_proto_init()
-	md_QueryEvmAddressRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryEvmAddressRequest")
-	fd_QueryEvmAddressRequest_validator_address = md_QueryEvmAddressRequest.Fields().ByName("validator_address")
+	md_QueryEvmAddressForNonceRequest = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryEvmAddressForNonceRequest")
+	fd_QueryEvmAddressForNonceRequest_validator_address = md_QueryEvmAddressForNonceRequest.Fields().ByName("validator_address")
+	fd_QueryEvmAddressForNonceRequest_nonce = md_QueryEvmAddressForNonceRequest.Fields().ByName("nonce")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryEvmAddressRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryEvmAddressForNonceRequest)(nil)
 
-type fastReflection_QueryEvmAddressRequest QueryEvmAddressRequest
+type fastReflection_QueryEvmAddressForNonceRequest QueryEvmAddressForNonceRequest
 
-func (x *QueryEvmAddressRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryEvmAddressRequest)(x)
+func (x *QueryEvmAddressForNonceRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEvmAddressForNonceRequest)(x)
 }
 
-func (x *QueryEvmAddressRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[16]
+func (x *QueryEvmAddressForNonceRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6428,43 +11157,43 @@ func (x *QueryEvmAddressRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryEvmAddressRequest_messageType fastReflection_QueryEvmAddressRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryEvmAddressRequest_messageType{}
+var _fastReflection_QueryEvmAddressForNonceRequest_messageType fastReflection_QueryEvmAddressForNonceRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEvmAddressForNonceRequest_messageType{}
 
-type fastReflection_QueryEvmAddressRequest_messageType struct{}
+type fastReflection_QueryEvmAddressForNonceRequest_messageType struct{}
 
-func (x fastReflection_QueryEvmAddressRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryEvmAddressRequest)(nil)
+func (x fastReflection_QueryEvmAddressForNonceRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEvmAddressForNonceRequest)(nil)
 }
-func (x fastReflection_QueryEvmAddressRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryEvmAddressRequest)
+func (x fastReflection_QueryEvmAddressForNonceRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEvmAddressForNonceRequest)
 }
-func (x fastReflection_QueryEvmAddressRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryEvmAddressRequest
+func (x fastReflection_QueryEvmAddressForNonceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEvmAddressForNonceRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryEvmAddressRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryEvmAddressRequest
+func (x *fastReflection_QueryEvmAddressForNonceRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEvmAddressForNonceRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryEvmAddressRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryEvmAddressRequest_messageType
+func (x *fastReflection_QueryEvmAddressForNonceRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEvmAddressForNonceRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryEvmAddressRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryEvmAddressRequest)
+func (x *fastReflection_QueryEvmAddressForNonceRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEvmAddressForNonceRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryEvmAddressRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryEvmAddressRequest)(x)
+func (x *fastReflection_QueryEvmAddressForNonceRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEvmAddressForNonceRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6472,10 +11201,16 @@ func (x *fastReflection_QueryEvmAddressRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryEvmAddressRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryEvmAddressForNonceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ValidatorAddress != "" {
 		value := protoreflect.ValueOfString(x.ValidatorAddress)
-		if !f(fd_QueryEvmAddressRequest_validator_address, value) {
+		if !f(fd_QueryEvmAddressForNonceRequest_validator_address, value) {
+			return
+		}
+	}
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_QueryEvmAddressForNonceRequest_nonce, value) {
 			return
 		}
 	}
@@ -6492,15 +11227,17 @@ func (x *fastReflection_QueryEvmAddressRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryEvmAddressRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryEvmAddressForNonceRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryEvmAddressRequest.validator_address":
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceRequest.validator_address":
 		return x.ValidatorAddress != ""
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceRequest.nonce":
+		return x.Nonce != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressForNonceRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressForNonceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6510,15 +11247,17 @@ func (x *fastReflection_QueryEvmAddressRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEvmAddressRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryEvmAddressForNonceRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryEvmAddressRequest.validator_address":
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceRequest.validator_address":
 		x.ValidatorAddress = ""
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceRequest.nonce":
+		x.Nonce = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressForNonceRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressForNonceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6528,16 +11267,19 @@ func (x *fastReflection_QueryEvmAddressRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryEvmAddressRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEvmAddressForNonceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.blobstream.v1.QueryEvmAddressRequest.validator_address":
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceRequest.validator_address":
 		value := x.ValidatorAddress
 		return protoreflect.ValueOfString(value)
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceRequest.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressForNonceRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressForNonceRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6551,15 +11293,17 @@ func (x *fastReflection_QueryEvmAddressRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEvmAddressRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryEvmAddressForNonceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryEvmAddressRequest.validator_address":
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceRequest.validator_address":
 		x.ValidatorAddress = value.Interface().(string)
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceRequest.nonce":
+		x.Nonce = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressForNonceRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressForNonceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6573,40 +11317,44 @@ func (x *fastReflection_QueryEvmAddressRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEvmAddressRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEvmAddressForNonceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryEvmAddressRequest.validator_address":
-		panic(fmt.Errorf("field validator_address of message sunrise.blobstream.v1.QueryEvmAddressRequest is not mutable"))
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceRequest.validator_address":
+		panic(fmt.Errorf("field validator_address of message sunrise.blobstream.v1.QueryEvmAddressForNonceRequest is not mutable"))
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceRequest.nonce":
+		panic(fmt.Errorf("field nonce of message sunrise.blobstream.v1.QueryEvmAddressForNonceRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressForNonceRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressForNonceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryEvmAddressRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEvmAddressForNonceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryEvmAddressRequest.validator_address":
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceRequest.validator_address":
 		return protoreflect.ValueOfString("")
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceRequest.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressForNonceRequest"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressForNonceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryEvmAddressRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryEvmAddressForNonceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryEvmAddressRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryEvmAddressForNonceRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6614,7 +11362,7 @@ func (x *fastReflection_QueryEvmAddressRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryEvmAddressRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryEvmAddressForNonceRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6625,7 +11373,7 @@ func (x *fastReflection_QueryEvmAddressRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEvmAddressRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryEvmAddressForNonceRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6637,7 +11385,7 @@ func (x *fastReflection_QueryEvmAddressRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryEvmAddressRequest) IsValid() bool {
+func (x *fastReflection_QueryEvmAddressForNonceRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6647,9 +11395,9 @@ func (x *fastReflection_QueryEvmAddressRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryEvmAddressRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryEvmAddressForNonceRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryEvmAddressRequest)
+		x := input.Message.Interface().(*QueryEvmAddressForNonceRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6665,6 +11413,9 @@ func (x *fastReflection_QueryEvmAddressRequest) ProtoMethods() *protoiface.Metho
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6675,7 +11426,7 @@ func (x *fastReflection_QueryEvmAddressRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryEvmAddressRequest)
+		x := input.Message.Interface().(*QueryEvmAddressForNonceRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6694,6 +11445,11 @@ func (x *fastReflection_QueryEvmAddressRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x10
+		}
 		if len(x.ValidatorAddress) > 0 {
 			i -= len(x.ValidatorAddress)
 			copy(dAtA[i:], x.ValidatorAddress)
@@ -6712,7 +11468,7 @@ func (x *fastReflection_QueryEvmAddressRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryEvmAddressRequest)
+		x := input.Message.Interface().(*QueryEvmAddressForNonceRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6744,10 +11500,10 @@ func (x *fastReflection_QueryEvmAddressRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEvmAddressRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEvmAddressForNonceRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEvmAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEvmAddressForNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6782,6 +11538,25 @@ func (x *fastReflection_QueryEvmAddressRequest) ProtoMethods() *protoiface.Metho
 				}
 				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6818,26 +11593,26 @@ func (x *fastReflection_QueryEvmAddressRequest) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryEvmAddressResponse             protoreflect.MessageDescriptor
-	fd_QueryEvmAddressResponse_evm_address protoreflect.FieldDescriptor
+	md_QueryEvmAddressForNonceResponse             protoreflect.MessageDescriptor
+	fd_QueryEvmAddressForNonceResponse_evm_address protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_sunrise_blobstream_v1_query_proto_init()
-	md_QueryEvmAddressResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryEvmAddressResponse")
-	fd_QueryEvmAddressResponse_evm_address = md_QueryEvmAddressResponse.Fields().ByName("evm_address")
+	md_QueryEvmAddressForNonceResponse = File_sunrise_blobstream_v1_query_proto.Messages().ByName("QueryEvmAddressForNonceResponse")
+	fd_QueryEvmAddressForNonceResponse_evm_address = md_QueryEvmAddressForNonceResponse.Fields().ByName("evm_address")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryEvmAddressResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryEvmAddressForNonceResponse)(nil)
 
-type fastReflection_QueryEvmAddressResponse QueryEvmAddressResponse
+type fastReflection_QueryEvmAddressForNonceResponse QueryEvmAddressForNonceResponse
 
-func (x *QueryEvmAddressResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryEvmAddressResponse)(x)
+func (x *QueryEvmAddressForNonceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEvmAddressForNonceResponse)(x)
 }
 
-func (x *QueryEvmAddressResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[17]
+func (x *QueryEvmAddressForNonceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6848,43 +11623,43 @@ func (x *QueryEvmAddressResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryEvmAddressResponse_messageType fastReflection_QueryEvmAddressResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryEvmAddressResponse_messageType{}
+var _fastReflection_QueryEvmAddressForNonceResponse_messageType fastReflection_QueryEvmAddressForNonceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEvmAddressForNonceResponse_messageType{}
 
-type fastReflection_QueryEvmAddressResponse_messageType struct{}
+type fastReflection_QueryEvmAddressForNonceResponse_messageType struct{}
 
-func (x fastReflection_QueryEvmAddressResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryEvmAddressResponse)(nil)
+func (x fastReflection_QueryEvmAddressForNonceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEvmAddressForNonceResponse)(nil)
 }
-func (x fastReflection_QueryEvmAddressResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryEvmAddressResponse)
+func (x fastReflection_QueryEvmAddressForNonceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEvmAddressForNonceResponse)
 }
-func (x fastReflection_QueryEvmAddressResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryEvmAddressResponse
+func (x fastReflection_QueryEvmAddressForNonceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEvmAddressForNonceResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryEvmAddressResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryEvmAddressResponse
+func (x *fastReflection_QueryEvmAddressForNonceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEvmAddressForNonceResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryEvmAddressResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryEvmAddressResponse_messageType
+func (x *fastReflection_QueryEvmAddressForNonceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEvmAddressForNonceResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryEvmAddressResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryEvmAddressResponse)
+func (x *fastReflection_QueryEvmAddressForNonceResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEvmAddressForNonceResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryEvmAddressResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryEvmAddressResponse)(x)
+func (x *fastReflection_QueryEvmAddressForNonceResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEvmAddressForNonceResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6892,10 +11667,10 @@ func (x *fastReflection_QueryEvmAddressResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryEvmAddressResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryEvmAddressForNonceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.EvmAddress != "" {
 		value := protoreflect.ValueOfString(x.EvmAddress)
-		if !f(fd_QueryEvmAddressResponse_evm_address, value) {
+		if !f(fd_QueryEvmAddressForNonceResponse_evm_address, value) {
 			return
 		}
 	}
@@ -6912,15 +11687,15 @@ func (x *fastReflection_QueryEvmAddressResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryEvmAddressResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryEvmAddressForNonceResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryEvmAddressResponse.evm_address":
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceResponse.evm_address":
 		return x.EvmAddress != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressForNonceResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressForNonceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6930,15 +11705,15 @@ func (x *fastReflection_QueryEvmAddressResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEvmAddressResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryEvmAddressForNonceResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryEvmAddressResponse.evm_address":
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceResponse.evm_address":
 		x.EvmAddress = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressForNonceResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressForNonceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6948,16 +11723,16 @@ func (x *fastReflection_QueryEvmAddressResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryEvmAddressResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEvmAddressForNonceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "sunrise.blobstream.v1.QueryEvmAddressResponse.evm_address":
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceResponse.evm_address":
 		value := x.EvmAddress
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressForNonceResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressForNonceResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6971,15 +11746,15 @@ func (x *fastReflection_QueryEvmAddressResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEvmAddressResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryEvmAddressForNonceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryEvmAddressResponse.evm_address":
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceResponse.evm_address":
 		x.EvmAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressForNonceResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressForNonceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6993,40 +11768,40 @@ func (x *fastReflection_QueryEvmAddressResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEvmAddressResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEvmAddressForNonceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryEvmAddressResponse.evm_address":
-		panic(fmt.Errorf("field evm_address of message sunrise.blobstream.v1.QueryEvmAddressResponse is not mutable"))
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceResponse.evm_address":
+		panic(fmt.Errorf("field evm_address of message sunrise.blobstream.v1.QueryEvmAddressForNonceResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressForNonceResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressForNonceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryEvmAddressResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEvmAddressForNonceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "sunrise.blobstream.v1.QueryEvmAddressResponse.evm_address":
+	case "sunrise.blobstream.v1.QueryEvmAddressForNonceResponse.evm_address":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.blobstream.v1.QueryEvmAddressForNonceResponse"))
 		}
-		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message sunrise.blobstream.v1.QueryEvmAddressForNonceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryEvmAddressResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryEvmAddressForNonceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryEvmAddressResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.blobstream.v1.QueryEvmAddressForNonceResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7034,7 +11809,7 @@ func (x *fastReflection_QueryEvmAddressResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryEvmAddressResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryEvmAddressForNonceResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7045,7 +11820,7 @@ func (x *fastReflection_QueryEvmAddressResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryEvmAddressResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryEvmAddressForNonceResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7057,7 +11832,7 @@ func (x *fastReflection_QueryEvmAddressResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryEvmAddressResponse) IsValid() bool {
+func (x *fastReflection_QueryEvmAddressForNonceResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7067,9 +11842,9 @@ func (x *fastReflection_QueryEvmAddressResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryEvmAddressResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryEvmAddressForNonceResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryEvmAddressResponse)
+		x := input.Message.Interface().(*QueryEvmAddressForNonceResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7095,7 +11870,7 @@ func (x *fastReflection_QueryEvmAddressResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryEvmAddressResponse)
+		x := input.Message.Interface().(*QueryEvmAddressForNonceResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7132,7 +11907,7 @@ func (x *fastReflection_QueryEvmAddressResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryEvmAddressResponse)
+		x := input.Message.Interface().(*QueryEvmAddressForNonceResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7164,10 +11939,10 @@ func (x *fastReflection_QueryEvmAddressResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEvmAddressResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEvmAddressForNonceResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEvmAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEvmAddressForNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7389,6 +12164,364 @@ func (x *QueryAttestationRequestByNonceResponse) GetAttestation() *anypb.Any {
 	return nil
 }
 
+// QuerySignedAttestationRequest
+type QuerySignedAttestationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *QuerySignedAttestationRequest) Reset() {
+	*x = QuerySignedAttestationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySignedAttestationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySignedAttestationRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySignedAttestationRequest.ProtoReflect.Descriptor instead.
+func (*QuerySignedAttestationRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QuerySignedAttestationRequest) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+// QuerySignedAttestationResponse
+type QuerySignedAttestationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attestation *anypb.Any `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	// data_root_tuple_root is the commitment signed by the validators if the
+	// attestation is a data commitment.
+	DataRootTupleRoot []byte `protobuf:"bytes,2,opt,name=data_root_tuple_root,json=dataRootTupleRoot,proto3" json:"data_root_tuple_root,omitempty"`
+	// signatures holds the signatures of the signing valset members that
+	// attested to the same sign bytes.
+	Signatures []*AttestationSignature `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *QuerySignedAttestationResponse) Reset() {
+	*x = QuerySignedAttestationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySignedAttestationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySignedAttestationResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySignedAttestationResponse.ProtoReflect.Descriptor instead.
+func (*QuerySignedAttestationResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QuerySignedAttestationResponse) GetAttestation() *anypb.Any {
+	if x != nil {
+		return x.Attestation
+	}
+	return nil
+}
+
+func (x *QuerySignedAttestationResponse) GetDataRootTupleRoot() []byte {
+	if x != nil {
+		return x.DataRootTupleRoot
+	}
+	return nil
+}
+
+func (x *QuerySignedAttestationResponse) GetSignatures() []*AttestationSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// QueryAttestationsRequest
+type QueryAttestationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from_nonce is the nonce of the first attestation to return. Pruned
+	// attestations are skipped.
+	FromNonce uint64 `protobuf:"varint,1,opt,name=from_nonce,json=fromNonce,proto3" json:"from_nonce,omitempty"`
+	// limit is the maximum number of attestations to return. Defaults to, and
+	// is capped at, 100.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// type_filter restricts the attestations to the provided type, if set.
+	TypeFilter AttestationType `protobuf:"varint,3,opt,name=type_filter,json=typeFilter,proto3,enum=sunrise.blobstream.v1.AttestationType" json:"type_filter,omitempty"`
+}
+
+func (x *QueryAttestationsRequest) Reset() {
+	*x = QueryAttestationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAttestationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAttestationsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAttestationsRequest.ProtoReflect.Descriptor instead.
+func (*QueryAttestationsRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryAttestationsRequest) GetFromNonce() uint64 {
+	if x != nil {
+		return x.FromNonce
+	}
+	return 0
+}
+
+func (x *QueryAttestationsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryAttestationsRequest) GetTypeFilter() AttestationType {
+	if x != nil {
+		return x.TypeFilter
+	}
+	return AttestationType_ATTESTATION_TYPE_UNSPECIFIED
+}
+
+// QueryAttestationsResponse
+type QueryAttestationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attestations []*anypb.Any `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	// next_nonce is the from_nonce of the next page. Zero if there are no more
+	// attestations.
+	NextNonce uint64 `protobuf:"varint,2,opt,name=next_nonce,json=nextNonce,proto3" json:"next_nonce,omitempty"`
+}
+
+func (x *QueryAttestationsResponse) Reset() {
+	*x = QueryAttestationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAttestationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAttestationsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAttestationsResponse.ProtoReflect.Descriptor instead.
+func (*QueryAttestationsResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryAttestationsResponse) GetAttestations() []*anypb.Any {
+	if x != nil {
+		return x.Attestations
+	}
+	return nil
+}
+
+func (x *QueryAttestationsResponse) GetNextNonce() uint64 {
+	if x != nil {
+		return x.NextNonce
+	}
+	return 0
+}
+
+// QueryAttestationNonceForHeightRequest
+type QueryAttestationNonceForHeightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *QueryAttestationNonceForHeightRequest) Reset() {
+	*x = QueryAttestationNonceForHeightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAttestationNonceForHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAttestationNonceForHeightRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAttestationNonceForHeightRequest.ProtoReflect.Descriptor instead.
+func (*QueryAttestationNonceForHeightRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryAttestationNonceForHeightRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// QueryAttestationNonceForHeightResponse
+type QueryAttestationNonceForHeightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *QueryAttestationNonceForHeightResponse) Reset() {
+	*x = QueryAttestationNonceForHeightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAttestationNonceForHeightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAttestationNonceForHeightResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAttestationNonceForHeightResponse.ProtoReflect.Descriptor instead.
+func (*QueryAttestationNonceForHeightResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryAttestationNonceForHeightResponse) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+// QueryValsetsInRangeRequest
+type QueryValsetsInRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromNonce uint64 `protobuf:"varint,1,opt,name=from_nonce,json=fromNonce,proto3" json:"from_nonce,omitempty"`
+	ToNonce   uint64 `protobuf:"varint,2,opt,name=to_nonce,json=toNonce,proto3" json:"to_nonce,omitempty"`
+}
+
+func (x *QueryValsetsInRangeRequest) Reset() {
+	*x = QueryValsetsInRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValsetsInRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValsetsInRangeRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryValsetsInRangeRequest.ProtoReflect.Descriptor instead.
+func (*QueryValsetsInRangeRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryValsetsInRangeRequest) GetFromNonce() uint64 {
+	if x != nil {
+		return x.FromNonce
+	}
+	return 0
+}
+
+func (x *QueryValsetsInRangeRequest) GetToNonce() uint64 {
+	if x != nil {
+		return x.ToNonce
+	}
+	return 0
+}
+
+// QueryValsetsInRangeResponse
+type QueryValsetsInRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// valsets holds up to 100 valsets, ordered by nonce.
+	Valsets []*Valset `protobuf:"bytes,1,rep,name=valsets,proto3" json:"valsets,omitempty"`
+	// next_nonce is the from_nonce of the next page. Zero if there are no more
+	// valsets in the range.
+	NextNonce uint64 `protobuf:"varint,2,opt,name=next_nonce,json=nextNonce,proto3" json:"next_nonce,omitempty"`
+}
+
+func (x *QueryValsetsInRangeResponse) Reset() {
+	*x = QueryValsetsInRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValsetsInRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValsetsInRangeResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryValsetsInRangeResponse.ProtoReflect.Descriptor instead.
+func (*QueryValsetsInRangeResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryValsetsInRangeResponse) GetValsets() []*Valset {
+	if x != nil {
+		return x.Valsets
+	}
+	return nil
+}
+
+func (x *QueryValsetsInRangeResponse) GetNextNonce() uint64 {
+	if x != nil {
+		return x.NextNonce
+	}
+	return 0
+}
+
 // QueryLatestAttestationNonceRequest latest attestation nonce request
 type QueryLatestAttestationNonceRequest struct {
 	state         protoimpl.MessageState
@@ -7399,7 +12532,7 @@ type QueryLatestAttestationNonceRequest struct {
 func (x *QueryLatestAttestationNonceRequest) Reset() {
 	*x = QueryLatestAttestationNonceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[4]
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7413,7 +12546,7 @@ func (*QueryLatestAttestationNonceRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryLatestAttestationNonceRequest.ProtoReflect.Descriptor instead.
 func (*QueryLatestAttestationNonceRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{4}
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
 // QueryLatestAttestationNonceResponse latest attestation nonce response
@@ -7428,7 +12561,7 @@ type QueryLatestAttestationNonceResponse struct {
 func (x *QueryLatestAttestationNonceResponse) Reset() {
 	*x = QueryLatestAttestationNonceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[5]
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7442,7 +12575,7 @@ func (*QueryLatestAttestationNonceResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryLatestAttestationNonceResponse.ProtoReflect.Descriptor instead.
 func (*QueryLatestAttestationNonceResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{5}
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *QueryLatestAttestationNonceResponse) GetNonce() uint64 {
@@ -7462,7 +12595,7 @@ type QueryEarliestAttestationNonceRequest struct {
 func (x *QueryEarliestAttestationNonceRequest) Reset() {
 	*x = QueryEarliestAttestationNonceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[6]
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7476,7 +12609,7 @@ func (*QueryEarliestAttestationNonceRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryEarliestAttestationNonceRequest.ProtoReflect.Descriptor instead.
 func (*QueryEarliestAttestationNonceRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{6}
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
 // QueryEarliestAttestationNonceResponse earliest attestation nonce response
@@ -7491,7 +12624,7 @@ type QueryEarliestAttestationNonceResponse struct {
 func (x *QueryEarliestAttestationNonceResponse) Reset() {
 	*x = QueryEarliestAttestationNonceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[7]
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7505,7 +12638,7 @@ func (*QueryEarliestAttestationNonceResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryEarliestAttestationNonceResponse.ProtoReflect.Descriptor instead.
 func (*QueryEarliestAttestationNonceResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{7}
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryEarliestAttestationNonceResponse) GetNonce() uint64 {
@@ -7528,7 +12661,7 @@ type QueryLatestValsetRequestBeforeNonceRequest struct {
 func (x *QueryLatestValsetRequestBeforeNonceRequest) Reset() {
 	*x = QueryLatestValsetRequestBeforeNonceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[8]
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7542,7 +12675,7 @@ func (*QueryLatestValsetRequestBeforeNonceRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryLatestValsetRequestBeforeNonceRequest.ProtoReflect.Descriptor instead.
 func (*QueryLatestValsetRequestBeforeNonceRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{8}
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *QueryLatestValsetRequestBeforeNonceRequest) GetNonce() uint64 {
@@ -7565,7 +12698,7 @@ type QueryLatestValsetRequestBeforeNonceResponse struct {
 func (x *QueryLatestValsetRequestBeforeNonceResponse) Reset() {
 	*x = QueryLatestValsetRequestBeforeNonceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[9]
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7579,7 +12712,7 @@ func (*QueryLatestValsetRequestBeforeNonceResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryLatestValsetRequestBeforeNonceResponse.ProtoReflect.Descriptor instead.
 func (*QueryLatestValsetRequestBeforeNonceResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{9}
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueryLatestValsetRequestBeforeNonceResponse) GetValset() *Valset {
@@ -7599,7 +12732,7 @@ type QueryLatestUnbondingHeightRequest struct {
 func (x *QueryLatestUnbondingHeightRequest) Reset() {
 	*x = QueryLatestUnbondingHeightRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[10]
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7613,7 +12746,7 @@ func (*QueryLatestUnbondingHeightRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryLatestUnbondingHeightRequest.ProtoReflect.Descriptor instead.
 func (*QueryLatestUnbondingHeightRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{10}
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
 // QueryLatestUnbondingHeightResponse
@@ -7628,7 +12761,7 @@ type QueryLatestUnbondingHeightResponse struct {
 func (x *QueryLatestUnbondingHeightResponse) Reset() {
 	*x = QueryLatestUnbondingHeightResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[11]
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7642,7 +12775,7 @@ func (*QueryLatestUnbondingHeightResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryLatestUnbondingHeightResponse.ProtoReflect.Descriptor instead.
 func (*QueryLatestUnbondingHeightResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{11}
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *QueryLatestUnbondingHeightResponse) GetHeight() uint64 {
@@ -7662,7 +12795,7 @@ type QueryLatestDataCommitmentRequest struct {
 func (x *QueryLatestDataCommitmentRequest) Reset() {
 	*x = QueryLatestDataCommitmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[12]
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7676,7 +12809,7 @@ func (*QueryLatestDataCommitmentRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryLatestDataCommitmentRequest.ProtoReflect.Descriptor instead.
 func (*QueryLatestDataCommitmentRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{12}
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
 // QueryLatestDataCommitmentResponse
@@ -7691,7 +12824,7 @@ type QueryLatestDataCommitmentResponse struct {
 func (x *QueryLatestDataCommitmentResponse) Reset() {
 	*x = QueryLatestDataCommitmentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[13]
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7705,7 +12838,7 @@ func (*QueryLatestDataCommitmentResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryLatestDataCommitmentResponse.ProtoReflect.Descriptor instead.
 func (*QueryLatestDataCommitmentResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{13}
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *QueryLatestDataCommitmentResponse) GetDataCommitment() *DataCommitment {
@@ -7727,7 +12860,7 @@ type QueryDataCommitmentRangeForHeightRequest struct {
 func (x *QueryDataCommitmentRangeForHeightRequest) Reset() {
 	*x = QueryDataCommitmentRangeForHeightRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[14]
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7741,7 +12874,7 @@ func (*QueryDataCommitmentRangeForHeightRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryDataCommitmentRangeForHeightRequest.ProtoReflect.Descriptor instead.
 func (*QueryDataCommitmentRangeForHeightRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{14}
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *QueryDataCommitmentRangeForHeightRequest) GetHeight() uint64 {
@@ -7763,7 +12896,7 @@ type QueryDataCommitmentRangeForHeightResponse struct {
 func (x *QueryDataCommitmentRangeForHeightResponse) Reset() {
 	*x = QueryDataCommitmentRangeForHeightResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[15]
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7777,7 +12910,7 @@ func (*QueryDataCommitmentRangeForHeightResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryDataCommitmentRangeForHeightResponse.ProtoReflect.Descriptor instead.
 func (*QueryDataCommitmentRangeForHeightResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{15}
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *QueryDataCommitmentRangeForHeightResponse) GetDataCommitment() *DataCommitment {
@@ -7799,7 +12932,7 @@ type QueryEvmAddressRequest struct {
 func (x *QueryEvmAddressRequest) Reset() {
 	*x = QueryEvmAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[16]
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7813,7 +12946,7 @@ func (*QueryEvmAddressRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryEvmAddressRequest.ProtoReflect.Descriptor instead.
 func (*QueryEvmAddressRequest) Descriptor() ([]byte, []int) {
-	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{16}
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *QueryEvmAddressRequest) GetValidatorAddress() string {
@@ -7835,7 +12968,7 @@ type QueryEvmAddressResponse struct {
 func (x *QueryEvmAddressResponse) Reset() {
 	*x = QueryEvmAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[17]
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7849,7 +12982,7 @@ func (*QueryEvmAddressResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryEvmAddressResponse.ProtoReflect.Descriptor instead.
 func (*QueryEvmAddressResponse) Descriptor() ([]byte, []int) {
-	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{17}
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *QueryEvmAddressResponse) GetEvmAddress() string {
@@ -7859,6 +12992,86 @@ func (x *QueryEvmAddressResponse) GetEvmAddress() string {
 	return ""
 }
 
+// QueryEvmAddressForNonceRequest
+type QueryEvmAddressForNonceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Nonce            uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *QueryEvmAddressForNonceRequest) Reset() {
+	*x = QueryEvmAddressForNonceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEvmAddressForNonceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEvmAddressForNonceRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryEvmAddressForNonceRequest.ProtoReflect.Descriptor instead.
+func (*QueryEvmAddressForNonceRequest) Descriptor() ([]byte, []int) {
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *QueryEvmAddressForNonceRequest) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *QueryEvmAddressForNonceRequest) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+// QueryEvmAddressForNonceResponse
+type QueryEvmAddressForNonceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EvmAddress string `protobuf:"bytes,1,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+}
+
+func (x *QueryEvmAddressForNonceResponse) Reset() {
+	*x = QueryEvmAddressForNonceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_blobstream_v1_query_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEvmAddressForNonceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEvmAddressForNonceResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryEvmAddressForNonceResponse.ProtoReflect.Descriptor instead.
+func (*QueryEvmAddressForNonceResponse) Descriptor() ([]byte, []int) {
+	return file_sunrise_blobstream_v1_query_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *QueryEvmAddressForNonceResponse) GetEvmAddress() string {
+	if x != nil {
+		return x.EvmAddress
+	}
+	return ""
+}
+
 var File_sunrise_blobstream_v1_query_proto protoreflect.FileDescriptor
 
 var file_sunrise_blobstream_v1_query_proto_rawDesc = []byte{
@@ -7899,7 +13112,66 @@ var file_sunrise_blobstream_v1_query_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x17, 0xca,
 	0xb4, 0x2d, 0x13, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65,
+	0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xf5, 0x01, 0x0a, 0x1e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a,
+	0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x17, 0xca, 0xb4, 0x2d, 0x13, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f,
+	0x0a, 0x14, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x74, 0x75, 0x70, 0x6c,
+	0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x6f, 0x6f, 0x74, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x51, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c,
+	0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x47, 0x0a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x75, 0x6e, 0x72,
+	0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x8d, 0x01,
+	0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x17, 0xca, 0xb4, 0x2d, 0x13, 0x41, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x3f, 0x0a,
+	0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x3e,
+	0x0a, 0x26, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x56,
+	0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x73, 0x65, 0x74, 0x73, 0x49, 0x6e,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x6f, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
+	0x6f, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x7b, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
+	0x61, 0x6c, 0x73, 0x65, 0x74, 0x73, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x76, 0x61, 0x6c, 0x73, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x61, 0x6c, 0x73, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x76, 0x61, 0x6c,
+	0x73, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x4e, 0x6f,
+	0x6e, 0x63, 0x65, 0x22, 0x24, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65,
 	0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e,
 	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3b, 0x0a, 0x23, 0x51, 0x75, 0x65,
 	0x72, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
@@ -7958,131 +13230,201 @@ var file_sunrise_blobstream_v1_query_proto_rawDesc = []byte{
 	0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x6d, 0x5f, 0x61, 0x64,
 	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x6d,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x32, 0xe8, 0x0d, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x86, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x2e, 0x73,
-	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
-	0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x73, 0x75,
-	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xd6, 0x01, 0x0a, 0x19, 0x41,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x63, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x45, 0x76, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6f, 0x72, 0x4e, 0x6f, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x42, 0x0a, 0x1f,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46,
+	0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x32, 0x9c, 0x15, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x86, 0x01, 0x0a, 0x06, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62,
+	0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0xd6, 0x01, 0x0a, 0x19, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x4e, 0x6f, 0x6e, 0x63,
+	0x65, 0x12, 0x3c, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
 	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x42, 0x79, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x3c, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69,
-	0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
-	0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f,
-	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x7d, 0x12, 0xc9, 0x01, 0x0a, 0x16, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x41, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x39,
-	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65,
-	0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x73, 0x75, 0x6e, 0x72,
-	0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f,
-	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12,
-	0xd1, 0x01, 0x0a, 0x18, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65,
-	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x2e, 0x73,
-	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65,
-	0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x73, 0x75, 0x6e, 0x72,
-	0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x41,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52,
+	0x74, 0x42, 0x79, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x3d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x79, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x7d, 0x12, 0xc9, 0x01, 0x0a,
+	0x16, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f,
+	0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x2f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0xd1, 0x01, 0x0a, 0x18, 0x45, 0x61, 0x72,
+	0x6c, 0x69, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f,
+	0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69,
+	0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x2f, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x12, 0xe5, 0x01, 0x0a,
+	0x1e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
+	0x41, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x42, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f,
+	0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34,
+	0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x73, 0x65, 0x74, 0x2f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x2f, 0x7b, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x7d, 0x12, 0xbc, 0x01, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x2e, 0x73, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x35, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12,
 	0x32, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74,
 	0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2f, 0x65, 0x61, 0x72, 0x6c, 0x69,
-	0x65, 0x73, 0x74, 0x12, 0xe5, 0x01, 0x0a, 0x1e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x61,
-	0x6c, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72,
-	0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x41, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
-	0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x73, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x42, 0x2e, 0x73, 0x75, 0x6e, 0x72,
-	0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
-	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f,
-	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61,
-	0x6c, 0x73, 0x65, 0x74, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2f, 0x62, 0x65, 0x66,
-	0x6f, 0x72, 0x65, 0x2f, 0x7b, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x7d, 0x12, 0xb6, 0x01, 0x0a, 0x15,
-	0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x2f, 0x7b, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x7d, 0x12, 0x9e, 0x01, 0x0a, 0x0c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62,
+	0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
 	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x39, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x22, 0x12, 0x20, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f,
-	0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x6e, 0x62, 0x6f, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x12, 0xde, 0x01, 0x0a, 0x1c, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3f, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12,
+	0x23, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0xdb, 0x01, 0x0a, 0x19, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x3c, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f,
+	0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x46, 0x6f, 0x72, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x3d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x46, 0x6f,
+	0x72, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12, 0x39, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x2f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2f, 0x7b, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x7d, 0x12, 0x9f, 0x01, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x73, 0x65, 0x74, 0x73, 0x49, 0x6e,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x31, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
 	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
-	0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35,
-	0x12, 0x33, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x2f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0xc0, 0x01, 0x0a, 0x14, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x37,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x73, 0x65, 0x74, 0x73, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69,
+	0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x73, 0x65, 0x74, 0x73, 0x49, 0x6e, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62,
+	0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c,
+	0x73, 0x65, 0x74, 0x73, 0x12, 0xb6, 0x01, 0x0a, 0x15, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x55,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38,
 	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72,
 	0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65,
-	0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
-	0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x73, 0x75, 0x6e, 0x72,
+	0x73, 0x74, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69,
+	0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x55, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x2f, 0x76, 0x31, 0x2f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0xde, 0x01,
+	0x0a, 0x1c, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3f,
+	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46,
+	0x6f, 0x72, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x40, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x46, 0x6f, 0x72, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x73, 0x75, 0x6e, 0x72,
 	0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76,
 	0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x2f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x97, 0x01, 0x0a, 0x0a, 0x45, 0x76, 0x6d,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x74, 0x2f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x2f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0xc0,
+	0x01, 0x0a, 0x14, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
 	0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
-	0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22,
-	0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x42, 0xd2, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69,
-	0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31,
-	0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x42, 0x58, 0xaa, 0x02, 0x15, 0x53, 0x75, 0x6e, 0x72,
-	0x69, 0x73, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x15, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x42, 0x6c, 0x6f, 0x62,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x53, 0x75, 0x6e, 0x72,
-	0x69, 0x73, 0x65, 0x5c, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17,
-	0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x38, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f,
+	0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x12, 0x97, 0x01, 0x0a, 0x0a, 0x45, 0x76, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x2d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76,
+	0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2e, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x6d,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
+	0x65, 0x76, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0xd1, 0x01, 0x0a, 0x12,
+	0x45, 0x76, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6f, 0x72, 0x4e, 0x6f, 0x6e,
+	0x63, 0x65, 0x12, 0x35, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f,
+	0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x45, 0x76, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6f, 0x72, 0x4e, 0x6f, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x73, 0x75, 0x6e, 0x72,
+	0x69, 0x73, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x46, 0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x46, 0x12, 0x44, 0x2f, 0x73, 0x75, 0x6e, 0x72,
+	0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x76, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x7b, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x7d, 0x2f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x7d, 0x42,
+	0xd2, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x53, 0x42, 0x58, 0xaa, 0x02, 0x15, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x15, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x5c, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x53, 0x75, 0x6e,
+	0x72, 0x69, 0x73, 0x65, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8097,60 +13439,87 @@ func file_sunrise_blobstream_v1_query_proto_rawDescGZIP() []byte {
 	return file_sunrise_blobstream_v1_query_proto_rawDescData
 }
 
-var file_sunrise_blobstream_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_sunrise_blobstream_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_sunrise_blobstream_v1_query_proto_goTypes = []interface{}{
 	(*QueryParamsRequest)(nil),                          // 0: sunrise.blobstream.v1.QueryParamsRequest
 	(*QueryParamsResponse)(nil),                         // 1: sunrise.blobstream.v1.QueryParamsResponse
 	(*QueryAttestationRequestByNonceRequest)(nil),       // 2: sunrise.blobstream.v1.QueryAttestationRequestByNonceRequest
 	(*QueryAttestationRequestByNonceResponse)(nil),      // 3: sunrise.blobstream.v1.QueryAttestationRequestByNonceResponse
-	(*QueryLatestAttestationNonceRequest)(nil),          // 4: sunrise.blobstream.v1.QueryLatestAttestationNonceRequest
-	(*QueryLatestAttestationNonceResponse)(nil),         // 5: sunrise.blobstream.v1.QueryLatestAttestationNonceResponse
-	(*QueryEarliestAttestationNonceRequest)(nil),        // 6: sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest
-	(*QueryEarliestAttestationNonceResponse)(nil),       // 7: sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse
-	(*QueryLatestValsetRequestBeforeNonceRequest)(nil),  // 8: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest
-	(*QueryLatestValsetRequestBeforeNonceResponse)(nil), // 9: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse
-	(*QueryLatestUnbondingHeightRequest)(nil),           // 10: sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest
-	(*QueryLatestUnbondingHeightResponse)(nil),          // 11: sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse
-	(*QueryLatestDataCommitmentRequest)(nil),            // 12: sunrise.blobstream.v1.QueryLatestDataCommitmentRequest
-	(*QueryLatestDataCommitmentResponse)(nil),           // 13: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse
-	(*QueryDataCommitmentRangeForHeightRequest)(nil),    // 14: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest
-	(*QueryDataCommitmentRangeForHeightResponse)(nil),   // 15: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse
-	(*QueryEvmAddressRequest)(nil),                      // 16: sunrise.blobstream.v1.QueryEvmAddressRequest
-	(*QueryEvmAddressResponse)(nil),                     // 17: sunrise.blobstream.v1.QueryEvmAddressResponse
-	(*Params)(nil),                                      // 18: sunrise.blobstream.v1.Params
-	(*anypb.Any)(nil),                                   // 19: google.protobuf.Any
-	(*Valset)(nil),                                      // 20: sunrise.blobstream.v1.Valset
-	(*DataCommitment)(nil),                              // 21: sunrise.blobstream.v1.DataCommitment
+	(*QuerySignedAttestationRequest)(nil),               // 4: sunrise.blobstream.v1.QuerySignedAttestationRequest
+	(*QuerySignedAttestationResponse)(nil),              // 5: sunrise.blobstream.v1.QuerySignedAttestationResponse
+	(*QueryAttestationsRequest)(nil),                    // 6: sunrise.blobstream.v1.QueryAttestationsRequest
+	(*QueryAttestationsResponse)(nil),                   // 7: sunrise.blobstream.v1.QueryAttestationsResponse
+	(*QueryAttestationNonceForHeightRequest)(nil),       // 8: sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest
+	(*QueryAttestationNonceForHeightResponse)(nil),      // 9: sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse
+	(*QueryValsetsInRangeRequest)(nil),                  // 10: sunrise.blobstream.v1.QueryValsetsInRangeRequest
+	(*QueryValsetsInRangeResponse)(nil),                 // 11: sunrise.blobstream.v1.QueryValsetsInRangeResponse
+	(*QueryLatestAttestationNonceRequest)(nil),          // 12: sunrise.blobstream.v1.QueryLatestAttestationNonceRequest
+	(*QueryLatestAttestationNonceResponse)(nil),         // 13: sunrise.blobstream.v1.QueryLatestAttestationNonceResponse
+	(*QueryEarliestAttestationNonceRequest)(nil),        // 14: sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest
+	(*QueryEarliestAttestationNonceResponse)(nil),       // 15: sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse
+	(*QueryLatestValsetRequestBeforeNonceRequest)(nil),  // 16: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest
+	(*QueryLatestValsetRequestBeforeNonceResponse)(nil), // 17: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse
+	(*QueryLatestUnbondingHeightRequest)(nil),           // 18: sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest
+	(*QueryLatestUnbondingHeightResponse)(nil),          // 19: sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse
+	(*QueryLatestDataCommitmentRequest)(nil),            // 20: sunrise.blobstream.v1.QueryLatestDataCommitmentRequest
+	(*QueryLatestDataCommitmentResponse)(nil),           // 21: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse
+	(*QueryDataCommitmentRangeForHeightRequest)(nil),    // 22: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest
+	(*QueryDataCommitmentRangeForHeightResponse)(nil),   // 23: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse
+	(*QueryEvmAddressRequest)(nil),                      // 24: sunrise.blobstream.v1.QueryEvmAddressRequest
+	(*QueryEvmAddressResponse)(nil),                     // 25: sunrise.blobstream.v1.QueryEvmAddressResponse
+	(*QueryEvmAddressForNonceRequest)(nil),              // 26: sunrise.blobstream.v1.QueryEvmAddressForNonceRequest
+	(*QueryEvmAddressForNonceResponse)(nil),             // 27: sunrise.blobstream.v1.QueryEvmAddressForNonceResponse
+	(*Params)(nil),                                      // 28: sunrise.blobstream.v1.Params
+	(*anypb.Any)(nil),                                   // 29: google.protobuf.Any
+	(*AttestationSignature)(nil),                        // 30: sunrise.blobstream.v1.AttestationSignature
+	(AttestationType)(0),                                // 31: sunrise.blobstream.v1.AttestationType
+	(*Valset)(nil),                                      // 32: sunrise.blobstream.v1.Valset
+	(*DataCommitment)(nil),                              // 33: sunrise.blobstream.v1.DataCommitment
 }
 var file_sunrise_blobstream_v1_query_proto_depIdxs = []int32{
-	18, // 0: sunrise.blobstream.v1.QueryParamsResponse.params:type_name -> sunrise.blobstream.v1.Params
-	19, // 1: sunrise.blobstream.v1.QueryAttestationRequestByNonceResponse.attestation:type_name -> google.protobuf.Any
-	20, // 2: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse.valset:type_name -> sunrise.blobstream.v1.Valset
-	21, // 3: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse.data_commitment:type_name -> sunrise.blobstream.v1.DataCommitment
-	21, // 4: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse.data_commitment:type_name -> sunrise.blobstream.v1.DataCommitment
-	0,  // 5: sunrise.blobstream.v1.Query.Params:input_type -> sunrise.blobstream.v1.QueryParamsRequest
-	2,  // 6: sunrise.blobstream.v1.Query.AttestationRequestByNonce:input_type -> sunrise.blobstream.v1.QueryAttestationRequestByNonceRequest
-	4,  // 7: sunrise.blobstream.v1.Query.LatestAttestationNonce:input_type -> sunrise.blobstream.v1.QueryLatestAttestationNonceRequest
-	6,  // 8: sunrise.blobstream.v1.Query.EarliestAttestationNonce:input_type -> sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest
-	8,  // 9: sunrise.blobstream.v1.Query.LatestValsetRequestBeforeNonce:input_type -> sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest
-	10, // 10: sunrise.blobstream.v1.Query.LatestUnbondingHeight:input_type -> sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest
-	14, // 11: sunrise.blobstream.v1.Query.DataCommitmentRangeForHeight:input_type -> sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest
-	12, // 12: sunrise.blobstream.v1.Query.LatestDataCommitment:input_type -> sunrise.blobstream.v1.QueryLatestDataCommitmentRequest
-	16, // 13: sunrise.blobstream.v1.Query.EvmAddress:input_type -> sunrise.blobstream.v1.QueryEvmAddressRequest
-	1,  // 14: sunrise.blobstream.v1.Query.Params:output_type -> sunrise.blobstream.v1.QueryParamsResponse
-	3,  // 15: sunrise.blobstream.v1.Query.AttestationRequestByNonce:output_type -> sunrise.blobstream.v1.QueryAttestationRequestByNonceResponse
-	5,  // 16: sunrise.blobstream.v1.Query.LatestAttestationNonce:output_type -> sunrise.blobstream.v1.QueryLatestAttestationNonceResponse
-	7,  // 17: sunrise.blobstream.v1.Query.EarliestAttestationNonce:output_type -> sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse
-	9,  // 18: sunrise.blobstream.v1.Query.LatestValsetRequestBeforeNonce:output_type -> sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse
-	11, // 19: sunrise.blobstream.v1.Query.LatestUnbondingHeight:output_type -> sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse
-	15, // 20: sunrise.blobstream.v1.Query.DataCommitmentRangeForHeight:output_type -> sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse
-	13, // 21: sunrise.blobstream.v1.Query.LatestDataCommitment:output_type -> sunrise.blobstream.v1.QueryLatestDataCommitmentResponse
-	17, // 22: sunrise.blobstream.v1.Query.EvmAddress:output_type -> sunrise.blobstream.v1.QueryEvmAddressResponse
-	14, // [14:23] is the sub-list for method output_type
-	5,  // [5:14] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	28, // 0: sunrise.blobstream.v1.QueryParamsResponse.params:type_name -> sunrise.blobstream.v1.Params
+	29, // 1: sunrise.blobstream.v1.QueryAttestationRequestByNonceResponse.attestation:type_name -> google.protobuf.Any
+	29, // 2: sunrise.blobstream.v1.QuerySignedAttestationResponse.attestation:type_name -> google.protobuf.Any
+	30, // 3: sunrise.blobstream.v1.QuerySignedAttestationResponse.signatures:type_name -> sunrise.blobstream.v1.AttestationSignature
+	31, // 4: sunrise.blobstream.v1.QueryAttestationsRequest.type_filter:type_name -> sunrise.blobstream.v1.AttestationType
+	29, // 5: sunrise.blobstream.v1.QueryAttestationsResponse.attestations:type_name -> google.protobuf.Any
+	32, // 6: sunrise.blobstream.v1.QueryValsetsInRangeResponse.valsets:type_name -> sunrise.blobstream.v1.Valset
+	32, // 7: sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse.valset:type_name -> sunrise.blobstream.v1.Valset
+	33, // 8: sunrise.blobstream.v1.QueryLatestDataCommitmentResponse.data_commitment:type_name -> sunrise.blobstream.v1.DataCommitment
+	33, // 9: sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse.data_commitment:type_name -> sunrise.blobstream.v1.DataCommitment
+	0,  // 10: sunrise.blobstream.v1.Query.Params:input_type -> sunrise.blobstream.v1.QueryParamsRequest
+	2,  // 11: sunrise.blobstream.v1.Query.AttestationRequestByNonce:input_type -> sunrise.blobstream.v1.QueryAttestationRequestByNonceRequest
+	12, // 12: sunrise.blobstream.v1.Query.LatestAttestationNonce:input_type -> sunrise.blobstream.v1.QueryLatestAttestationNonceRequest
+	14, // 13: sunrise.blobstream.v1.Query.EarliestAttestationNonce:input_type -> sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest
+	16, // 14: sunrise.blobstream.v1.Query.LatestValsetRequestBeforeNonce:input_type -> sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest
+	4,  // 15: sunrise.blobstream.v1.Query.SignedAttestation:input_type -> sunrise.blobstream.v1.QuerySignedAttestationRequest
+	6,  // 16: sunrise.blobstream.v1.Query.Attestations:input_type -> sunrise.blobstream.v1.QueryAttestationsRequest
+	8,  // 17: sunrise.blobstream.v1.Query.AttestationNonceForHeight:input_type -> sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest
+	10, // 18: sunrise.blobstream.v1.Query.ValsetsInRange:input_type -> sunrise.blobstream.v1.QueryValsetsInRangeRequest
+	18, // 19: sunrise.blobstream.v1.Query.LatestUnbondingHeight:input_type -> sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest
+	22, // 20: sunrise.blobstream.v1.Query.DataCommitmentRangeForHeight:input_type -> sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest
+	20, // 21: sunrise.blobstream.v1.Query.LatestDataCommitment:input_type -> sunrise.blobstream.v1.QueryLatestDataCommitmentRequest
+	24, // 22: sunrise.blobstream.v1.Query.EvmAddress:input_type -> sunrise.blobstream.v1.QueryEvmAddressRequest
+	26, // 23: sunrise.blobstream.v1.Query.EvmAddressForNonce:input_type -> sunrise.blobstream.v1.QueryEvmAddressForNonceRequest
+	1,  // 24: sunrise.blobstream.v1.Query.Params:output_type -> sunrise.blobstream.v1.QueryParamsResponse
+	3,  // 25: sunrise.blobstream.v1.Query.AttestationRequestByNonce:output_type -> sunrise.blobstream.v1.QueryAttestationRequestByNonceResponse
+	13, // 26: sunrise.blobstream.v1.Query.LatestAttestationNonce:output_type -> sunrise.blobstream.v1.QueryLatestAttestationNonceResponse
+	15, // 27: sunrise.blobstream.v1.Query.EarliestAttestationNonce:output_type -> sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse
+	17, // 28: sunrise.blobstream.v1.Query.LatestValsetRequestBeforeNonce:output_type -> sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse
+	5,  // 29: sunrise.blobstream.v1.Query.SignedAttestation:output_type -> sunrise.blobstream.v1.QuerySignedAttestationResponse
+	7,  // 30: sunrise.blobstream.v1.Query.Attestations:output_type -> sunrise.blobstream.v1.QueryAttestationsResponse
+	9,  // 31: sunrise.blobstream.v1.Query.AttestationNonceForHeight:output_type -> sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse
+	11, // 32: sunrise.blobstream.v1.Query.ValsetsInRange:output_type -> sunrise.blobstream.v1.QueryValsetsInRangeResponse
+	19, // 33: sunrise.blobstream.v1.Query.LatestUnbondingHeight:output_type -> sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse
+	23, // 34: sunrise.blobstream.v1.Query.DataCommitmentRangeForHeight:output_type -> sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse
+	21, // 35: sunrise.blobstream.v1.Query.LatestDataCommitment:output_type -> sunrise.blobstream.v1.QueryLatestDataCommitmentResponse
+	25, // 36: sunrise.blobstream.v1.Query.EvmAddress:output_type -> sunrise.blobstream.v1.QueryEvmAddressResponse
+	27, // 37: sunrise.blobstream.v1.Query.EvmAddressForNonce:output_type -> sunrise.blobstream.v1.QueryEvmAddressForNonceResponse
+	24, // [24:38] is the sub-list for method output_type
+	10, // [10:24] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_sunrise_blobstream_v1_query_proto_init() }
@@ -8210,7 +13579,7 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 			}
 		}
 		file_sunrise_blobstream_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryLatestAttestationNonceRequest); i {
+			switch v := v.(*QuerySignedAttestationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8222,7 +13591,7 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 			}
 		}
 		file_sunrise_blobstream_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryLatestAttestationNonceResponse); i {
+			switch v := v.(*QuerySignedAttestationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8234,7 +13603,7 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 			}
 		}
 		file_sunrise_blobstream_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryEarliestAttestationNonceRequest); i {
+			switch v := v.(*QueryAttestationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8246,7 +13615,7 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 			}
 		}
 		file_sunrise_blobstream_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryEarliestAttestationNonceResponse); i {
+			switch v := v.(*QueryAttestationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8258,7 +13627,7 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 			}
 		}
 		file_sunrise_blobstream_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryLatestValsetRequestBeforeNonceRequest); i {
+			switch v := v.(*QueryAttestationNonceForHeightRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8270,7 +13639,7 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 			}
 		}
 		file_sunrise_blobstream_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryLatestValsetRequestBeforeNonceResponse); i {
+			switch v := v.(*QueryAttestationNonceForHeightResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8282,7 +13651,7 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 			}
 		}
 		file_sunrise_blobstream_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryLatestUnbondingHeightRequest); i {
+			switch v := v.(*QueryValsetsInRangeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8294,7 +13663,7 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 			}
 		}
 		file_sunrise_blobstream_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryLatestUnbondingHeightResponse); i {
+			switch v := v.(*QueryValsetsInRangeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8306,7 +13675,7 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 			}
 		}
 		file_sunrise_blobstream_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryLatestDataCommitmentRequest); i {
+			switch v := v.(*QueryLatestAttestationNonceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8318,7 +13687,7 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 			}
 		}
 		file_sunrise_blobstream_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryLatestDataCommitmentResponse); i {
+			switch v := v.(*QueryLatestAttestationNonceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8330,7 +13699,7 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 			}
 		}
 		file_sunrise_blobstream_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryDataCommitmentRangeForHeightRequest); i {
+			switch v := v.(*QueryEarliestAttestationNonceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8342,7 +13711,7 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 			}
 		}
 		file_sunrise_blobstream_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryDataCommitmentRangeForHeightResponse); i {
+			switch v := v.(*QueryEarliestAttestationNonceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8354,7 +13723,7 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 			}
 		}
 		file_sunrise_blobstream_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryEvmAddressRequest); i {
+			switch v := v.(*QueryLatestValsetRequestBeforeNonceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8366,6 +13735,102 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 			}
 		}
 		file_sunrise_blobstream_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLatestValsetRequestBeforeNonceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_blobstream_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLatestUnbondingHeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_blobstream_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLatestUnbondingHeightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_blobstream_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLatestDataCommitmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_blobstream_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLatestDataCommitmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_blobstream_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDataCommitmentRangeForHeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_blobstream_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDataCommitmentRangeForHeightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_blobstream_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEvmAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_blobstream_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryEvmAddressResponse); i {
 			case 0:
 				return &v.state
@@ -8377,6 +13842,30 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_sunrise_blobstream_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEvmAddressForNonceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_blobstream_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEvmAddressForNonceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8384,7 +13873,7 @@ func file_sunrise_blobstream_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sunrise_blobstream_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,10 +24,15 @@ const (
 	Query_LatestAttestationNonce_FullMethodName         = "/sunrise.blobstream.v1.Query/LatestAttestationNonce"
 	Query_EarliestAttestationNonce_FullMethodName       = "/sunrise.blobstream.v1.Query/EarliestAttestationNonce"
 	Query_LatestValsetRequestBeforeNonce_FullMethodName = "/sunrise.blobstream.v1.Query/LatestValsetRequestBeforeNonce"
+	Query_SignedAttestation_FullMethodName              = "/sunrise.blobstream.v1.Query/SignedAttestation"
+	Query_Attestations_FullMethodName                   = "/sunrise.blobstream.v1.Query/Attestations"
+	Query_AttestationNonceForHeight_FullMethodName      = "/sunrise.blobstream.v1.Query/AttestationNonceForHeight"
+	Query_ValsetsInRange_FullMethodName                 = "/sunrise.blobstream.v1.Query/ValsetsInRange"
 	Query_LatestUnbondingHeight_FullMethodName          = "/sunrise.blobstream.v1.Query/LatestUnbondingHeight"
 	Query_DataCommitmentRangeForHeight_FullMethodName   = "/sunrise.blobstream.v1.Query/DataCommitmentRangeForHeight"
 	Query_LatestDataCommitment_FullMethodName           = "/sunrise.blobstream.v1.Query/LatestDataCommitment"
 	Query_EvmAddress_FullMethodName                     = "/sunrise.blobstream.v1.Query/EvmAddress"
+	Query_EvmAddressForNonce_FullMethodName             = "/sunrise.blobstream.v1.Query/EvmAddressForNonce"
 )
 
 // QueryClient is the client API for Query service.
@@ -49,6 +54,20 @@ type QueryClient interface {
 	// If the provided nonce is 1, it will return an error, because, there is
 	// no valset before nonce 1.
 	LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error)
+	// SignedAttestation queries the attestation with the provided nonce along
+	// with the signatures gathered via vote extensions. Returns an error if the
+	// attestation has not been signed yet by two thirds of the signing valset
+	// power.
+	SignedAttestation(ctx context.Context, in *QuerySignedAttestationRequest, opts ...grpc.CallOption) (*QuerySignedAttestationResponse, error)
+	// Attestations queries up to limit attestations, ordered by nonce, starting
+	// from the provided nonce. The attestations can be filtered by type.
+	Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error)
+	// AttestationNonceForHeight queries the nonce of the latest attestation
+	// requested at or before the provided height.
+	AttestationNonceForHeight(ctx context.Context, in *QueryAttestationNonceForHeightRequest, opts ...grpc.CallOption) (*QueryAttestationNonceForHeightResponse, error)
+	// ValsetsInRange queries the valsets whose nonces are between the provided
+	// nonces, inclusive.
+	ValsetsInRange(ctx context.Context, in *QueryValsetsInRangeRequest, opts ...grpc.CallOption) (*QueryValsetsInRangeResponse, error)
 	// LatestUnbondingHeight returns the latest unbonding height
 	LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error)
 	// DataCommitmentRangeForHeight returns the data commitment window
@@ -59,6 +78,10 @@ type QueryClient interface {
 	// EvmAddress returns the evm address associated with a supplied
 	// validator address
 	EvmAddress(ctx context.Context, in *QueryEvmAddressRequest, opts ...grpc.CallOption) (*QueryEvmAddressResponse, error)
+	// EvmAddressForNonce returns the evm address that the supplied validator
+	// must use to sign the attestation with the provided nonce. It can differ
+	// from the registered one if the validator rotated its evm address.
+	EvmAddressForNonce(ctx context.Context, in *QueryEvmAddressForNonceRequest, opts ...grpc.CallOption) (*QueryEvmAddressForNonceResponse, error)
 }
 
 type queryClient struct {
@@ -114,6 +137,42 @@ func (c *queryClient) LatestValsetRequestBeforeNonce(ctx context.Context, in *Qu
 	return out, nil
 }
 
+func (c *queryClient) SignedAttestation(ctx context.Context, in *QuerySignedAttestationRequest, opts ...grpc.CallOption) (*QuerySignedAttestationResponse, error) {
+	out := new(QuerySignedAttestationResponse)
+	err := c.cc.Invoke(ctx, Query_SignedAttestation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error) {
+	out := new(QueryAttestationsResponse)
+	err := c.cc.Invoke(ctx, Query_Attestations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationNonceForHeight(ctx context.Context, in *QueryAttestationNonceForHeightRequest, opts ...grpc.CallOption) (*QueryAttestationNonceForHeightResponse, error) {
+	out := new(QueryAttestationNonceForHeightResponse)
+	err := c.cc.Invoke(ctx, Query_AttestationNonceForHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValsetsInRange(ctx context.Context, in *QueryValsetsInRangeRequest, opts ...grpc.CallOption) (*QueryValsetsInRangeResponse, error) {
+	out := new(QueryValsetsInRangeResponse)
+	err := c.cc.Invoke(ctx, Query_ValsetsInRange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error) {
 	out := new(QueryLatestUnbondingHeightResponse)
 	err := c.cc.Invoke(ctx, Query_LatestUnbondingHeight_FullMethodName, in, out, opts...)
@@ -150,6 +209,15 @@ func (c *queryClient) EvmAddress(ctx context.Context, in *QueryEvmAddressRequest
 	return out, nil
 }
 
+func (c *queryClient) EvmAddressForNonce(ctx context.Context, in *QueryEvmAddressForNonceRequest, opts ...grpc.CallOption) (*QueryEvmAddressForNonceResponse, error) {
+	out := new(QueryEvmAddressForNonceResponse)
+	err := c.cc.Invoke(ctx, Query_EvmAddressForNonce_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -169,6 +237,20 @@ type QueryServer interface {
 	// If the provided nonce is 1, it will return an error, because, there is
 	// no valset before nonce 1.
 	LatestValsetRequestBeforeNonce(context.Context, *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error)
+	// SignedAttestation queries the attestation with the provided nonce along
+	// with the signatures gathered via vote extensions. Returns an error if the
+	// attestation has not been signed yet by two thirds of the signing valset
+	// power.
+	SignedAttestation(context.Context, *QuerySignedAttestationRequest) (*QuerySignedAttestationResponse, error)
+	// Attestations queries up to limit attestations, ordered by nonce, starting
+	// from the provided nonce. The attestations can be filtered by type.
+	Attestations(context.Context, *QueryAttestationsRequest) (*QueryAttestationsResponse, error)
+	// AttestationNonceForHeight queries the nonce of the latest attestation
+	// requested at or before the provided height.
+	AttestationNonceForHeight(context.Context, *QueryAttestationNonceForHeightRequest) (*QueryAttestationNonceForHeightResponse, error)
+	// ValsetsInRange queries the valsets whose nonces are between the provided
+	// nonces, inclusive.
+	ValsetsInRange(context.Context, *QueryValsetsInRangeRequest) (*QueryValsetsInRangeResponse, error)
 	// LatestUnbondingHeight returns the latest unbonding height
 	LatestUnbondingHeight(context.Context, *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error)
 	// DataCommitmentRangeForHeight returns the data commitment window
@@ -179,6 +261,10 @@ type QueryServer interface {
 	// EvmAddress returns the evm address associated with a supplied
 	// validator address
 	EvmAddress(context.Context, *QueryEvmAddressRequest) (*QueryEvmAddressResponse, error)
+	// EvmAddressForNonce returns the evm address that the supplied validator
+	// must use to sign the attestation with the provided nonce. It can differ
+	// from the registered one if the validator rotated its evm address.
+	EvmAddressForNonce(context.Context, *QueryEvmAddressForNonceRequest) (*QueryEvmAddressForNonceResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -201,6 +287,18 @@ func (UnimplementedQueryServer) EarliestAttestationNonce(context.Context, *Query
 func (UnimplementedQueryServer) LatestValsetRequestBeforeNonce(context.Context, *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestValsetRequestBeforeNonce not implemented")
 }
+func (UnimplementedQueryServer) SignedAttestation(context.Context, *QuerySignedAttestationRequest) (*QuerySignedAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignedAttestation not implemented")
+}
+func (UnimplementedQueryServer) Attestations(context.Context, *QueryAttestationsRequest) (*QueryAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestations not implemented")
+}
+func (UnimplementedQueryServer) AttestationNonceForHeight(context.Context, *QueryAttestationNonceForHeightRequest) (*QueryAttestationNonceForHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationNonceForHeight not implemented")
+}
+func (UnimplementedQueryServer) ValsetsInRange(context.Context, *QueryValsetsInRangeRequest) (*QueryValsetsInRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValsetsInRange not implemented")
+}
 func (UnimplementedQueryServer) LatestUnbondingHeight(context.Context, *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestUnbondingHeight not implemented")
 }
@@ -213,6 +311,9 @@ func (UnimplementedQueryServer) LatestDataCommitment(context.Context, *QueryLate
 func (UnimplementedQueryServer) EvmAddress(context.Context, *QueryEvmAddressRequest) (*QueryEvmAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EvmAddress not implemented")
 }
+func (UnimplementedQueryServer) EvmAddressForNonce(context.Context, *QueryEvmAddressForNonceRequest) (*QueryEvmAddressForNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvmAddressForNonce not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -316,6 +417,78 @@ func _Query_LatestValsetRequestBeforeNonce_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SignedAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignedAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignedAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SignedAttestation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignedAttestation(ctx, req.(*QuerySignedAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Attestations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestations(ctx, req.(*QueryAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationNonceForHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationNonceForHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationNonceForHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AttestationNonceForHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationNonceForHeight(ctx, req.(*QueryAttestationNonceForHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValsetsInRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValsetsInRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValsetsInRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValsetsInRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValsetsInRange(ctx, req.(*QueryValsetsInRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LatestUnbondingHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLatestUnbondingHeightRequest)
 	if err := dec(in); err != nil {
@@ -388,6 +561,24 @@ func _Query_EvmAddress_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EvmAddressForNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvmAddressForNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvmAddressForNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EvmAddressForNonce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvmAddressForNonce(ctx, req.(*QueryEvmAddressForNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -415,6 +606,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LatestValsetRequestBeforeNonce",
 			Handler:    _Query_LatestValsetRequestBeforeNonce_Handler,
 		},
+		{
+			MethodName: "SignedAttestation",
+			Handler:    _Query_SignedAttestation_Handler,
+		},
+		{
+			MethodName: "Attestations",
+			Handler:    _Query_Attestations_Handler,
+		},
+		{
+			MethodName: "AttestationNonceForHeight",
+			Handler:    _Query_AttestationNonceForHeight_Handler,
+		},
+		{
+			MethodName: "ValsetsInRange",
+			Handler:    _Query_ValsetsInRange_Handler,
+		},
 		{
 			MethodName: "LatestUnbondingHeight",
 			Handler:    _Query_LatestUnbondingHeight_Handler,
@@ -431,6 +638,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EvmAddress",
 			Handler:    _Query_EvmAddress_Handler,
 		},
+		{
+			MethodName: "EvmAddressForNonce",
+			Handler:    _Query_EvmAddressForNonce_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sunrise/blobstream/v1/query.proto",
@@ -18,6 +18,7 @@ var (
 	fd_Blob_data              protoreflect.FieldDescriptor
 	fd_Blob_share_version     protoreflect.FieldDescriptor
 	fd_Blob_namespace_version protoreflect.FieldDescriptor
+	fd_Blob_signer            protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -27,6 +28,7 @@ func init() {
 	fd_Blob_data = md_Blob.Fields().ByName("data")
 	fd_Blob_share_version = md_Blob.Fields().ByName("share_version")
 	fd_Blob_namespace_version = md_Blob.Fields().ByName("namespace_version")
+	fd_Blob_signer = md_Blob.Fields().ByName("signer")
 }
 
 var _ protoreflect.Message = (*fastReflection_Blob)(nil)
@@ -118,6 +120,12 @@ func (x *fastReflection_Blob) Range(f func(protoreflect.FieldDescriptor, protore
 			return
 		}
 	}
+	if len(x.Signer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signer)
+		if !f(fd_Blob_signer, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -141,6 +149,8 @@ func (x *fastReflection_Blob) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ShareVersion != uint32(0)
 	case "sunrise.core.v1.blob.Blob.namespace_version":
 		return x.NamespaceVersion != uint32(0)
+	case "sunrise.core.v1.blob.Blob.signer":
+		return len(x.Signer) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.blob.Blob"))
@@ -165,6 +175,8 @@ func (x *fastReflection_Blob) Clear(fd protoreflect.FieldDescriptor) {
 		x.ShareVersion = uint32(0)
 	case "sunrise.core.v1.blob.Blob.namespace_version":
 		x.NamespaceVersion = uint32(0)
+	case "sunrise.core.v1.blob.Blob.signer":
+		x.Signer = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.blob.Blob"))
@@ -193,6 +205,9 @@ func (x *fastReflection_Blob) Get(descriptor protoreflect.FieldDescriptor) proto
 	case "sunrise.core.v1.blob.Blob.namespace_version":
 		value := x.NamespaceVersion
 		return protoreflect.ValueOfUint32(value)
+	case "sunrise.core.v1.blob.Blob.signer":
+		value := x.Signer
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.blob.Blob"))
@@ -221,6 +236,8 @@ func (x *fastReflection_Blob) Set(fd protoreflect.FieldDescriptor, value protore
 		x.ShareVersion = uint32(value.Uint())
 	case "sunrise.core.v1.blob.Blob.namespace_version":
 		x.NamespaceVersion = uint32(value.Uint())
+	case "sunrise.core.v1.blob.Blob.signer":
+		x.Signer = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.blob.Blob"))
@@ -249,6 +266,8 @@ func (x *fastReflection_Blob) Mutable(fd protoreflect.FieldDescriptor) protorefl
 		panic(fmt.Errorf("field share_version of message sunrise.core.v1.blob.Blob is not mutable"))
 	case "sunrise.core.v1.blob.Blob.namespace_version":
 		panic(fmt.Errorf("field namespace_version of message sunrise.core.v1.blob.Blob is not mutable"))
+	case "sunrise.core.v1.blob.Blob.signer":
+		panic(fmt.Errorf("field signer of message sunrise.core.v1.blob.Blob is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.blob.Blob"))
@@ -270,6 +289,8 @@ func (x *fastReflection_Blob) NewField(fd protoreflect.FieldDescriptor) protoref
 		return protoreflect.ValueOfUint32(uint32(0))
 	case "sunrise.core.v1.blob.Blob.namespace_version":
 		return protoreflect.ValueOfUint32(uint32(0))
+	case "sunrise.core.v1.blob.Blob.signer":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.blob.Blob"))
@@ -353,6 +374,10 @@ func (x *fastReflection_Blob) ProtoMethods() *protoiface.Methods {
 		if x.NamespaceVersion != 0 {
 			n += 1 + runtime.Sov(uint64(x.NamespaceVersion))
 		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -382,6 +407,13 @@ func (x *fastReflection_Blob) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if x.NamespaceVersion != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.NamespaceVersion))
 			i--
@@ -561,6 +593,40 @@ func (x *fastReflection_Blob) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = append(x.Signer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signer == nil {
+					x.Signer = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -647,11 +713,58 @@ func (x *_BlobTx_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_BlobTx_4_list)(nil)
+
+type _BlobTx_4_list struct {
+	list *[]uint32
+}
+
+func (x *_BlobTx_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BlobTx_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint32((*x.list)[i])
+}
+
+func (x *_BlobTx_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BlobTx_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BlobTx_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message BlobTx at list field MsgIndexes as it is not of Message kind"))
+}
+
+func (x *_BlobTx_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BlobTx_4_list) NewElement() protoreflect.Value {
+	v := uint32(0)
+	return protoreflect.ValueOfUint32(v)
+}
+
+func (x *_BlobTx_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_BlobTx         protoreflect.MessageDescriptor
-	fd_BlobTx_tx      protoreflect.FieldDescriptor
-	fd_BlobTx_blobs   protoreflect.FieldDescriptor
-	fd_BlobTx_type_id protoreflect.FieldDescriptor
+	md_BlobTx             protoreflect.MessageDescriptor
+	fd_BlobTx_tx          protoreflect.FieldDescriptor
+	fd_BlobTx_blobs       protoreflect.FieldDescriptor
+	fd_BlobTx_type_id     protoreflect.FieldDescriptor
+	fd_BlobTx_msg_indexes protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -660,6 +773,7 @@ func init() {
 	fd_BlobTx_tx = md_BlobTx.Fields().ByName("tx")
 	fd_BlobTx_blobs = md_BlobTx.Fields().ByName("blobs")
 	fd_BlobTx_type_id = md_BlobTx.Fields().ByName("type_id")
+	fd_BlobTx_msg_indexes = md_BlobTx.Fields().ByName("msg_indexes")
 }
 
 var _ protoreflect.Message = (*fastReflection_BlobTx)(nil)
@@ -745,6 +859,12 @@ func (x *fastReflection_BlobTx) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.MsgIndexes) != 0 {
+		value := protoreflect.ValueOfList(&_BlobTx_4_list{list: &x.MsgIndexes})
+		if !f(fd_BlobTx_msg_indexes, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -766,6 +886,8 @@ func (x *fastReflection_BlobTx) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.Blobs) != 0
 	case "sunrise.core.v1.blob.BlobTx.type_id":
 		return x.TypeId != ""
+	case "sunrise.core.v1.blob.BlobTx.msg_indexes":
+		return len(x.MsgIndexes) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.blob.BlobTx"))
@@ -788,6 +910,8 @@ func (x *fastReflection_BlobTx) Clear(fd protoreflect.FieldDescriptor) {
 		x.Blobs = nil
 	case "sunrise.core.v1.blob.BlobTx.type_id":
 		x.TypeId = ""
+	case "sunrise.core.v1.blob.BlobTx.msg_indexes":
+		x.MsgIndexes = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.blob.BlobTx"))
@@ -816,6 +940,12 @@ func (x *fastReflection_BlobTx) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "sunrise.core.v1.blob.BlobTx.type_id":
 		value := x.TypeId
 		return protoreflect.ValueOfString(value)
+	case "sunrise.core.v1.blob.BlobTx.msg_indexes":
+		if len(x.MsgIndexes) == 0 {
+			return protoreflect.ValueOfList(&_BlobTx_4_list{})
+		}
+		listValue := &_BlobTx_4_list{list: &x.MsgIndexes}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.blob.BlobTx"))
@@ -844,6 +974,10 @@ func (x *fastReflection_BlobTx) Set(fd protoreflect.FieldDescriptor, value proto
 		x.Blobs = *clv.list
 	case "sunrise.core.v1.blob.BlobTx.type_id":
 		x.TypeId = value.Interface().(string)
+	case "sunrise.core.v1.blob.BlobTx.msg_indexes":
+		lv := value.List()
+		clv := lv.(*_BlobTx_4_list)
+		x.MsgIndexes = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.blob.BlobTx"))
@@ -870,6 +1004,12 @@ func (x *fastReflection_BlobTx) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_BlobTx_2_list{list: &x.Blobs}
 		return protoreflect.ValueOfList(value)
+	case "sunrise.core.v1.blob.BlobTx.msg_indexes":
+		if x.MsgIndexes == nil {
+			x.MsgIndexes = []uint32{}
+		}
+		value := &_BlobTx_4_list{list: &x.MsgIndexes}
+		return protoreflect.ValueOfList(value)
 	case "sunrise.core.v1.blob.BlobTx.tx":
 		panic(fmt.Errorf("field tx of message sunrise.core.v1.blob.BlobTx is not mutable"))
 	case "sunrise.core.v1.blob.BlobTx.type_id":
@@ -894,6 +1034,9 @@ func (x *fastReflection_BlobTx) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfList(&_BlobTx_2_list{list: &list})
 	case "sunrise.core.v1.blob.BlobTx.type_id":
 		return protoreflect.ValueOfString("")
+	case "sunrise.core.v1.blob.BlobTx.msg_indexes":
+		list := []uint32{}
+		return protoreflect.ValueOfList(&_BlobTx_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.blob.BlobTx"))
@@ -977,6 +1120,13 @@ func (x *fastReflection_BlobTx) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.MsgIndexes) > 0 {
+			l = 0
+			for _, e := range x.MsgIndexes {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1006,6 +1156,26 @@ func (x *fastReflection_BlobTx) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MsgIndexes) > 0 {
+			var pksize2 int
+			for _, num := range x.MsgIndexes {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.MsgIndexes {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x22
+		}
 		if len(x.TypeId) > 0 {
 			i -= len(x.TypeId)
 			copy(dAtA[i:], x.TypeId)
@@ -1185,6 +1355,82 @@ func (x *fastReflection_BlobTx) ProtoMethods() *protoiface.Methods {
 				}
 				x.TypeId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType == 0 {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.MsgIndexes = append(x.MsgIndexes, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.MsgIndexes) == 0 {
+						x.MsgIndexes = make([]uint32, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint32
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint32(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.MsgIndexes = append(x.MsgIndexes, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgIndexes", wireType)
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1246,6 +1492,9 @@ type Blob struct {
 	Data             []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	ShareVersion     uint32 `protobuf:"varint,3,opt,name=share_version,json=shareVersion,proto3" json:"share_version,omitempty"`
 	NamespaceVersion uint32 `protobuf:"varint,4,opt,name=namespace_version,json=namespaceVersion,proto3" json:"namespace_version,omitempty"`
+	// signer is the address of the signer of the MsgPayForBlobs paying for the
+	// blob. It is only set for share version 1, whose first share embeds it.
+	Signer []byte `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
 func (x *Blob) Reset() {
@@ -1296,6 +1545,13 @@ func (x *Blob) GetNamespaceVersion() uint32 {
 	return 0
 }
 
+func (x *Blob) GetSigner() []byte {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
 // BlobTx wraps an encoded sdk.Tx with a second field to contain blobs of data.
 // The raw bytes of the blobs are not signed over, instead we verify each blob
 // using the relevant MsgPayForBlobs that is signed over in the encoded sdk.Tx.
@@ -1307,6 +1563,10 @@ type BlobTx struct {
 	Tx     []byte  `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
 	Blobs  []*Blob `protobuf:"bytes,2,rep,name=blobs,proto3" json:"blobs,omitempty"`
 	TypeId string  `protobuf:"bytes,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	// msg_indexes holds, for each blob, the index of the MsgPayForBlobs in the
+	// messages of tx that pays for it. It is empty when tx contains a single
+	// MsgPayForBlobs.
+	MsgIndexes []uint32 `protobuf:"varint,4,rep,packed,name=msg_indexes,json=msgIndexes,proto3" json:"msg_indexes,omitempty"`
 }
 
 func (x *BlobTx) Reset() {
@@ -1350,13 +1610,20 @@ func (x *BlobTx) GetTypeId() string {
 	return ""
 }
 
+func (x *BlobTx) GetMsgIndexes() []uint32 {
+	if x != nil {
+		return x.MsgIndexes
+	}
+	return nil
+}
+
 var File_sunrise_core_v1_blob_blob_proto protoreflect.FileDescriptor
 
 var file_sunrise_core_v1_blob_blob_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76,
 	0x31, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x14, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x22, 0x8f, 0x01, 0x0a, 0x04, 0x42, 0x6c, 0x6f, 0x62,
+	0x76, 0x31, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x22, 0xa7, 0x01, 0x0a, 0x04, 0x42, 0x6c, 0x6f, 0x62,
 	0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
 	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -1365,26 +1632,30 @@ var file_sunrise_core_v1_blob_blob_proto_rawDesc = []byte{
 	0x73, 0x68, 0x61, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11,
 	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
 	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x06, 0x42, 0x6c, 0x6f,
-	0x62, 0x54, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x02, 0x74, 0x78, 0x12, 0x30, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x05,
-	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x42, 0xc0,
-	0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x42, 0x09, 0x42, 0x6c, 0x6f,
-	0x62, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69,
-	0x73, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0xa2,
-	0x02, 0x04, 0x53, 0x43, 0x56, 0x42, 0xaa, 0x02, 0x14, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
-	0x2e, 0x43, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0xca, 0x02, 0x14,
-	0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x5c,
-	0x42, 0x6c, 0x6f, 0x62, 0xe2, 0x02, 0x20, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x43,
-	0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x42, 0x6c, 0x6f, 0x62, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73,
-	0x65, 0x3a, 0x3a, 0x43, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x3a, 0x3a, 0x42, 0x6c, 0x6f,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x22, 0x84, 0x01, 0x0a, 0x06, 0x42, 0x6c, 0x6f, 0x62, 0x54, 0x78, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x78, 0x12, 0x30, 0x0a, 0x05,
+	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6c,
+	0x6f, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x73, 0x67, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x73,
+	0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x42, 0xc0, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d,
+	0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x42, 0x09, 0x42, 0x6c, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x63, 0x6f, 0x72,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0xa2, 0x02, 0x04, 0x53, 0x43, 0x56, 0x42,
+	0xaa, 0x02, 0x14, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x2e,
+	0x56, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0xca, 0x02, 0x14, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73,
+	0x65, 0x5c, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x42, 0x6c, 0x6f, 0x62, 0xe2, 0x02,
+	0x20, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31,
+	0x5c, 0x42, 0x6c, 0x6f, 0x62, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x17, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x3a, 0x3a, 0x43, 0x6f, 0x72,
+	0x65, 0x3a, 0x3a, 0x56, 0x31, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -0,0 +1,154 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: sunrise/core/v1/das/query.proto
+
+package das
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_DataAvailabilityHeader_FullMethodName = "/sunrise.core.v1.das.Query/DataAvailabilityHeader"
+	Query_Sample_FullMethodName                 = "/sunrise.core.v1.das.Query/Sample"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// DataAvailabilityHeader queries the data availability header of the
+	// extended data square of a block.
+	DataAvailabilityHeader(ctx context.Context, in *QueryDataAvailabilityHeaderRequest, opts ...grpc.CallOption) (*QueryDataAvailabilityHeaderResponse, error)
+	// Sample queries a share of the extended data square of a block, with its
+	// inclusion proof in its row.
+	Sample(ctx context.Context, in *QuerySampleRequest, opts ...grpc.CallOption) (*QuerySampleResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) DataAvailabilityHeader(ctx context.Context, in *QueryDataAvailabilityHeaderRequest, opts ...grpc.CallOption) (*QueryDataAvailabilityHeaderResponse, error) {
+	out := new(QueryDataAvailabilityHeaderResponse)
+	err := c.cc.Invoke(ctx, Query_DataAvailabilityHeader_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Sample(ctx context.Context, in *QuerySampleRequest, opts ...grpc.CallOption) (*QuerySampleResponse, error) {
+	out := new(QuerySampleResponse)
+	err := c.cc.Invoke(ctx, Query_Sample_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// DataAvailabilityHeader queries the data availability header of the
+	// extended data square of a block.
+	DataAvailabilityHeader(context.Context, *QueryDataAvailabilityHeaderRequest) (*QueryDataAvailabilityHeaderResponse, error)
+	// Sample queries a share of the extended data square of a block, with its
+	// inclusion proof in its row.
+	Sample(context.Context, *QuerySampleRequest) (*QuerySampleResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) DataAvailabilityHeader(context.Context, *QueryDataAvailabilityHeaderRequest) (*QueryDataAvailabilityHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataAvailabilityHeader not implemented")
+}
+func (UnimplementedQueryServer) Sample(context.Context, *QuerySampleRequest) (*QuerySampleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sample not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_DataAvailabilityHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataAvailabilityHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataAvailabilityHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DataAvailabilityHeader_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataAvailabilityHeader(ctx, req.(*QueryDataAvailabilityHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sample_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySampleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sample(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Sample_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sample(ctx, req.(*QuerySampleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sunrise.core.v1.das.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DataAvailabilityHeader",
+			Handler:    _Query_DataAvailabilityHeader_Handler,
+		},
+		{
+			MethodName: "Sample",
+			Handler:    _Query_Sample_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sunrise/core/v1/das/query.proto",
+}
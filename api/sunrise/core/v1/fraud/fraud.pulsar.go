@@ -0,0 +1,1515 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package fraud
+
+import (
+	proof "github.com/sunrise-zone/sunrise-app/api/sunrise/core/v1/proof"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_BadEncodingProof_4_list)(nil)
+
+type _BadEncodingProof_4_list struct {
+	list *[]*ShareWithProof
+}
+
+func (x *_BadEncodingProof_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BadEncodingProof_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BadEncodingProof_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ShareWithProof)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BadEncodingProof_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ShareWithProof)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BadEncodingProof_4_list) AppendMutable() protoreflect.Value {
+	v := new(ShareWithProof)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BadEncodingProof_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BadEncodingProof_4_list) NewElement() protoreflect.Value {
+	v := new(ShareWithProof)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BadEncodingProof_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_BadEncodingProof        protoreflect.MessageDescriptor
+	fd_BadEncodingProof_height protoreflect.FieldDescriptor
+	fd_BadEncodingProof_axis   protoreflect.FieldDescriptor
+	fd_BadEncodingProof_index  protoreflect.FieldDescriptor
+	fd_BadEncodingProof_shares protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_core_v1_fraud_fraud_proto_init()
+	md_BadEncodingProof = File_sunrise_core_v1_fraud_fraud_proto.Messages().ByName("BadEncodingProof")
+	fd_BadEncodingProof_height = md_BadEncodingProof.Fields().ByName("height")
+	fd_BadEncodingProof_axis = md_BadEncodingProof.Fields().ByName("axis")
+	fd_BadEncodingProof_index = md_BadEncodingProof.Fields().ByName("index")
+	fd_BadEncodingProof_shares = md_BadEncodingProof.Fields().ByName("shares")
+}
+
+var _ protoreflect.Message = (*fastReflection_BadEncodingProof)(nil)
+
+type fastReflection_BadEncodingProof BadEncodingProof
+
+func (x *BadEncodingProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BadEncodingProof)(x)
+}
+
+func (x *BadEncodingProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_core_v1_fraud_fraud_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BadEncodingProof_messageType fastReflection_BadEncodingProof_messageType
+var _ protoreflect.MessageType = fastReflection_BadEncodingProof_messageType{}
+
+type fastReflection_BadEncodingProof_messageType struct{}
+
+func (x fastReflection_BadEncodingProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BadEncodingProof)(nil)
+}
+func (x fastReflection_BadEncodingProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_BadEncodingProof)
+}
+func (x fastReflection_BadEncodingProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BadEncodingProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BadEncodingProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_BadEncodingProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BadEncodingProof) Type() protoreflect.MessageType {
+	return _fastReflection_BadEncodingProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BadEncodingProof) New() protoreflect.Message {
+	return new(fastReflection_BadEncodingProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BadEncodingProof) Interface() protoreflect.ProtoMessage {
+	return (*BadEncodingProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BadEncodingProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_BadEncodingProof_height, value) {
+			return
+		}
+	}
+	if x.Axis != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Axis))
+		if !f(fd_BadEncodingProof_axis, value) {
+			return
+		}
+	}
+	if x.Index != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Index)
+		if !f(fd_BadEncodingProof_index, value) {
+			return
+		}
+	}
+	if len(x.Shares) != 0 {
+		value := protoreflect.ValueOfList(&_BadEncodingProof_4_list{list: &x.Shares})
+		if !f(fd_BadEncodingProof_shares, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BadEncodingProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.core.v1.fraud.BadEncodingProof.height":
+		return x.Height != int64(0)
+	case "sunrise.core.v1.fraud.BadEncodingProof.axis":
+		return x.Axis != 0
+	case "sunrise.core.v1.fraud.BadEncodingProof.index":
+		return x.Index != uint32(0)
+	case "sunrise.core.v1.fraud.BadEncodingProof.shares":
+		return len(x.Shares) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.fraud.BadEncodingProof"))
+		}
+		panic(fmt.Errorf("message sunrise.core.v1.fraud.BadEncodingProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BadEncodingProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.core.v1.fraud.BadEncodingProof.height":
+		x.Height = int64(0)
+	case "sunrise.core.v1.fraud.BadEncodingProof.axis":
+		x.Axis = 0
+	case "sunrise.core.v1.fraud.BadEncodingProof.index":
+		x.Index = uint32(0)
+	case "sunrise.core.v1.fraud.BadEncodingProof.shares":
+		x.Shares = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.fraud.BadEncodingProof"))
+		}
+		panic(fmt.Errorf("message sunrise.core.v1.fraud.BadEncodingProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BadEncodingProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.core.v1.fraud.BadEncodingProof.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "sunrise.core.v1.fraud.BadEncodingProof.axis":
+		value := x.Axis
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "sunrise.core.v1.fraud.BadEncodingProof.index":
+		value := x.Index
+		return protoreflect.ValueOfUint32(value)
+	case "sunrise.core.v1.fraud.BadEncodingProof.shares":
+		if len(x.Shares) == 0 {
+			return protoreflect.ValueOfList(&_BadEncodingProof_4_list{})
+		}
+		listValue := &_BadEncodingProof_4_list{list: &x.Shares}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.fraud.BadEncodingProof"))
+		}
+		panic(fmt.Errorf("message sunrise.core.v1.fraud.BadEncodingProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BadEncodingProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.core.v1.fraud.BadEncodingProof.height":
+		x.Height = value.Int()
+	case "sunrise.core.v1.fraud.BadEncodingProof.axis":
+		x.Axis = (Axis)(value.Enum())
+	case "sunrise.core.v1.fraud.BadEncodingProof.index":
+		x.Index = uint32(value.Uint())
+	case "sunrise.core.v1.fraud.BadEncodingProof.shares":
+		lv := value.List()
+		clv := lv.(*_BadEncodingProof_4_list)
+		x.Shares = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.fraud.BadEncodingProof"))
+		}
+		panic(fmt.Errorf("message sunrise.core.v1.fraud.BadEncodingProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BadEncodingProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.core.v1.fraud.BadEncodingProof.shares":
+		if x.Shares == nil {
+			x.Shares = []*ShareWithProof{}
+		}
+		value := &_BadEncodingProof_4_list{list: &x.Shares}
+		return protoreflect.ValueOfList(value)
+	case "sunrise.core.v1.fraud.BadEncodingProof.height":
+		panic(fmt.Errorf("field height of message sunrise.core.v1.fraud.BadEncodingProof is not mutable"))
+	case "sunrise.core.v1.fraud.BadEncodingProof.axis":
+		panic(fmt.Errorf("field axis of message sunrise.core.v1.fraud.BadEncodingProof is not mutable"))
+	case "sunrise.core.v1.fraud.BadEncodingProof.index":
+		panic(fmt.Errorf("field index of message sunrise.core.v1.fraud.BadEncodingProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.fraud.BadEncodingProof"))
+		}
+		panic(fmt.Errorf("message sunrise.core.v1.fraud.BadEncodingProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BadEncodingProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.core.v1.fraud.BadEncodingProof.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "sunrise.core.v1.fraud.BadEncodingProof.axis":
+		return protoreflect.ValueOfEnum(0)
+	case "sunrise.core.v1.fraud.BadEncodingProof.index":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "sunrise.core.v1.fraud.BadEncodingProof.shares":
+		list := []*ShareWithProof{}
+		return protoreflect.ValueOfList(&_BadEncodingProof_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.fraud.BadEncodingProof"))
+		}
+		panic(fmt.Errorf("message sunrise.core.v1.fraud.BadEncodingProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BadEncodingProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.core.v1.fraud.BadEncodingProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BadEncodingProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BadEncodingProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BadEncodingProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BadEncodingProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BadEncodingProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Axis != 0 {
+			n += 1 + runtime.Sov(uint64(x.Axis))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if len(x.Shares) > 0 {
+			for _, e := range x.Shares {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BadEncodingProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Shares) > 0 {
+			for iNdEx := len(x.Shares) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Shares[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Axis != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Axis))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BadEncodingProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BadEncodingProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BadEncodingProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Axis", wireType)
+				}
+				x.Axis = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Axis |= Axis(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Shares = append(x.Shares, &ShareWithProof{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Shares[len(x.Shares)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ShareWithProof          protoreflect.MessageDescriptor
+	fd_ShareWithProof_position protoreflect.FieldDescriptor
+	fd_ShareWithProof_share    protoreflect.FieldDescriptor
+	fd_ShareWithProof_proof    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_sunrise_core_v1_fraud_fraud_proto_init()
+	md_ShareWithProof = File_sunrise_core_v1_fraud_fraud_proto.Messages().ByName("ShareWithProof")
+	fd_ShareWithProof_position = md_ShareWithProof.Fields().ByName("position")
+	fd_ShareWithProof_share = md_ShareWithProof.Fields().ByName("share")
+	fd_ShareWithProof_proof = md_ShareWithProof.Fields().ByName("proof")
+}
+
+var _ protoreflect.Message = (*fastReflection_ShareWithProof)(nil)
+
+type fastReflection_ShareWithProof ShareWithProof
+
+func (x *ShareWithProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ShareWithProof)(x)
+}
+
+func (x *ShareWithProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_sunrise_core_v1_fraud_fraud_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ShareWithProof_messageType fastReflection_ShareWithProof_messageType
+var _ protoreflect.MessageType = fastReflection_ShareWithProof_messageType{}
+
+type fastReflection_ShareWithProof_messageType struct{}
+
+func (x fastReflection_ShareWithProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ShareWithProof)(nil)
+}
+func (x fastReflection_ShareWithProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_ShareWithProof)
+}
+func (x fastReflection_ShareWithProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ShareWithProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ShareWithProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_ShareWithProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ShareWithProof) Type() protoreflect.MessageType {
+	return _fastReflection_ShareWithProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ShareWithProof) New() protoreflect.Message {
+	return new(fastReflection_ShareWithProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ShareWithProof) Interface() protoreflect.ProtoMessage {
+	return (*ShareWithProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ShareWithProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Position != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Position)
+		if !f(fd_ShareWithProof_position, value) {
+			return
+		}
+	}
+	if len(x.Share) != 0 {
+		value := protoreflect.ValueOfBytes(x.Share)
+		if !f(fd_ShareWithProof_share, value) {
+			return
+		}
+	}
+	if x.Proof != nil {
+		value := protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+		if !f(fd_ShareWithProof_proof, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ShareWithProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "sunrise.core.v1.fraud.ShareWithProof.position":
+		return x.Position != uint32(0)
+	case "sunrise.core.v1.fraud.ShareWithProof.share":
+		return len(x.Share) != 0
+	case "sunrise.core.v1.fraud.ShareWithProof.proof":
+		return x.Proof != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.fraud.ShareWithProof"))
+		}
+		panic(fmt.Errorf("message sunrise.core.v1.fraud.ShareWithProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ShareWithProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "sunrise.core.v1.fraud.ShareWithProof.position":
+		x.Position = uint32(0)
+	case "sunrise.core.v1.fraud.ShareWithProof.share":
+		x.Share = nil
+	case "sunrise.core.v1.fraud.ShareWithProof.proof":
+		x.Proof = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.fraud.ShareWithProof"))
+		}
+		panic(fmt.Errorf("message sunrise.core.v1.fraud.ShareWithProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ShareWithProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "sunrise.core.v1.fraud.ShareWithProof.position":
+		value := x.Position
+		return protoreflect.ValueOfUint32(value)
+	case "sunrise.core.v1.fraud.ShareWithProof.share":
+		value := x.Share
+		return protoreflect.ValueOfBytes(value)
+	case "sunrise.core.v1.fraud.ShareWithProof.proof":
+		value := x.Proof
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.fraud.ShareWithProof"))
+		}
+		panic(fmt.Errorf("message sunrise.core.v1.fraud.ShareWithProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ShareWithProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "sunrise.core.v1.fraud.ShareWithProof.position":
+		x.Position = uint32(value.Uint())
+	case "sunrise.core.v1.fraud.ShareWithProof.share":
+		x.Share = value.Bytes()
+	case "sunrise.core.v1.fraud.ShareWithProof.proof":
+		x.Proof = value.Message().Interface().(*proof.NMTProof)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.fraud.ShareWithProof"))
+		}
+		panic(fmt.Errorf("message sunrise.core.v1.fraud.ShareWithProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ShareWithProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.core.v1.fraud.ShareWithProof.proof":
+		if x.Proof == nil {
+			x.Proof = new(proof.NMTProof)
+		}
+		return protoreflect.ValueOfMessage(x.Proof.ProtoReflect())
+	case "sunrise.core.v1.fraud.ShareWithProof.position":
+		panic(fmt.Errorf("field position of message sunrise.core.v1.fraud.ShareWithProof is not mutable"))
+	case "sunrise.core.v1.fraud.ShareWithProof.share":
+		panic(fmt.Errorf("field share of message sunrise.core.v1.fraud.ShareWithProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.fraud.ShareWithProof"))
+		}
+		panic(fmt.Errorf("message sunrise.core.v1.fraud.ShareWithProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ShareWithProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "sunrise.core.v1.fraud.ShareWithProof.position":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "sunrise.core.v1.fraud.ShareWithProof.share":
+		return protoreflect.ValueOfBytes(nil)
+	case "sunrise.core.v1.fraud.ShareWithProof.proof":
+		m := new(proof.NMTProof)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: sunrise.core.v1.fraud.ShareWithProof"))
+		}
+		panic(fmt.Errorf("message sunrise.core.v1.fraud.ShareWithProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ShareWithProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in sunrise.core.v1.fraud.ShareWithProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ShareWithProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ShareWithProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ShareWithProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ShareWithProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ShareWithProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Position != 0 {
+			n += 1 + runtime.Sov(uint64(x.Position))
+		}
+		l = len(x.Share)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Proof != nil {
+			l = options.Size(x.Proof)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ShareWithProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Proof != nil {
+			encoded, err := options.Marshal(x.Proof)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Share) > 0 {
+			i -= len(x.Share)
+			copy(dAtA[i:], x.Share)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Share)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Position != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Position))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ShareWithProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ShareWithProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ShareWithProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				}
+				x.Position = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Position |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Share = append(x.Share[:0], dAtA[iNdEx:postIndex]...)
+				if x.Share == nil {
+					x.Share = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Proof == nil {
+					x.Proof = &proof.NMTProof{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proof); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: sunrise/core/v1/fraud/fraud.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Axis is a row or a column of an extended data square.
+type Axis int32
+
+const (
+	// ROW is a row of the extended data square.
+	Axis_ROW Axis = 0
+	// COL is a column of the extended data square.
+	Axis_COL Axis = 1
+)
+
+// Enum value maps for Axis.
+var (
+	Axis_name = map[int32]string{
+		0: "ROW",
+		1: "COL",
+	}
+	Axis_value = map[string]int32{
+		"ROW": 0,
+		"COL": 1,
+	}
+)
+
+func (x Axis) Enum() *Axis {
+	p := new(Axis)
+	*p = x
+	return p
+}
+
+func (x Axis) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Axis) Descriptor() protoreflect.EnumDescriptor {
+	return file_sunrise_core_v1_fraud_fraud_proto_enumTypes[0].Descriptor()
+}
+
+func (Axis) Type() protoreflect.EnumType {
+	return &file_sunrise_core_v1_fraud_fraud_proto_enumTypes[0]
+}
+
+func (x Axis) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Axis.Descriptor instead.
+func (Axis) EnumDescriptor() ([]byte, []int) {
+	return file_sunrise_core_v1_fraud_fraud_proto_rawDescGZIP(), []int{0}
+}
+
+// BadEncodingProof proves that a row or a column of the extended data square
+// of a block isn't correctly erasure coded. It holds enough shares of the row
+// or column to rebuild it, each proven against the root of the orthogonal
+// column or row of the data availability header.
+type BadEncodingProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// axis is the axis of the badly encoded row or column.
+	Axis Axis `protobuf:"varint,2,opt,name=axis,proto3,enum=sunrise.core.v1.fraud.Axis" json:"axis,omitempty"`
+	// index is the index of the badly encoded row or column.
+	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// shares are the shares of the row or column, ordered by position.
+	Shares []*ShareWithProof `protobuf:"bytes,4,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *BadEncodingProof) Reset() {
+	*x = BadEncodingProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_core_v1_fraud_fraud_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BadEncodingProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadEncodingProof) ProtoMessage() {}
+
+// Deprecated: Use BadEncodingProof.ProtoReflect.Descriptor instead.
+func (*BadEncodingProof) Descriptor() ([]byte, []int) {
+	return file_sunrise_core_v1_fraud_fraud_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BadEncodingProof) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BadEncodingProof) GetAxis() Axis {
+	if x != nil {
+		return x.Axis
+	}
+	return Axis_ROW
+}
+
+func (x *BadEncodingProof) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BadEncodingProof) GetShares() []*ShareWithProof {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+// ShareWithProof is a share of a row or a column of the extended data square,
+// with its NMT inclusion proof in the orthogonal column or row.
+type ShareWithProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// position is the position of the share in the row or column.
+	Position uint32 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	// share is the raw share.
+	Share []byte `protobuf:"bytes,2,opt,name=share,proto3" json:"share,omitempty"`
+	// proof is the inclusion proof of the share in the orthogonal column or
+	// row.
+	Proof *proof.NMTProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *ShareWithProof) Reset() {
+	*x = ShareWithProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sunrise_core_v1_fraud_fraud_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareWithProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareWithProof) ProtoMessage() {}
+
+// Deprecated: Use ShareWithProof.ProtoReflect.Descriptor instead.
+func (*ShareWithProof) Descriptor() ([]byte, []int) {
+	return file_sunrise_core_v1_fraud_fraud_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ShareWithProof) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ShareWithProof) GetShare() []byte {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+func (x *ShareWithProof) GetProof() *proof.NMTProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+var File_sunrise_core_v1_fraud_fraud_proto protoreflect.FileDescriptor
+
+var file_sunrise_core_v1_fraud_fraud_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76,
+	0x31, 0x2f, 0x66, 0x72, 0x61, 0x75, 0x64, 0x2f, 0x66, 0x72, 0x61, 0x75, 0x64, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x15, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x66, 0x72, 0x61, 0x75, 0x64, 0x1a, 0x21, 0x73, 0x75, 0x6e, 0x72,
+	0x69, 0x73, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x2f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb0, 0x01,
+	0x0a, 0x10, 0x42, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x61, 0x78,
+	0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69,
+	0x73, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x66, 0x72, 0x61, 0x75, 0x64,
+	0x2e, 0x41, 0x78, 0x69, 0x73, 0x52, 0x04, 0x61, 0x78, 0x69, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x66, 0x72, 0x61, 0x75, 0x64, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x57,
+	0x69, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73,
+	0x22, 0x79, 0x0a, 0x0e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2e, 0x4e, 0x4d, 0x54, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2a, 0x18, 0x0a, 0x04, 0x41,
+	0x78, 0x69, 0x73, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x4f, 0x57, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x43, 0x4f, 0x4c, 0x10, 0x01, 0x42, 0xc7, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x66, 0x72,
+	0x61, 0x75, 0x64, 0x42, 0x0a, 0x46, 0x72, 0x61, 0x75, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x66, 0x72, 0x61, 0x75, 0x64, 0xa2, 0x02, 0x04, 0x53, 0x43, 0x56, 0x46,
+	0xaa, 0x02, 0x15, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x2e,
+	0x56, 0x31, 0x2e, 0x46, 0x72, 0x61, 0x75, 0x64, 0xca, 0x02, 0x15, 0x53, 0x75, 0x6e, 0x72, 0x69,
+	0x73, 0x65, 0x5c, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x46, 0x72, 0x61, 0x75, 0x64,
+	0xe2, 0x02, 0x21, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x43, 0x6f, 0x72, 0x65, 0x5c,
+	0x56, 0x31, 0x5c, 0x46, 0x72, 0x61, 0x75, 0x64, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x53, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x3a, 0x3a,
+	0x43, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x3a, 0x3a, 0x46, 0x72, 0x61, 0x75, 0x64, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sunrise_core_v1_fraud_fraud_proto_rawDescOnce sync.Once
+	file_sunrise_core_v1_fraud_fraud_proto_rawDescData = file_sunrise_core_v1_fraud_fraud_proto_rawDesc
+)
+
+func file_sunrise_core_v1_fraud_fraud_proto_rawDescGZIP() []byte {
+	file_sunrise_core_v1_fraud_fraud_proto_rawDescOnce.Do(func() {
+		file_sunrise_core_v1_fraud_fraud_proto_rawDescData = protoimpl.X.CompressGZIP(file_sunrise_core_v1_fraud_fraud_proto_rawDescData)
+	})
+	return file_sunrise_core_v1_fraud_fraud_proto_rawDescData
+}
+
+var file_sunrise_core_v1_fraud_fraud_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sunrise_core_v1_fraud_fraud_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_sunrise_core_v1_fraud_fraud_proto_goTypes = []interface{}{
+	(Axis)(0),                // 0: sunrise.core.v1.fraud.Axis
+	(*BadEncodingProof)(nil), // 1: sunrise.core.v1.fraud.BadEncodingProof
+	(*ShareWithProof)(nil),   // 2: sunrise.core.v1.fraud.ShareWithProof
+	(*proof.NMTProof)(nil),   // 3: sunrise.core.v1.proof.NMTProof
+}
+var file_sunrise_core_v1_fraud_fraud_proto_depIdxs = []int32{
+	0, // 0: sunrise.core.v1.fraud.BadEncodingProof.axis:type_name -> sunrise.core.v1.fraud.Axis
+	2, // 1: sunrise.core.v1.fraud.BadEncodingProof.shares:type_name -> sunrise.core.v1.fraud.ShareWithProof
+	3, // 2: sunrise.core.v1.fraud.ShareWithProof.proof:type_name -> sunrise.core.v1.proof.NMTProof
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_sunrise_core_v1_fraud_fraud_proto_init() }
+func file_sunrise_core_v1_fraud_fraud_proto_init() {
+	if File_sunrise_core_v1_fraud_fraud_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sunrise_core_v1_fraud_fraud_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BadEncodingProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sunrise_core_v1_fraud_fraud_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareWithProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sunrise_core_v1_fraud_fraud_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sunrise_core_v1_fraud_fraud_proto_goTypes,
+		DependencyIndexes: file_sunrise_core_v1_fraud_fraud_proto_depIdxs,
+		EnumInfos:         file_sunrise_core_v1_fraud_fraud_proto_enumTypes,
+		MessageInfos:      file_sunrise_core_v1_fraud_fraud_proto_msgTypes,
+	}.Build()
+	File_sunrise_core_v1_fraud_fraud_proto = out.File
+	file_sunrise_core_v1_fraud_fraud_proto_rawDesc = nil
+	file_sunrise_core_v1_fraud_fraud_proto_goTypes = nil
+	file_sunrise_core_v1_fraud_fraud_proto_depIdxs = nil
+}
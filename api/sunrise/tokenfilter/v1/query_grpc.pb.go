@@ -0,0 +1,232 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: sunrise/tokenfilter/v1/query.proto
+
+package tokenfilterv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_AllowedDenoms_FullMethodName      = "/sunrise.tokenfilter.v1.Query/AllowedDenoms"
+	Query_Allowlists_FullMethodName         = "/sunrise.tokenfilter.v1.Query/Allowlists"
+	Query_DerivativePolicy_FullMethodName   = "/sunrise.tokenfilter.v1.Query/DerivativePolicy"
+	Query_DerivativePolicies_FullMethodName = "/sunrise.tokenfilter.v1.Query/DerivativePolicies"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// AllowedDenoms queries the non-native base denominations allowed to be
+	// received over a channel.
+	AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error)
+	// Allowlists queries the allowlists of all the channels.
+	Allowlists(ctx context.Context, in *QueryAllowlistsRequest, opts ...grpc.CallOption) (*QueryAllowlistsResponse, error)
+	// DerivativePolicy queries the policy applied to the liquid staking
+	// derivatives sent over a channel.
+	DerivativePolicy(ctx context.Context, in *QueryDerivativePolicyRequest, opts ...grpc.CallOption) (*QueryDerivativePolicyResponse, error)
+	// DerivativePolicies queries the derivative policies of all the channels.
+	DerivativePolicies(ctx context.Context, in *QueryDerivativePoliciesRequest, opts ...grpc.CallOption) (*QueryDerivativePoliciesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error) {
+	out := new(QueryAllowedDenomsResponse)
+	err := c.cc.Invoke(ctx, Query_AllowedDenoms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Allowlists(ctx context.Context, in *QueryAllowlistsRequest, opts ...grpc.CallOption) (*QueryAllowlistsResponse, error) {
+	out := new(QueryAllowlistsResponse)
+	err := c.cc.Invoke(ctx, Query_Allowlists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DerivativePolicy(ctx context.Context, in *QueryDerivativePolicyRequest, opts ...grpc.CallOption) (*QueryDerivativePolicyResponse, error) {
+	out := new(QueryDerivativePolicyResponse)
+	err := c.cc.Invoke(ctx, Query_DerivativePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DerivativePolicies(ctx context.Context, in *QueryDerivativePoliciesRequest, opts ...grpc.CallOption) (*QueryDerivativePoliciesResponse, error) {
+	out := new(QueryDerivativePoliciesResponse)
+	err := c.cc.Invoke(ctx, Query_DerivativePolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// AllowedDenoms queries the non-native base denominations allowed to be
+	// received over a channel.
+	AllowedDenoms(context.Context, *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error)
+	// Allowlists queries the allowlists of all the channels.
+	Allowlists(context.Context, *QueryAllowlistsRequest) (*QueryAllowlistsResponse, error)
+	// DerivativePolicy queries the policy applied to the liquid staking
+	// derivatives sent over a channel.
+	DerivativePolicy(context.Context, *QueryDerivativePolicyRequest) (*QueryDerivativePolicyResponse, error)
+	// DerivativePolicies queries the derivative policies of all the channels.
+	DerivativePolicies(context.Context, *QueryDerivativePoliciesRequest) (*QueryDerivativePoliciesResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) AllowedDenoms(context.Context, *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedDenoms not implemented")
+}
+func (UnimplementedQueryServer) Allowlists(context.Context, *QueryAllowlistsRequest) (*QueryAllowlistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowlists not implemented")
+}
+func (UnimplementedQueryServer) DerivativePolicy(context.Context, *QueryDerivativePolicyRequest) (*QueryDerivativePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DerivativePolicy not implemented")
+}
+func (UnimplementedQueryServer) DerivativePolicies(context.Context, *QueryDerivativePoliciesRequest) (*QueryDerivativePoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DerivativePolicies not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_AllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllowedDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedDenoms(ctx, req.(*QueryAllowedDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Allowlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowlistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowlists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Allowlists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowlists(ctx, req.(*QueryAllowlistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DerivativePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDerivativePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DerivativePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DerivativePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DerivativePolicy(ctx, req.(*QueryDerivativePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DerivativePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDerivativePoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DerivativePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DerivativePolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DerivativePolicies(ctx, req.(*QueryDerivativePoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sunrise.tokenfilter.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AllowedDenoms",
+			Handler:    _Query_AllowedDenoms_Handler,
+		},
+		{
+			MethodName: "Allowlists",
+			Handler:    _Query_Allowlists_Handler,
+		},
+		{
+			MethodName: "DerivativePolicy",
+			Handler:    _Query_DerivativePolicy_Handler,
+		},
+		{
+			MethodName: "DerivativePolicies",
+			Handler:    _Query_DerivativePolicies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sunrise/tokenfilter/v1/query.proto",
+}
@@ -0,0 +1,154 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: sunrise/tokenfilter/v1/tx.proto
+
+package tokenfilterv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_SetAllowedDenoms_FullMethodName    = "/sunrise.tokenfilter.v1.Msg/SetAllowedDenoms"
+	Msg_SetDerivativePolicy_FullMethodName = "/sunrise.tokenfilter.v1.Msg/SetDerivativePolicy"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetAllowedDenoms defines a (governance) operation for replacing the
+	// non-native base denominations allowed to be received over a channel.
+	SetAllowedDenoms(ctx context.Context, in *MsgSetAllowedDenoms, opts ...grpc.CallOption) (*MsgSetAllowedDenomsResponse, error)
+	// SetDerivativePolicy defines a (governance) operation for replacing the
+	// policy applied to the liquid staking derivatives sent over a channel.
+	SetDerivativePolicy(ctx context.Context, in *MsgSetDerivativePolicy, opts ...grpc.CallOption) (*MsgSetDerivativePolicyResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetAllowedDenoms(ctx context.Context, in *MsgSetAllowedDenoms, opts ...grpc.CallOption) (*MsgSetAllowedDenomsResponse, error) {
+	out := new(MsgSetAllowedDenomsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAllowedDenoms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDerivativePolicy(ctx context.Context, in *MsgSetDerivativePolicy, opts ...grpc.CallOption) (*MsgSetDerivativePolicyResponse, error) {
+	out := new(MsgSetDerivativePolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDerivativePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// SetAllowedDenoms defines a (governance) operation for replacing the
+	// non-native base denominations allowed to be received over a channel.
+	SetAllowedDenoms(context.Context, *MsgSetAllowedDenoms) (*MsgSetAllowedDenomsResponse, error)
+	// SetDerivativePolicy defines a (governance) operation for replacing the
+	// policy applied to the liquid staking derivatives sent over a channel.
+	SetDerivativePolicy(context.Context, *MsgSetDerivativePolicy) (*MsgSetDerivativePolicyResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) SetAllowedDenoms(context.Context, *MsgSetAllowedDenoms) (*MsgSetAllowedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllowedDenoms not implemented")
+}
+func (UnimplementedMsgServer) SetDerivativePolicy(context.Context, *MsgSetDerivativePolicy) (*MsgSetDerivativePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDerivativePolicy not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_SetAllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAllowedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAllowedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAllowedDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAllowedDenoms(ctx, req.(*MsgSetAllowedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDerivativePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDerivativePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDerivativePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDerivativePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDerivativePolicy(ctx, req.(*MsgSetDerivativePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sunrise.tokenfilter.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetAllowedDenoms",
+			Handler:    _Msg_SetAllowedDenoms_Handler,
+		},
+		{
+			MethodName: "SetDerivativePolicy",
+			Handler:    _Msg_SetDerivativePolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sunrise/tokenfilter/v1/tx.proto",
+}
@@ -0,0 +1,118 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunrise-zone/sunrise-app/app/ante"
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+	blobtypes "github.com/sunrise-zone/sunrise-app/x/blob/types"
+	blobibctypes "github.com/sunrise-zone/sunrise-app/x/blobibc/types"
+)
+
+// pendingBlobTxs returns the blob transactions paying for the blobs received
+// over IBC, in the order they were received. They are placed before the
+// transactions of the mempool, so that the proposer includes them first, as
+// their fee was already paid. Only the ones fitting in the square and in the
+// bytes of the block are taken, the others stay queued for the next blocks.
+func (app *App) pendingBlobTxs(ctx sdk.Context, maxSquareSize int, maxTxBytes int64) [][]byte {
+	builder, err := square.NewBuilder(maxSquareSize, app.BaseApp.AppVersion())
+	if err != nil {
+		app.Logger().Error("failed to create the square builder of the pending blobs", "err", err.Error())
+		return nil
+	}
+	var (
+		txs   [][]byte
+		bytes int64
+	)
+	app.BlobIBCKeeper.IteratePendingBlobs(ctx, func(pending blobibctypes.PendingBlob) bool {
+		tx, err := app.pendingBlobTx(ctx, pending)
+		if err != nil {
+			app.Logger().Error("failed to build the transaction of a pending blob", "pending_blob_id", pending.Id, "err", err.Error())
+			return false
+		}
+		blobTx, _ := blob.UnmarshalBlobTx(tx)
+		bytes += int64(len(tx))
+		if bytes > maxTxBytes || !builder.AppendBlobTx(blobTx) {
+			return true
+		}
+		txs = append(txs, tx)
+		return false
+	})
+	return txs
+}
+
+// pendingBlobTx returns the blob transaction paying for the pending blob,
+// with the gas limit estimated for its blob with the current params.
+func (app *App) pendingBlobTx(ctx sdk.Context, pending blobibctypes.PendingBlob) ([]byte, error) {
+	gasLimit := blobtypes.EstimateGas(
+		[]uint32{uint32(len(pending.Data))},
+		app.BlobKeeper.GasPerBlobByte(ctx),
+		app.AccountKeeper.GetParams(ctx).TxSizeCostPerByte,
+	)
+	return blobibctypes.NewBlobTx(app.txConfig, pending, gasLimit)
+}
+
+// validatePendingBlobTx validates a blob transaction paying for a blob
+// received over IBC. These transactions aren't signed, so they must be
+// exactly the transactions built by the proposers for the pending blobs,
+// and each pending blob can only be included once.
+func (app *App) validatePendingBlobTx(ctx sdk.Context, id uint64, rawTx []byte, included map[uint64]bool) error {
+	if included[id] {
+		return fmt.Errorf("pending blob %d is included twice", id)
+	}
+	included[id] = true
+
+	pending, found := app.BlobIBCKeeper.GetPendingBlob(ctx, id)
+	if !found {
+		return fmt.Errorf("pending blob %d does not exist", id)
+	}
+	expected, err := app.pendingBlobTx(ctx, pending)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(rawTx, expected) {
+		return fmt.Errorf("transaction of pending blob %d differs from the expected transaction", id)
+	}
+	return nil
+}
+
+// pendingBlobID returns the ID of the pending blob paid by the blob
+// transaction, if any.
+func (app *App) pendingBlobID(blobTx blob.BlobTx) (uint64, bool) {
+	sdkTx, err := app.txConfig.TxDecoder()(blobTx.Tx)
+	if err != nil {
+		return 0, false
+	}
+	return blobibctypes.PendingBlobID(sdkTx)
+}
+
+// pendingBlobAnteHandler returns the ante handler delivering the blob
+// transactions paying for the blobs received over IBC, which were validated
+// in ProcessProposal. They aren't signed and their fee was paid when the blob
+// was received, so instead of the provided ante handler, they go through the
+// decorators needing neither a signature nor a fee, see
+// ante.NewUnsignedAnteHandler, and the blob is acknowledged with the share
+// commitment of the PayForBlobs. The other transactions, and the ones that
+// aren't delivered, are handled by the provided ante handler.
+func (app *App) pendingBlobAnteHandler(next sdk.AnteHandler) sdk.AnteHandler {
+	unsigned := ante.NewUnsignedAnteHandler(app.AccountKeeper, app.BlobKeeper)
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		id, ok := blobibctypes.PendingBlobID(tx)
+		if !ok || ctx.ExecMode() != sdk.ExecModeFinalize {
+			return next(ctx, tx, simulate)
+		}
+		ctx, err := unsigned(ctx, tx, simulate)
+		if err != nil {
+			return ctx, err
+		}
+		pfb := tx.GetMsgs()[0].(*blobtypes.MsgPayForBlobs)
+		if err := app.BlobIBCKeeper.IncludeBlob(ctx, id, pfb.ShareCommitments[0]); err != nil {
+			return ctx, err
+		}
+		return ctx, nil
+	}
+}
@@ -3,10 +3,12 @@ package app
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/sunrise-zone/sunrise-app/pkg/blob"
 	blobtypes "github.com/sunrise-zone/sunrise-app/x/blob/types"
+	blobibctypes "github.com/sunrise-zone/sunrise-app/x/blobibc/types"
 )
 
 // CheckTx implements the ABCI interface and executes a tx in CheckTx mode. This
@@ -23,11 +25,9 @@ func (app *App) CheckTx(req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error)
 		if err != nil {
 			return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false), err
 		}
-		// reject transactions that have a MsgPFB but no blobs attached to the tx
-		for _, msg := range sdkTx.GetMsgs() {
-			if _, ok := msg.(*blobtypes.MsgPayForBlobs); !ok {
-				continue
-			}
+		// reject transactions that have a MsgPFB but no blobs attached to the
+		// tx, including the MsgPFBs executed by authz MsgExec
+		if len(blobtypes.ExtractPFBs(sdkTx.GetMsgs())) > 0 {
 			return sdkerrors.ResponseCheckTxWithEvents(blobtypes.ErrNoBlobs, 0, 0, []abci.Event{}, false), blobtypes.ErrNoBlobs
 		}
 		// don't do anything special if we have a normal transaction
@@ -37,10 +37,17 @@ func (app *App) CheckTx(req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error)
 	switch req.Type {
 	// new transactions must be checked in their entirety
 	case abci.CheckTxType_New:
-		err := blobtypes.ValidateBlobTx(app.txConfig, btx)
+		// the successful validations are cached for ProcessProposal
+		err := app.blobTxCache.validateBlobTx(app.txConfig, tx, btx, app.BaseApp.AppVersion())
 		if err != nil {
 			return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false), err
 		}
+		// the transactions paying for the blobs received over IBC are only
+		// included by the proposers.
+		if _, ok := app.pendingBlobID(btx); ok {
+			err := errorsmod.Wrap(blobibctypes.ErrInvalidSigner, "pending blob transactions are included by the proposer")
+			return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false), err
+		}
 	case abci.CheckTxType_Recheck:
 	default:
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
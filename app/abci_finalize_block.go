@@ -0,0 +1,80 @@
+package app
+
+import (
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+// FinalizeBlock delivers the transactions of the block, leaving out the
+// extended commit transaction and the block info that aren't sdk transactions
+// and the blobs of the blob transactions, then queues the export of the
+// extended data square of the block if the node is configured to.
+func (app *App) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	// the block was built with the app version preceding its finalization
+	appVersion := app.BaseApp.AppVersion()
+
+	// the extended commit info is passed to the PreBlocker aside.
+	deliverReq := *req
+	leading := 0
+	if len(req.Txs) > 0 {
+		if extCommitInfo, ok := da.UnmarshalExtendedCommitTx(req.Txs[0]); ok {
+			app.extCommitInfo = extCommitInfo
+			deliverReq.Txs = req.Txs[1:]
+			leading = 1
+		}
+	}
+	// the block info was validated in ProcessProposal. Blocks that don't end
+	// with it, which aren't proposed by the app, are delivered as they are.
+	if squareTxs, _, _, err := square.ExtractBlockInfo(req.Txs, appVersion); err == nil {
+		deliverReq.Txs = sdkTxs(squareTxs)
+	}
+	res, err := app.App.FinalizeBlock(&deliverReq)
+	app.extCommitInfo = nil
+	if err != nil {
+		return res, err
+	}
+	trailing := len(req.Txs) - leading - len(deliverReq.Txs)
+	res.TxResults = withPseudoTxResults(res.TxResults, leading, trailing)
+
+	if app.edsExporter != nil {
+		app.edsExporter.enqueue(req.Height, req.Txs, appVersion)
+	}
+	return res, nil
+}
+
+// sdkTxs returns the transactions with the sdk transaction of the blob
+// transactions, whose blobs were validated in ProcessProposal, as CheckTx
+// does: the blobs are paid for by the PFB and not by the size of the
+// transaction.
+func sdkTxs(txs [][]byte) [][]byte {
+	delivered := make([][]byte, len(txs))
+	for i, tx := range txs {
+		if btx, isBlob := blob.UnmarshalBlobTx(tx); isBlob {
+			tx = btx.Tx
+		}
+		delivered[i] = tx
+	}
+	return delivered
+}
+
+// withPseudoTxResults returns the results of the delivered transactions with
+// the results of the leading and trailing transactions of the block that
+// aren't delivered, so that there is a result for each transaction of the
+// block.
+func withPseudoTxResults(results []*abci.ExecTxResult, leading, trailing int) []*abci.ExecTxResult {
+	if leading == 0 && trailing == 0 {
+		return results
+	}
+	all := make([]*abci.ExecTxResult, 0, leading+len(results)+trailing)
+	for i := 0; i < leading; i++ {
+		all = append(all, &abci.ExecTxResult{})
+	}
+	all = append(all, results...)
+	for i := 0; i < trailing; i++ {
+		all = append(all, &abci.ExecTxResult{})
+	}
+	return all
+}
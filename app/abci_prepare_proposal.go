@@ -1,12 +1,10 @@
 package app
 
 import (
-	"encoding/binary"
 	"time"
 
 	"github.com/sunrise-zone/sunrise-app/app/ante"
 	"github.com/sunrise-zone/sunrise-app/pkg/da"
-	"github.com/sunrise-zone/sunrise-app/pkg/shares"
 	"github.com/sunrise-zone/sunrise-app/pkg/square"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -39,11 +37,12 @@ func (app *App) PrepareProposal(req *abci.RequestPrepareProposal) (*abci.Respons
 
 	// create a context using a branch of the state and loaded using the
 	// proposal height and chain-id
-	sdkCtx := app.NewProposalContext(cmtproto.Header{
+	header := cmtproto.Header{
 		ChainID: app.ChainID(),
 		Height:  req.Height,
 		Time:    req.Time,
-	})
+	}
+	sdkCtx := app.NewProposalContext(header)
 	// filter out invalid transactions.
 	// TODO: we can remove all state independent checks from the ante handler here such as signature verification
 	// and only check the state dependent checks like fees and nonces as all these transactions have already
@@ -58,6 +57,7 @@ func (app *App) PrepareProposal(req *abci.RequestPrepareProposal) (*abci.Respons
 		app.IBCKeeper,
 	)
 
+	maxSquareSize := app.GovSquareSizeUpperBound(sdkCtx)
 	var txs [][]byte
 	// This if statement verifies whether the preparation of the proposal
 	// pertains to the first block. If it does, the block is constructed using
@@ -75,41 +75,69 @@ func (app *App) PrepareProposal(req *abci.RequestPrepareProposal) (*abci.Respons
 		txs = make([][]byte, 0)
 	} else {
 		txs = FilterTxs(app.Logger(), sdkCtx, handler, app.txConfig, req.Txs)
+		// include the blobs received over IBC before the blobs of the
+		// mempool, as their fee was already paid.
+		txs = append(app.pendingBlobTxs(sdkCtx, maxSquareSize, req.MaxTxBytes), txs...)
 	}
 
-	// build the square from the set of valid and prioritised transactions.
-	// The txs returned are the ones used in the square and block
-	dataSquare, txs, err := square.Build(txs, app.BaseApp.AppVersion(), app.GovSquareSizeUpperBound(sdkCtx))
+	// choose the transactions of the square from the set of valid
+	// transactions, picking the ones paying the most fees per share if they
+	// don't all fit. The txs returned are the ones used in the square and
+	// block
+	_, txs, err := square.BuildFeeMaximizing(txs, app.BaseApp.AppVersion(), maxSquareSize, app.squarePackingTxInfo)
 	if err != nil {
 		panic(err)
 	}
+	// the transactions were filtered in the order of the mempool, so they are
+	// filtered again in the order chosen for the square
+	if app.LastBlockHeight() != 0 {
+		txs, err = app.filterPackedTxs(header, handler, txs, maxSquareSize)
+		if err != nil {
+			panic(err)
+		}
+	}
 
-	// erasure the data square which we use to create the data root.
+	// construct the square from the transactions as the validators do in
+	// ProcessProposal, so that the data root of the proposal is the one they
+	// compute, then erasure it and create the data availability header (merkle
+	// roots of each row and col of the erasure data).
 	// Note: uses the nmt wrapper to construct the tree.
 	// checkout pkg/wrapper/nmt_wrapper.go for more information.
-	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	entry, err := app.constructSquare(txs, maxSquareSize)
 	if err != nil {
 		app.Logger().Error(
-			"failure to erasure the data square while creating a proposal block",
+			"failure to construct the data square while creating a proposal block",
 			"error",
 			err.Error(),
 		)
 		panic(err)
 	}
 
-	// create the new data root by creating the data availability header (merkle
-	// roots of each row and col of the erasure data).
-	dah, err := da.NewDataAvailabilityHeader(eds)
+	// include the vote extensions of the previous height as the first
+	// transaction so that the attestation signatures they contain can be
+	// stored in state. It isn't part of the square.
+	if voteExtensionsEnabled(sdkCtx, req.Height) {
+		extCommitInfo, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		extCommitTx, err := da.MarshalExtendedCommitTx(extCommitInfo)
+		if err != nil {
+			panic(err)
+		}
+		txs = append([][]byte{extCommitTx}, txs...)
+	}
+
+	// pass the data root and the square size to the validators along with
+	// the transactions, see square.AppendBlockInfo.
+	txs, err = square.AppendBlockInfo(txs, entry.dah.Hash(), uint64(entry.square.Size()), app.BaseApp.AppVersion())
 	if err != nil {
-		app.Logger().Error(
-			"failure to create new data availability header",
-			"error",
-			err.Error(),
-		)
 		panic(err)
 	}
 
-	txs = AppendInfoInTxs(txs, dah.Hash(), uint64(dataSquare.Size()))
+	// cache the square so that it isn't constructed again when processing
+	// the proposal
+	app.squareCache.add(newSquareCacheKey(req.Height, txs), entry)
 
 	// tendermint doesn't need to use any of the erasure data, as only the
 	// protobuf encoded version of the block data is gossiped.
@@ -117,12 +145,3 @@ func (app *App) PrepareProposal(req *abci.RequestPrepareProposal) (*abci.Respons
 		Txs: txs,
 	}, nil
 }
-
-func AppendInfoInTxs(txs [][]byte, dataHash []byte, squareSize uint64) [][]byte {
-	txs = append(txs, dataHash)
-	squareSizeBigEndican := make([]byte, 8)
-	binary.BigEndian.PutUint64(squareSizeBigEndican, squareSize)
-	txs = append(txs, squareSizeBigEndican)
-
-	return txs
-}
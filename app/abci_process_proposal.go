@@ -2,21 +2,19 @@ package app
 
 import (
 	"bytes"
-	"encoding/binary"
 	"fmt"
 	"time"
 
 	"github.com/sunrise-zone/sunrise-app/app/ante"
-	"github.com/sunrise-zone/sunrise-app/pkg/blob"
 	"github.com/sunrise-zone/sunrise-app/pkg/da"
-	"github.com/sunrise-zone/sunrise-app/pkg/shares"
 	"github.com/sunrise-zone/sunrise-app/pkg/square"
 	blobtypes "github.com/sunrise-zone/sunrise-app/x/blob/types"
+	blobibctypes "github.com/sunrise-zone/sunrise-app/x/blobibc/types"
 
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/telemetry"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 )
@@ -25,6 +23,13 @@ const rejectedPropBlockLog = "Rejected proposal block:"
 
 func (app *App) ProcessProposal(req *abci.RequestProcessProposal) (retResp *abci.ResponseProcessProposal, retErr error) {
 	defer telemetry.MeasureSince(time.Now(), "process_proposal")
+	// the square of a rejected proposal must not be reused
+	cacheKey := newSquareCacheKey(req.Height, req.Txs)
+	defer func() {
+		if retResp == nil || retResp.Status != abci.ResponseProcessProposal_ACCEPT {
+			app.squareCache.remove(cacheKey)
+		}
+	}()
 	// In the case of a panic from an unexpected condition, it is better for the liveness of the
 	// network that we catch it, log an error and vote nil than to crash the node.
 	defer func() {
@@ -57,23 +62,54 @@ func (app *App) ProcessProposal(req *abci.RequestProcessProposal) (retResp *abci
 		Time:    req.Time,
 	})
 
-	txs, dataHash, squareSize, err := ExtractInfoFromTxs(req.Txs)
+	// separate the data root and the square size of the block from the
+	// transactions of the square
+	txs, dataHash, squareSize, err := square.ExtractBlockInfo(req.Txs, app.BaseApp.AppVersion())
 	if err != nil {
 		logInvalidPropBlock(app.Logger(), req.ProposerAddress, err.Error())
 		return reject(err)
 	}
 
+	// the first transaction holds the vote extensions of the previous height,
+	// which must be signed by validators holding two thirds of the power. It
+	// isn't part of the square, and was left out of the txs above.
+	extCommitInfo, hasExtCommit := []byte(nil), false
+	if len(req.Txs) > 0 {
+		extCommitInfo, hasExtCommit = da.UnmarshalExtendedCommitTx(req.Txs[0])
+	}
+	if voteExtensionsEnabled(sdkCtx, req.Height) {
+		if !hasExtCommit {
+			err := fmt.Errorf("missing extended commit info")
+			logInvalidPropBlock(app.Logger(), req.ProposerAddress, err.Error())
+			return reject(err)
+		}
+		var extCommit abci.ExtendedCommitInfo
+		if err := extCommit.Unmarshal(extCommitInfo); err != nil {
+			logInvalidPropBlockError(app.Logger(), req.ProposerAddress, "failure to decode extended commit info", err)
+			return reject(err)
+		}
+		if err := baseapp.ValidateVoteExtensions(sdkCtx, app.StakingKeeper, req.Height, app.ChainID(), extCommit); err != nil {
+			logInvalidPropBlockError(app.Logger(), req.ProposerAddress, "invalid vote extensions", err)
+			return reject(err)
+		}
+	} else if hasExtCommit {
+		err := fmt.Errorf("unexpected extended commit info")
+		logInvalidPropBlock(app.Logger(), req.ProposerAddress, err.Error())
+		return reject(err)
+	}
+
+	// decode the txs, validate the blobTxs and verify the signatures
+	// concurrently, so that only the stateful checks of the ante handler are
+	// performed sequentially below.
+	proposalTxs, verifiedSigs := app.verifyProposalTxs(sdkCtx, txs)
+	sdkCtx = ante.WithVerifiedSignatures(sdkCtx, verifiedSigs)
+
 	// iterate over all txs and ensure that all blobTxs are valid, PFBs are correctly signed and non
 	// blobTxs have no PFBs present
+	includedPendingBlobs := make(map[uint64]bool)
 	for idx, rawTx := range txs {
-		tx := rawTx
-		blobTx, isBlobTx := blob.UnmarshalBlobTx(rawTx)
-		if isBlobTx {
-			tx = blobTx.Tx
-		}
-
-		sdkTx, err := app.txConfig.TxDecoder()(tx)
-		if err != nil {
+		isBlobTx, sdkTx := proposalTxs[idx].isBlobTx, proposalTxs[idx].sdkTx
+		if sdkTx == nil {
 			// we don't reject the block here because it is not a block validity
 			// rule that all transactions included in the block data are
 			// decodable
@@ -84,8 +120,9 @@ func (app *App) ProcessProposal(req *abci.RequestProcessProposal) (retResp *abci
 		if !isBlobTx {
 			msgs := sdkTx.GetMsgs()
 
-			_, has := hasPFB(msgs)
-			if has {
+			// the PFBs executed by authz MsgExec must be paired with blobs
+			// too.
+			if len(blobtypes.ExtractPFBs(msgs)) > 0 {
 				// A non blob tx has a PFB, which is invalid
 				err := fmt.Errorf("tx %d has PFB but is not a blob tx", idx)
 				logInvalidPropBlock(app.Logger(), req.ProposerAddress, err.Error())
@@ -107,16 +144,26 @@ func (app *App) ProcessProposal(req *abci.RequestProcessProposal) (retResp *abci
 		}
 
 		// validate the blobTx. This is the same validation used in CheckTx ensuring
-		// - there is one PFB
+		// - there are no more PFBs than allowed by the app version
 		// - that each blob has a valid namespace
 		// - that the sizes match
 		// - that the namespaces match between blob and PFB
 		// - that the share commitment is correct
-		if err := blobtypes.ValidateBlobTx(app.txConfig, blobTx); err != nil {
+		if err := proposalTxs[idx].validateBlobTxErr; err != nil {
 			logInvalidPropBlockError(app.Logger(), req.ProposerAddress, fmt.Sprintf("invalid blob tx %d", idx), err)
 			return reject(err)
 		}
 
+		// the PFBs of the blobs received over IBC aren't signed, and must
+		// pay for a pending blob.
+		if id, ok := blobibctypes.PendingBlobID(sdkTx); ok {
+			if err := app.validatePendingBlobTx(sdkCtx, id, rawTx, includedPendingBlobs); err != nil {
+				logInvalidPropBlockError(app.Logger(), req.ProposerAddress, fmt.Sprintf("invalid pending blob tx %d", idx), err)
+				return reject(err)
+			}
+			continue
+		}
+
 		// validated the PFB signature
 		sdkCtx, err = handler(sdkCtx, sdkTx, false)
 		if err != nil {
@@ -126,12 +173,17 @@ func (app *App) ProcessProposal(req *abci.RequestProcessProposal) (retResp *abci
 
 	}
 
-	// Construct the data square from the block's transactions
-	dataSquare, err := square.Construct(req.Txs, app.BaseApp.AppVersion(), app.GovSquareSizeUpperBound(sdkCtx))
-	if err != nil {
-		logInvalidPropBlockError(app.Logger(), req.ProposerAddress, "failure to compute data square from transactions:", err)
-		return reject(err)
+	// reuse the square constructed for this proposal, if any, else construct
+	// the data square from the block's transactions
+	entry, cached := app.squareCache.get(cacheKey)
+	if !cached {
+		entry, err = app.constructSquare(txs, app.GovSquareSizeUpperBound(sdkCtx))
+		if err != nil {
+			logInvalidPropBlockError(app.Logger(), req.ProposerAddress, "failure to compute data square from transactions", err)
+			return reject(err)
+		}
 	}
+	dataSquare, dah := entry.square, entry.dah
 
 	// Assert that the square size stated by the proposer is correct
 	if uint64(dataSquare.Size()) != squareSize {
@@ -140,17 +192,6 @@ func (app *App) ProcessProposal(req *abci.RequestProcessProposal) (retResp *abci
 		return reject(err)
 	}
 
-	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
-	if err != nil {
-		logInvalidPropBlockError(app.Logger(), req.ProposerAddress, "failure to erasure the data square", err)
-		return reject(err)
-	}
-
-	dah, err := da.NewDataAvailabilityHeader(eds)
-	if err != nil {
-		logInvalidPropBlockError(app.Logger(), req.ProposerAddress, "failure to create new data availability header", err)
-		return reject(err)
-	}
 	// by comparing the hashes we know the computed IndexWrappers (with the share indexes of the PFB's blobs)
 	// are identical and that square layout is consistent. This also means that the share commitment rules
 	// have been followed and thus each blobs share commitment should be valid
@@ -160,16 +201,17 @@ func (app *App) ProcessProposal(req *abci.RequestProcessProposal) (retResp *abci
 		return reject(err)
 	}
 
-	return accept()
-}
+	// keep the square for ExtendBlock
+	if !cached {
+		app.squareCache.add(cacheKey, entry)
+	}
 
-func hasPFB(msgs []sdk.Msg) (*blobtypes.MsgPayForBlobs, bool) {
-	for _, msg := range msgs {
-		if pfb, ok := msg.(*blobtypes.MsgPayForBlobs); ok {
-			return pfb, true
-		}
+	// report the share of the square lost to padding
+	if padding, err := dataSquare.PaddingShares(); err == nil {
+		telemetry.SetGauge(float32(padding)/float32(len(dataSquare)), "process_proposal", "padding_ratio")
 	}
-	return nil, false
+
+	return accept()
 }
 
 func logInvalidPropBlock(l log.Logger, proposerAddress []byte, reason string) {
@@ -205,18 +247,3 @@ func accept() (*abci.ResponseProcessProposal, error) {
 		Status: abci.ResponseProcessProposal_ACCEPT,
 	}, nil
 }
-
-func ExtractInfoFromTxs(txsWithInfo [][]byte) (txs [][]byte, dataHash []byte, squareSize uint64, err error) {
-	length := len(txsWithInfo)
-	if length < 2 {
-		err = fmt.Errorf("txs must contain the data hash and the square size at the end, and its length must not be lower than 2")
-		return
-	}
-
-	txs = txsWithInfo[:length-2]
-	dataHash = txsWithInfo[length-2]
-	squareSizeBigEndian := txsWithInfo[length-1]
-	squareSize = binary.BigEndian.Uint64(squareSizeBigEndian)
-
-	return
-}
@@ -0,0 +1,193 @@
+package app
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	streamkeeper "github.com/sunrise-zone/sunrise-app/x/blobstream/keeper"
+	streamtypes "github.com/sunrise-zone/sunrise-app/x/blobstream/types"
+)
+
+// dataCommitmentQueryTimeout bounds the time spent querying a data root tuple
+// root while extending a vote, so that a slow RPC can't stall consensus.
+const dataCommitmentQueryTimeout = 2 * time.Second
+
+// DataCommitmentClient queries the commitment over the data roots of a range
+// of blocks. It is implemented by the CometBFT RPC clients.
+type DataCommitmentClient interface {
+	DataCommitment(ctx context.Context, start, end uint64) (*rpctypes.ResultDataCommitment, error)
+}
+
+// VoteExtensionHandler signs the pending Blobstream attestations in the vote
+// extensions of the validator, and verifies the ones of the other validators.
+type VoteExtensionHandler struct {
+	logger       log.Logger
+	streamKeeper streamkeeper.Keeper
+	// evmKey is the EVM key registered by the validator. Attestations are not
+	// signed if nil.
+	evmKey *ecdsa.PrivateKey
+	client DataCommitmentClient
+}
+
+// NewVoteExtensionHandler returns a new VoteExtensionHandler. The EVM key can
+// be nil for nodes that don't sign attestations.
+func NewVoteExtensionHandler(
+	logger log.Logger,
+	streamKeeper streamkeeper.Keeper,
+	evmKey *ecdsa.PrivateKey,
+	client DataCommitmentClient,
+) *VoteExtensionHandler {
+	return &VoteExtensionHandler{
+		logger:       logger,
+		streamKeeper: streamKeeper,
+		evmKey:       evmKey,
+		client:       client,
+	}
+}
+
+// ExtendVoteHandler returns the handler signing the pending attestations.
+// Attestations that can't be signed, e.g. because the data commitment range
+// can't be queried yet, are skipped and retried at the next height.
+func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		if h.evmKey == nil {
+			return &abci.ResponseExtendVote{}, nil
+		}
+		evmAddress := crypto.PubkeyToAddress(h.evmKey.PublicKey)
+		pending, err := h.streamKeeper.GetPendingAttestations(ctx, evmAddress, streamtypes.MaxAttestationSignaturesPerVoteExtension)
+		if err != nil {
+			return nil, err
+		}
+
+		ext := streamtypes.AttestationVoteExtension{}
+		for _, at := range pending {
+			sig, err := h.signAttestation(ctx, at, evmAddress)
+			if err != nil {
+				h.logger.Error(
+					"failed to sign attestation",
+					"nonce", at.GetNonce(),
+					"height", req.Height,
+					"err", err.Error(),
+				)
+				continue
+			}
+			ext.Signatures = append(ext.Signatures, sig)
+		}
+		if len(ext.Signatures) == 0 {
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		bz, err := ext.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns the handler verifying that the
+// attestation signatures of a vote extension were made by the EVM address
+// registered by the validator.
+func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+		var ext streamtypes.AttestationVoteExtension
+		if err := ext.Unmarshal(req.VoteExtension); err != nil {
+			h.logger.Error("failed to decode vote extension", "height", req.Height, "err", err.Error())
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+		if err := h.streamKeeper.ValidateVoteExtension(ctx, req.ValidatorAddress, ext); err != nil {
+			h.logger.Error(
+				"rejected vote extension",
+				"height", req.Height,
+				"validator", sdk.ConsAddress(req.ValidatorAddress).String(),
+				"err", err.Error(),
+			)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+func (h *VoteExtensionHandler) signAttestation(
+	ctx sdk.Context,
+	at streamtypes.AttestationRequestI,
+	evmAddress gethcommon.Address,
+) (streamtypes.AttestationSignature, error) {
+	var dataRootTupleRoot []byte
+	if dc, ok := at.(*streamtypes.DataCommitment); ok {
+		queryCtx, cancel := context.WithTimeout(ctx, dataCommitmentQueryTimeout)
+		defer cancel()
+		res, err := h.client.DataCommitment(queryCtx, dc.BeginBlock, dc.EndBlock)
+		if err != nil {
+			return streamtypes.AttestationSignature{}, err
+		}
+		dataRootTupleRoot = res.DataCommitment
+	}
+	signBytes, err := streamtypes.AttestationSignBytes(at, dataRootTupleRoot)
+	if err != nil {
+		return streamtypes.AttestationSignature{}, err
+	}
+	signature, err := streamtypes.SignAttestation(signBytes, h.evmKey)
+	if err != nil {
+		return streamtypes.AttestationSignature{}, err
+	}
+	return streamtypes.AttestationSignature{
+		Nonce:             at.GetNonce(),
+		EvmAddress:        evmAddress.Hex(),
+		DataRootTupleRoot: dataRootTupleRoot,
+		Signature:         signature,
+	}, nil
+}
+
+// voteExtensionsEnabled returns true if the vote extensions of the previous
+// height must be included in the block at the provided height.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
+
+// PreBlocker runs the module pre-blockers, then stores the attestation
+// signatures of the vote extensions that the proposer included in the
+// extended commit transaction of the block.
+func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	res, err := app.App.PreBlocker(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if !voteExtensionsEnabled(ctx, req.Height) || app.extCommitInfo == nil {
+		return res, nil
+	}
+
+	// the extended commit was validated in ProcessProposal, and left out of
+	// the delivered transactions by FinalizeBlock.
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(app.extCommitInfo); err != nil {
+		return nil, err
+	}
+	stored := 0
+	for _, vote := range extCommit.Votes {
+		if len(vote.VoteExtension) == 0 {
+			continue
+		}
+		var ext streamtypes.AttestationVoteExtension
+		if err := ext.Unmarshal(vote.VoteExtension); err != nil {
+			continue
+		}
+		stored += app.StreamKeeper.StoreVoteExtension(ctx, vote.Validator.Address, ext)
+	}
+	if stored > 0 {
+		app.Logger().Debug("stored attestation signatures", "height", req.Height, "count", stored)
+	}
+	return res, nil
+}
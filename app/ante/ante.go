@@ -51,9 +51,10 @@ func NewAnteHandler(
 		ante.NewSigGasConsumeDecorator(accountKeeper, sigGasConsumer),
 		// Ensure that the tx's signatures are valid. For each signature, ensure
 		// that the signature's sequence number (a.k.a nonce) matches the
-		// account sequence number of the signer.
+		// account sequence number of the signer. Skips the signatures
+		// verified ahead, see WithVerifiedSignatures.
 		// Note: does not consume gas from the gas meter.
-		ante.NewSigVerificationDecorator(accountKeeper, signModeHandler),
+		NewSigVerificationDecorator(accountKeeper, signModeHandler),
 		// Ensure that the tx's gas limit is > the gas consumed based on the blob size(s).
 		// Contract: must be called after all decorators that consume gas.
 		// Note: does not consume gas from the gas meter.
@@ -67,4 +68,33 @@ func NewAnteHandler(
 	)
 }
 
+// NewUnsignedAnteHandler returns the ante handler of the transactions that are
+// neither signed nor paying a fee, such as the ones paying for the blobs
+// received over IBC, whose fee was paid when they were received. It runs the
+// decorators of NewAnteHandler that need neither a signature nor a fee.
+func NewUnsignedAnteHandler(accountKeeper ante.AccountKeeper, blobKeeper blob.Keeper) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		// Wraps the panic with the string format of the transaction
+		NewHandlePanicDecorator(),
+		// Set up the context with a gas meter.
+		// Must be called before gas consumption occurs in any other decorator.
+		ante.NewSetUpContextDecorator(),
+		// Ensure the tx does not contain any extension options.
+		ante.NewExtensionOptionsDecorator(nil),
+		// Ensure the tx has not reached a height timeout.
+		ante.NewTxTimeoutHeightDecorator(),
+		// Ensure the tx memo <= max memo characters.
+		ante.NewValidateMemoDecorator(accountKeeper),
+		// Ensure the tx's gas limit is > the gas consumed based on the tx size.
+		// Side effect: consumes gas from the gas meter.
+		ante.NewConsumeGasForTxSizeDecorator(accountKeeper),
+		// Ensure that the tx's gas limit is > the gas consumed based on the blob size(s).
+		// Contract: must be called after all decorators that consume gas.
+		// Note: does not consume gas from the gas meter.
+		blobante.NewMinGasPFBDecorator(blobKeeper),
+		// Ensure that the tx's total blob size is <= the max blob size.
+		blobante.NewMaxBlobSizeDecorator(blobKeeper),
+	)
+}
+
 var DefaultSigVerificationGasConsumer = ante.DefaultSigVerificationGasConsumer
@@ -0,0 +1,296 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"sync"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	errorsmod "cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// VerifiedSignatures records the signatures verified ahead of the ante
+// handler, for instance concurrently for all the transactions of a block.
+// The SigVerificationDecorator doesn't verify these signatures again when
+// the context carries them, see WithVerifiedSignatures. It is safe for
+// concurrent use.
+type VerifiedSignatures struct {
+	mtx  sync.RWMutex
+	keys map[[sha256.Size]byte]struct{}
+}
+
+func NewVerifiedSignatures() *VerifiedSignatures {
+	return &VerifiedSignatures{keys: make(map[[sha256.Size]byte]struct{})}
+}
+
+// Len returns the number of verified signatures.
+func (v *VerifiedSignatures) Len() int {
+	v.mtx.RLock()
+	defer v.mtx.RUnlock()
+	return len(v.keys)
+}
+
+func (v *VerifiedSignatures) add(key [sha256.Size]byte) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	v.keys[key] = struct{}{}
+}
+
+func (v *VerifiedSignatures) has(key [sha256.Size]byte) bool {
+	v.mtx.RLock()
+	defer v.mtx.RUnlock()
+	_, ok := v.keys[key]
+	return ok
+}
+
+type verifiedSignaturesKey struct{}
+
+// WithVerifiedSignatures returns a copy of the context whose
+// SigVerificationDecorator skips the signatures recorded in verified.
+func WithVerifiedSignatures(ctx sdk.Context, verified *VerifiedSignatures) sdk.Context {
+	return ctx.WithValue(verifiedSignaturesKey{}, verified)
+}
+
+func verifiedSignaturesFromContext(ctx sdk.Context) *VerifiedSignatures {
+	verified, _ := ctx.Value(verifiedSignaturesKey{}).(*VerifiedSignatures)
+	return verified
+}
+
+// Signature holds a signature of a transaction along with the data of its
+// signer required to verify it, which is read from the state by
+// CollectSignatures. Its verification doesn't access the state.
+type Signature struct {
+	pubKey     cryptotypes.PubKey
+	signerData txsigning.SignerData
+	sig        signing.SignatureV2
+	txData     txsigning.TxData
+	key        [sha256.Size]byte
+}
+
+// CollectSignatures returns the signatures of the transaction that can be
+// verified ahead of the ante handler, using the account numbers and public
+// keys of the signers in the state, or the public keys of the transaction for
+// the signers that have none yet. The signatures are expected to be verified
+// with the sequences they claim, which the ante handler still checks. The
+// signatures that can't be verified ahead, such as multisig ones, are
+// skipped and verified by the ante handler.
+func CollectSignatures(ctx sdk.Context, ak ante.AccountKeeper, tx sdk.Tx) []Signature {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return nil
+	}
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return nil
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil || len(sigs) != len(signers) {
+		return nil
+	}
+	pubKeys, err := sigTx.GetPubKeys()
+	if err != nil {
+		return nil
+	}
+
+	txData := adaptableTx.GetSigningTxData()
+	signatures := make([]Signature, 0, len(sigs))
+	for i, sig := range sigs {
+		acc := ak.GetAccount(ctx, signers[i])
+		if acc == nil {
+			continue
+		}
+		pubKey := acc.GetPubKey()
+		if pubKey == nil && i < len(pubKeys) {
+			pubKey = pubKeys[i]
+		}
+		if pubKey == nil {
+			continue
+		}
+		signerData := newSignerData(ctx, acc, pubKey, sig.Sequence)
+		key, ok := signatureKey(pubKey, signerData, sig, txData)
+		if !ok {
+			continue
+		}
+		signatures = append(signatures, Signature{
+			pubKey:     pubKey,
+			signerData: signerData,
+			sig:        sig,
+			txData:     txData,
+			key:        key,
+		})
+	}
+	return signatures
+}
+
+// Verify verifies the signature and records it in verified if valid. It is
+// safe to call concurrently.
+func (s Signature) Verify(ctx sdk.Context, signModeHandler *txsigning.HandlerMap, verified *VerifiedSignatures) {
+	if err := authsigning.VerifySignature(ctx, s.pubKey, s.signerData, s.sig.Data, signModeHandler, s.txData); err == nil {
+		verified.add(s.key)
+	}
+}
+
+// SigVerificationDecorator verifies the signatures of a transaction exactly
+// like the SigVerificationDecorator of the auth module, but skips the
+// signatures recorded in the VerifiedSignatures of the context, if any.
+type SigVerificationDecorator struct {
+	ak              ante.AccountKeeper
+	signModeHandler *txsigning.HandlerMap
+}
+
+func NewSigVerificationDecorator(ak ante.AccountKeeper, signModeHandler *txsigning.HandlerMap) SigVerificationDecorator {
+	return SigVerificationDecorator{
+		ak:              ak,
+		signModeHandler: signModeHandler,
+	}
+}
+
+func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	// stdSigs contains the sequence number, account number, and signatures.
+	// When simulating, this would just be a 0-length slice.
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	// check that signer length and signature length are the same
+	if len(sigs) != len(signers) {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
+	}
+
+	verified := verifiedSignaturesFromContext(ctx)
+	for i, sig := range sigs {
+		acc, err := ante.GetSignerAcc(ctx, svd.ak, signers[i])
+		if err != nil {
+			return ctx, err
+		}
+
+		// retrieve pubkey
+		pubKey := acc.GetPubKey()
+		if !simulate && pubKey == nil {
+			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+		}
+
+		// Check account sequence number.
+		if sig.Sequence != acc.GetSequence() {
+			return ctx, errorsmod.Wrapf(
+				sdkerrors.ErrWrongSequence,
+				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+			)
+		}
+
+		// no need to verify signatures on recheck tx
+		if simulate || ctx.IsReCheckTx() {
+			continue
+		}
+
+		signerData := newSignerData(ctx, acc, pubKey, acc.GetSequence())
+		adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+		if !ok {
+			return ctx, fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+		}
+		txData := adaptableTx.GetSigningTxData()
+
+		// skip the signatures already verified with the same signer data
+		if verified != nil {
+			if key, ok := signatureKey(pubKey, signerData, sig, txData); ok && verified.has(key) {
+				continue
+			}
+		}
+
+		err = authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
+		if err != nil {
+			var errMsg string
+			if ante.OnlyLegacyAminoSigners(sig.Data) {
+				// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
+				// and therefore communicate sequence number as a potential cause of error.
+				errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", signerData.AccountNumber, acc.GetSequence(), signerData.ChainID)
+			} else {
+				errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", signerData.AccountNumber, signerData.ChainID, err.Error())
+			}
+			return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// newSignerData returns the data of the signer used to verify its signature.
+// The account number is zero for the transactions of the genesis.
+func newSignerData(ctx sdk.Context, acc sdk.AccountI, pubKey cryptotypes.PubKey, sequence uint64) txsigning.SignerData {
+	var accNum uint64
+	if ctx.BlockHeight() != 0 {
+		accNum = acc.GetAccountNumber()
+	}
+	anyPk, _ := codectypes.NewAnyWithValue(pubKey)
+	return txsigning.SignerData{
+		Address:       acc.GetAddress().String(),
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      sequence,
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}
+}
+
+// signatureKey returns a hash of all the inputs of the verification of a
+// signature, so that a signature verified ahead is only skipped if verified
+// with the exact same inputs. Only single signatures are supported.
+func signatureKey(pubKey cryptotypes.PubKey, signerData txsigning.SignerData, sig signing.SignatureV2, txData txsigning.TxData) (key [sha256.Size]byte, ok bool) {
+	data, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok || pubKey == nil {
+		return key, false
+	}
+
+	hasher := sha256.New()
+	for _, bz := range [][]byte{
+		txData.BodyBytes,
+		txData.AuthInfoBytes,
+		[]byte(signerData.PubKey.TypeUrl),
+		pubKey.Bytes(),
+		[]byte(signerData.Address),
+		[]byte(signerData.ChainID),
+		data.Signature,
+	} {
+		writeUint64(hasher, uint64(len(bz)))
+		hasher.Write(bz)
+	}
+	writeUint64(hasher, signerData.AccountNumber)
+	writeUint64(hasher, signerData.Sequence)
+	writeUint64(hasher, uint64(data.SignMode))
+	copy(key[:], hasher.Sum(nil))
+	return key, true
+}
+
+func writeUint64(h hash.Hash, v uint64) {
+	var bz [8]byte
+	binary.BigEndian.PutUint64(bz[:], v)
+	h.Write(bz[:])
+}
@@ -0,0 +1,240 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sunrise-zone/sunrise-app/app/ante"
+	"github.com/sunrise-zone/sunrise-app/pkg/parallel"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/tx/signing"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = "test-chain"
+
+func TestSigVerificationDecorator(t *testing.T) {
+	txConfig := newTestTxConfig(t)
+	ak := newMockAccountKeeper()
+	ctx := newTestContext()
+
+	// the pubkey of the first account is only known from its transactions
+	txs := generateSignedTxs(t, txConfig, ak, 3, 2)
+	tamperedTx := tamperSignature(t, txConfig, txs[0])
+
+	verified := ante.NewVerifiedSignatures()
+	for _, tx := range append(txs, tamperedTx) {
+		for _, sig := range ante.CollectSignatures(ctx, ak, tx) {
+			sig.Verify(ctx, txConfig.SignModeHandler(), verified)
+		}
+	}
+	require.Equal(t, len(txs), verified.Len())
+
+	decorator := ante.NewSigVerificationDecorator(ak, txConfig.SignModeHandler())
+	handler := sdk.ChainAnteDecorators(decorator)
+	verifiedCtx := ante.WithVerifiedSignatures(ctx, verified)
+
+	// the results are the same whether the signatures were verified ahead or
+	// not
+	for _, tx := range txs {
+		ak.setSequence(t, tx)
+		_, err := handler(ctx, tx, false)
+		require.NoError(t, err)
+		_, err = handler(verifiedCtx, tx, false)
+		require.NoError(t, err)
+	}
+	ak.setSequence(t, tamperedTx)
+	_, err := handler(ctx, tamperedTx, false)
+	require.Error(t, err)
+	_, verifiedErr := handler(verifiedCtx, tamperedTx, false)
+	require.Equal(t, err.Error(), verifiedErr.Error())
+
+	// the signatures verified ahead aren't verified again
+	skippingHandler := sdk.ChainAnteDecorators(ante.NewSigVerificationDecorator(ak, nil))
+	ak.setSequence(t, txs[0])
+	_, err = skippingHandler(verifiedCtx, txs[0], false)
+	require.NoError(t, err)
+
+	// unless the signer data changed since
+	sigs, err := txs[0].(authsigning.Tx).GetSignaturesV2()
+	require.NoError(t, err)
+	ak.accounts[string(sigs[0].PubKey.Address())].(*authtypes.BaseAccount).AccountNumber++
+	_, err = handler(verifiedCtx, txs[0], false)
+	require.Error(t, err)
+}
+
+func BenchmarkSigVerification(b *testing.B) {
+	const numTxs = 1000
+	txConfig := newTestTxConfig(b)
+	ak := newMockAccountKeeper()
+	ctx := newTestContext()
+	txs := generateSignedTxs(b, txConfig, ak, numTxs, 1)
+	for _, tx := range txs {
+		ak.setSequence(b, tx)
+	}
+	handler := sdk.ChainAnteDecorators(ante.NewSigVerificationDecorator(ak, txConfig.SignModeHandler()))
+
+	b.Run("sequential", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, tx := range txs {
+				_, err := handler(ctx, tx, false)
+				require.NoError(b, err)
+			}
+		}
+	})
+
+	b.Run("parallel", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			verified := ante.NewVerifiedSignatures()
+			var sigs []ante.Signature
+			for _, tx := range txs {
+				sigs = append(sigs, ante.CollectSignatures(ctx, ak, tx)...)
+			}
+			parallel.ForEach(len(sigs), func(i int) {
+				sigs[i].Verify(ctx, txConfig.SignModeHandler(), verified)
+			})
+			verifiedCtx := ante.WithVerifiedSignatures(ctx, verified)
+			for _, tx := range txs {
+				_, err := handler(verifiedCtx, tx, false)
+				require.NoError(b, err)
+			}
+		}
+	})
+}
+
+func newTestTxConfig(t testing.TB) client.TxConfig {
+	registry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+			ValidatorAddressCodec: addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
+		},
+	})
+	require.NoError(t, err)
+	std.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	return authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+}
+
+func newTestContext() sdk.Context {
+	return sdk.NewContext(nil, cmtproto.Header{ChainID: testChainID, Height: 1}, false, log.NewNopLogger())
+}
+
+// generateSignedTxs returns numTxsPerAccount signed bank sends for each of
+// numAccounts accounts, added to the account keeper. The first account has
+// no public key in the state.
+func generateSignedTxs(t testing.TB, txConfig client.TxConfig, ak *mockAccountKeeper, numAccounts, numTxsPerAccount int) []sdk.Tx {
+	var txs []sdk.Tx
+	for i := 0; i < numAccounts; i++ {
+		priv := secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		acc := authtypes.NewBaseAccount(addr, nil, uint64(i), 0)
+		if i > 0 {
+			require.NoError(t, acc.SetPubKey(priv.PubKey()))
+		}
+		ak.accounts[string(addr)] = acc
+
+		for seq := 0; seq < numTxsPerAccount; seq++ {
+			builder := txConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))))
+			builder.SetGasLimit(100000)
+			signMode := signingtypes.SignMode(txConfig.SignModeHandler().DefaultMode())
+			require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+				PubKey:   priv.PubKey(),
+				Data:     &signingtypes.SingleSignatureData{SignMode: signMode},
+				Sequence: uint64(seq),
+			}))
+			sig, err := clienttx.SignWithPrivKey(context.Background(), signMode, authsigning.SignerData{
+				Address:       addr.String(),
+				ChainID:       testChainID,
+				AccountNumber: acc.AccountNumber,
+				Sequence:      uint64(seq),
+				PubKey:        priv.PubKey(),
+			}, builder, priv, txConfig, uint64(seq))
+			require.NoError(t, err)
+			require.NoError(t, builder.SetSignatures(sig))
+			txs = append(txs, builder.GetTx())
+		}
+	}
+	return txs
+}
+
+// tamperSignature returns a copy of the tx with an invalid signature.
+func tamperSignature(t testing.TB, txConfig client.TxConfig, tx sdk.Tx) sdk.Tx {
+	builder, err := txConfig.WrapTxBuilder(tx)
+	require.NoError(t, err)
+	sigs, err := tx.(authsigning.Tx).GetSignaturesV2()
+	require.NoError(t, err)
+	data := sigs[0].Data.(*signingtypes.SingleSignatureData)
+	signature := append([]byte{}, data.Signature...)
+	signature[0] ^= 0xff
+	sigs[0].Data = &signingtypes.SingleSignatureData{SignMode: data.SignMode, Signature: signature}
+
+	tampered := txConfig.NewTxBuilder()
+	require.NoError(t, tampered.SetMsgs(builder.GetTx().GetMsgs()...))
+	tampered.SetGasLimit(builder.GetTx().GetGas())
+	require.NoError(t, tampered.SetSignatures(sigs...))
+	return tampered.GetTx()
+}
+
+type mockAccountKeeper struct {
+	accounts map[string]sdk.AccountI
+}
+
+func newMockAccountKeeper() *mockAccountKeeper {
+	return &mockAccountKeeper{accounts: make(map[string]sdk.AccountI)}
+}
+
+// setSequence sets the sequences of the signers of the tx to the ones of its
+// signatures, and their public keys if not set yet, like the decorators
+// preceding the signature verification.
+func (ak *mockAccountKeeper) setSequence(t testing.TB, tx sdk.Tx) {
+	sigs, err := tx.(authsigning.Tx).GetSignaturesV2()
+	require.NoError(t, err)
+	for _, sig := range sigs {
+		acc := ak.accounts[string(sig.PubKey.Address())]
+		require.NoError(t, acc.SetSequence(sig.Sequence))
+		if acc.GetPubKey() == nil {
+			require.NoError(t, acc.SetPubKey(sig.PubKey))
+		}
+	}
+}
+
+func (ak *mockAccountKeeper) GetParams(context.Context) authtypes.Params {
+	return authtypes.DefaultParams()
+}
+
+func (ak *mockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return ak.accounts[string(addr)]
+}
+
+func (ak *mockAccountKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	ak.accounts[string(acc.GetAddress())] = acc
+}
+
+func (ak *mockAccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+func (ak *mockAccountKeeper) AddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"io"
 	"os"
 	"path/filepath"
@@ -12,6 +13,7 @@ import (
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -52,16 +54,22 @@ import (
 	ibcfeekeeper "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
 
 	blobmodulekeeper "github.com/sunrise-zone/sunrise-app/x/blob/keeper"
 	grantmodulekeeper "github.com/sunrise-zone/sunrise-app/x/blobgrant/keeper"
+	blobibckeeper "github.com/sunrise-zone/sunrise-app/x/blobibc/keeper"
 	streammodulekeeper "github.com/sunrise-zone/sunrise-app/x/blobstream/keeper"
+	intertxkeeper "github.com/sunrise-zone/sunrise-app/x/intertx/keeper"
 	liquidstakingmodulekeeper "github.com/sunrise-zone/sunrise-app/x/liquidstaking/keeper"
+	ratelimitkeeper "github.com/sunrise-zone/sunrise-app/x/ratelimit/keeper"
 	sunrisemodulekeeper "github.com/sunrise-zone/sunrise-app/x/sunrise/keeper"
+	tokenfilterkeeper "github.com/sunrise-zone/sunrise-app/x/tokenfilter/keeper"
 
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 
 	"github.com/sunrise-zone/sunrise-app/docs"
+	"github.com/sunrise-zone/sunrise-app/pkg/das"
 )
 
 const (
@@ -114,12 +122,17 @@ type App struct {
 	ICAControllerKeeper icacontrollerkeeper.Keeper
 	ICAHostKeeper       icahostkeeper.Keeper
 	TransferKeeper      ibctransferkeeper.Keeper
+	TokenFilterKeeper   tokenfilterkeeper.Keeper
+	RateLimitKeeper     ratelimitkeeper.Keeper
+	InterTxKeeper       intertxkeeper.Keeper
+	BlobIBCKeeper       blobibckeeper.Keeper
 
 	// Scoped IBC
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
 	ScopedIBCTransferKeeper   capabilitykeeper.ScopedKeeper
 	ScopedICAControllerKeeper capabilitykeeper.ScopedKeeper
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
+	ScopedBlobIBCKeeper       capabilitykeeper.ScopedKeeper
 
 	SunriseKeeper       sunrisemodulekeeper.Keeper
 	BlobKeeper          blobmodulekeeper.Keeper
@@ -130,6 +143,18 @@ type App struct {
 
 	// simulation manager
 	sm *module.SimulationManager
+
+	// squareCache holds the squares of the latest proposals
+	squareCache *squareCache
+	// blobTxCache holds the blob transactions validated in CheckTx
+	blobTxCache *blobTxCache
+	// edsExporter exports the extended data squares of the committed
+	// blocks, if configured
+	edsExporter *edsExporter
+	// extCommitInfo is the extended commit info of the block being
+	// finalized, if any, left out of the delivered transactions for the
+	// PreBlocker
+	extCommitInfo []byte
 }
 
 func init() {
@@ -181,7 +206,7 @@ func New(
 	baseAppOptions ...func(*baseapp.BaseApp),
 ) (*App, error) {
 	var (
-		app        = &App{}
+		app        = &App{squareCache: newSquareCache(squareCacheSize)}
 		appBuilder *runtime.AppBuilder
 
 		// merge the AppConfig and other configuration in one config
@@ -299,17 +324,41 @@ func New(
 	// }
 	// baseAppOptions = append(baseAppOptions, prepareOpt)
 	//
-	// create and set vote extension handler
-	// voteExtOp := func(bApp *baseapp.BaseApp) {
-	// 	voteExtHandler := NewVoteExtensionHandler()
-	// 	voteExtHandler.SetHandlers(bApp)
-	// }
-
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	// sign the pending Blobstream attestations in vote extensions and store
+	// the aggregated signatures in the next block.
+	blobstreamConfig := ReadBlobstreamConfig(appOpts)
+	evmKey, err := blobstreamConfig.LoadEVMKey()
+	if err != nil {
+		return nil, err
+	}
+	rpcClient, err := rpchttp.New(blobstreamConfig.RPCAddress, "/websocket")
+	if err != nil {
+		return nil, err
+	}
+	voteExtHandler := NewVoteExtensionHandler(logger, app.StreamKeeper, evmKey, rpcClient)
+	app.SetExtendVoteHandler(voteExtHandler.ExtendVoteHandler())
+	app.SetVerifyVoteExtensionHandler(voteExtHandler.VerifyVoteExtensionHandler())
+	app.SetPreBlocker(app.PreBlocker)
+
+	// cache the blob transactions validated in CheckTx for ProcessProposal.
+	app.blobTxCache, err = newBlobTxCache(ReadBlobConfig(appOpts).TxCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// export the extended data squares of the committed blocks.
+	if exportDir := ReadEDSConfig(appOpts).ExportDir; exportDir != "" {
+		app.edsExporter = newEDSExporter(exportDir, app.squareCache, logger)
+	}
+
 	// Register legacy modules
 	app.registerIBCModules()
 
+	// deliver the transactions of the blobs received over IBC.
+	app.SetAnteHandler(app.pendingBlobAnteHandler(app.AnteHandler()))
+
 	// register streaming services
 	if err := app.RegisterStreamingServices(appOpts, app.kvStoreKeys()); err != nil {
 		return nil, err
@@ -343,10 +392,14 @@ func New(
 	// 	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap())
 	// 	return app.App.InitChainer(ctx, req)
 	// })
+	app.SetInitChainer(app.initChainer)
 
 	if err := app.Load(loadLatest); err != nil {
 		return nil, err
 	}
+	if err := app.loadAppVersion(); err != nil {
+		return nil, err
+	}
 
 	return app, nil
 }
@@ -420,6 +473,19 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 
 	// register app's OpenAPI routes.
 	docs.RegisterOpenAPIService(Name, apiSvr.Router)
+
+	// register the data availability sampling routes.
+	if err := das.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, das.NewQueryClient(apiSvr.ClientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterNodeService registers the node gRPC services on the app gRPC router,
+// along with the data availability sampling service serving the extended data
+// squares of the blocks of the node.
+func (app *App) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
+	app.App.RegisterNodeService(clientCtx, cfg)
+	das.RegisterQueryServer(app.GRPCQueryRouter(), das.NewQueryServer(clientCtx.Client, app.ExtendBlockAt))
 }
 
 // GetIBCKeeper returns the IBC keeper.
@@ -432,6 +498,21 @@ func (app *App) GetCapabilityScopedKeeper(moduleName string) capabilitykeeper.Sc
 	return app.CapabilityKeeper.ScopeToModule(moduleName)
 }
 
+// GetBaseApp returns the base app.
+func (app *App) GetBaseApp() *baseapp.BaseApp {
+	return app.App.BaseApp
+}
+
+// GetStakingKeeper returns the staking keeper.
+func (app *App) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return app.StakingKeeper
+}
+
+// GetScopedIBCKeeper returns the IBC scoped keeper.
+func (app *App) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -80,12 +80,16 @@ import (
 	blobmoduletypes "github.com/sunrise-zone/sunrise-app/x/blob/types"
 	_ "github.com/sunrise-zone/sunrise-app/x/blobgrant/module" // import for side-effects
 	grantmoduletypes "github.com/sunrise-zone/sunrise-app/x/blobgrant/types"
+	blobibctypes "github.com/sunrise-zone/sunrise-app/x/blobibc/types"
 	_ "github.com/sunrise-zone/sunrise-app/x/blobstream/module" // import for side-effects
 	streammoduletypes "github.com/sunrise-zone/sunrise-app/x/blobstream/types"
+	intertxtypes "github.com/sunrise-zone/sunrise-app/x/intertx/types"
 	_ "github.com/sunrise-zone/sunrise-app/x/liquidstaking/module" // import for side-effects
 	liquidstakingmoduletypes "github.com/sunrise-zone/sunrise-app/x/liquidstaking/types"
+	ratelimittypes "github.com/sunrise-zone/sunrise-app/x/ratelimit/types"
 	_ "github.com/sunrise-zone/sunrise-app/x/sunrise/module" // import for side-effects
 	sunrisemoduletypes "github.com/sunrise-zone/sunrise-app/x/sunrise/types"
+	tokenfiltertypes "github.com/sunrise-zone/sunrise-app/x/tokenfilter/types"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 )
 
@@ -114,6 +118,10 @@ var (
 		ibctransfertypes.ModuleName,
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
+		tokenfiltertypes.ModuleName,
+		ratelimittypes.ModuleName,
+		intertxtypes.ModuleName,
+		blobibctypes.ModuleName,
 		feegrant.ModuleName,
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
@@ -151,6 +159,7 @@ var (
 		ibctransfertypes.ModuleName,
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
+		ratelimittypes.ModuleName,
 		// chain modules
 		sunrisemoduletypes.ModuleName,
 		blobmoduletypes.ModuleName,
@@ -0,0 +1,43 @@
+package app
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// initChainer sets the app version to the one of the genesis consensus
+// params, which is the one of the block headers, before initializing the
+// chain. The layout of the blocks depends on it, see
+// appconsts.UsesBlockInfoTx.
+func (app *App) initChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
+	if req.ConsensusParams != nil && req.ConsensusParams.Version != nil {
+		app.SetProtocolVersion(req.ConsensusParams.Version.App)
+	}
+	res, err := app.App.InitChainer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	// the consensus engine takes the version of the first block from Info,
+	// called before InitChain, unless the consensus params are returned.
+	if res.ConsensusParams == nil {
+		res.ConsensusParams = req.ConsensusParams
+	}
+	return res, nil
+}
+
+// loadAppVersion sets the app version to the one of the consensus params of
+// the committed state, if any.
+func (app *App) loadAppVersion() error {
+	if app.LastBlockHeight() == 0 {
+		return nil
+	}
+	params, err := app.ConsensusParamsKeeper.ParamsStore.Get(app.NewUncachedContext(false, cmtproto.Header{}))
+	if err != nil {
+		return err
+	}
+	if params.Version != nil {
+		app.SetProtocolVersion(params.Version.App)
+	}
+	return nil
+}
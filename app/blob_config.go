@@ -0,0 +1,50 @@
+package app
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// FlagBlobTxCacheSize is the app.toml key of the number of blob transactions
+// whose validation is cached.
+const FlagBlobTxCacheSize = "blob.tx-cache-size"
+
+// BlobConfig defines the options of the node for the handling of blob
+// transactions.
+type BlobConfig struct {
+	// TxCacheSize is the number of blob transactions whose successful
+	// validation in CheckTx is cached, so that the share commitments of their
+	// blobs aren't computed again when processing proposals. The cache is
+	// disabled if zero.
+	TxCacheSize int `mapstructure:"tx-cache-size"`
+}
+
+// DefaultBlobConfig returns the default blob config.
+func DefaultBlobConfig() BlobConfig {
+	return BlobConfig{
+		TxCacheSize: 10_000,
+	}
+}
+
+// BlobConfigTemplate is the app.toml template of the blob config.
+const BlobConfigTemplate = `
+###############################################################################
+###                            Blob Configuration                           ###
+###############################################################################
+
+[blob]
+
+# Number of blob transactions whose successful validation in CheckTx is cached,
+# so that the share commitments of their blobs aren't computed again when
+# processing proposals. Set to 0 to disable the cache.
+tx-cache-size = {{ .Blob.TxCacheSize }}
+`
+
+// ReadBlobConfig reads the blob config from the app options.
+func ReadBlobConfig(appOpts servertypes.AppOptions) BlobConfig {
+	cfg := DefaultBlobConfig()
+	if v := appOpts.Get(FlagBlobTxCacheSize); v != nil {
+		cfg.TxCacheSize = cast.ToInt(v)
+	}
+	return cfg
+}
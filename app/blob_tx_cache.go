@@ -0,0 +1,89 @@
+package app
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	blobtypes "github.com/sunrise-zone/sunrise-app/x/blob/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// blobTxCache is a LRU cache of the blob transactions successfully validated
+// by ValidateBlobTx, keyed by the hash of the blob transactions. It lets
+// ProcessProposal skip the validation, and in particular the computation of
+// the share commitments, of the blob transactions already validated in
+// CheckTx. As the validation depends on the app version, the cache is purged
+// when the app version changes. A nil cache is disabled. It is safe for
+// concurrent use.
+type blobTxCache struct {
+	mtx        sync.Mutex
+	appVersion uint64
+	cache      *lru.Cache
+}
+
+// newBlobTxCache returns a cache of the given number of blob transactions, or
+// nil if the size isn't positive.
+func newBlobTxCache(size int) (*blobTxCache, error) {
+	if size <= 0 {
+		return nil, nil
+	}
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &blobTxCache{cache: cache}, nil
+}
+
+// validateBlobTx validates the blob transaction like ValidateBlobTx, unless
+// its successful validation at the same app version is cached. Successful
+// validations are cached.
+func (c *blobTxCache) validateBlobTx(txConfig client.TxEncodingConfig, rawTx []byte, bTx blob.BlobTx, appVersion uint64) error {
+	if c.contains(rawTx, appVersion) {
+		return nil
+	}
+	if err := blobtypes.ValidateBlobTx(txConfig, bTx, appVersion); err != nil {
+		return err
+	}
+	c.add(rawTx, appVersion)
+	return nil
+}
+
+// contains returns true if the successful validation of the blob transaction
+// at the app version is cached.
+func (c *blobTxCache) contains(rawTx []byte, appVersion uint64) bool {
+	if c == nil {
+		return false
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.purgeIfVersionChanged(appVersion)
+	if _, ok := c.cache.Get(sha256.Sum256(rawTx)); ok {
+		telemetry.IncrCounter(1, "blob_tx_cache", "hits")
+		return true
+	}
+	telemetry.IncrCounter(1, "blob_tx_cache", "misses")
+	return false
+}
+
+// add caches the successful validation of the blob transaction at the app
+// version.
+func (c *blobTxCache) add(rawTx []byte, appVersion uint64) {
+	if c == nil {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.purgeIfVersionChanged(appVersion)
+	c.cache.Add(sha256.Sum256(rawTx), struct{}{})
+}
+
+func (c *blobTxCache) purgeIfVersionChanged(appVersion uint64) {
+	if c.appVersion != appVersion {
+		c.cache.Purge()
+		c.appVersion = appVersion
+	}
+}
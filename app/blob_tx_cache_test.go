@@ -0,0 +1,49 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/sunrise-zone/sunrise-app/app/encoding"
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobTxCache(t *testing.T) {
+	cache, err := newBlobTxCache(2)
+	require.NoError(t, err)
+	tx1, tx2, tx3 := []byte("tx1"), []byte("tx2"), []byte("tx3")
+
+	require.False(t, cache.contains(tx1, 1))
+	cache.add(tx1, 1)
+	require.True(t, cache.contains(tx1, 1))
+
+	// the least recently used tx is evicted
+	cache.add(tx2, 1)
+	require.True(t, cache.contains(tx1, 1))
+	cache.add(tx3, 1)
+	require.False(t, cache.contains(tx2, 1))
+	require.True(t, cache.contains(tx1, 1))
+	require.True(t, cache.contains(tx3, 1))
+
+	// the cache is purged when the app version changes
+	require.False(t, cache.contains(tx1, 2))
+	cache.add(tx1, 2)
+	require.True(t, cache.contains(tx1, 2))
+	require.False(t, cache.contains(tx3, 2))
+
+	// failed validations aren't cached
+	encCfg := encoding.MakeConfig(ModuleEncodingRegisters...)
+	invalidTx := []byte("invalid")
+	err = cache.validateBlobTx(encCfg.TxConfig, invalidTx, blob.BlobTx{Tx: invalidTx}, 2)
+	require.Error(t, err)
+	require.False(t, cache.contains(invalidTx, 2))
+}
+
+func TestBlobTxCacheDisabled(t *testing.T) {
+	cache, err := newBlobTxCache(0)
+	require.NoError(t, err)
+	require.Nil(t, cache)
+	cache.add([]byte("tx"), 1)
+	require.False(t, cache.contains([]byte("tx"), 1))
+}
@@ -0,0 +1,108 @@
+package app
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+	"strings"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/spf13/cast"
+)
+
+const (
+	// FlagBlobstreamEVMKeystoreFile is the app.toml key of the encrypted
+	// keystore file holding the EVM key used to sign Blobstream attestations.
+	FlagBlobstreamEVMKeystoreFile = "blobstream.evm-keystore-file"
+	// FlagBlobstreamEVMPassphraseFile is the app.toml key of the file
+	// containing the passphrase of the EVM keystore file.
+	FlagBlobstreamEVMPassphraseFile = "blobstream.evm-passphrase-file"
+	// FlagBlobstreamRPCAddress is the app.toml key of the CometBFT RPC address
+	// queried for the data root tuple roots of the data commitments.
+	FlagBlobstreamRPCAddress = "blobstream.rpc-address"
+)
+
+// BlobstreamConfig defines the options used by validators to sign Blobstream
+// attestations in their vote extensions.
+type BlobstreamConfig struct {
+	// EVMKeystoreFile is the path to the encrypted keystore file holding the
+	// EVM key registered by the validator. Attestations are not signed if
+	// empty.
+	EVMKeystoreFile string `mapstructure:"evm-keystore-file"`
+	// EVMPassphraseFile is the path to the file containing the passphrase of
+	// the keystore file. An empty passphrase is used if empty.
+	EVMPassphraseFile string `mapstructure:"evm-passphrase-file"`
+	// RPCAddress is the address of the CometBFT RPC of the node, used to
+	// query the data root tuple roots of the data commitments.
+	RPCAddress string `mapstructure:"rpc-address"`
+}
+
+// DefaultBlobstreamConfig returns the default Blobstream config, which doesn't
+// sign attestations.
+func DefaultBlobstreamConfig() BlobstreamConfig {
+	return BlobstreamConfig{
+		RPCAddress: "tcp://localhost:26657",
+	}
+}
+
+// BlobstreamConfigTemplate is the app.toml template of the Blobstream config.
+const BlobstreamConfigTemplate = `
+###############################################################################
+###                         Blobstream Configuration                        ###
+###############################################################################
+
+[blobstream]
+
+# Path to the encrypted keystore file holding the EVM key registered by the
+# validator. Blobstream attestations are signed in vote extensions using this
+# key. Leave empty to not sign attestations.
+evm-keystore-file = "{{ .Blobstream.EVMKeystoreFile }}"
+
+# Path to the file containing the passphrase of the keystore file.
+evm-passphrase-file = "{{ .Blobstream.EVMPassphraseFile }}"
+
+# CometBFT RPC address of the node, queried for the data root tuple roots of the
+# data commitments.
+rpc-address = "{{ .Blobstream.RPCAddress }}"
+`
+
+// ReadBlobstreamConfig reads the Blobstream config from the app options.
+func ReadBlobstreamConfig(appOpts servertypes.AppOptions) BlobstreamConfig {
+	cfg := DefaultBlobstreamConfig()
+	if v := cast.ToString(appOpts.Get(FlagBlobstreamEVMKeystoreFile)); v != "" {
+		cfg.EVMKeystoreFile = v
+	}
+	if v := cast.ToString(appOpts.Get(FlagBlobstreamEVMPassphraseFile)); v != "" {
+		cfg.EVMPassphraseFile = v
+	}
+	if v := cast.ToString(appOpts.Get(FlagBlobstreamRPCAddress)); v != "" {
+		cfg.RPCAddress = v
+	}
+	return cfg
+}
+
+// LoadEVMKey decrypts the EVM key from the keystore file defined in the
+// config. Returns nil if no keystore file is configured.
+func (cfg BlobstreamConfig) LoadEVMKey() (*ecdsa.PrivateKey, error) {
+	if cfg.EVMKeystoreFile == "" {
+		return nil, nil
+	}
+	keyJSON, err := os.ReadFile(cfg.EVMKeystoreFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading EVM keystore file: %w", err)
+	}
+	var passphrase string
+	if cfg.EVMPassphraseFile != "" {
+		bz, err := os.ReadFile(cfg.EVMPassphraseFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading EVM passphrase file: %w", err)
+		}
+		passphrase = strings.TrimRight(string(bz), "\r\n")
+	}
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting EVM keystore file: %w", err)
+	}
+	return key.PrivateKey, nil
+}
@@ -1,9 +1,40 @@
 package app
 
 import (
+	"cosmossdk.io/x/circuit"
+	"cosmossdk.io/x/evidence"
+	feegrantmodule "cosmossdk.io/x/feegrant/module"
+	"cosmossdk.io/x/upgrade"
 	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+	"github.com/cosmos/cosmos-sdk/x/consensus"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/ibc-go/modules/capability"
+	icamodule "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts"
+	ibcfee "github.com/cosmos/ibc-go/v8/modules/apps/29-fee"
+	ibctransfer "github.com/cosmos/ibc-go/v8/modules/apps/transfer"
+	ibc "github.com/cosmos/ibc-go/v8/modules/core"
+	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
 	"github.com/sunrise-zone/sunrise-app/app/encoding"
 	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	blobmodule "github.com/sunrise-zone/sunrise-app/x/blob/module"
+	grantmodule "github.com/sunrise-zone/sunrise-app/x/blobgrant/module"
+	"github.com/sunrise-zone/sunrise-app/x/blobibc"
+	streammodule "github.com/sunrise-zone/sunrise-app/x/blobstream/module"
+	"github.com/sunrise-zone/sunrise-app/x/intertx"
+	liquidstakingmodule "github.com/sunrise-zone/sunrise-app/x/liquidstaking/module"
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit"
+	sunrisemodule "github.com/sunrise-zone/sunrise-app/x/sunrise/module"
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter"
 
 	"github.com/cosmos/cosmos-sdk/client"
 )
@@ -18,7 +49,46 @@ const (
 )
 
 var (
-	ModuleBasics = sdkmodule.NewBasicManager()
+	// ModuleBasics is the basic manager of the modules wired in the app
+	// config and of the IBC modules, with the default genesis overrides. It is
+	// used to build the genesis and the encoding configs without
+	// instantiating the app.
+	ModuleBasics = sdkmodule.NewBasicManager(
+		auth.AppModuleBasic{},
+		vesting.AppModuleBasic{},
+		bankModule{},
+		stakingModule{},
+		slashingModule{},
+		distributionModule{},
+		mint.AppModuleBasic{},
+		govModule{gov.NewAppModuleBasic(getGovProposalHandlers())},
+		params.AppModuleBasic{},
+		crisisModule{},
+		upgrade.AppModuleBasic{},
+		authzmodule.AppModuleBasic{},
+		evidence.AppModuleBasic{},
+		feegrantmodule.AppModuleBasic{},
+		groupmodule.AppModuleBasic{},
+		consensus.AppModuleBasic{},
+		circuit.AppModuleBasic{},
+		genutil.NewAppModuleBasic(genutiltypes.DefaultMessageValidator),
+		capability.AppModuleBasic{},
+		ibc.AppModuleBasic{},
+		ibctransfer.AppModuleBasic{},
+		ibcfee.AppModuleBasic{},
+		icamodule.AppModuleBasic{},
+		ibctm.AppModuleBasic{},
+		solomachine.AppModuleBasic{},
+		tokenfilter.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
+		intertx.AppModuleBasic{},
+		blobibc.AppModuleBasic{},
+		sunrisemodule.AppModuleBasic{},
+		blobmodule.AppModuleBasic{},
+		grantmodule.AppModuleBasic{},
+		streammodule.AppModuleBasic{},
+		liquidstakingmodule.AppModuleBasic{},
+	)
 	// ModuleEncodingRegisters keeps track of all the module methods needed to
 	// register interfaces and specific type to encoding config
 	ModuleEncodingRegisters = extractRegisters(ModuleBasics)
@@ -13,8 +13,15 @@ import (
 // ExtendBlock extends the given block data into a data square for a given app
 // version.
 func ExtendBlock(data coretypes.Data, appVersion uint64) (*rsmt2d.ExtendedDataSquare, error) {
+	// the data root and the square size carried by the block are not part of
+	// the square
+	txs, _, _, err := square.ExtractBlockInfo(data.Txs.ToSliceOfBytes(), appVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	// Construct the data square from the block's transactions
-	dataSquare, err := square.Construct(data.Txs.ToSliceOfBytes(), appVersion, appconsts.SquareSizeUpperBound(appVersion))
+	dataSquare, err := square.Construct(txs, appVersion, appconsts.SquareSizeUpperBound(appVersion))
 	if err != nil {
 		return nil, err
 	}
@@ -22,8 +29,24 @@ func ExtendBlock(data coretypes.Data, appVersion uint64) (*rsmt2d.ExtendedDataSq
 	return da.ExtendShares(shares.ToBytes(dataSquare))
 }
 
+// ExtendBlockAt extends the given block data of the given height like
+// ExtendBlock, reusing the square constructed when this node prepared or
+// processed the block, if still cached. The returned square must not be
+// modified.
+func (app *App) ExtendBlockAt(height int64, data coretypes.Data, appVersion uint64) (*rsmt2d.ExtendedDataSquare, error) {
+	if entry, ok := app.squareCache.get(newSquareCacheKey(height, data.Txs.ToSliceOfBytes())); ok {
+		return entry.eds, nil
+	}
+	return ExtendBlock(data, appVersion)
+}
+
 // EmptyBlock returns true if the given block data is considered empty by the
-// application at a given version.
-func IsEmptyBlock(data coretypes.Data, _ uint64) bool {
-	return len(data.Txs) == 0
+// application at a given version, i.e. it has no transactions besides its data
+// root and square size.
+func IsEmptyBlock(data coretypes.Data, appVersion uint64) bool {
+	txs, _, _, err := square.ExtractBlockInfo(data.Txs.ToSliceOfBytes(), appVersion)
+	if err != nil {
+		return len(data.Txs) == 0
+	}
+	return len(txs) == 0
 }
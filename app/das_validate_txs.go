@@ -109,7 +109,12 @@ func encodeBlobTxs(blobTxs []blob.BlobTx) [][]byte {
 	txs := make([][]byte, len(blobTxs))
 	var err error
 	for i, tx := range blobTxs {
-		txs[i], err = blob.MarshalBlobTx(tx.Tx, tx.Blobs...)
+		// the message indexes are only set for multiple PFBs
+		if len(tx.MsgIndexes) > 0 {
+			txs[i], err = blob.MarshalBlobTxWithIndexes(tx.Tx, tx.Blobs, tx.MsgIndexes)
+		} else {
+			txs[i], err = blob.MarshalBlobTx(tx.Tx, tx.Blobs...)
+		}
 		if err != nil {
 			panic(err)
 		}
@@ -161,6 +161,11 @@ func DefaultConsensusParams() *tmproto.ConsensusParams {
 		Version: &tmproto.VersionParams{
 			App: appconsts.LatestVersion,
 		},
+		Abci: &tmproto.ABCIParams{
+			// validators sign the Blobstream attestations in their vote
+			// extensions from the first block.
+			VoteExtensionsEnableHeight: 1,
+		},
 	}
 }
 
@@ -0,0 +1,48 @@
+package app
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// FlagEDSExportDir is the app.toml key of the directory the extended data
+// squares of the committed blocks are exported to.
+const FlagEDSExportDir = "eds.export-dir"
+
+// EDSConfig defines the options of the node for the export of the extended
+// data squares of the blocks to disk.
+type EDSConfig struct {
+	// ExportDir is the directory the extended data square of each committed
+	// block is written to, in the format of the eds package. The squares
+	// aren't exported if empty.
+	ExportDir string `mapstructure:"export-dir"`
+}
+
+// DefaultEDSConfig returns the default EDS config, which doesn't export the
+// squares.
+func DefaultEDSConfig() EDSConfig {
+	return EDSConfig{}
+}
+
+// EDSConfigTemplate is the app.toml template of the EDS config.
+const EDSConfigTemplate = `
+###############################################################################
+###                   Extended Data Square Configuration                    ###
+###############################################################################
+
+[eds]
+
+# Directory the extended data square of each committed block is written to,
+# as its original data square along with its data availability header. Leave
+# empty to not export the squares.
+export-dir = "{{ .EDS.ExportDir }}"
+`
+
+// ReadEDSConfig reads the EDS config from the app options.
+func ReadEDSConfig(appOpts servertypes.AppOptions) EDSConfig {
+	cfg := DefaultEDSConfig()
+	if v := appOpts.Get(FlagEDSExportDir); v != nil {
+		cfg.ExportDir = cast.ToString(v)
+	}
+	return cfg
+}
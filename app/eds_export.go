@@ -0,0 +1,139 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	"github.com/sunrise-zone/sunrise-app/pkg/eds"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+
+	"cosmossdk.io/log"
+	coretypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// edsExportQueueSize is the number of finalized blocks whose extended data
+// square can wait to be exported. The squares of the blocks finalized while
+// the queue is full aren't exported.
+const edsExportQueueSize = 64
+
+// squareExport is a finalized block whose extended data square is to be
+// exported.
+type squareExport struct {
+	height     int64
+	blockTxs   [][]byte
+	appVersion uint64
+}
+
+// edsExporter exports the extended data squares of the finalized blocks in
+// the background, so that writing them doesn't delay the consensus.
+type edsExporter struct {
+	dir    string
+	cache  *squareCache
+	logger log.Logger
+
+	queue chan squareExport
+	wg    sync.WaitGroup
+	once  sync.Once
+}
+
+// newEDSExporter returns an exporter writing the extended data squares to the
+// given directory, reusing the squares of the cache, and starts it.
+func newEDSExporter(dir string, cache *squareCache, logger log.Logger) *edsExporter {
+	e := &edsExporter{
+		dir:    dir,
+		cache:  cache,
+		logger: logger,
+		queue:  make(chan squareExport, edsExportQueueSize),
+	}
+	e.wg.Add(1)
+	go e.run()
+	return e
+}
+
+// enqueue queues the export of the extended data square of the block. It
+// doesn't block: the export is dropped if the queue is full.
+func (e *edsExporter) enqueue(height int64, blockTxs [][]byte, appVersion uint64) {
+	select {
+	case e.queue <- squareExport{height: height, blockTxs: blockTxs, appVersion: appVersion}:
+	default:
+		e.logger.Error("dropped the export of the extended data square, the export queue is full", "height", height)
+	}
+}
+
+// run exports the queued squares until the exporter is stopped. A failed
+// export must not halt the node, it is logged.
+func (e *edsExporter) run() {
+	defer e.wg.Done()
+	for export := range e.queue {
+		if err := e.export(export); err != nil {
+			e.logger.Error("failed to export the extended data square", "height", export.height, "err", err)
+		}
+	}
+}
+
+// stop waits for the queued squares to be exported and stops the exporter.
+func (e *edsExporter) stop() {
+	e.once.Do(func() { close(e.queue) })
+	e.wg.Wait()
+}
+
+// export writes the extended data square of the block to the export
+// directory, reusing the square constructed when this node prepared or
+// processed the block, if still cached.
+func (e *edsExporter) export(export squareExport) error {
+	defer telemetry.MeasureSince(time.Now(), "eds", "export")
+	var exported *eds.Square
+	if entry, ok := e.cache.get(newSquareCacheKey(export.height, export.blockTxs)); ok {
+		exported = &eds.Square{Height: export.height, EDS: entry.eds, DAH: entry.dah}
+	} else {
+		var err error
+		exported, err = blockSquare(export.height, export.blockTxs, export.appVersion)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := eds.WriteFile(e.dir, exported)
+	return err
+}
+
+// Close waits for the queued extended data squares to be exported, then
+// closes the app.
+func (app *App) Close() error {
+	if app.edsExporter != nil {
+		app.edsExporter.stop()
+	}
+	return app.App.Close()
+}
+
+// BlockSquare extends the data of the block like ExtendBlock, and returns
+// its extended data square checked against the data root carried by the
+// block, which differs from the data hash of its header.
+func BlockSquare(block *coretypes.Block) (*eds.Square, error) {
+	return blockSquare(block.Height, block.Data.Txs.ToSliceOfBytes(), block.Header.Version.App)
+}
+
+// blockSquare is BlockSquare for the transactions of the block of the given
+// height and app version.
+func blockSquare(height int64, blockTxs [][]byte, appVersion uint64) (*eds.Square, error) {
+	_, dataRoot, _, err := square.ExtractBlockInfo(blockTxs, appVersion)
+	if err != nil {
+		return nil, err
+	}
+	blockSquare := &eds.Square{Height: height}
+	blockSquare.EDS, err = ExtendBlock(coretypes.Data{Txs: coretypes.ToTxs(blockTxs)}, appVersion)
+	if err != nil {
+		return nil, err
+	}
+	blockSquare.DAH, err = da.NewDataAvailabilityHeader(blockSquare.EDS)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(blockSquare.DAH.Hash(), dataRoot) {
+		return nil, fmt.Errorf("data availability header of block %d doesn't match its data root %X", height, dataRoot)
+	}
+	return blockSquare, nil
+}
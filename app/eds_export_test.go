@@ -0,0 +1,39 @@
+package app
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	"github.com/sunrise-zone/sunrise-app/pkg/eds"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEDSExporter(t *testing.T) {
+	dah := da.MinDataAvailabilityHeader()
+	blockTxs, err := square.AppendBlockInfo(nil, dah.Hash(), 1, appconsts.LatestVersion)
+	require.NoError(t, err)
+	wrongRootTxs, err := square.AppendBlockInfo(nil, make([]byte, 32), 1, appconsts.LatestVersion)
+	require.NoError(t, err)
+
+	// the squares of the blocks that aren't cached are constructed and
+	// checked against the data root of the block
+	dir := t.TempDir()
+	exporter := newEDSExporter(dir, newSquareCache(1), log.NewNopLogger())
+	exporter.enqueue(1, blockTxs, appconsts.LatestVersion)
+	exporter.enqueue(2, wrongRootTxs, appconsts.LatestVersion)
+	exporter.stop()
+	// stopping is idempotent
+	exporter.stop()
+
+	exported, err := eds.ReadFile(eds.FilePath(dir, 1))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), exported.Height)
+	require.Equal(t, dah.Hash(), exported.DAH.Hash())
+	_, err = os.Stat(eds.FilePath(dir, 2))
+	require.True(t, os.IsNotExist(err))
+}
@@ -4,6 +4,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	storetypes "cosmossdk.io/store/types"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -33,7 +34,20 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/sunrise-zone/sunrise-app/x/blobibc"
+	blobibckeeper "github.com/sunrise-zone/sunrise-app/x/blobibc/keeper"
+	blobibctypes "github.com/sunrise-zone/sunrise-app/x/blobibc/types"
+	"github.com/sunrise-zone/sunrise-app/x/ibchooks"
+	ibchookskeeper "github.com/sunrise-zone/sunrise-app/x/ibchooks/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/intertx"
+	intertxkeeper "github.com/sunrise-zone/sunrise-app/x/intertx/keeper"
+	intertxtypes "github.com/sunrise-zone/sunrise-app/x/intertx/types"
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit"
+	ratelimitkeeper "github.com/sunrise-zone/sunrise-app/x/ratelimit/keeper"
+	ratelimittypes "github.com/sunrise-zone/sunrise-app/x/ratelimit/types"
 	"github.com/sunrise-zone/sunrise-app/x/tokenfilter"
+	tokenfilterkeeper "github.com/sunrise-zone/sunrise-app/x/tokenfilter/keeper"
+	tokenfiltertypes "github.com/sunrise-zone/sunrise-app/x/tokenfilter/types"
 	// this line is used by starport scaffolding # ibc/app/import
 )
 
@@ -47,6 +61,10 @@ func (app *App) registerIBCModules() {
 		storetypes.NewKVStoreKey(ibcfeetypes.StoreKey),
 		storetypes.NewKVStoreKey(icahosttypes.StoreKey),
 		storetypes.NewKVStoreKey(icacontrollertypes.StoreKey),
+		storetypes.NewKVStoreKey(tokenfiltertypes.StoreKey),
+		storetypes.NewKVStoreKey(ratelimittypes.StoreKey),
+		storetypes.NewKVStoreKey(intertxtypes.StoreKey),
+		storetypes.NewKVStoreKey(blobibctypes.StoreKey),
 		storetypes.NewMemoryStoreKey(capabilitytypes.MemStoreKey),
 		storetypes.NewTransientStoreKey(paramstypes.TStoreKey),
 	); err != nil {
@@ -73,6 +91,7 @@ func (app *App) registerIBCModules() {
 	scopedIBCTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedBlobIBCKeeper := app.CapabilityKeeper.ScopeToModule(blobibctypes.ModuleName)
 
 	// Create IBC keeper
 	app.IBCKeeper = ibckeeper.NewKeeper(
@@ -92,21 +111,38 @@ func (app *App) registerIBCModules() {
 	govRouter := govv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler)
 
-	tokenFilterKeeper := tokenfilter.NewKeeper(app.IBCKeeper.ChannelKeeper)
 	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
 		app.appCodec, app.GetKey(ibcfeetypes.StoreKey),
-		// app.IBCKeeper.ChannelKeeper, // may be replaced with IBC middleware
-		tokenFilterKeeper,
+		app.IBCKeeper.ChannelKeeper, // may be replaced with IBC middleware
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.PortKeeper, app.AccountKeeper, app.BankKeeper,
 	)
 
+	// The transfer stack is, from the transfer module down to the core,
+	// transfer -> ratelimit -> tokenfilter -> ics29 fee.
+	app.TokenFilterKeeper = tokenfilterkeeper.NewKeeper(
+		app.appCodec,
+		runtime.NewKVStoreService(app.GetKey(tokenfiltertypes.StoreKey)),
+		app.Logger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.StakingKeeper,
+		app.IBCFeeKeeper,
+	)
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		app.appCodec,
+		runtime.NewKVStoreService(app.GetKey(ratelimittypes.StoreKey)),
+		app.Logger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.BankKeeper,
+		app.TokenFilterKeeper,
+	)
+
 	// Create IBC transfer keeper
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		app.appCodec,
 		app.GetKey(ibctransfertypes.StoreKey),
 		app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCFeeKeeper,
+		app.RateLimitKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
@@ -139,15 +175,42 @@ func (app *App) registerIBCModules() {
 		app.MsgServiceRouter(),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	app.InterTxKeeper = intertxkeeper.NewKeeper(
+		app.appCodec,
+		runtime.NewKVStoreService(app.GetKey(intertxtypes.StoreKey)),
+		app.Logger(),
+		app.ICAControllerKeeper,
+	)
+	app.BlobIBCKeeper = blobibckeeper.NewKeeper(
+		app.appCodec,
+		runtime.NewKVStoreService(app.GetKey(blobibctypes.StoreKey)),
+		app.Logger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.PortKeeper,
+		scopedBlobIBCKeeper,
+		app.BankKeeper,
+		app.BlobKeeper,
+	)
 	app.GovKeeper.SetLegacyRouter(govRouter)
 
-	// Create IBC modules with ibcfee middleware
-	transferIBCModule := ibcfee.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.IBCFeeKeeper)
+	// Create IBC modules with ibcfee middleware. The transfer stack filters
+	// the inbound non-native tokens, then enforces the rate limits, before
+	// the packets reach the transfer module. The actions of the memos are
+	// executed once the transfer module credited the tokens.
+	var transferIBCModule porttypes.IBCModule = ibctransfer.NewIBCModule(app.TransferKeeper)
+	transferIBCModule = ibchooks.NewIBCMiddleware(
+		transferIBCModule,
+		ibchookskeeper.NewKeeper(app.StakingKeeper, app.LiquidstakingKeeper, app.GrantKeeper),
+	)
+	transferIBCModule = ratelimit.NewIBCMiddleware(transferIBCModule, app.RateLimitKeeper)
+	transferIBCModule = tokenfilter.NewIBCMiddleware(transferIBCModule, app.TokenFilterKeeper)
+	transferIBCModule = ibcfee.NewIBCMiddleware(transferIBCModule, app.IBCFeeKeeper)
 
-	// integration point for custom authentication modules
-	var noAuthzModule porttypes.IBCModule
+	// The interchain accounts registered with x/intertx are authenticated by
+	// its module, which records the results of their packets.
 	icaControllerIBCModule := ibcfee.NewIBCMiddleware(
-		icacontroller.NewIBCMiddleware(noAuthzModule, app.ICAControllerKeeper),
+		icacontroller.NewIBCMiddleware(intertx.NewIBCModule(app.InterTxKeeper), app.ICAControllerKeeper),
 		app.IBCFeeKeeper,
 	)
 
@@ -157,7 +220,8 @@ func (app *App) registerIBCModules() {
 	ibcRouter := porttypes.NewRouter().
 		AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
-		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
+		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+		AddRoute(blobibctypes.ModuleName, blobibc.NewIBCModule(app.BlobIBCKeeper))
 
 	// this line is used by starport scaffolding # ibc/app/module
 
@@ -167,12 +231,17 @@ func (app *App) registerIBCModules() {
 	app.ScopedIBCTransferKeeper = scopedIBCTransferKeeper
 	app.ScopedICAHostKeeper = scopedICAHostKeeper
 	app.ScopedICAControllerKeeper = scopedICAControllerKeeper
+	app.ScopedBlobIBCKeeper = scopedBlobIBCKeeper
 
 	// register IBC modules
 	if err := app.RegisterModules(
 		ibc.NewAppModule(app.IBCKeeper),
 		ibctransfer.NewAppModule(app.TransferKeeper),
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
+		tokenfilter.NewAppModule(app.TokenFilterKeeper),
+		ratelimit.NewAppModule(app.RateLimitKeeper),
+		intertx.NewAppModule(app.InterTxKeeper),
+		blobibc.NewAppModule(app.BlobIBCKeeper),
 		icamodule.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		capability.NewAppModule(app.appCodec, *app.CapabilityKeeper, false),
 		ibctm.AppModule{},
@@ -190,6 +259,10 @@ func RegisterIBC(registry cdctypes.InterfaceRegistry) map[string]appmodule.AppMo
 		ibcexported.ModuleName:      ibc.AppModule{},
 		ibctransfertypes.ModuleName: ibctransfer.AppModule{},
 		ibcfeetypes.ModuleName:      ibcfee.AppModule{},
+		tokenfiltertypes.ModuleName: tokenfilter.AppModule{},
+		ratelimittypes.ModuleName:   ratelimit.AppModule{},
+		intertxtypes.ModuleName:     intertx.AppModule{},
+		blobibctypes.ModuleName:     blobibc.AppModule{},
 		icatypes.ModuleName:         icamodule.AppModule{},
 		capabilitytypes.ModuleName:  capability.AppModule{},
 		ibctm.ModuleName:            ibctm.AppModule{},
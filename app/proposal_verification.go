@@ -0,0 +1,67 @@
+package app
+
+import (
+	"github.com/sunrise-zone/sunrise-app/app/ante"
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	"github.com/sunrise-zone/sunrise-app/pkg/parallel"
+	blobtypes "github.com/sunrise-zone/sunrise-app/x/blob/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// proposalTx holds a transaction of a proposal decoded and verified ahead of
+// the ante handler.
+type proposalTx struct {
+	isBlobTx bool
+	// sdkTx is nil if the transaction can't be decoded.
+	sdkTx sdk.Tx
+	// validateBlobTxErr is the result of ValidateBlobTx for blob
+	// transactions.
+	validateBlobTxErr error
+}
+
+// verifyProposalTxs decodes the transactions of a proposal, validates its
+// blob transactions and verifies its signatures concurrently. Only the
+// stateless verifications are performed here, and their results are
+// consumed by ProcessProposal in the order of the transactions, so that it
+// accepts or rejects the same proposals as verifying them sequentially. The
+// signatures are verified using the state at the beginning of the block,
+// and the ante handler verifies again the ones whose signer data changed
+// since.
+func (app *App) verifyProposalTxs(ctx sdk.Context, txs [][]byte) ([]proposalTx, *ante.VerifiedSignatures) {
+	appVersion := app.BaseApp.AppVersion()
+	proposalTxs := make([]proposalTx, len(txs))
+	parallel.ForEach(len(txs), func(i int) {
+		ptx := &proposalTxs[i]
+		tx := txs[i]
+		blobTx, isBlobTx := blob.UnmarshalBlobTx(tx)
+		if isBlobTx {
+			tx = blobTx.Tx
+		}
+		ptx.isBlobTx = isBlobTx
+		sdkTx, err := app.txConfig.TxDecoder()(tx)
+		if err != nil {
+			return
+		}
+		ptx.sdkTx = sdkTx
+		// the blob txs validated in CheckTx don't need to be validated again
+		if isBlobTx && !app.blobTxCache.contains(txs[i], appVersion) {
+			ptx.validateBlobTxErr = blobtypes.ValidateBlobTx(app.txConfig, blobTx, appVersion)
+		}
+	})
+
+	// reading the signers from the state isn't safe for concurrent use
+	var signatures []ante.Signature
+	for _, ptx := range proposalTxs {
+		if ptx.sdkTx != nil {
+			signatures = append(signatures, ante.CollectSignatures(ctx, app.AccountKeeper, ptx.sdkTx)...)
+		}
+	}
+	verified := ante.NewVerifiedSignatures()
+	signModeHandler := app.txConfig.SignModeHandler()
+	parallel.ForEach(len(signatures), func(i int) {
+		signatures[i].Verify(ctx, signModeHandler, verified)
+	})
+
+	return proposalTxs, verified
+}
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	"github.com/sunrise-zone/sunrise-app/pkg/shares"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// squareCacheSize is the number of proposals whose square is kept in the
+// cache. It covers a few rounds of the current height and the latest
+// committed heights, which are the ones sampled after ExtendBlock.
+const squareCacheSize = 8
+
+// squareCacheKey identifies a proposal by its height and the hash of its
+// transactions, including the block info.
+type squareCacheKey struct {
+	height int64
+	txHash [sha256.Size]byte
+}
+
+// newSquareCacheKey returns the key of the proposal at the given height made
+// of the given transactions.
+func newSquareCacheKey(height int64, blockTxs [][]byte) squareCacheKey {
+	hasher := sha256.New()
+	for _, tx := range blockTxs {
+		txHash := sha256.Sum256(tx)
+		hasher.Write(txHash[:])
+	}
+	key := squareCacheKey{height: height}
+	copy(key.txHash[:], hasher.Sum(nil))
+	return key
+}
+
+// squareCacheEntry holds the square constructed from the transactions of a
+// proposal, along with its extension and data availability header. They
+// must not be modified once cached.
+type squareCacheEntry struct {
+	square square.Square
+	eds    *rsmt2d.ExtendedDataSquare
+	dah    da.DataAvailabilityHeader
+}
+
+// memory returns the approximate number of bytes held by the entry.
+func (e *squareCacheEntry) memory() int {
+	return len(e.square)*appconsts.ShareSize + int(e.eds.Width()*e.eds.Width())*appconsts.ShareSize
+}
+
+// squareCache is a bounded cache of the squares constructed for the latest
+// proposals. It lets the proposer skip the construction of its own square in
+// ProcessProposal, and the validators skip it in ExtendBlock for the blocks
+// they processed. The oldest entries are evicted first.
+type squareCache struct {
+	mtx     sync.Mutex
+	size    int
+	entries map[squareCacheKey]*squareCacheEntry
+	// order holds the keys of the entries in insertion order.
+	order  []squareCacheKey
+	memory int
+}
+
+func newSquareCache(size int) *squareCache {
+	return &squareCache{
+		size:    size,
+		entries: make(map[squareCacheKey]*squareCacheEntry, size),
+	}
+}
+
+// get returns the entry of the proposal, if cached.
+func (c *squareCache) get(key squareCacheKey) (*squareCacheEntry, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	entry, ok := c.entries[key]
+	if ok {
+		telemetry.IncrCounter(1, "square_cache", "hits")
+	} else {
+		telemetry.IncrCounter(1, "square_cache", "misses")
+	}
+	return entry, ok
+}
+
+// add caches the entry of the proposal, evicting the oldest entries if the
+// cache is full.
+func (c *squareCache) add(key squareCacheKey, entry *squareCacheEntry) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if _, ok := c.entries[key]; ok {
+		return
+	}
+	for len(c.order) >= c.size {
+		c.removeLocked(c.order[0])
+	}
+	c.entries[key] = entry
+	c.order = append(c.order, key)
+	c.memory += entry.memory()
+	c.setGauges()
+}
+
+// remove drops the entry of the proposal, if cached. It is used to
+// invalidate the squares of rejected proposals.
+func (c *squareCache) remove(key squareCacheKey) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.removeLocked(key)
+	c.setGauges()
+}
+
+func (c *squareCache) removeLocked(key squareCacheKey) {
+	entry, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	delete(c.entries, key)
+	c.memory -= entry.memory()
+	for i, k := range c.order {
+		if k == key {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+}
+
+func (c *squareCache) setGauges() {
+	telemetry.SetGauge(float32(len(c.entries)), "square_cache", "entries")
+	telemetry.SetGauge(float32(c.memory), "square_cache", "bytes")
+}
+
+// constructSquare constructs the square of the given transactions, without
+// the block info, and extends it.
+func (app *App) constructSquare(txs [][]byte, maxSquareSize int) (*squareCacheEntry, error) {
+	dataSquare, err := square.Construct(txs, app.BaseApp.AppVersion(), maxSquareSize)
+	if err != nil {
+		return nil, err
+	}
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	if err != nil {
+		return nil, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, err
+	}
+	return &squareCacheEntry{square: dataSquare, eds: eds, dah: dah}, nil
+}
@@ -0,0 +1,54 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	"github.com/sunrise-zone/sunrise-app/pkg/shares"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSquareCache(t *testing.T) {
+	dataSquare := square.EmptySquare()
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	entry := &squareCacheEntry{square: dataSquare, eds: eds, dah: dah}
+
+	cache := newSquareCache(2)
+	keys := []squareCacheKey{
+		newSquareCacheKey(1, [][]byte{[]byte("tx")}),
+		newSquareCacheKey(2, [][]byte{[]byte("tx")}),
+		newSquareCacheKey(2, [][]byte{[]byte("other tx")}),
+	}
+	require.NotEqual(t, keys[0], keys[1])
+	require.NotEqual(t, keys[1], keys[2])
+	require.Equal(t, keys[0], newSquareCacheKey(1, [][]byte{[]byte("tx")}))
+
+	cache.add(keys[0], entry)
+	got, ok := cache.get(keys[0])
+	require.True(t, ok)
+	require.Equal(t, entry, got)
+	_, ok = cache.get(keys[1])
+	require.False(t, ok)
+
+	// the oldest entry is evicted once the cache is full
+	cache.add(keys[1], entry)
+	cache.add(keys[2], entry)
+	_, ok = cache.get(keys[0])
+	require.False(t, ok)
+	_, ok = cache.get(keys[1])
+	require.True(t, ok)
+	require.Equal(t, 2*entry.memory(), cache.memory)
+
+	// the entries of rejected proposals are removed
+	cache.remove(keys[1])
+	_, ok = cache.get(keys[1])
+	require.False(t, ok)
+	_, ok = cache.get(keys[2])
+	require.True(t, ok)
+	require.Equal(t, entry.memory(), cache.memory)
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"math"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	coretypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// squarePackingTxInfo returns the information used to pack the transactions
+// of a proposal in its square: the fee paid in the bond denom, and the first
+// signer, whose nonces must stay sequential. The transactions that can't be
+// decoded, such as the vote extensions, and the transactions paying for the
+// blobs received over IBC are included first.
+func (app *App) squarePackingTxInfo(tx []byte) (square.TxInfo, bool) {
+	blobTx, isBlobTx := blob.UnmarshalBlobTx(tx)
+	if isBlobTx {
+		if _, ok := app.pendingBlobID(blobTx); ok {
+			return square.TxInfo{}, false
+		}
+		tx = blobTx.Tx
+	}
+	sdkTx, err := app.txConfig.TxDecoder()(tx)
+	if err != nil {
+		return square.TxInfo{}, false
+	}
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return square.TxInfo{}, false
+	}
+	sigTx, ok := sdkTx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return square.TxInfo{}, false
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil || len(signers) == 0 {
+		return square.TxInfo{}, false
+	}
+
+	fee := feeTx.GetFee().AmountOf(appconsts.BondDenom)
+	info := square.TxInfo{Fee: math.MaxUint64, Signer: string(signers[0])}
+	if fee.IsUint64() {
+		info.Fee = fee.Uint64()
+	}
+	return info, true
+}
+
+// filterPackedTxs runs the ante handler again over the transactions chosen
+// for the square, in the order of the square, and drops the ones that fail.
+// FilterTxs checked the transactions in the order of the mempool, but the
+// packing reorders them across signers, while the sequences of the other
+// signers, the balances paying the fees and the fee grants depend on the
+// transactions before. The square is built again from the remaining
+// transactions, until they all pass. The transactions paying for the blobs
+// received over IBC aren't signed and are kept.
+func (app *App) filterPackedTxs(header cmtproto.Header, handler sdk.AnteHandler, txs [][]byte, maxSquareSize int) ([][]byte, error) {
+	for {
+		ctx := app.NewProposalContext(header)
+		valid := make([][]byte, 0, len(txs))
+		for _, tx := range txs {
+			rawTx := tx
+			if blobTx, isBlobTx := blob.UnmarshalBlobTx(tx); isBlobTx {
+				if _, ok := app.pendingBlobID(blobTx); ok {
+					valid = append(valid, tx)
+					continue
+				}
+				rawTx = blobTx.Tx
+			}
+			sdkTx, err := app.txConfig.TxDecoder()(rawTx)
+			if err != nil {
+				continue
+			}
+			ctx, err = handler(ctx, sdkTx, false)
+			if err != nil {
+				app.Logger().Error(
+					"filtering reordered transaction",
+					"tx", tmbytes.HexBytes(coretypes.Tx(rawTx).Hash()),
+					"error", err,
+				)
+				telemetry.IncrCounter(1, "prepare_proposal", "invalid_reordered_txs")
+				continue
+			}
+			valid = append(valid, tx)
+		}
+		if len(valid) == len(txs) {
+			return txs, nil
+		}
+
+		var err error
+		_, txs, err = square.Build(valid, app.BaseApp.AppVersion(), maxSquareSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
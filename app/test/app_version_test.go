@@ -0,0 +1,56 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sunrise-zone/sunrise-app/app"
+	"github.com/sunrise-zone/sunrise-app/test/util"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAppVersion checks that the app version is the one of the genesis
+// consensus params, which are returned to the consensus engine, and that it is
+// loaded back with the committed state.
+func TestAppVersion(t *testing.T) {
+	const appVersion = 1
+	db := dbm.NewMemDB()
+	newApp := func() *app.App {
+		testApp, err := app.New(log.NewNopLogger(), db, nil, true, util.EmptyAppOptions{}, baseapp.SetChainID(util.ChainID))
+		require.NoError(t, err)
+		return testApp
+	}
+
+	testApp := newApp()
+	genesisState, valSet, _ := util.GenesisStateWithSingleValidator(testApp)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	cparams := app.DefaultConsensusParams()
+	cparams.Version.App = appVersion
+	res, err := testApp.InitChain(&abci.RequestInitChain{
+		Time:            time.Now(),
+		ConsensusParams: cparams,
+		AppStateBytes:   stateBytes,
+		ChainId:         util.ChainID,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, appVersion, testApp.AppVersion())
+	require.EqualValues(t, appVersion, res.ConsensusParams.Version.App)
+
+	_, err = testApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:             1,
+		Hash:               testApp.LastCommitID().Hash,
+		NextValidatorsHash: valSet.Hash(),
+	})
+	require.NoError(t, err)
+	_, err = testApp.Commit()
+	require.NoError(t, err)
+
+	require.EqualValues(t, appVersion, newApp().AppVersion())
+}
@@ -0,0 +1,66 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sunrise-zone/sunrise-app/app"
+	"github.com/sunrise-zone/sunrise-app/app/encoding"
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/eds"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+	"github.com/sunrise-zone/sunrise-app/pkg/user"
+	"github.com/sunrise-zone/sunrise-app/test/util"
+	"github.com/sunrise-zone/sunrise-app/test/util/blobfactory"
+	"github.com/sunrise-zone/sunrise-app/test/util/testfactory"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmrand "github.com/cometbft/cometbft/libs/rand"
+	coretypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBlockSquareExport checks that the extended data square of a block built
+// by PrepareProposal is checked against the data root carried by the block,
+// and is read back from its exported file.
+func TestBlockSquareExport(t *testing.T) {
+	testApp, kr := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), "blob")
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	addr := testfactory.GetAddress(kr, "blob")
+	acc := util.DirectQueryAccount(testApp, addr)
+	signer, err := user.NewSigner(kr, nil, addr, enc.TxConfig, util.ChainID, acc.GetAccountNumber(), acc.GetSequence())
+	require.NoError(t, err)
+	blobTx := blobfactory.RandBlobTxs(signer, tmrand.NewRand(), 1, 2, 1000)[0]
+
+	height := testApp.LastBlockHeight() + 1
+	prep, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Height: height,
+		Time:   time.Now(),
+		Txs:    [][]byte{blobTx},
+	})
+	require.NoError(t, err)
+	_, dataRoot, _, err := square.ExtractBlockInfo(prep.Txs, appconsts.LatestVersion)
+	require.NoError(t, err)
+
+	block := &coretypes.Block{Data: coretypes.Data{Txs: coretypes.ToTxs(prep.Txs)}}
+	block.Header.Height = height
+	block.Header.Version.App = appconsts.LatestVersion
+	block.Header.DataHash = block.Data.Hash()
+
+	blockSquare, err := app.BlockSquare(block)
+	require.NoError(t, err)
+	require.Equal(t, dataRoot, blockSquare.DAH.Hash())
+
+	path, err := eds.WriteFile(t.TempDir(), blockSquare)
+	require.NoError(t, err)
+	exported, err := eds.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, height, exported.Height)
+	require.Equal(t, dataRoot, exported.DAH.Hash())
+
+	// the square of a block without its blob transaction doesn't match
+	// the data root
+	block.Data.Txs = block.Data.Txs[len(block.Data.Txs)-1:]
+	_, err = app.BlockSquare(block)
+	require.Error(t, err)
+}
@@ -0,0 +1,62 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sunrise-zone/sunrise-app/app"
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+	"github.com/sunrise-zone/sunrise-app/test/util"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtendedCommitTx(t *testing.T) {
+	cparams := cmttypes.DefaultConsensusParams().ToProto()
+	cparams.Version.App = appconsts.LatestVersion
+	cparams.Abci.VoteExtensionsEnableHeight = 1
+	testApp, _ := util.SetupTestAppWithGenesisValSet(&cparams)
+
+	height := testApp.LastBlockHeight() + 1
+	blockTime := time.Now()
+	// no vote extension is needed for an empty commit
+	extCommit := abci.ExtendedCommitInfo{Round: 1}
+	prep, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Height:          height,
+		Time:            blockTime,
+		LocalLastCommit: extCommit,
+	})
+	require.NoError(t, err)
+	extCommitInfo, ok := da.UnmarshalExtendedCommitTx(prep.Txs[0])
+	require.True(t, ok)
+	expected, err := extCommit.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, expected, extCommitInfo)
+
+	// the extended commit tx isn't part of the square
+	squareTxs, dataRoot, _, err := square.ExtractBlockInfo(prep.Txs, appconsts.LatestVersion)
+	require.NoError(t, err)
+	require.Len(t, squareTxs, len(prep.Txs)-2)
+	ext, err := app.ExtendBlock(cmttypes.Data{Txs: cmttypes.ToTxs(prep.Txs)}, appconsts.LatestVersion)
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(ext)
+	require.NoError(t, err)
+	require.Equal(t, dataRoot, dah.Hash())
+
+	process := func(txs [][]byte) abci.ResponseProcessProposal_ProposalStatus {
+		res, _ := testApp.ProcessProposal(&abci.RequestProcessProposal{Height: height, Time: blockTime, Txs: txs})
+		return res.Status
+	}
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, process(prep.Txs[1:]))
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, process(append([][]byte{prep.Txs[1], prep.Txs[0]}, prep.Txs[2:]...)))
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, process(prep.Txs))
+
+	res, err := testApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Time: blockTime, Txs: prep.Txs})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, len(prep.Txs))
+	require.Equal(t, abci.CodeTypeOK, res.TxResults[0].Code)
+}
@@ -0,0 +1,270 @@
+package app_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sunrise-zone/sunrise-app/app"
+	"github.com/sunrise-zone/sunrise-app/app/encoding"
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	v2 "github.com/sunrise-zone/sunrise-app/pkg/appconsts/v2"
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	apprand "github.com/sunrise-zone/sunrise-app/pkg/random"
+	"github.com/sunrise-zone/sunrise-app/pkg/shares"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+	"github.com/sunrise-zone/sunrise-app/pkg/user"
+	"github.com/sunrise-zone/sunrise-app/test/util"
+	"github.com/sunrise-zone/sunrise-app/test/util/blobfactory"
+	"github.com/sunrise-zone/sunrise-app/test/util/testfactory"
+	blobtypes "github.com/sunrise-zone/sunrise-app/x/blob/types"
+	blobibctypes "github.com/sunrise-zone/sunrise-app/x/blobibc/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmrand "github.com/cometbft/cometbft/libs/rand"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFinalizeBlockTxResults checks that the transactions of a block built by
+// PrepareProposal are all delivered successfully, and that the extended commit
+// transaction and the block info, which aren't delivered, are given an empty
+// result.
+func TestFinalizeBlockTxResults(t *testing.T) {
+	for _, appVersion := range []uint64{v2.Version, appconsts.LatestVersion} {
+		t.Run(fmt.Sprintf("v%d", appVersion), func(t *testing.T) {
+			cparams := app.DefaultConsensusParams()
+			cparams.Version.App = appVersion
+			accounts := []string{"send", "blob"}
+			testApp, kr := util.SetupTestAppWithGenesisValSet(cparams, accounts...)
+			enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+			signers := make([]*user.Signer, len(accounts))
+			for i, account := range accounts {
+				addr := testfactory.GetAddress(kr, account)
+				acc := util.DirectQueryAccount(testApp, addr)
+				signer, err := user.NewSigner(kr, nil, addr, enc.TxConfig, util.ChainID, acc.GetAccountNumber(), acc.GetSequence())
+				require.NoError(t, err)
+				signers[i] = signer
+			}
+			sendTx := blobfactory.GenerateRawSendTx(signers[0], 10)
+			blobTx := blobfactory.RandBlobTxs(signers[1], tmrand.NewRand(), 1, 2, 100)[0]
+
+			height := testApp.LastBlockHeight() + 1
+			blockTime := time.Now()
+			prep, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+				Height: height,
+				Time:   blockTime,
+				Txs:    [][]byte{sendTx, blobTx},
+			})
+			require.NoError(t, err)
+			process, err := testApp.ProcessProposal(&abci.RequestProcessProposal{Height: height, Time: blockTime, Txs: prep.Txs})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseProcessProposal_ACCEPT, process.Status)
+
+			res, err := testApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Time: blockTime, Txs: prep.Txs})
+			require.NoError(t, err)
+			require.Len(t, res.TxResults, len(prep.Txs))
+			delivered := 0
+			for i, txResult := range res.TxResults {
+				require.Equal(t, abci.CodeTypeOK, txResult.Code, "tx %d: %s", i, txResult.Log)
+				if txResult.GasUsed > 0 {
+					delivered++
+				}
+			}
+			require.Equal(t, 2, delivered)
+
+			// the block info trails the block, after the delivered transactions
+			leading := 0
+			if da.IsExtendedCommitTx(prep.Txs[0]) {
+				leading = 1
+			}
+			require.NotZero(t, res.TxResults[leading].GasUsed)
+			require.NotZero(t, res.TxResults[leading+1].GasUsed)
+			require.Zero(t, res.TxResults[len(res.TxResults)-1].GasUsed)
+		})
+	}
+}
+
+// TestFinalizeBlockBlobTxGas checks that the blob transactions are delivered
+// without their blobs, whose gas is paid for by the PFB as estimated, and not
+// by the size of the transaction.
+func TestFinalizeBlockBlobTxGas(t *testing.T) {
+	testApp, kr := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), "blob")
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	addr := testfactory.GetAddress(kr, "blob")
+	acc := util.DirectQueryAccount(testApp, addr)
+	signer, err := user.NewSigner(kr, nil, addr, enc.TxConfig, util.ChainID, acc.GetAccountNumber(), acc.GetSequence())
+	require.NoError(t, err)
+
+	blobData := tmrand.Bytes(shares.AvailableBytesFromSparseShares(12))
+	b, err := blobtypes.NewBlob(apprand.RandomBlobNamespace(), blobData, appconsts.ShareVersionZero)
+	require.NoError(t, err)
+	gas := blobtypes.DefaultEstimateGas([]uint32{uint32(len(blobData))})
+	blobTx, err := signer.CreatePayForBlob([]*blob.Blob{b}, blobfactory.FeeTxOpts(gas)...)
+	require.NoError(t, err)
+
+	height := testApp.LastBlockHeight() + 1
+	blockTime := time.Now()
+	prep, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{Height: height, Time: blockTime, Txs: [][]byte{blobTx}})
+	require.NoError(t, err)
+	require.Contains(t, prep.Txs, blobTx)
+	res, err := testApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Time: blockTime, Txs: prep.Txs})
+	require.NoError(t, err)
+	for i, tx := range prep.Txs {
+		if bytes.Equal(tx, blobTx) {
+			require.Equal(t, abci.CodeTypeOK, res.TxResults[i].Code, res.TxResults[i].Log)
+			require.LessOrEqual(t, res.TxResults[i].GasUsed, int64(gas))
+		}
+	}
+}
+
+// TestFinalizeBlockPendingBlob checks that the unsigned transaction paying
+// for a blob received over IBC is delivered successfully, and that the blob
+// is acknowledged once delivered.
+func TestFinalizeBlockPendingBlob(t *testing.T) {
+	testApp, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	height := testApp.LastBlockHeight() + 1
+	blockTime := time.Now()
+
+	packet := channeltypes.NewPacket([]byte("data"), 1, "blobibc", "channel-0", "blobibc", "channel-0", clienttypes.NewHeight(0, 100), 0)
+	packetBz, err := packet.Marshal()
+	require.NoError(t, err)
+	pending := blobibctypes.PendingBlob{
+		Id:        1,
+		Namespace: apprand.RandomNamespace().Bytes(),
+		Data:      []byte("blob received over ibc"),
+		Packet:    packetBz,
+	}
+	ctx := testApp.NewUncachedContext(false, cmtproto.Header{Height: testApp.LastBlockHeight()})
+	testApp.BlobIBCKeeper.SetPendingBlob(ctx, pending)
+
+	prep, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{Height: height, Time: blockTime, MaxTxBytes: appconsts.DefaultMaxBytes})
+	require.NoError(t, err)
+	pendingTx, err := blobibctypes.NewBlobTx(testApp.GetTxConfig(), pending, blobtypes.DefaultEstimateGas([]uint32{uint32(len(pending.Data))}))
+	require.NoError(t, err)
+	require.Contains(t, prep.Txs, pendingTx)
+	process, err := testApp.ProcessProposal(&abci.RequestProcessProposal{Height: height, Time: blockTime, Txs: prep.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, process.Status)
+
+	res, err := testApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Time: blockTime, Txs: prep.Txs})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, len(prep.Txs))
+	for i, tx := range prep.Txs {
+		require.Equal(t, abci.CodeTypeOK, res.TxResults[i].Code, "tx %d: %s", i, res.TxResults[i].Log)
+		if bytes.Equal(tx, pendingTx) {
+			require.True(t, hasEvent(res.TxResults[i].Events, blobibctypes.EventTypeBlobIncluded))
+		}
+	}
+	_, err = testApp.Commit()
+	require.NoError(t, err)
+	_, found := testApp.BlobIBCKeeper.GetPendingBlob(testApp.NewContext(true), pending.Id)
+	require.False(t, found)
+}
+
+// TestFinalizeBlockPendingBlobAnte checks that the unsigned transactions
+// paying for the blobs received over IBC still go through the checks of the
+// ante handler that need neither a signature nor a fee, and that the blobs
+// failing them stay queued.
+func TestFinalizeBlockPendingBlobAnte(t *testing.T) {
+	testApp, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	packet := channeltypes.NewPacket([]byte("data"), 1, "blobibc", "channel-0", "blobibc", "channel-0", clienttypes.NewHeight(0, 100), 0)
+	packetBz, err := packet.Marshal()
+	require.NoError(t, err)
+	pending := blobibctypes.PendingBlob{
+		Id:        1,
+		Namespace: apprand.RandomNamespace().Bytes(),
+		Data:      tmrand.Bytes(shares.AvailableBytesFromSparseShares(8)),
+		Packet:    packetBz,
+	}
+	ctx := testApp.NewUncachedContext(false, cmtproto.Header{Height: testApp.LastBlockHeight()})
+	testApp.BlobIBCKeeper.SetPendingBlob(ctx, pending)
+	pendingTx, err := blobibctypes.NewBlobTx(testApp.GetTxConfig(), pending, blobtypes.DefaultEstimateGas([]uint32{uint32(len(pending.Data))}))
+	require.NoError(t, err)
+
+	// the gas of the blobs increases once the transaction was proposed, so
+	// its gas limit is too low
+	params := testApp.BlobKeeper.GetParams(ctx)
+	params.GasPerBlobByte *= 4
+	require.NoError(t, testApp.BlobKeeper.SetParams(ctx, params))
+
+	height := testApp.LastBlockHeight() + 1
+	txs, err := square.AppendBlockInfo([][]byte{pendingTx}, tmrand.Bytes(32), 2, testApp.AppVersion())
+	require.NoError(t, err)
+	res, err := testApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Time: time.Now(), Txs: txs})
+	require.NoError(t, err)
+	require.NotEqual(t, abci.CodeTypeOK, res.TxResults[0].Code)
+	require.Contains(t, res.TxResults[0].Log, "not enough gas to pay for blobs")
+	_, err = testApp.Commit()
+	require.NoError(t, err)
+	_, found := testApp.BlobIBCKeeper.GetPendingBlob(testApp.NewContext(true), pending.Id)
+	require.True(t, found)
+}
+
+// TestPrepareProposalPendingBlobsLimit checks that the blobs received over IBC
+// are only included while they fit in the square and in the bytes of the
+// block, and that the others stay queued.
+func TestPrepareProposalPendingBlobsLimit(t *testing.T) {
+	testApp, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := testApp.NewUncachedContext(false, cmtproto.Header{Height: testApp.LastBlockHeight()})
+	params := testApp.BlobKeeper.GetParams(ctx)
+	params.GovMaxSquareSize = 8
+	require.NoError(t, testApp.BlobKeeper.SetParams(ctx, params))
+
+	packet := channeltypes.NewPacket([]byte("data"), 1, "blobibc", "channel-0", "blobibc", "channel-0", clienttypes.NewHeight(0, 100), 0)
+	packetBz, err := packet.Marshal()
+	require.NoError(t, err)
+	// each blob takes a bit more than a third of the square
+	pendingTxs := make([][]byte, 3)
+	for i := range pendingTxs {
+		pending := blobibctypes.PendingBlob{
+			Id:        uint64(i + 1),
+			Namespace: apprand.RandomNamespace().Bytes(),
+			Data:      tmrand.Bytes(shares.AvailableBytesFromSparseShares(22)),
+			Packet:    packetBz,
+		}
+		testApp.BlobIBCKeeper.SetPendingBlob(ctx, pending)
+		pendingTxs[i], err = blobibctypes.NewBlobTx(testApp.GetTxConfig(), pending, blobtypes.DefaultEstimateGas([]uint32{uint32(len(pending.Data))}))
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		name       string
+		maxTxBytes int64
+		included   int
+	}{
+		{"limited by the square", appconsts.DefaultMaxBytes, 2},
+		{"limited by the bytes", int64(len(pendingTxs[0]) + len(pendingTxs[1]) - 1), 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			height := testApp.LastBlockHeight() + 1
+			blockTime := time.Now()
+			prep, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{Height: height, Time: blockTime, MaxTxBytes: tc.maxTxBytes})
+			require.NoError(t, err)
+			for i, pendingTx := range pendingTxs {
+				if i < tc.included {
+					require.Contains(t, prep.Txs, pendingTx)
+				} else {
+					require.NotContains(t, prep.Txs, pendingTx)
+				}
+			}
+			process, err := testApp.ProcessProposal(&abci.RequestProcessProposal{Height: height, Time: blockTime, Txs: prep.Txs})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseProcessProposal_ACCEPT, process.Status)
+		})
+	}
+}
+
+func hasEvent(events []abci.Event, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package app_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/sunrise-zone/sunrise-app/app"
+	"github.com/sunrise-zone/sunrise-app/app/encoding"
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+	"github.com/sunrise-zone/sunrise-app/pkg/user"
+	"github.com/sunrise-zone/sunrise-app/test/util"
+	"github.com/sunrise-zone/sunrise-app/test/util/blobfactory"
+	"github.com/sunrise-zone/sunrise-app/test/util/testfactory"
+	blobtypes "github.com/sunrise-zone/sunrise-app/x/blob/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMultiPFBBlobTxProposal checks that a blob transaction holding several
+// PFBs is included by PrepareProposal, in a block accepted by
+// ProcessProposal, and delivered successfully.
+func TestMultiPFBBlobTxProposal(t *testing.T) {
+	account := "batcher"
+	testApp, kr := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), account)
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	addr := testfactory.GetAddress(kr, account)
+	acc := util.DirectQueryAccount(testApp, addr)
+	signer, err := user.NewSigner(kr, nil, addr, enc.TxConfig, util.ChainID, acc.GetAccountNumber(), acc.GetSequence())
+	require.NoError(t, err)
+
+	// the blobs are grouped by PFB, in the order of the messages.
+	var (
+		msgs       []sdk.Msg
+		blobs      []*blob.Blob
+		msgIndexes []uint32
+	)
+	for i, sizes := range [][]int{{100, 2000}, {600}} {
+		ns := appns.MustNewV0(bytes.Repeat([]byte{byte(i + 1)}, appns.NamespaceVersionZeroIDSize))
+		msgBlobs := blobfactory.RandBlobsWithNamespace(blobfactory.Repeat(ns, len(sizes)), sizes)
+		pfb, err := blobtypes.NewMsgPayForBlobs(addr.String(), msgBlobs...)
+		require.NoError(t, err)
+		msgs = append(msgs, pfb)
+		blobs = append(blobs, msgBlobs...)
+		for range msgBlobs {
+			msgIndexes = append(msgIndexes, uint32(i))
+		}
+	}
+	tx, err := signer.CreateTx(msgs, blobfactory.DefaultTxOpts()...)
+	require.NoError(t, err)
+	blobTx, err := blob.MarshalBlobTxWithIndexes(tx, blobs, msgIndexes)
+	require.NoError(t, err)
+
+	height := testApp.LastBlockHeight() + 1
+	blockTime := time.Now()
+	prep, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Height: height,
+		Time:   blockTime,
+		Txs:    [][]byte{blobTx},
+	})
+	require.NoError(t, err)
+	require.Contains(t, prep.Txs, blobTx)
+
+	// the square holds a share index for each blob of the PFBs
+	squareTxs, _, _, err := square.ExtractBlockInfo(prep.Txs, appconsts.LatestVersion)
+	require.NoError(t, err)
+	dataSquare, err := square.Construct(squareTxs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+	wrappedPFBs, err := dataSquare.WrappedPFBs()
+	require.NoError(t, err)
+	require.Len(t, wrappedPFBs, 1)
+	wrapper, isWrapper := coretypes.UnmarshalIndexWrapper(wrappedPFBs[0])
+	require.True(t, isWrapper)
+	require.Len(t, wrapper.ShareIndexes, len(blobs))
+
+	process, err := testApp.ProcessProposal(&abci.RequestProcessProposal{Height: height, Time: blockTime, Txs: prep.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, process.Status)
+
+	res, err := testApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Time: blockTime, Txs: prep.Txs})
+	require.NoError(t, err)
+	for i, txResult := range res.TxResults {
+		require.Equal(t, abci.CodeTypeOK, txResult.Code, "tx %d: %s", i, txResult.Log)
+	}
+}
@@ -0,0 +1,176 @@
+package app_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sunrise-zone/sunrise-app/app"
+	"github.com/sunrise-zone/sunrise-app/app/encoding"
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	"github.com/sunrise-zone/sunrise-app/pkg/shares"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+	"github.com/sunrise-zone/sunrise-app/pkg/user"
+	"github.com/sunrise-zone/sunrise-app/test/util"
+	"github.com/sunrise-zone/sunrise-app/test/util/blobfactory"
+	"github.com/sunrise-zone/sunrise-app/test/util/testfactory"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmrand "github.com/cometbft/cometbft/libs/rand"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPrepareProposalDependentTxs checks that the transactions reordered by
+// the fee maximizing packing are accepted by ProcessProposal when the
+// transactions of two signers depend on each other: the first transaction is
+// signed by both signers, and the second signer's next transaction pays more
+// fees per share.
+func TestPrepareProposalDependentTxs(t *testing.T) {
+	accounts := []string{"first", "second", "blob"}
+	testApp, kr := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	first := util.DirectQueryAccount(testApp, testfactory.GetAddress(kr, "first"))
+	second := util.DirectQueryAccount(testApp, testfactory.GetAddress(kr, "second"))
+	blobAcc := util.DirectQueryAccount(testApp, testfactory.GetAddress(kr, "blob"))
+
+	// the square only fits the transactions of the signers, or the
+	// transaction of the second signer along with the blob
+	ctx := testApp.NewUncachedContext(false, cmtproto.Header{Height: testApp.LastBlockHeight()})
+	params := testApp.BlobKeeper.GetParams(ctx)
+	params.GovMaxSquareSize = 2
+	require.NoError(t, testApp.BlobKeeper.SetParams(ctx, params))
+
+	const gasLimit = 200_000
+	memo := strings.Repeat("m", 200)
+	send := func(acc sdk.AccountI) sdk.Msg {
+		return banktypes.NewMsgSend(acc.GetAddress(), acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1)))
+	}
+	jointTx := multiSignerTx(t, enc.TxConfig, kr, []sdk.Msg{send(first), send(second)}, 1_000, gasLimit, memo, first, second)
+	secondSigner, err := user.NewSigner(kr, nil, second.GetAddress(), enc.TxConfig, util.ChainID, second.GetAccountNumber(), second.GetSequence()+1)
+	require.NoError(t, err)
+	secondTx, err := secondSigner.CreateTx([]sdk.Msg{send(second)}, user.SetGasLimit(gasLimit), user.SetFee(1_000_000), user.SetMemo(memo))
+	require.NoError(t, err)
+	blobSigner, err := user.NewSigner(kr, nil, blobAcc.GetAddress(), enc.TxConfig, util.ChainID, blobAcc.GetAccountNumber(), blobAcc.GetSequence())
+	require.NoError(t, err)
+	blobTx := []byte(blobfactory.RandBlobTxs(blobSigner, tmrand.NewRand(), 1, 1, 100)[0])
+
+	height := testApp.LastBlockHeight() + 1
+	blockTime := time.Now()
+	prep, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Height: height,
+		Time:   blockTime,
+		Txs:    [][]byte{jointTx, secondTx, blobTx},
+	})
+	require.NoError(t, err)
+	txs, _, _, err := square.ExtractBlockInfo(prep.Txs, testApp.AppVersion())
+	require.NoError(t, err)
+	// the transaction of the second signer can't be included without the
+	// joint transaction, which is left out for the blob
+	require.NotContains(t, txs, secondTx)
+	require.Contains(t, txs, blobTx)
+
+	process, err := testApp.ProcessProposal(&abci.RequestProcessProposal{Height: height, Time: blockTime, Txs: prep.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, process.Status)
+}
+
+// TestPrepareProposalConstructedSquare checks that the data root and the
+// square size of a proposal are the ones of the square constructed by the
+// validators from its transactions.
+func TestPrepareProposalConstructedSquare(t *testing.T) {
+	accounts := []string{"first", "second", "third"}
+	testApp, kr := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+
+	var blobTxs [][]byte
+	for _, name := range accounts {
+		acc := util.DirectQueryAccount(testApp, testfactory.GetAddress(kr, name))
+		signer, err := user.NewSigner(kr, nil, acc.GetAddress(), enc.TxConfig, util.ChainID, acc.GetAccountNumber(), acc.GetSequence())
+		require.NoError(t, err)
+		for _, tx := range blobfactory.RandBlobTxs(signer, tmrand.NewRand(), 1, 2, 2_000) {
+			blobTxs = append(blobTxs, []byte(tx))
+		}
+	}
+
+	height := testApp.LastBlockHeight() + 1
+	blockTime := time.Now()
+	prep, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Height: height,
+		Time:   blockTime,
+		Txs:    blobTxs,
+	})
+	require.NoError(t, err)
+	txs, dataRoot, squareSize, err := square.ExtractBlockInfo(prep.Txs, testApp.AppVersion())
+	require.NoError(t, err)
+	require.Len(t, txs, len(blobTxs))
+
+	dataSquare, err := square.Construct(txs, testApp.AppVersion(), appconsts.DefaultGovMaxSquareSize)
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	require.Equal(t, dah.Hash(), dataRoot)
+	require.Equal(t, uint64(dataSquare.Size()), squareSize)
+
+	process, err := testApp.ProcessProposal(&abci.RequestProcessProposal{Height: height, Time: blockTime, Txs: prep.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, process.Status)
+}
+
+// multiSignerTx returns a transaction signed by the accounts, in their order,
+// with the keys of the keyring.
+func multiSignerTx(t *testing.T, txConfig client.TxConfig, kr keyring.Keyring, msgs []sdk.Msg, fee, gasLimit uint64, memo string, accs ...sdk.AccountI) []byte {
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetGasLimit(gasLimit)
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, int64(fee))))
+	builder.SetMemo(memo)
+
+	// the signer infos of all the signers are signed, so they are set before
+	// signing
+	signMode := signingtypes.SignMode_SIGN_MODE_DIRECT
+	records := make([]*keyring.Record, len(accs))
+	sigs := make([]signingtypes.SignatureV2, len(accs))
+	for i, acc := range accs {
+		record, err := kr.KeyByAddress(acc.GetAddress())
+		require.NoError(t, err)
+		pubKey, err := record.GetPubKey()
+		require.NoError(t, err)
+		records[i] = record
+		sigs[i] = signingtypes.SignatureV2{
+			PubKey:   pubKey,
+			Data:     &signingtypes.SingleSignatureData{SignMode: signMode},
+			Sequence: acc.GetSequence(),
+		}
+	}
+	require.NoError(t, builder.SetSignatures(sigs...))
+
+	for i, acc := range accs {
+		signBytes, err := authsigning.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(), signMode, authsigning.SignerData{
+			Address:       acc.GetAddress().String(),
+			ChainID:       util.ChainID,
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+			PubKey:        sigs[i].PubKey,
+		}, builder.GetTx())
+		require.NoError(t, err)
+		signature, _, err := kr.Sign(records[i].Name, signBytes, signMode)
+		require.NoError(t, err)
+		sigs[i].Data = &signingtypes.SingleSignatureData{SignMode: signMode, Signature: signature}
+	}
+	require.NoError(t, builder.SetSignatures(sigs...))
+
+	tx, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return tx
+}
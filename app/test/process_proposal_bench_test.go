@@ -0,0 +1,85 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sunrise-zone/sunrise-app/app"
+	"github.com/sunrise-zone/sunrise-app/app/encoding"
+	"github.com/sunrise-zone/sunrise-app/test/util/blobfactory"
+	"github.com/sunrise-zone/sunrise-app/test/util/testfactory"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmrand "github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/require"
+)
+
+// Profile with:
+// `go test -benchmem -run=^$ -bench ^BenchmarkProcessProposal ./app/test -cpuprofile cpu.out`
+func BenchmarkProcessProposal(b *testing.B) {
+	const (
+		numAccounts      = 100
+		numTxsPerAccount = 10
+	)
+	accounts := testfactory.RandomAccountNames(numAccounts)
+	testApp, kr := setupProposalTestApp(accounts...)
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+
+	benchmarks := []struct {
+		name string
+		txs  func() [][]byte
+	}{
+		{
+			name: "sends",
+			txs: func() [][]byte {
+				var txs [][]byte
+				for _, signer := range newSigners(b, testApp, kr, enc, accounts) {
+					for i := 0; i < numTxsPerAccount; i++ {
+						txs = append(txs, blobfactory.GenerateRawSendTx(signer, 1))
+					}
+				}
+				return txs
+			},
+		},
+		{
+			name: "pfbs",
+			txs: func() [][]byte {
+				var txs [][]byte
+				for _, signer := range newSigners(b, testApp, kr, enc, accounts) {
+					txs = append(txs, blobfactory.RandBlobTxs(signer, tmrand.NewRand(), numTxsPerAccount, 1, 1000).ToSliceOfBytes()...)
+				}
+				return txs
+			},
+		},
+	}
+	for _, bm := range benchmarks {
+		txs := bm.txs()
+		blockTxs := proposalBlock(b, txs)
+		height := testApp.LastBlockHeight() + 1
+		blockTime := time.Now()
+
+		// the whole pipeline, verifying the signatures ahead of the ante
+		// handler concurrently
+		b.Run(bm.name+"/process-proposal", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				res, err := testApp.ProcessProposal(&abci.RequestProcessProposal{
+					Height: height,
+					Time:   blockTime,
+					Txs:    blockTxs,
+				})
+				require.NoError(b, err)
+				require.Equal(b, abci.ResponseProcessProposal_ACCEPT, res.Status)
+			}
+		})
+
+		// the ante handler alone, verifying the signatures sequentially,
+		// which is the part of the pipeline that pre-verification offloads
+		b.Run(bm.name+"/ante-sequential", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				require.NoError(b, verifySequentially(testApp, height, blockTime, txs))
+			}
+		})
+	}
+}
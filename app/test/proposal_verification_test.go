@@ -0,0 +1,210 @@
+package app_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sunrise-zone/sunrise-app/app"
+	"github.com/sunrise-zone/sunrise-app/app/ante"
+	"github.com/sunrise-zone/sunrise-app/app/encoding"
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	"github.com/sunrise-zone/sunrise-app/pkg/shares"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+	"github.com/sunrise-zone/sunrise-app/pkg/user"
+	"github.com/sunrise-zone/sunrise-app/test/util"
+	"github.com/sunrise-zone/sunrise-app/test/util/blobfactory"
+	"github.com/sunrise-zone/sunrise-app/test/util/testfactory"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmrand "github.com/cometbft/cometbft/libs/rand"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProcessProposalSignatureVerification checks that ProcessProposal, which
+// verifies the signatures of the proposal ahead of the ante handler, accepts
+// the same proposals as the ante handler verifying them sequentially.
+func TestProcessProposalSignatureVerification(t *testing.T) {
+	accounts := testfactory.RandomAccountNames(4)
+	testApp, kr := setupProposalTestApp(accounts...)
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+
+	type test struct {
+		name string
+		// txs returns the transactions of the proposal, signed by the
+		// signers of the accounts, whose sequences are the ones in state.
+		txs func(signers []*user.Signer) [][]byte
+		// expectedErr is nil if the proposal is accepted
+		expectedErr error
+	}
+	tests := []test{
+		{
+			name: "valid signatures",
+			txs: func(signers []*user.Signer) [][]byte {
+				return [][]byte{
+					blobfactory.GenerateRawSendTx(signers[0], 1),
+					blobfactory.GenerateRawSendTx(signers[1], 1),
+					blobfactory.RandBlobTxs(signers[2], tmrand.NewRand(), 1, 1, 100)[0],
+				}
+			},
+		},
+		{
+			name: "bad signature",
+			txs: func(signers []*user.Signer) [][]byte {
+				return [][]byte{
+					blobfactory.GenerateRawSendTx(signers[0], 1),
+					tamperSignature(t, enc, blobfactory.GenerateRawSendTx(signers[1], 1)),
+				}
+			},
+			expectedErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			// the public keys of the genesis accounts are set by their first
+			// transaction, so the signature of the second one is verified
+			// ahead with a public key that isn't in state yet.
+			name: "public key set mid-block",
+			txs: func(signers []*user.Signer) [][]byte {
+				return [][]byte{
+					blobfactory.GenerateRawSendTx(signers[0], 1),
+					blobfactory.GenerateRawSendTx(signers[0], 1),
+					blobfactory.GenerateRawSendTx(signers[0], 1),
+				}
+			},
+		},
+		{
+			name: "wrong sequence",
+			txs: func(signers []*user.Signer) [][]byte {
+				signers[0].ForceSetSequence(signers[0].GetSequence() + 1)
+				return [][]byte{blobfactory.GenerateRawSendTx(signers[0], 1)}
+			},
+			expectedErr: sdkerrors.ErrWrongSequence,
+		},
+		{
+			name: "sequences out of order",
+			txs: func(signers []*user.Signer) [][]byte {
+				first := blobfactory.GenerateRawSendTx(signers[0], 1)
+				second := blobfactory.GenerateRawSendTx(signers[0], 1)
+				return [][]byte{second, first}
+			},
+			expectedErr: sdkerrors.ErrWrongSequence,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txs := tt.txs(newSigners(t, testApp, kr, enc, accounts))
+			height := testApp.LastBlockHeight() + 1
+			blockTime := time.Now()
+
+			err := verifySequentially(testApp, height, blockTime, txs)
+			res, processErr := testApp.ProcessProposal(&abci.RequestProcessProposal{
+				Height: height,
+				Time:   blockTime,
+				Txs:    proposalBlock(t, txs),
+			})
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				require.NoError(t, processErr)
+				require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.ErrorIs(t, processErr, tt.expectedErr)
+			require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+		})
+	}
+}
+
+// setupProposalTestApp returns a test app at the latest app version, with
+// the given funded accounts, that doesn't expect vote extensions in the
+// proposals.
+func setupProposalTestApp(accounts ...string) (*app.App, keyring.Keyring) {
+	cparams := cmttypes.DefaultConsensusParams().ToProto()
+	cparams.Version.App = appconsts.LatestVersion
+	return util.SetupTestAppWithGenesisValSet(&cparams, accounts...)
+}
+
+// newSigners returns a signer for each account, with the sequence of the
+// account in state.
+func newSigners(t testing.TB, testApp *app.App, kr keyring.Keyring, enc encoding.Config, accounts []string) []*user.Signer {
+	signers := make([]*user.Signer, len(accounts))
+	for i, account := range accounts {
+		addr := testfactory.GetAddress(kr, account)
+		acc := util.DirectQueryAccount(testApp, addr)
+		signer, err := user.NewSigner(kr, nil, addr, enc.TxConfig, util.ChainID, acc.GetAccountNumber(), acc.GetSequence())
+		require.NoError(t, err)
+		signers[i] = signer
+	}
+	return signers
+}
+
+// proposalBlock returns the transactions of a block holding the given
+// transactions, followed by its block info, as proposed by PrepareProposal
+// but without filtering the invalid transactions.
+func proposalBlock(t testing.TB, txs [][]byte) [][]byte {
+	dataSquare, squareTxs, err := square.Build(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+	require.Len(t, squareTxs, len(txs))
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	blockTxs, err := square.AppendBlockInfo(squareTxs, dah.Hash(), uint64(dataSquare.Size()), appconsts.LatestVersion)
+	require.NoError(t, err)
+	return blockTxs
+}
+
+// verifySequentially runs the ante handler of ProcessProposal over the
+// transactions without verifying their signatures ahead, and returns the
+// first error.
+func verifySequentially(testApp *app.App, height int64, blockTime time.Time, txs [][]byte) error {
+	handler := ante.NewAnteHandler(
+		testApp.AccountKeeper,
+		testApp.BankKeeper,
+		testApp.BlobKeeper,
+		testApp.FeeGrantKeeper,
+		testApp.GetTxConfig().SignModeHandler(),
+		ante.DefaultSigVerificationGasConsumer,
+		testApp.IBCKeeper,
+	)
+	ctx := testApp.NewProposalContext(cmtproto.Header{ChainID: testApp.ChainID(), Height: height, Time: blockTime})
+	for i, tx := range txs {
+		if blobTx, isBlobTx := blob.UnmarshalBlobTx(tx); isBlobTx {
+			tx = blobTx.Tx
+		}
+		sdkTx, err := testApp.GetTxConfig().TxDecoder()(tx)
+		if err != nil {
+			return fmt.Errorf("tx %d: %w", i, err)
+		}
+		ctx, err = handler(ctx, sdkTx, false)
+		if err != nil {
+			return fmt.Errorf("tx %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// tamperSignature returns the transaction with an invalid signature.
+func tamperSignature(t testing.TB, enc encoding.Config, rawTx []byte) []byte {
+	tx, err := enc.TxConfig.TxDecoder()(rawTx)
+	require.NoError(t, err)
+	builder, err := enc.TxConfig.WrapTxBuilder(tx)
+	require.NoError(t, err)
+	sigs, err := tx.(authsigning.Tx).GetSignaturesV2()
+	require.NoError(t, err)
+	data := sigs[0].Data.(*signing.SingleSignatureData)
+	signature := append([]byte{}, data.Signature...)
+	signature[0] ^= 0xff
+	sigs[0].Data = &signing.SingleSignatureData{SignMode: data.SignMode, Signature: signature}
+	require.NoError(t, builder.SetSignatures(sigs...))
+	tampered, err := enc.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return tampered
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+
+	"github.com/sunrise-zone/sunrise-app/app"
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/relayer"
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
+)
+
+const (
+	flagEVMRPC            = "evm-rpc"
+	flagContractAddress   = "contract-address"
+	flagEVMKeystoreFile   = "evm-keystore-file"
+	flagEVMPassphraseFile = "evm-passphrase-file"
+	flagPollInterval      = "poll-interval"
+)
+
+// blobstreamCommand builds the `sunrised blobstream` command.
+func blobstreamCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "blobstream",
+		Short:                      "Blobstream subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(blobstreamRelayCommand())
+
+	return cmd
+}
+
+// blobstreamRelayCommand builds the command relaying the signed attestations
+// to the Blobstream contract.
+func blobstreamRelayCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay",
+		Short: "Relay the signed Blobstream attestations to the Blobstream contract",
+		Long: `Relay the signed Blobstream attestations to the Blobstream contract.
+
+The attestations are queried from the node defined by --node, and submitted in
+nonce order, starting from the one following the latest nonce of the contract,
+using the EVM key of the keystore file to pay for the transactions.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddress, _ := cmd.Flags().GetString(flagContractAddress)
+			if !gethcommon.IsHexAddress(contractAddress) {
+				return fmt.Errorf("invalid contract address %q", contractAddress)
+			}
+			keystoreFile, _ := cmd.Flags().GetString(flagEVMKeystoreFile)
+			if keystoreFile == "" {
+				return errors.New("the EVM keystore file is required")
+			}
+			passphraseFile, _ := cmd.Flags().GetString(flagEVMPassphraseFile)
+			evmKey, err := app.BlobstreamConfig{
+				EVMKeystoreFile:   keystoreFile,
+				EVMPassphraseFile: passphraseFile,
+			}.LoadEVMKey()
+			if err != nil {
+				return err
+			}
+
+			evmRPC, _ := cmd.Flags().GetString(flagEVMRPC)
+			evmClient, err := ethclient.DialContext(cmd.Context(), evmRPC)
+			if err != nil {
+				return fmt.Errorf("connecting to the EVM RPC: %w", err)
+			}
+			defer evmClient.Close()
+			chainID, err := evmClient.ChainID(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("querying the EVM chain ID: %w", err)
+			}
+			opts, err := bind.NewKeyedTransactorWithChainID(evmKey, chainID)
+			if err != nil {
+				return err
+			}
+
+			pollInterval, _ := cmd.Flags().GetDuration(flagPollInterval)
+			r, err := relayer.NewRelayer(
+				log.NewLogger(cmd.OutOrStdout()),
+				types.NewQueryClient(clientCtx),
+				clientCtx.InterfaceRegistry,
+				evmClient,
+				gethcommon.HexToAddress(contractAddress),
+				opts,
+				pollInterval,
+			)
+			if err != nil {
+				return err
+			}
+			return r.Start(cmd.Context())
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagEVMRPC, "http://localhost:8545", "The EVM RPC address of the chain on which the Blobstream contract is deployed")
+	cmd.Flags().String(flagContractAddress, "", "The address of the Blobstream contract")
+	cmd.Flags().String(flagEVMKeystoreFile, "", "The encrypted keystore file holding the EVM key used to send the transactions")
+	cmd.Flags().String(flagEVMPassphraseFile, "", "The file containing the passphrase of the EVM keystore file")
+	cmd.Flags().Duration(flagPollInterval, relayer.DefaultPollInterval, "The interval at which new signed attestations are checked")
+	_ = cmd.MarkFlagRequired(flagContractAddress)
+	_ = cmd.MarkFlagRequired(flagEVMKeystoreFile)
+
+	return cmd
+}
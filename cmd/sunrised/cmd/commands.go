@@ -52,6 +52,8 @@ func initRootCmd(
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
+		blobstreamCommand(),
+		edsCommand(),
 	)
 }
 
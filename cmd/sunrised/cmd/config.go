@@ -42,6 +42,10 @@ func initAppConfig() (string, interface{}) {
 	// The following code snippet is just for reference.
 	type CustomAppConfig struct {
 		serverconfig.Config `mapstructure:",squash"`
+
+		Blobstream app.BlobstreamConfig `mapstructure:"blobstream"`
+		Blob       app.BlobConfig       `mapstructure:"blob"`
+		EDS        app.EDSConfig        `mapstructure:"eds"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -63,10 +67,13 @@ func initAppConfig() (string, interface{}) {
 	// srvCfg.BaseConfig.IAVLDisableFastNode = true // disable fastnode by default
 
 	customAppConfig := CustomAppConfig{
-		Config: *srvCfg,
+		Config:     *srvCfg,
+		Blobstream: app.DefaultBlobstreamConfig(),
+		Blob:       app.DefaultBlobConfig(),
+		EDS:        app.DefaultEDSConfig(),
 	}
 
-	customAppTemplate := serverconfig.DefaultConfigTemplate
+	customAppTemplate := serverconfig.DefaultConfigTemplate + app.BlobstreamConfigTemplate + app.BlobConfigTemplate + app.EDSConfigTemplate
 	// Edit the default template file
 	//
 	// customAppTemplate := serverconfig.DefaultConfigTemplate + `
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/sunrise-zone/sunrise-app/app"
+	"github.com/sunrise-zone/sunrise-app/pkg/eds"
+)
+
+const (
+	flagFrom = "from"
+	flagTo   = "to"
+	flagOut  = "out"
+)
+
+// edsCommand builds the `sunrised eds` command.
+func edsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "eds",
+		Short:                      "Extended data square subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		edsExportCommand(),
+		edsVerifyCommand(),
+	)
+
+	return cmd
+}
+
+// edsExportCommand builds the command exporting the extended data squares of
+// a range of heights to disk.
+func edsExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the extended data squares of a range of heights to disk",
+		Long: `Export the extended data squares of the blocks of a range of heights to disk.
+
+The blocks are queried from the node defined by --node, and their data is
+extended into their extended data square, which is checked against the data
+root of the block. Each square is written to the --out directory as its
+original data square along with its data availability header.`,
+		Example: fmt.Sprintf("%s eds export --from 1 --to 100 --out ./eds", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			from, _ := cmd.Flags().GetInt64(flagFrom)
+			to, _ := cmd.Flags().GetInt64(flagTo)
+			if to == 0 {
+				to = from
+			}
+			if from <= 0 || to < from {
+				return fmt.Errorf("invalid range of heights [%d, %d]", from, to)
+			}
+			out, _ := cmd.Flags().GetString(flagOut)
+			if out == "" {
+				return errors.New("the output directory is required")
+			}
+
+			for height := from; height <= to; height++ {
+				res, err := node.Block(cmd.Context(), &height)
+				if err != nil {
+					return fmt.Errorf("querying block %d: %w", height, err)
+				}
+				square, err := app.BlockSquare(res.Block)
+				if err != nil {
+					return fmt.Errorf("extending block %d: %w", height, err)
+				}
+				path, err := eds.WriteFile(out, square)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%d %X %s\n", height, square.DAH.Hash(), path)
+			}
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Int64(flagFrom, 0, "The first height to export")
+	cmd.Flags().Int64(flagTo, 0, "The last height to export, the first one if 0")
+	cmd.Flags().String(flagOut, "", "The directory the squares are written to")
+	_ = cmd.MarkFlagRequired(flagFrom)
+	_ = cmd.MarkFlagRequired(flagOut)
+
+	return cmd
+}
+
+// edsVerifyCommand builds the command reading exported extended data squares.
+func edsVerifyCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify [file]...",
+		Short: "Reconstruct exported extended data squares and verify them against their data availability header",
+		Long: `Reconstruct the extended data squares of exported files, and verify them
+against the data availability header stored with them.
+
+The height and the data root of each square are printed, to be checked
+against the block of the height.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, path := range args {
+				square, err := eds.ReadFile(path)
+				if err != nil {
+					return fmt.Errorf("%s: %w", path, err)
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%d %X %s\n", square.Height, square.DAH.Hash(), path)
+			}
+			return nil
+		},
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/spf13/cast"
 )
 
@@ -31,8 +32,10 @@ func NewAppServer(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts se
 		panic(err)
 	}
 
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+
 	// Add snapshots
-	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
+	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
 	//nolint: staticcheck
 	snapshotDB, err := dbm.NewGoLevelDB("metadata", snapshotDir, dbm.OptionsMap{})
 	if err != nil {
@@ -46,6 +49,7 @@ func NewAppServer(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts se
 	a, _ := app.New(
 		logger, db, traceStore, true,
 		appOpts,
+		baseapp.SetChainID(ChainID(appOpts)),
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(server.FlagMinGasPrices))),
 		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))),
@@ -60,3 +64,22 @@ func NewAppServer(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts se
 
 	return a
 }
+
+// ChainID returns the chain ID of the app options, which is checked against
+// the one of InitChain, falling back to the one of the genesis file when it
+// isn't passed as a flag.
+func ChainID(appOpts servertypes.AppOptions) string {
+	if chainID := cast.ToString(appOpts.Get(flags.FlagChainID)); chainID != "" {
+		return chainID
+	}
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	genDocFile := filepath.Join(homeDir, "config", "genesis.json")
+	if genesisFile := cast.ToString(appOpts.Get("genesis_file")); genesisFile != "" {
+		genDocFile = filepath.Join(homeDir, genesisFile)
+	}
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genDocFile)
+	if err != nil {
+		panic(err)
+	}
+	return appGenesis.ChainID
+}
@@ -3,6 +3,7 @@ package appconsts
 import (
 	"math"
 
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
 	ns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
 
 	"github.com/celestiaorg/rsmt2d"
@@ -40,6 +41,14 @@ const (
 	// ShareVersionZero is the first share version format.
 	ShareVersionZero = uint8(0)
 
+	// ShareVersionOne is the share version format whose first sparse share
+	// embeds the address of the signer of the blob after the sequence length.
+	ShareVersionOne = uint8(blob.ShareVersionOne)
+
+	// SignerSize is the size of the signer address in the first sparse share
+	// of a blob of share version one.
+	SignerSize = blob.SignerSize
+
 	// DefaultShareVersion is the defacto share version. Use this if you are
 	// unsure of which version to use.
 	DefaultShareVersion = ShareVersionZero
@@ -93,7 +102,7 @@ var (
 	DefaultCodec = rsmt2d.NewLeoRSCodec
 
 	// SupportedShareVersions is a list of supported share versions.
-	SupportedShareVersions = []uint8{ShareVersionZero}
+	SupportedShareVersions = []uint8{ShareVersionZero, ShareVersionOne}
 )
 
 // HashLength returns the length of a hash in bytes.
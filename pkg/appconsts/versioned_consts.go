@@ -3,11 +3,11 @@ package appconsts
 import (
 	"github.com/sunrise-zone/sunrise-app/pkg/appconsts/testground"
 	v1 "github.com/sunrise-zone/sunrise-app/pkg/appconsts/v1"
-	v2 "github.com/sunrise-zone/sunrise-app/pkg/appconsts/v2"
+	v3 "github.com/sunrise-zone/sunrise-app/pkg/appconsts/v3"
 )
 
 const (
-	LatestVersion = v2.Version
+	LatestVersion = v3.Version
 )
 
 // SubtreeRootThreshold works as a target upper bound for the number of subtree
@@ -35,6 +35,44 @@ func SquareSizeUpperBound(v uint64) int {
 	}
 }
 
+// MaxPFBsPerBlobTx is the maximum number of MsgPayForBlobs that a single
+// BlobTx can contain. Versions prior to v3 only accept a single
+// MsgPayForBlobs per BlobTx.
+func MaxPFBsPerBlobTx(v uint64) int {
+	if v < v3.Version {
+		return 1
+	}
+	return v3.MaxPFBsPerBlobTx
+}
+
+// LatestShareVersion is the latest share version that the blobs can use for a
+// version of the state machine. Share version one, embedding the signer of the
+// blob, is supported starting with v3.
+func LatestShareVersion(v uint64) uint8 {
+	if v < v3.Version {
+		return ShareVersionZero
+	}
+	return ShareVersionOne
+}
+
+// UsesBlockInfoTx returns true if the data root and the square size of the
+// blocks are carried by a single block info transaction, at the end of the
+// block, for a version of the state machine. Before v3, they are carried by
+// two trailing pseudo transactions: the data root and the big endian encoded
+// square size.
+func UsesBlockInfoTx(v uint64) bool {
+	return v >= v3.Version
+}
+
+// MinimizesBlobPadding returns true if the blobs of a namespace are ordered
+// to minimize the padding between them, and the square size is computed from
+// the shares actually used rather than from their worst case padding, for a
+// version of the state machine. Before v3, the blobs of a namespace are
+// ordered by priority.
+func MinimizesBlobPadding(v uint64) bool {
+	return v >= v3.Version
+}
+
 var (
 	DefaultSubtreeRootThreshold = SubtreeRootThreshold(LatestVersion)
 	DefaultSquareSizeUpperBound = SquareSizeUpperBound(LatestVersion)
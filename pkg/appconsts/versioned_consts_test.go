@@ -10,6 +10,7 @@ import (
 	"github.com/sunrise-zone/sunrise-app/pkg/appconsts/testground"
 	v1 "github.com/sunrise-zone/sunrise-app/pkg/appconsts/v1"
 	v2 "github.com/sunrise-zone/sunrise-app/pkg/appconsts/v2"
+	v3 "github.com/sunrise-zone/sunrise-app/pkg/appconsts/v3"
 )
 
 func TestSubtreeRootThreshold(t *testing.T) {
@@ -20,6 +21,7 @@ func TestSubtreeRootThreshold(t *testing.T) {
 	testCases := []testCase{
 		{version: v1.Version, want: v1.SubtreeRootThreshold},
 		{version: v2.Version, want: v2.SubtreeRootThreshold},
+		{version: v3.Version, want: v3.SubtreeRootThreshold},
 		{version: testground.Version, want: testground.SubtreeRootThreshold},
 	}
 	for _, tc := range testCases {
@@ -39,6 +41,7 @@ func TestSquareSizeUpperBound(t *testing.T) {
 	testCases := []testCase{
 		{version: v1.Version, want: v1.SquareSizeUpperBound},
 		{version: v2.Version, want: v2.SquareSizeUpperBound},
+		{version: v3.Version, want: v3.SquareSizeUpperBound},
 		{version: testground.Version, want: testground.SquareSizeUpperBound},
 	}
 	for _, tc := range testCases {
@@ -49,3 +52,57 @@ func TestSquareSizeUpperBound(t *testing.T) {
 		})
 	}
 }
+
+func TestMaxPFBsPerBlobTx(t *testing.T) {
+	type testCase struct {
+		version uint64
+		want    int
+	}
+	testCases := []testCase{
+		{version: v1.Version, want: 1},
+		{version: v2.Version, want: 1},
+		{version: v3.Version, want: v3.MaxPFBsPerBlobTx},
+		{version: testground.Version, want: v3.MaxPFBsPerBlobTx},
+	}
+	for _, tc := range testCases {
+		name := fmt.Sprintf("version %v", tc.version)
+		t.Run(name, func(t *testing.T) {
+			got := appconsts.MaxPFBsPerBlobTx(tc.version)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestLatestShareVersion(t *testing.T) {
+	type testCase struct {
+		version uint64
+		want    uint8
+	}
+	testCases := []testCase{
+		{version: v1.Version, want: appconsts.ShareVersionZero},
+		{version: v2.Version, want: appconsts.ShareVersionZero},
+		{version: v3.Version, want: appconsts.ShareVersionOne},
+		{version: testground.Version, want: appconsts.ShareVersionOne},
+	}
+	for _, tc := range testCases {
+		name := fmt.Sprintf("version %v", tc.version)
+		t.Run(name, func(t *testing.T) {
+			got := appconsts.LatestShareVersion(tc.version)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestUsesBlockInfoTx(t *testing.T) {
+	assert.False(t, appconsts.UsesBlockInfoTx(v1.Version))
+	assert.False(t, appconsts.UsesBlockInfoTx(v2.Version))
+	assert.True(t, appconsts.UsesBlockInfoTx(v3.Version))
+	assert.True(t, appconsts.UsesBlockInfoTx(testground.Version))
+}
+
+func TestMinimizesBlobPadding(t *testing.T) {
+	assert.False(t, appconsts.MinimizesBlobPadding(v1.Version))
+	assert.False(t, appconsts.MinimizesBlobPadding(v2.Version))
+	assert.True(t, appconsts.MinimizesBlobPadding(v3.Version))
+	assert.True(t, appconsts.MinimizesBlobPadding(testground.Version))
+}
@@ -14,6 +14,14 @@ import (
 // decoding binaries that are not actually BlobTxs.
 const ProtoBlobTxTypeID = "BLOB"
 
+const (
+	// ShareVersionOne is the share version of the blobs whose first share
+	// embeds the address of the signer of the blob.
+	ShareVersionOne = 1
+	// SignerSize is the size of the signer address of a blob.
+	SignerSize = 20
+)
+
 // NewBlob creates a new coretypes.Blob from the provided data after performing
 // basic stateless checks over it.
 func New(ns namespace.Namespace, blob []byte, shareVersion uint8) *Blob {
@@ -25,6 +33,18 @@ func New(ns namespace.Namespace, blob []byte, shareVersion uint8) *Blob {
 	}
 }
 
+// NewV1 creates a new coretypes.Blob of share version one, embedding the
+// address of the signer paying for it.
+func NewV1(ns namespace.Namespace, blob []byte, signer []byte) *Blob {
+	return &Blob{
+		NamespaceId:      ns.ID,
+		Data:             blob,
+		ShareVersion:     ShareVersionOne,
+		NamespaceVersion: uint32(ns.Version),
+		Signer:           signer,
+	}
+}
+
 // Namespace returns the namespace of the blob
 func (b Blob) Namespace() namespace.Namespace {
 	return namespace.Namespace{
@@ -50,6 +70,13 @@ func (b *Blob) Validate() error {
 	if len(b.Data) == 0 {
 		return errors.New("blob data can not be empty")
 	}
+	if b.ShareVersion == ShareVersionOne {
+		if len(b.Signer) != SignerSize {
+			return fmt.Errorf("signer must be %d bytes", SignerSize)
+		}
+	} else if len(b.Signer) != 0 {
+		return fmt.Errorf("signer is only supported by share version %d", ShareVersionOne)
+	}
 	return nil
 }
 
@@ -72,6 +99,9 @@ func UnmarshalBlobTx(tx []byte) (bTx BlobTx, isBlob bool) {
 			return bTx, false
 		}
 	}
+	if len(bTx.MsgIndexes) != 0 && len(bTx.MsgIndexes) != len(bTx.Blobs) {
+		return bTx, false
+	}
 	return bTx, true
 }
 
@@ -89,6 +119,46 @@ func MarshalBlobTx(tx []byte, blobs ...*Blob) ([]byte, error) {
 	return bTx.Marshal()
 }
 
+// MarshalBlobTxWithIndexes creates a BlobTx using a transaction containing
+// several MsgPayForBlobs, and the blobs they pay for. msgIndexes holds, for
+// each blob, the index of the message paying for it. The blobs must be grouped
+// by message, in the order of the messages, and in the order of the blobs of
+// each message.
+//
+// NOTE: Any checks on the blobs or the transaction must be performed in the
+// application
+func MarshalBlobTxWithIndexes(tx []byte, blobs []*Blob, msgIndexes []uint32) ([]byte, error) {
+	if len(blobs) != len(msgIndexes) {
+		return nil, fmt.Errorf("number of blobs %d does not match number of message indexes %d", len(blobs), len(msgIndexes))
+	}
+	bTx := BlobTx{
+		Tx:         tx,
+		Blobs:      blobs,
+		TypeId:     ProtoBlobTxTypeID,
+		MsgIndexes: msgIndexes,
+	}
+	return bTx.Marshal()
+}
+
+// BlobsForMsg returns the blobs of the BlobTx paid for by the message at
+// msgIndex. All the blobs belong to the first message if the BlobTx has no
+// message indexes.
+func (bTx BlobTx) BlobsForMsg(msgIndex int) []*Blob {
+	if len(bTx.MsgIndexes) == 0 {
+		if msgIndex == 0 {
+			return bTx.Blobs
+		}
+		return nil
+	}
+	var blobs []*Blob
+	for i, idx := range bTx.MsgIndexes {
+		if int(idx) == msgIndex && i < len(bTx.Blobs) {
+			blobs = append(blobs, bTx.Blobs[i])
+		}
+	}
+	return blobs
+}
+
 func Sort(blobs []*Blob) {
 	sort.SliceStable(blobs, func(i, j int) bool {
 		return bytes.Compare(blobs[i].Namespace().Bytes(), blobs[j].Namespace().Bytes()) < 0
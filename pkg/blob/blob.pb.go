@@ -31,6 +31,9 @@ type Blob struct {
 	Data             []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	ShareVersion     uint32 `protobuf:"varint,3,opt,name=share_version,json=shareVersion,proto3" json:"share_version,omitempty"`
 	NamespaceVersion uint32 `protobuf:"varint,4,opt,name=namespace_version,json=namespaceVersion,proto3" json:"namespace_version,omitempty"`
+	// signer is the address of the signer of the MsgPayForBlobs paying for the
+	// blob. It is only set for share version 1, whose first share embeds it.
+	Signer []byte `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
 func (m *Blob) Reset()         { *m = Blob{} }
@@ -94,6 +97,13 @@ func (m *Blob) GetNamespaceVersion() uint32 {
 	return 0
 }
 
+func (m *Blob) GetSigner() []byte {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
 // BlobTx wraps an encoded sdk.Tx with a second field to contain blobs of data.
 // The raw bytes of the blobs are not signed over, instead we verify each blob
 // using the relevant MsgPayForBlobs that is signed over in the encoded sdk.Tx.
@@ -101,6 +111,10 @@ type BlobTx struct {
 	Tx     []byte  `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
 	Blobs  []*Blob `protobuf:"bytes,2,rep,name=blobs,proto3" json:"blobs,omitempty"`
 	TypeId string  `protobuf:"bytes,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	// msg_indexes holds, for each blob, the index of the MsgPayForBlobs in the
+	// messages of tx that pays for it. It is empty when tx contains a single
+	// MsgPayForBlobs.
+	MsgIndexes []uint32 `protobuf:"varint,4,rep,packed,name=msg_indexes,json=msgIndexes,proto3" json:"msg_indexes,omitempty"`
 }
 
 func (m *BlobTx) Reset()         { *m = BlobTx{} }
@@ -157,6 +171,13 @@ func (m *BlobTx) GetTypeId() string {
 	return ""
 }
 
+func (m *BlobTx) GetMsgIndexes() []uint32 {
+	if m != nil {
+		return m.MsgIndexes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Blob)(nil), "sunrise.core.v1.blob.Blob")
 	proto.RegisterType((*BlobTx)(nil), "sunrise.core.v1.blob.BlobTx")
@@ -165,26 +186,28 @@ func init() {
 func init() { proto.RegisterFile("sunrise/core/v1/blob/blob.proto", fileDescriptor_ddb51f5eb2ed1c90) }
 
 var fileDescriptor_ddb51f5eb2ed1c90 = []byte{
-	// 289 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2f, 0x2e, 0xcd, 0x2b,
-	0xca, 0x2c, 0x4e, 0xd5, 0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0xca, 0xc9, 0x4f,
-	0x02, 0x13, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x22, 0x50, 0x05, 0x7a, 0x20, 0x05, 0x7a,
-	0x65, 0x86, 0x7a, 0x20, 0x39, 0xa5, 0x7e, 0x46, 0x2e, 0x16, 0xa7, 0x9c, 0xfc, 0x24, 0x21, 0x45,
-	0x2e, 0x9e, 0xbc, 0xc4, 0xdc, 0xd4, 0xe2, 0x82, 0xc4, 0xe4, 0xd4, 0xf8, 0xcc, 0x14, 0x09, 0x46,
-	0x05, 0x46, 0x0d, 0x9e, 0x20, 0x6e, 0xb8, 0x98, 0x67, 0x8a, 0x90, 0x10, 0x17, 0x4b, 0x4a, 0x62,
-	0x49, 0xa2, 0x04, 0x13, 0x58, 0x0a, 0xcc, 0x16, 0x52, 0xe6, 0xe2, 0x2d, 0xce, 0x48, 0x2c, 0x4a,
-	0x8d, 0x2f, 0x4b, 0x2d, 0x2a, 0xce, 0xcc, 0xcf, 0x93, 0x60, 0x56, 0x60, 0xd4, 0xe0, 0x0d, 0xe2,
-	0x01, 0x0b, 0x86, 0x41, 0xc4, 0x84, 0xb4, 0xb9, 0x04, 0x11, 0x66, 0xc3, 0x14, 0xb2, 0x80, 0x15,
-	0x0a, 0xc0, 0x25, 0xa0, 0x8a, 0x95, 0x92, 0xb9, 0xd8, 0x40, 0x0e, 0x0a, 0xa9, 0x10, 0xe2, 0xe3,
-	0x62, 0x2a, 0xa9, 0x80, 0x3a, 0x84, 0xa9, 0xa4, 0x42, 0xc8, 0x80, 0x8b, 0x15, 0xe4, 0xe6, 0x62,
-	0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x29, 0x3d, 0x6c, 0x3e, 0xd2, 0x03, 0x69, 0x0e, 0x82,
-	0x28, 0x14, 0x12, 0xe7, 0x62, 0x2f, 0xa9, 0x2c, 0x00, 0xfb, 0x07, 0xe4, 0x2e, 0xce, 0x20, 0x36,
-	0x10, 0xd7, 0x33, 0xc5, 0xc9, 0xed, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c,
-	0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2,
-	0x74, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xa1, 0xe6, 0xeb, 0x56,
-	0xe5, 0xe7, 0xa5, 0xc2, 0x39, 0x89, 0x05, 0x05, 0xfa, 0x05, 0xd9, 0xe9, 0xe0, 0xa0, 0x4d, 0x62,
-	0x03, 0x87, 0xad, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x62, 0x16, 0xd4, 0x81, 0x7e, 0x01, 0x00,
-	0x00,
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xeb, 0x34, 0xcd, 0xaf, 0xff, 0xb6, 0x45, 0x60, 0x21, 0x88, 0x18, 0xd2, 0x50, 0x96,
+	0x48, 0x80, 0x43, 0xe1, 0x0d, 0x3a, 0x20, 0x75, 0x8d, 0x10, 0x03, 0x4b, 0x95, 0x34, 0x56, 0x1a,
+	0xd1, 0xc4, 0x96, 0xed, 0x56, 0x81, 0x99, 0x07, 0xe0, 0x2d, 0x78, 0x15, 0xc6, 0x8e, 0x8c, 0xa8,
+	0x7d, 0x11, 0x64, 0x37, 0x0d, 0x0b, 0x4b, 0x94, 0x7b, 0xce, 0x67, 0xfb, 0x1c, 0x5d, 0x18, 0xc8,
+	0x65, 0x29, 0x72, 0x49, 0xc3, 0x19, 0x13, 0x34, 0x5c, 0x8d, 0xc2, 0x64, 0xc1, 0x12, 0xf3, 0x21,
+	0x5c, 0x30, 0xc5, 0xf0, 0x71, 0x0d, 0x10, 0x0d, 0x90, 0xd5, 0x88, 0x68, 0x6f, 0xf8, 0x81, 0xc0,
+	0x1e, 0x2f, 0x58, 0x82, 0xcf, 0xa1, 0x57, 0xc6, 0x05, 0x95, 0x3c, 0x9e, 0xd1, 0x69, 0x9e, 0xba,
+	0xc8, 0x47, 0x41, 0x2f, 0xea, 0x36, 0xda, 0x24, 0xc5, 0x18, 0xec, 0x34, 0x56, 0xb1, 0x6b, 0x19,
+	0xcb, 0xfc, 0xe3, 0x0b, 0xe8, 0xcb, 0x79, 0x2c, 0xe8, 0x74, 0x45, 0x85, 0xcc, 0x59, 0xe9, 0xb6,
+	0x7d, 0x14, 0xf4, 0xa3, 0x9e, 0x11, 0x1f, 0x77, 0x1a, 0xbe, 0x84, 0xa3, 0xdf, 0xbb, 0xf7, 0xa0,
+	0x6d, 0xc0, 0xc3, 0xc6, 0xd8, 0xc3, 0x27, 0xe0, 0xc8, 0x3c, 0x2b, 0xa9, 0x70, 0x3b, 0xe6, 0x9d,
+	0x7a, 0x1a, 0xbe, 0x21, 0x70, 0x74, 0xd2, 0x87, 0x0a, 0x1f, 0x80, 0xa5, 0xaa, 0x3a, 0xa1, 0xa5,
+	0x2a, 0x7c, 0x03, 0x1d, 0x5d, 0x46, 0xba, 0x96, 0xdf, 0x0e, 0xba, 0xb7, 0x67, 0xe4, 0xaf, 0xaa,
+	0x44, 0x1f, 0x8e, 0x76, 0x20, 0x3e, 0x85, 0x7f, 0xea, 0x85, 0x9b, 0xa2, 0x3a, 0xf0, 0xff, 0xc8,
+	0xd1, 0xe3, 0x24, 0xc5, 0x03, 0xe8, 0x16, 0x32, 0x9b, 0xe6, 0x65, 0x4a, 0x2b, 0x2a, 0x5d, 0xdb,
+	0x6f, 0x07, 0xfd, 0x08, 0x0a, 0x99, 0x4d, 0x76, 0xca, 0xf8, 0xfe, 0x73, 0xe3, 0xa1, 0xf5, 0xc6,
+	0x43, 0xdf, 0x1b, 0x0f, 0xbd, 0x6f, 0xbd, 0xd6, 0x7a, 0xeb, 0xb5, 0xbe, 0xb6, 0x5e, 0xeb, 0xe9,
+	0x2a, 0xcb, 0xd5, 0x7c, 0x99, 0x90, 0x19, 0x2b, 0xc2, 0x3a, 0xc0, 0xf5, 0x2b, 0x2b, 0x69, 0x33,
+	0xc4, 0x9c, 0x87, 0xfc, 0x39, 0x33, 0x4b, 0x49, 0x1c, 0xb3, 0x95, 0xbb, 0x9f, 0x01, 0x00, 0x5b,
+	0xe9, 0xb2, 0x85, 0xb8, 0x01, 0x00, 0x00,
 }
 
 func (m *Blob) Marshal() (dAtA []byte, err error) {
@@ -207,6 +230,13 @@ func (m *Blob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintBlob(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.NamespaceVersion != 0 {
 		i = encodeVarintBlob(dAtA, i, uint64(m.NamespaceVersion))
 		i--
@@ -254,6 +284,24 @@ func (m *BlobTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgIndexes) > 0 {
+		dAtA2 := make([]byte, len(m.MsgIndexes)*10)
+		var j1 int
+		for _, num := range m.MsgIndexes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintBlob(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.TypeId) > 0 {
 		i -= len(m.TypeId)
 		copy(dAtA[i:], m.TypeId)
@@ -316,6 +364,10 @@ func (m *Blob) Size() (n int) {
 	if m.NamespaceVersion != 0 {
 		n += 1 + sovBlob(uint64(m.NamespaceVersion))
 	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovBlob(uint64(l))
+	}
 	return n
 }
 
@@ -339,6 +391,13 @@ func (m *BlobTx) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBlob(uint64(l))
 	}
+	if len(m.MsgIndexes) > 0 {
+		l = 0
+		for _, e := range m.MsgIndexes {
+			l += sovBlob(uint64(e))
+		}
+		n += 1 + sovBlob(uint64(l)) + l
+	}
 	return n
 }
 
@@ -483,6 +542,40 @@ func (m *Blob) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlob
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlob(dAtA[iNdEx:])
@@ -633,6 +726,82 @@ func (m *BlobTx) Unmarshal(dAtA []byte) error {
 			}
 			m.TypeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlob
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MsgIndexes = append(m.MsgIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlob
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBlob
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBlob
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MsgIndexes) == 0 {
+					m.MsgIndexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlob
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MsgIndexes = append(m.MsgIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndexes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlob(dAtA[iNdEx:])
@@ -0,0 +1,43 @@
+package da
+
+import (
+	"errors"
+
+	daproto "github.com/sunrise-zone/sunrise-app/proto/sunrise/core/v1/da"
+)
+
+// ProtoBlockInfoTypeID is included in each encoded BlockInfo to identify it
+// among the transactions of a block.
+const ProtoBlockInfoTypeID = "BINF"
+
+// MarshalBlockInfoTx creates the block info transaction carrying the data root
+// and the square size of a block.
+func MarshalBlockInfoTx(dataRoot []byte, squareSize uint64) ([]byte, error) {
+	if len(dataRoot) == 0 {
+		return nil, errors.New("data root can not be empty")
+	}
+	info := daproto.BlockInfo{
+		DataRoot:   dataRoot,
+		SquareSize: squareSize,
+		TypeId:     ProtoBlockInfoTypeID,
+	}
+	return info.Marshal()
+}
+
+// UnmarshalBlockInfoTx attempts to unmarshal a transaction into a block info
+// transaction. If an error is thrown, false is returned.
+func UnmarshalBlockInfoTx(tx []byte) (info daproto.BlockInfo, isBlockInfo bool) {
+	if err := info.Unmarshal(tx); err != nil {
+		return daproto.BlockInfo{}, false
+	}
+	if info.TypeId != ProtoBlockInfoTypeID || len(info.DataRoot) == 0 {
+		return daproto.BlockInfo{}, false
+	}
+	return info, true
+}
+
+// IsBlockInfoTx returns true if the transaction is a block info transaction.
+func IsBlockInfoTx(tx []byte) bool {
+	_, isBlockInfo := UnmarshalBlockInfoTx(tx)
+	return isBlockInfo
+}
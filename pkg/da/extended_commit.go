@@ -0,0 +1,42 @@
+package da
+
+import (
+	daproto "github.com/sunrise-zone/sunrise-app/proto/sunrise/core/v1/da"
+)
+
+// ProtoExtendedCommitTypeID is included in each encoded ExtendedCommit to
+// identify it among the transactions of a block.
+const ProtoExtendedCommitTypeID = "ECMT"
+
+// MarshalExtendedCommitTx creates the transaction carrying the encoded
+// extended commit info of the previous height, with the vote extensions of
+// the validators. The extended commit info of a height without vote extension
+// is empty.
+func MarshalExtendedCommitTx(extendedCommitInfo []byte) ([]byte, error) {
+	extCommit := daproto.ExtendedCommit{
+		ExtendedCommitInfo: extendedCommitInfo,
+		TypeId:             ProtoExtendedCommitTypeID,
+	}
+	return extCommit.Marshal()
+}
+
+// UnmarshalExtendedCommitTx attempts to unmarshal a transaction into an
+// extended commit transaction, returning the encoded extended commit info it
+// carries. If an error is thrown, false is returned.
+func UnmarshalExtendedCommitTx(tx []byte) (extendedCommitInfo []byte, isExtendedCommit bool) {
+	var extCommit daproto.ExtendedCommit
+	if err := extCommit.Unmarshal(tx); err != nil {
+		return nil, false
+	}
+	if extCommit.TypeId != ProtoExtendedCommitTypeID {
+		return nil, false
+	}
+	return extCommit.ExtendedCommitInfo, true
+}
+
+// IsExtendedCommitTx returns true if the transaction is an extended commit
+// transaction.
+func IsExtendedCommitTx(tx []byte) bool {
+	_, isExtendedCommit := UnmarshalExtendedCommitTx(tx)
+	return isExtendedCommit
+}
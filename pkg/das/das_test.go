@@ -0,0 +1,213 @@
+package das_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sunrise-zone/sunrise-app/app"
+	"github.com/sunrise-zone/sunrise-app/app/encoding"
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	"github.com/sunrise-zone/sunrise-app/pkg/das"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+	"github.com/sunrise-zone/sunrise-app/pkg/user"
+	"github.com/sunrise-zone/sunrise-app/test/util"
+	"github.com/sunrise-zone/sunrise-app/test/util/blobfactory"
+	"github.com/sunrise-zone/sunrise-app/test/util/testfactory"
+
+	"github.com/celestiaorg/rsmt2d"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmrand "github.com/cometbft/cometbft/libs/rand"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const height = 3
+
+func TestSample(t *testing.T) {
+	data, dataRoot := testBlockData(t)
+
+	t.Run("available square", func(t *testing.T) {
+		sampler := das.NewSampler(testQueryClient(t, das.NewQueryServer(&blockClient{data: data}, extend)))
+		res, err := sampler.Sample(context.Background(), height, dataRoot, 16)
+		require.NoError(t, err)
+		require.Equal(t, 16, res.Samples)
+		require.Equal(t, dataRoot, res.DAH.Hash())
+		require.Equal(t, das.Confidence(res.DAH.SquareSize(), 16), res.Confidence)
+		require.Greater(t, res.Confidence, 0.99)
+
+		// there are no more samples than shares
+		res, err = sampler.Sample(context.Background(), height, dataRoot, 1<<20)
+		require.NoError(t, err)
+		require.Equal(t, len(res.DAH.RowRoots)*len(res.DAH.RowRoots), res.Samples)
+	})
+
+	t.Run("wrong data root", func(t *testing.T) {
+		sampler := das.NewSampler(testQueryClient(t, das.NewQueryServer(&blockClient{data: data}, extend)))
+		_, err := sampler.Sample(context.Background(), height, bytes.Repeat([]byte{1}, 32), 16)
+		require.ErrorIs(t, err, das.ErrDataRootMismatch)
+	})
+
+	t.Run("square not matching the block", func(t *testing.T) {
+		sampler := das.NewSampler(testQueryClient(t, das.NewQueryServer(&blockClient{data: data}, extendOther)))
+		_, err := sampler.Sample(context.Background(), height, dataRoot, 16)
+		require.Error(t, err)
+	})
+
+	t.Run("tampered shares", func(t *testing.T) {
+		server := das.NewQueryServer(&blockClient{data: data}, extend)
+		sampler := das.NewSampler(testQueryClient(t, tamperingServer{server}))
+		_, err := sampler.Sample(context.Background(), height, dataRoot, 1)
+		require.ErrorContains(t, err, "invalid inclusion proof")
+	})
+
+	t.Run("unknown block", func(t *testing.T) {
+		sampler := das.NewSampler(testQueryClient(t, das.NewQueryServer(&blockClient{data: data}, extend)))
+		_, err := sampler.Sample(context.Background(), height+1, dataRoot, 16)
+		require.Error(t, err)
+	})
+}
+
+func TestQueryServerSample(t *testing.T) {
+	data, dataRoot := testBlockData(t)
+	client := &blockClient{data: data}
+	server := das.NewQueryServer(client, extend)
+
+	dah, err := server.DataAvailabilityHeader(context.Background(), &das.QueryDataAvailabilityHeaderRequest{Height: height})
+	require.NoError(t, err)
+	blockDAH := da.DataAvailabilityHeader{RowRoots: dah.RowRoots, ColumnRoots: dah.ColumnRoots}
+	require.Equal(t, dataRoot, blockDAH.Hash())
+	width := uint32(len(dah.RowRoots))
+
+	for _, req := range []*das.QuerySampleRequest{
+		{Height: 0},
+		{Height: height, Row: width},
+		{Height: height, Col: width},
+	} {
+		_, err := server.Sample(context.Background(), req)
+		require.Error(t, err, req)
+	}
+	res, err := server.Sample(context.Background(), &das.QuerySampleRequest{Height: height, Row: width - 1, Col: width - 1})
+	require.NoError(t, err)
+	require.Len(t, res.Share, appconsts.ShareSize)
+
+	// the square is extended once for all the samples of the block
+	require.Equal(t, 1, client.fetched)
+}
+
+func TestConfidence(t *testing.T) {
+	require.Zero(t, das.Confidence(4, 0))
+	require.Equal(t, 1.0, das.Confidence(1, 1))
+	// a quarter of the shares, and one more row and column, must be
+	// withheld, which a sample misses with a probability under 3/4.
+	require.InDelta(t, 1-0.75*0.75, das.Confidence(1<<20, 2), 1e-6)
+	require.Less(t, das.Confidence(64, 10), das.Confidence(64, 11))
+	require.Greater(t, das.Confidence(128, 16), 0.99)
+}
+
+// testBlockData returns the data of a block of blobs prepared by the app,
+// and its data root.
+func testBlockData(t *testing.T) (cmttypes.Data, []byte) {
+	accounts := make([]string, 8)
+	for i := range accounts {
+		accounts[i] = fmt.Sprintf("account%d", i)
+	}
+	testApp, kr := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	rand := tmrand.NewRand()
+	txs := make([][]byte, 0, len(accounts))
+	for i, account := range accounts {
+		addr := testfactory.GetAddress(kr, account)
+		acc := util.DirectQueryAccount(testApp, addr)
+		signer, err := user.NewSigner(kr, nil, addr, enc.TxConfig, util.ChainID, acc.GetAccountNumber(), acc.GetSequence())
+		require.NoError(t, err)
+		size := (1 + i*7) * appconsts.ContinuationSparseShareContentSize
+		txs = append(txs, blobfactory.RandBlobTxs(signer, rand, 1, 1, size)[0])
+	}
+
+	prep, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Height: testApp.LastBlockHeight() + 1,
+		Time:   time.Now(),
+		Txs:    txs,
+	})
+	require.NoError(t, err)
+	squareTxs, dataRoot, _, err := square.ExtractBlockInfo(prep.Txs, appconsts.LatestVersion)
+	require.NoError(t, err)
+	require.Len(t, squareTxs, len(txs))
+	return cmttypes.Data{Txs: cmttypes.ToTxs(prep.Txs)}, dataRoot
+}
+
+func extend(_ int64, data cmttypes.Data, appVersion uint64) (*rsmt2d.ExtendedDataSquare, error) {
+	return app.ExtendBlock(data, appVersion)
+}
+
+// extendOther extends the data without its last blob transaction.
+func extendOther(height int64, data cmttypes.Data, appVersion uint64) (*rsmt2d.ExtendedDataSquare, error) {
+	txs := append(data.Txs[:len(data.Txs)-2:len(data.Txs)-2], data.Txs[len(data.Txs)-1])
+	return extend(height, cmttypes.Data{Txs: txs}, appVersion)
+}
+
+// blockClient serves a single block of the data.
+type blockClient struct {
+	data    cmttypes.Data
+	fetched int
+}
+
+func (c *blockClient) Block(_ context.Context, h *int64) (*coretypes.ResultBlock, error) {
+	if h == nil || *h != height {
+		return nil, errors.New("block not found")
+	}
+	c.fetched++
+	block := &cmttypes.Block{Data: c.data}
+	block.Header.Height = height
+	block.Header.Version.App = appconsts.LatestVersion
+	// the data hash of the header is the hash of the transactions, unlike
+	// the data root carried by the block info
+	block.Header.DataHash = c.data.Hash()
+	return &coretypes.ResultBlock{Block: block}, nil
+}
+
+// tamperingServer serves shares that don't match their proofs.
+type tamperingServer struct {
+	das.QueryServer
+}
+
+func (s tamperingServer) Sample(ctx context.Context, req *das.QuerySampleRequest) (*das.QuerySampleResponse, error) {
+	res, err := s.QueryServer.Sample(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res.Share = bytes.Clone(res.Share)
+	res.Share[appconsts.ShareSize-1]++
+	return res, nil
+}
+
+// testQueryClient returns a client of the server, served in memory.
+func testQueryClient(t *testing.T, server das.QueryServer) das.QueryClient {
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	das.RegisterQueryServer(grpcServer, server)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return das.NewQueryClient(conn)
+}
@@ -0,0 +1,1147 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sunrise/core/v1/das/query.proto
+
+package das
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	proof "github.com/sunrise-zone/sunrise-app/pkg/proof"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryDataAvailabilityHeaderRequest is the request type for the
+// Query/DataAvailabilityHeader RPC method.
+type QueryDataAvailabilityHeaderRequest struct {
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryDataAvailabilityHeaderRequest) Reset()         { *m = QueryDataAvailabilityHeaderRequest{} }
+func (m *QueryDataAvailabilityHeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataAvailabilityHeaderRequest) ProtoMessage()    {}
+func (*QueryDataAvailabilityHeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c98028cae1875f95, []int{0}
+}
+func (m *QueryDataAvailabilityHeaderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataAvailabilityHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataAvailabilityHeaderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataAvailabilityHeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataAvailabilityHeaderRequest.Merge(m, src)
+}
+func (m *QueryDataAvailabilityHeaderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataAvailabilityHeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataAvailabilityHeaderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataAvailabilityHeaderRequest proto.InternalMessageInfo
+
+func (m *QueryDataAvailabilityHeaderRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryDataAvailabilityHeaderResponse is the response type for the
+// Query/DataAvailabilityHeader RPC method.
+type QueryDataAvailabilityHeaderResponse struct {
+	// row_roots are the roots of the rows of the extended data square.
+	RowRoots [][]byte `protobuf:"bytes,1,rep,name=row_roots,json=rowRoots,proto3" json:"row_roots,omitempty"`
+	// column_roots are the roots of the columns of the extended data square.
+	ColumnRoots [][]byte `protobuf:"bytes,2,rep,name=column_roots,json=columnRoots,proto3" json:"column_roots,omitempty"`
+}
+
+func (m *QueryDataAvailabilityHeaderResponse) Reset()         { *m = QueryDataAvailabilityHeaderResponse{} }
+func (m *QueryDataAvailabilityHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataAvailabilityHeaderResponse) ProtoMessage()    {}
+func (*QueryDataAvailabilityHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c98028cae1875f95, []int{1}
+}
+func (m *QueryDataAvailabilityHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataAvailabilityHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataAvailabilityHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataAvailabilityHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataAvailabilityHeaderResponse.Merge(m, src)
+}
+func (m *QueryDataAvailabilityHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataAvailabilityHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataAvailabilityHeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataAvailabilityHeaderResponse proto.InternalMessageInfo
+
+func (m *QueryDataAvailabilityHeaderResponse) GetRowRoots() [][]byte {
+	if m != nil {
+		return m.RowRoots
+	}
+	return nil
+}
+
+func (m *QueryDataAvailabilityHeaderResponse) GetColumnRoots() [][]byte {
+	if m != nil {
+		return m.ColumnRoots
+	}
+	return nil
+}
+
+// QuerySampleRequest is the request type for the Query/Sample RPC method.
+type QuerySampleRequest struct {
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// row is the row of the share in the extended data square.
+	Row uint32 `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	// col is the column of the share in the extended data square.
+	Col uint32 `protobuf:"varint,3,opt,name=col,proto3" json:"col,omitempty"`
+}
+
+func (m *QuerySampleRequest) Reset()         { *m = QuerySampleRequest{} }
+func (m *QuerySampleRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySampleRequest) ProtoMessage()    {}
+func (*QuerySampleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c98028cae1875f95, []int{2}
+}
+func (m *QuerySampleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySampleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySampleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySampleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySampleRequest.Merge(m, src)
+}
+func (m *QuerySampleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySampleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySampleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySampleRequest proto.InternalMessageInfo
+
+func (m *QuerySampleRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QuerySampleRequest) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *QuerySampleRequest) GetCol() uint32 {
+	if m != nil {
+		return m.Col
+	}
+	return 0
+}
+
+// QuerySampleResponse is the response type for the Query/Sample RPC method.
+type QuerySampleResponse struct {
+	// share is the raw share.
+	Share []byte `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	// proof is the inclusion proof of the share in its row.
+	Proof *proof.NMTProof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QuerySampleResponse) Reset()         { *m = QuerySampleResponse{} }
+func (m *QuerySampleResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySampleResponse) ProtoMessage()    {}
+func (*QuerySampleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c98028cae1875f95, []int{3}
+}
+func (m *QuerySampleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySampleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySampleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySampleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySampleResponse.Merge(m, src)
+}
+func (m *QuerySampleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySampleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySampleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySampleResponse proto.InternalMessageInfo
+
+func (m *QuerySampleResponse) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+func (m *QuerySampleResponse) GetProof() *proof.NMTProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryDataAvailabilityHeaderRequest)(nil), "sunrise.core.v1.das.QueryDataAvailabilityHeaderRequest")
+	proto.RegisterType((*QueryDataAvailabilityHeaderResponse)(nil), "sunrise.core.v1.das.QueryDataAvailabilityHeaderResponse")
+	proto.RegisterType((*QuerySampleRequest)(nil), "sunrise.core.v1.das.QuerySampleRequest")
+	proto.RegisterType((*QuerySampleResponse)(nil), "sunrise.core.v1.das.QuerySampleResponse")
+}
+
+func init() { proto.RegisterFile("sunrise/core/v1/das/query.proto", fileDescriptor_c98028cae1875f95) }
+
+var fileDescriptor_c98028cae1875f95 = []byte{
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x24, 0x24, 0xe8, 0x34, 0x82, 0x4c, 0xa5, 0x84, 0x28, 0xdb, 0x64, 0x7b, 0x30, 0x22,
+	0xee, 0xd8, 0x88, 0xd8, 0x83, 0x17, 0x45, 0xc1, 0x8b, 0x52, 0x57, 0x4f, 0x5e, 0x64, 0xb2, 0x19,
+	0x77, 0x07, 0x27, 0xfb, 0xa6, 0x33, 0xb3, 0x09, 0x35, 0xe4, 0xe2, 0x2f, 0x10, 0xc4, 0xbf, 0xe2,
+	0x6f, 0xf0, 0x58, 0xf0, 0xd2, 0xa3, 0x24, 0xfe, 0x10, 0xd9, 0x99, 0xb5, 0xd0, 0x12, 0x0d, 0xbd,
+	0x2c, 0xf3, 0xde, 0x7c, 0xef, 0xfb, 0xbe, 0xfd, 0xde, 0x2e, 0xde, 0x35, 0x45, 0xae, 0x85, 0xe1,
+	0x34, 0x01, 0xcd, 0xe9, 0x74, 0x9f, 0x8e, 0x99, 0xa1, 0x47, 0x05, 0xd7, 0xc7, 0x91, 0xd2, 0x60,
+	0x81, 0x6c, 0x57, 0x80, 0xa8, 0x04, 0x44, 0xd3, 0xfd, 0x68, 0xcc, 0x4c, 0xf7, 0x56, 0x0a, 0x90,
+	0x4a, 0x4e, 0x99, 0x12, 0x94, 0xe5, 0x39, 0x58, 0x66, 0x05, 0xe4, 0xc6, 0x8f, 0x74, 0xfb, 0x17,
+	0x39, 0x95, 0x06, 0xf8, 0xe0, 0x9f, 0x1e, 0x12, 0x3e, 0xc6, 0xe1, 0xeb, 0x52, 0xe4, 0x19, 0xb3,
+	0xec, 0xc9, 0x94, 0x09, 0xc9, 0x46, 0x42, 0x0a, 0x7b, 0xfc, 0x82, 0xb3, 0x31, 0xd7, 0x31, 0x3f,
+	0x2a, 0xb8, 0xb1, 0x64, 0x07, 0xb7, 0x32, 0x2e, 0xd2, 0xcc, 0x76, 0x50, 0x0f, 0x0d, 0x1a, 0x71,
+	0x55, 0x85, 0x1c, 0xef, 0xfd, 0x77, 0xda, 0x28, 0xc8, 0x0d, 0x27, 0x37, 0xf1, 0x55, 0x0d, 0xb3,
+	0xf7, 0x1a, 0xc0, 0x9a, 0x0e, 0xea, 0x35, 0x06, 0xed, 0xf8, 0x8a, 0x86, 0x59, 0x5c, 0xd6, 0xa4,
+	0x8f, 0xdb, 0x09, 0xc8, 0x62, 0x92, 0x57, 0xf7, 0x75, 0x77, 0xbf, 0xe5, 0x7b, 0x0e, 0x12, 0x1e,
+	0x62, 0xe2, 0x64, 0xde, 0xb0, 0x89, 0x92, 0x7c, 0x83, 0x29, 0x72, 0x1d, 0x37, 0x34, 0xcc, 0x3a,
+	0xf5, 0x1e, 0x1a, 0x5c, 0x8b, 0xcb, 0x63, 0xd9, 0x49, 0x40, 0x76, 0x1a, 0xbe, 0x93, 0x80, 0x0c,
+	0x47, 0x78, 0xfb, 0x1c, 0x63, 0x65, 0xf4, 0x06, 0x6e, 0x9a, 0x8c, 0x69, 0xee, 0x18, 0xdb, 0xb1,
+	0x2f, 0xc8, 0x43, 0xdc, 0x74, 0x91, 0x39, 0xca, 0xad, 0xe1, 0x6e, 0x74, 0x71, 0x13, 0x3e, 0xd0,
+	0x57, 0x2f, 0xdf, 0x1e, 0x96, 0x87, 0xd8, 0xa3, 0x87, 0xa7, 0x75, 0xdc, 0x74, 0x22, 0xe4, 0x3b,
+	0xc2, 0x3b, 0xeb, 0x23, 0x22, 0x8f, 0xa2, 0x35, 0x6b, 0x8d, 0x36, 0xaf, 0xa4, 0x7b, 0x70, 0xf9,
+	0x41, 0xff, 0x92, 0xe1, 0x9d, 0xcf, 0x3f, 0x7f, 0x7f, 0xad, 0xef, 0x91, 0x3e, 0x5d, 0xf7, 0xc9,
+	0x8d, 0x59, 0x46, 0xe7, 0x3e, 0xc9, 0x05, 0xf9, 0x86, 0x70, 0xcb, 0x47, 0x44, 0x6e, 0xff, 0x5b,
+	0xef, 0xdc, 0x5a, 0xba, 0x83, 0xcd, 0xc0, 0xca, 0xc8, 0x81, 0x33, 0x32, 0x24, 0xf7, 0xd7, 0x1a,
+	0x31, 0x0e, 0x7c, 0xe6, 0x85, 0xce, 0x35, 0xcc, 0x16, 0x74, 0x9e, 0x80, 0x5c, 0x3c, 0x7d, 0xfe,
+	0x63, 0x19, 0xa0, 0x93, 0x65, 0x80, 0x7e, 0x2d, 0x03, 0xf4, 0x65, 0x15, 0xd4, 0x4e, 0x56, 0x41,
+	0xed, 0x74, 0x15, 0xd4, 0xde, 0xdd, 0x4d, 0x85, 0xcd, 0x8a, 0x51, 0x94, 0xc0, 0xe4, 0x2f, 0xeb,
+	0xbd, 0x4f, 0x90, 0xf3, 0xb3, 0x82, 0x29, 0x45, 0xd5, 0xc7, 0xb4, 0x94, 0x18, 0xb5, 0xdc, 0x3f,
+	0xf0, 0xe0, 0xcf, 0x00, 0x93, 0x7f, 0xbd, 0xbc, 0x7c, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// DataAvailabilityHeader queries the data availability header of the
+	// extended data square of a block.
+	DataAvailabilityHeader(ctx context.Context, in *QueryDataAvailabilityHeaderRequest, opts ...grpc.CallOption) (*QueryDataAvailabilityHeaderResponse, error)
+	// Sample queries a share of the extended data square of a block, with its
+	// inclusion proof in its row.
+	Sample(ctx context.Context, in *QuerySampleRequest, opts ...grpc.CallOption) (*QuerySampleResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) DataAvailabilityHeader(ctx context.Context, in *QueryDataAvailabilityHeaderRequest, opts ...grpc.CallOption) (*QueryDataAvailabilityHeaderResponse, error) {
+	out := new(QueryDataAvailabilityHeaderResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.core.v1.das.Query/DataAvailabilityHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Sample(ctx context.Context, in *QuerySampleRequest, opts ...grpc.CallOption) (*QuerySampleResponse, error) {
+	out := new(QuerySampleResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.core.v1.das.Query/Sample", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// DataAvailabilityHeader queries the data availability header of the
+	// extended data square of a block.
+	DataAvailabilityHeader(context.Context, *QueryDataAvailabilityHeaderRequest) (*QueryDataAvailabilityHeaderResponse, error)
+	// Sample queries a share of the extended data square of a block, with its
+	// inclusion proof in its row.
+	Sample(context.Context, *QuerySampleRequest) (*QuerySampleResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) DataAvailabilityHeader(ctx context.Context, req *QueryDataAvailabilityHeaderRequest) (*QueryDataAvailabilityHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataAvailabilityHeader not implemented")
+}
+func (*UnimplementedQueryServer) Sample(ctx context.Context, req *QuerySampleRequest) (*QuerySampleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sample not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_DataAvailabilityHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataAvailabilityHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataAvailabilityHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.core.v1.das.Query/DataAvailabilityHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataAvailabilityHeader(ctx, req.(*QueryDataAvailabilityHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sample_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySampleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sample(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.core.v1.das.Query/Sample",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sample(ctx, req.(*QuerySampleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sunrise.core.v1.das.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DataAvailabilityHeader",
+			Handler:    _Query_DataAvailabilityHeader_Handler,
+		},
+		{
+			MethodName: "Sample",
+			Handler:    _Query_Sample_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sunrise/core/v1/das/query.proto",
+}
+
+func (m *QueryDataAvailabilityHeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataAvailabilityHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataAvailabilityHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataAvailabilityHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataAvailabilityHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataAvailabilityHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ColumnRoots) > 0 {
+		for iNdEx := len(m.ColumnRoots) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ColumnRoots[iNdEx])
+			copy(dAtA[i:], m.ColumnRoots[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ColumnRoots[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RowRoots) > 0 {
+		for iNdEx := len(m.RowRoots) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RowRoots[iNdEx])
+			copy(dAtA[i:], m.RowRoots[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RowRoots[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySampleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySampleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySampleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Col != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Col))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Row != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Row))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySampleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySampleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySampleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDataAvailabilityHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryDataAvailabilityHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RowRoots) > 0 {
+		for _, b := range m.RowRoots {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ColumnRoots) > 0 {
+		for _, b := range m.ColumnRoots {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySampleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Row != 0 {
+		n += 1 + sovQuery(uint64(m.Row))
+	}
+	if m.Col != 0 {
+		n += 1 + sovQuery(uint64(m.Col))
+	}
+	return n
+}
+
+func (m *QuerySampleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDataAvailabilityHeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataAvailabilityHeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataAvailabilityHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataAvailabilityHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataAvailabilityHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataAvailabilityHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowRoots", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RowRoots = append(m.RowRoots, make([]byte, postIndex-iNdEx))
+			copy(m.RowRoots[len(m.RowRoots)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnRoots", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColumnRoots = append(m.ColumnRoots, make([]byte, postIndex-iNdEx))
+			copy(m.ColumnRoots[len(m.ColumnRoots)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySampleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySampleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySampleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Col", wireType)
+			}
+			m.Col = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Col |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySampleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySampleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySampleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &proof.NMTProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
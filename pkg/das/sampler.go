@@ -0,0 +1,155 @@
+package das
+
+import (
+	"bytes"
+	"context"
+	crand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+
+	"github.com/celestiaorg/nmt"
+)
+
+// Sampler samples the extended data squares of the blocks served by a full
+// node, as a light client checking that the data of the blocks is available.
+type Sampler struct {
+	client QueryClient
+
+	mtx  sync.Mutex
+	rand *rand.Rand
+}
+
+// SamplingResult is the result of the sampling of the extended data square of
+// a block.
+type SamplingResult struct {
+	Height int64
+	// DAH is the data availability header of the block, committed to by the
+	// data root.
+	DAH da.DataAvailabilityHeader
+	// Samples is the number of distinct shares verified.
+	Samples int
+	// Confidence is the probability that the data of the block is available,
+	// given that the shares were all served.
+	Confidence float64
+}
+
+// NewSampler returns a sampler querying the shares of the squares with the
+// client.
+func NewSampler(client QueryClient) *Sampler {
+	var seed [8]byte
+	if _, err := crand.Read(seed[:]); err != nil {
+		panic(err)
+	}
+	return &Sampler{
+		client: client,
+		rand:   rand.New(rand.NewSource(int64(binary.LittleEndian.Uint64(seed[:])))),
+	}
+}
+
+// Sample verifies the data availability header of the block of the height
+// against its data root, then verifies numSamples distinct shares of the
+// extended data square, at random coordinates, against the roots of their
+// rows. It returns an error if the header or any of the shares can't be
+// verified, in which case the data of the block must be considered
+// unavailable.
+func (s *Sampler) Sample(ctx context.Context, height int64, dataRoot []byte, numSamples int) (*SamplingResult, error) {
+	if numSamples <= 0 {
+		return nil, fmt.Errorf("invalid number of samples %d", numSamples)
+	}
+	res, err := s.client.DataAvailabilityHeader(ctx, &QueryDataAvailabilityHeaderRequest{Height: height})
+	if err != nil {
+		return nil, fmt.Errorf("querying data availability header of block %d: %w", height, err)
+	}
+	dah := da.DataAvailabilityHeader{RowRoots: res.RowRoots, ColumnRoots: res.ColumnRoots}
+	if err := dah.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid data availability header of block %d: %w", height, err)
+	}
+	if !bytes.Equal(dah.Hash(), dataRoot) {
+		return nil, errDataRootMismatch(height, dah.Hash(), dataRoot)
+	}
+
+	width := uint32(len(dah.RowRoots))
+	if numSamples > int(width*width) {
+		numSamples = int(width * width)
+	}
+	for _, coord := range s.coordinates(width, numSamples) {
+		row, col := coord[0], coord[1]
+		sample, err := s.client.Sample(ctx, &QuerySampleRequest{Height: height, Row: row, Col: col})
+		if err != nil {
+			return nil, fmt.Errorf("querying share (%d, %d) of block %d: %w", row, col, height, err)
+		}
+		if err := verifySample(sample, row, col, width, dah.RowRoots[row]); err != nil {
+			return nil, fmt.Errorf("share (%d, %d) of block %d: %w", row, col, height, err)
+		}
+	}
+	return &SamplingResult{
+		Height:     height,
+		DAH:        dah,
+		Samples:    numSamples,
+		Confidence: Confidence(dah.SquareSize(), numSamples),
+	}, nil
+}
+
+// coordinates returns count distinct random coordinates in a square of the
+// width.
+func (s *Sampler) coordinates(width uint32, count int) [][2]uint32 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	seen := make(map[[2]uint32]struct{}, count)
+	coords := make([][2]uint32, 0, count)
+	for len(coords) < count {
+		coord := [2]uint32{uint32(s.rand.Intn(int(width))), uint32(s.rand.Intn(int(width)))}
+		if _, ok := seen[coord]; ok {
+			continue
+		}
+		seen[coord] = struct{}{}
+		coords = append(coords, coord)
+	}
+	return coords
+}
+
+// verifySample verifies the inclusion proof of the sampled share at the
+// coordinates against the root of its row.
+func verifySample(sample *QuerySampleResponse, row, col, width uint32, rowRoot []byte) error {
+	if len(sample.Share) != appconsts.ShareSize {
+		return fmt.Errorf("share has %d bytes, expected %d", len(sample.Share), appconsts.ShareSize)
+	}
+	if sample.Proof == nil || sample.Proof.Start != int32(col) || sample.Proof.End != int32(col)+1 {
+		return fmt.Errorf("share isn't proven at index %d of its row", col)
+	}
+	// the shares of the original data square are pushed with their own
+	// namespace, and the parity shares with the parity namespace.
+	ns := appns.ParitySharesNamespace.Bytes()
+	if row < width/2 && col < width/2 {
+		ns = sample.Share[:appconsts.NamespaceSize]
+	}
+	nmtProof := nmt.NewInclusionProof(int(sample.Proof.Start), int(sample.Proof.End), sample.Proof.Nodes, true)
+	if !nmtProof.VerifyInclusion(appconsts.NewBaseHashFunc(), ns, [][]byte{sample.Share}, rowRoot) {
+		return errors.New("invalid inclusion proof")
+	}
+	return nil
+}
+
+// Confidence returns the probability that the extended data square of an
+// original data square of the width can be reconstructed, once the given
+// number of random shares were served. Withholding the square needs at least
+// (k+1)^2 of its 4k^2 shares to be withheld, any of which fails a sample.
+func Confidence(odsWidth int, samples int) float64 {
+	if odsWidth <= 0 || samples <= 0 {
+		return 0
+	}
+	k := float64(odsWidth)
+	withheld := (k + 1) * (k + 1) / (4 * k * k)
+	if withheld >= 1 {
+		return 1
+	}
+	return 1 - math.Pow(1-withheld, float64(samples))
+}
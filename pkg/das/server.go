@@ -0,0 +1,168 @@
+// Package das implements the data availability sampling of the extended data
+// squares of the blocks: a gRPC service serving the shares of the squares with
+// their inclusion proofs, and a light client sampler verifying random shares
+// against the data availability header.
+package das
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	"github.com/sunrise-zone/sunrise-app/pkg/proof"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+	"github.com/sunrise-zone/sunrise-app/pkg/wrapper"
+
+	"github.com/celestiaorg/rsmt2d"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	lru "github.com/hashicorp/golang-lru"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// squareCacheSize is the number of extended data squares kept by the server,
+// as the samples of a block are usually queried together. An extended data
+// square of the maximum size takes up 32 MiB.
+const squareCacheSize = 4
+
+// BlockClient fetches the blocks of the chain, like the CometBFT RPC client.
+type BlockClient interface {
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+}
+
+// ExtendFunc extends the data of the block of the given height into its
+// extended data square, which isn't modified by the server.
+type ExtendFunc func(height int64, data cmttypes.Data, appVersion uint64) (*rsmt2d.ExtendedDataSquare, error)
+
+type queryServer struct {
+	client BlockClient
+	extend ExtendFunc
+	// squares caches the extendedSquare of the last queried heights.
+	squares *lru.Cache
+}
+
+var _ QueryServer = queryServer{}
+
+// extendedSquare is the extended data square of a block with its data
+// availability header.
+type extendedSquare struct {
+	eds *rsmt2d.ExtendedDataSquare
+	dah da.DataAvailabilityHeader
+}
+
+// NewQueryServer returns the data availability sampling service, fetching
+// the blocks with the client and extending their data with extend.
+func NewQueryServer(client BlockClient, extend ExtendFunc) QueryServer {
+	squares, err := lru.New(squareCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return queryServer{client: client, extend: extend, squares: squares}
+}
+
+// DataAvailabilityHeader implements the Query/DataAvailabilityHeader gRPC
+// method.
+func (s queryServer) DataAvailabilityHeader(ctx context.Context, req *QueryDataAvailabilityHeaderRequest) (*QueryDataAvailabilityHeaderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	extended, err := s.extendedSquare(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryDataAvailabilityHeaderResponse{
+		RowRoots:    extended.dah.RowRoots,
+		ColumnRoots: extended.dah.ColumnRoots,
+	}, nil
+}
+
+// Sample implements the Query/Sample gRPC method.
+func (s queryServer) Sample(ctx context.Context, req *QuerySampleRequest) (*QuerySampleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	extended, err := s.extendedSquare(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	width := extended.eds.Width()
+	if uint(req.Row) >= width || uint(req.Col) >= width {
+		return nil, status.Errorf(codes.InvalidArgument, "share (%d, %d) out of range of a square of width %d", req.Row, req.Col, width)
+	}
+
+	row := extended.eds.Row(uint(req.Row))
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(width/2), uint(req.Row))
+	for _, share := range row {
+		if err := tree.Push(share); err != nil {
+			return nil, status.Errorf(codes.Internal, "pushing share to row %d: %s", req.Row, err)
+		}
+	}
+	nmtProof, err := tree.ProveRange(int(req.Col), int(req.Col)+1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "proving share (%d, %d): %s", req.Row, req.Col, err)
+	}
+	return &QuerySampleResponse{
+		Share: row[req.Col],
+		Proof: &proof.NMTProof{
+			Start:    int32(nmtProof.Start()),
+			End:      int32(nmtProof.End()),
+			Nodes:    nmtProof.Nodes(),
+			LeafHash: nmtProof.LeafHash(),
+		},
+	}, nil
+}
+
+// extendedSquare returns the extended data square of the block of the
+// height, checked against the data root of the block.
+func (s queryServer) extendedSquare(ctx context.Context, height int64) (extendedSquare, error) {
+	if height <= 0 {
+		return extendedSquare{}, status.Errorf(codes.InvalidArgument, "invalid height %d", height)
+	}
+	if cached, ok := s.squares.Get(height); ok {
+		return cached.(extendedSquare), nil
+	}
+	if s.client == nil {
+		return extendedSquare{}, status.Error(codes.Unavailable, "no node to fetch the blocks from")
+	}
+
+	res, err := s.client.Block(ctx, &height)
+	if err != nil {
+		return extendedSquare{}, status.Errorf(codes.NotFound, "fetching block %d: %s", height, err)
+	}
+	if res.Block == nil {
+		return extendedSquare{}, status.Errorf(codes.NotFound, "block %d not found", height)
+	}
+	appVersion := res.Block.Header.Version.App
+	// the data root is carried by the transactions of the block, and differs
+	// from the data hash of its header
+	_, dataRoot, _, err := square.ExtractBlockInfo(res.Block.Data.Txs.ToSliceOfBytes(), appVersion)
+	if err != nil {
+		return extendedSquare{}, status.Errorf(codes.Internal, "extracting data root of block %d: %s", height, err)
+	}
+	eds, err := s.extend(height, res.Block.Data, appVersion)
+	if err != nil {
+		return extendedSquare{}, status.Errorf(codes.Internal, "extending block %d: %s", height, err)
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return extendedSquare{}, status.Errorf(codes.Internal, "computing data availability header of block %d: %s", height, err)
+	}
+	if !bytes.Equal(dah.Hash(), dataRoot) {
+		return extendedSquare{}, status.Error(codes.Internal, errDataRootMismatch(height, dah.Hash(), dataRoot).Error())
+	}
+
+	extended := extendedSquare{eds: eds, dah: dah}
+	s.squares.Add(height, extended)
+	return extended, nil
+}
+
+// ErrDataRootMismatch is returned when a data availability header doesn't
+// match the data root of its block.
+var ErrDataRootMismatch = errors.New("data availability header doesn't match the data root")
+
+func errDataRootMismatch(height int64, got, expected []byte) error {
+	return fmt.Errorf("%w of block %d: %X != %X", ErrDataRootMismatch, height, got, expected)
+}
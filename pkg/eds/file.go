@@ -0,0 +1,209 @@
+// Package eds stores the extended data squares of the blocks on disk, for the
+// data availability nodes and archival tools that need the squares of past
+// heights.
+//
+// A square is stored as a file holding only its original data square, after
+// a header holding the height of its block and its data availability header:
+//
+//	magic      "SEDS"
+//	version    1 byte
+//	height     8 bytes, big endian
+//	ODS width  2 bytes, big endian
+//	row roots  2 * ODS width roots of NMT root size
+//	col roots  2 * ODS width roots of NMT root size
+//	shares     ODS width^2 shares of the original data square, row by row
+//
+// The extended data square is reconstructed by extending the original data
+// square again when reading the file, and verified against the stored data
+// availability header.
+package eds
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+
+	"github.com/celestiaorg/rsmt2d"
+)
+
+const (
+	// FileVersion is the version of the file format written by Write.
+	FileVersion = 1
+	// FileExtension is the extension of the files written by WriteFile.
+	FileExtension = ".ods"
+
+	// rootSize is the size of a row or column root, made of the minimum and
+	// maximum namespaces of the row or column and of their hash.
+	rootSize = 2*appconsts.NamespaceSize + sha256.Size
+)
+
+var fileMagic = []byte("SEDS")
+
+// ErrDAHMismatch is returned when the reconstructed extended data square
+// doesn't match the data availability header stored with it.
+var ErrDAHMismatch = errors.New("extended data square doesn't match the stored data availability header")
+
+// Square is the extended data square of the block of a height, along with
+// its data availability header.
+type Square struct {
+	Height int64
+	EDS    *rsmt2d.ExtendedDataSquare
+	DAH    da.DataAvailabilityHeader
+}
+
+// Write writes the original data square of the square, along with its height
+// and data availability header, to w. The data availability header must be
+// the one of the extended data square.
+func Write(w io.Writer, s *Square) error {
+	if s.Height < 0 {
+		return fmt.Errorf("invalid height %d", s.Height)
+	}
+	width := s.EDS.Width()
+	if len(s.DAH.RowRoots) != int(width) || len(s.DAH.ColumnRoots) != int(width) {
+		return fmt.Errorf("data availability header doesn't match a square of width %d", width)
+	}
+
+	bw := bufio.NewWriter(w)
+	header := make([]byte, 0, len(fileMagic)+11)
+	header = append(header, fileMagic...)
+	header = append(header, FileVersion)
+	header = binary.BigEndian.AppendUint64(header, uint64(s.Height))
+	header = binary.BigEndian.AppendUint16(header, uint16(width/2))
+	if _, err := bw.Write(header); err != nil {
+		return err
+	}
+	for _, roots := range [][][]byte{s.DAH.RowRoots, s.DAH.ColumnRoots} {
+		for _, root := range roots {
+			if len(root) != rootSize {
+				return fmt.Errorf("root has %d bytes, expected %d", len(root), rootSize)
+			}
+			if _, err := bw.Write(root); err != nil {
+				return err
+			}
+		}
+	}
+	for _, share := range s.EDS.FlattenedODS() {
+		if _, err := bw.Write(share); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// Read reads a square written by Write from r. It reconstructs the extended
+// data square from the original data square, and returns ErrDAHMismatch if
+// it doesn't match the stored data availability header. The stored header
+// must still be checked against the data root of the block.
+func Read(r io.Reader) (*Square, error) {
+	br := bufio.NewReader(r)
+	header := make([]byte, len(fileMagic)+11)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+	if !bytes.Equal(header[:len(fileMagic)], fileMagic) {
+		return nil, errors.New("not an extended data square file")
+	}
+	header = header[len(fileMagic):]
+	if header[0] != FileVersion {
+		return nil, fmt.Errorf("unsupported file version %d", header[0])
+	}
+	height := binary.BigEndian.Uint64(header[1:9])
+	if height > uint64(1<<63-1) {
+		return nil, fmt.Errorf("invalid height %d", height)
+	}
+	odsWidth := int(binary.BigEndian.Uint16(header[9:11]))
+	if odsWidth == 0 || odsWidth&(odsWidth-1) != 0 || odsWidth > appconsts.DefaultSquareSizeUpperBound {
+		return nil, fmt.Errorf("invalid square width %d", odsWidth)
+	}
+
+	dah := da.DataAvailabilityHeader{
+		RowRoots:    make([][]byte, 2*odsWidth),
+		ColumnRoots: make([][]byte, 2*odsWidth),
+	}
+	for _, roots := range [][][]byte{dah.RowRoots, dah.ColumnRoots} {
+		for i := range roots {
+			roots[i] = make([]byte, rootSize)
+			if _, err := io.ReadFull(br, roots[i]); err != nil {
+				return nil, fmt.Errorf("reading data availability header: %w", err)
+			}
+		}
+	}
+	ods := make([][]byte, odsWidth*odsWidth)
+	for i := range ods {
+		ods[i] = make([]byte, appconsts.ShareSize)
+		if _, err := io.ReadFull(br, ods[i]); err != nil {
+			return nil, fmt.Errorf("reading share %d: %w", i, err)
+		}
+	}
+	if _, err := br.ReadByte(); err != io.EOF {
+		return nil, errors.New("unexpected data after the shares")
+	}
+
+	eds, err := da.ExtendShares(ods)
+	if err != nil {
+		return nil, fmt.Errorf("extending the original data square: %w", err)
+	}
+	computed, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, err
+	}
+	if !computed.Equals(&dah) {
+		return nil, fmt.Errorf("%w of height %d", ErrDAHMismatch, height)
+	}
+	return &Square{Height: int64(height), EDS: eds, DAH: dah}, nil
+}
+
+// FilePath returns the path of the file of the square of the height in the
+// directory.
+func FilePath(dir string, height int64) string {
+	return filepath.Join(dir, fmt.Sprintf("%012d%s", height, FileExtension))
+}
+
+// WriteFile writes the square to its file in the directory, created if
+// needed, and returns the path of the file. The file is replaced at once, so
+// that it's never read partially written.
+func WriteFile(dir string, s *Square) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	tmp, err := os.CreateTemp(dir, ".tmp-*"+FileExtension)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0o644); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := Write(tmp, s); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+	path := FilePath(dir, s.Height)
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// ReadFile reads the square of the file, like Read.
+func ReadFile(path string) (*Square, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f)
+}
@@ -0,0 +1,106 @@
+package eds_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	"github.com/sunrise-zone/sunrise-app/pkg/eds"
+	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+
+	"github.com/stretchr/testify/require"
+)
+
+const odsWidth = 4
+
+func TestReadWrite(t *testing.T) {
+	square := randSquare(t, 42)
+	var buf bytes.Buffer
+	require.NoError(t, eds.Write(&buf, square))
+	// only the original data square is stored, after the header
+	require.Less(t, buf.Len(), 2*odsWidth*odsWidth*appconsts.ShareSize)
+
+	read, err := eds.Read(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, square.Height, read.Height)
+	require.True(t, square.EDS.Equals(read.EDS))
+	require.True(t, square.DAH.Equals(&read.DAH))
+}
+
+func TestReadInvalid(t *testing.T) {
+	square := randSquare(t, 42)
+	var buf bytes.Buffer
+	require.NoError(t, eds.Write(&buf, square))
+	encoded := buf.Bytes()
+
+	t.Run("tampered share", func(t *testing.T) {
+		tampered := bytes.Clone(encoded)
+		tampered[len(tampered)-1]++
+		_, err := eds.Read(bytes.NewReader(tampered))
+		require.ErrorIs(t, err, eds.ErrDAHMismatch)
+	})
+
+	t.Run("tampered root", func(t *testing.T) {
+		tampered := bytes.Clone(encoded)
+		// the last byte of the hash of the first row root
+		tampered[15+2*appconsts.NamespaceSize+31]++
+		_, err := eds.Read(bytes.NewReader(tampered))
+		require.ErrorIs(t, err, eds.ErrDAHMismatch)
+	})
+
+	for name, invalid := range map[string][]byte{
+		"empty":          {},
+		"truncated":      encoded[:len(encoded)-1],
+		"trailing data":  append(bytes.Clone(encoded), 0),
+		"wrong magic":    append([]byte("XXXX"), encoded[4:]...),
+		"wrong version":  append(append(bytes.Clone(encoded[:4]), 2), encoded[5:]...),
+		"invalid width":  append(append(bytes.Clone(encoded[:13]), 0, 3), encoded[15:]...),
+		"too wide width": append(append(bytes.Clone(encoded[:13]), 0xff, 0), encoded[15:]...),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := eds.Read(bytes.NewReader(invalid))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestReadWriteFile(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "eds")
+	square := randSquare(t, 7)
+	path, err := eds.WriteFile(dir, square)
+	require.NoError(t, err)
+	require.Equal(t, eds.FilePath(dir, 7), path)
+
+	// the square replaces the file of its height
+	square = randSquare(t, 7)
+	_, err = eds.WriteFile(dir, square)
+	require.NoError(t, err)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	read, err := eds.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), read.Height)
+	require.True(t, square.DAH.Equals(&read.DAH))
+}
+
+func randSquare(t *testing.T, height int64) *eds.Square {
+	shares := make([][]byte, odsWidth*odsWidth)
+	for i := range shares {
+		ns := appns.MustNewV0(bytes.Repeat([]byte{byte(1 + i)}, appns.NamespaceVersionZeroIDSize))
+		shares[i] = make([]byte, appconsts.ShareSize)
+		copy(shares[i], ns.Bytes())
+		_, err := rand.Read(shares[i][appconsts.NamespaceSize:])
+		require.NoError(t, err)
+	}
+	extended, err := da.ExtendShares(shares)
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(extended)
+	require.NoError(t, err)
+	return &eds.Square{Height: height, EDS: extended, DAH: dah}
+}
@@ -0,0 +1,213 @@
+// Package fraud implements the bad encoding fraud proofs, proving to light
+// clients that the extended data square of a block isn't correctly erasure
+// coded.
+package fraud
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+	"github.com/sunrise-zone/sunrise-app/pkg/proof"
+	"github.com/sunrise-zone/sunrise-app/pkg/wrapper"
+
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// ErrNoBadEncoding is returned when validating a bad encoding proof whose row
+// or column is correctly erasure coded.
+var ErrNoBadEncoding = errors.New("the row or column of the proof is correctly erasure coded")
+
+// rangeProver is implemented by the trees able to prove the inclusion of a
+// range of leaves, such as wrapper.ErasuredNamespacedMerkleTree.
+type rangeProver interface {
+	ProveRange(start, end int) (nmt.Proof, error)
+}
+
+// DetectBadEncoding repairs the extended data square against the data
+// availability header, and returns a proof of the first row or column found
+// not to be correctly erasure coded, if any. The missing shares of the square
+// must be nil. The trees of the rows and columns are created with treeFn,
+// usually wrapper.NewConstructor. It returns a nil proof if the square is
+// repaired, and an error if it can't be repaired.
+func DetectBadEncoding(height int64, eds *rsmt2d.ExtendedDataSquare, dah *da.DataAvailabilityHeader, treeFn rsmt2d.TreeConstructorFn) (*BadEncodingProof, error) {
+	err := eds.Repair(dah.RowRoots, dah.ColumnRoots)
+	if err == nil {
+		return nil, nil
+	}
+	var byzErr *rsmt2d.ErrByzantineData
+	if !errors.As(err, &byzErr) {
+		return nil, err
+	}
+	return NewBadEncodingProof(height, eds, dah, byzErr.Axis, byzErr.Index, treeFn)
+}
+
+// NewBadEncodingProof returns a proof that the row or column of the extended
+// data square isn't correctly erasure coded. It holds the first half of the
+// shares of the row or column that can be proven against the root of their
+// orthogonal column or row in the data availability header, that is whose
+// orthogonal column or row is complete in the square and committed to by the
+// header. The trees of the orthogonal axes are created with treeFn.
+func NewBadEncodingProof(height int64, eds *rsmt2d.ExtendedDataSquare, dah *da.DataAvailabilityHeader, axis rsmt2d.Axis, index uint, treeFn rsmt2d.TreeConstructorFn) (*BadEncodingProof, error) {
+	width := eds.Width()
+	if index >= width {
+		return nil, fmt.Errorf("%s %d out of range of a square of width %d", axis, index, width)
+	}
+	if len(dah.RowRoots) != int(width) || len(dah.ColumnRoots) != int(width) {
+		return nil, fmt.Errorf("data availability header doesn't match a square of width %d", width)
+	}
+	orthogonal := orthogonalAxis(axis)
+	orthogonalRoots := dah.ColumnRoots
+	if orthogonal == rsmt2d.Row {
+		orthogonalRoots = dah.RowRoots
+	}
+	befp := &BadEncodingProof{
+		Height: height,
+		Axis:   toProtoAxis(axis),
+		Index:  uint32(index),
+	}
+	for position := uint(0); position < width && len(befp.Shares) < int(width/2); position++ {
+		orthogonalShares := axisShares(eds, orthogonal, position)
+		if hasMissingShares(orthogonalShares) {
+			continue
+		}
+		tree := treeFn(orthogonal, position)
+		if !commits(tree, orthogonalShares, orthogonalRoots[position]) {
+			continue
+		}
+		prover, ok := tree.(rangeProver)
+		if !ok {
+			return nil, fmt.Errorf("tree %T doesn't prove ranges of leaves", tree)
+		}
+		nmtProof, err := prover.ProveRange(int(index), int(index)+1)
+		if err != nil {
+			return nil, fmt.Errorf("proving share %d of %s %d: %w", index, orthogonal, position, err)
+		}
+		befp.Shares = append(befp.Shares, &ShareWithProof{
+			Position: uint32(position),
+			Share:    orthogonalShares[index],
+			Proof: &proof.NMTProof{
+				Start:    int32(nmtProof.Start()),
+				End:      int32(nmtProof.End()),
+				Nodes:    nmtProof.Nodes(),
+				LeafHash: nmtProof.LeafHash(),
+			},
+		})
+	}
+	if len(befp.Shares) < int(width/2) {
+		return nil, fmt.Errorf("only %d of the %d shares needed to rebuild %s %d can be proven", len(befp.Shares), width/2, axis, index)
+	}
+	return befp, nil
+}
+
+// Validate returns nil if the proof proves that its row or column, as
+// committed to by the data availability header, isn't correctly erasure
+// coded. It verifies the inclusion of the shares of the proof in their
+// orthogonal columns or rows, rebuilds the row or column from them, and
+// compares the root of the rebuilt row or column with the one of the header.
+// It returns ErrNoBadEncoding if the roots match.
+func (p *BadEncodingProof) Validate(dah *da.DataAvailabilityHeader) error {
+	width := len(dah.RowRoots)
+	if width == 0 || len(dah.ColumnRoots) != width {
+		return fmt.Errorf("invalid data availability header with %d row roots and %d column roots", len(dah.RowRoots), len(dah.ColumnRoots))
+	}
+	odsWidth := width / 2
+	if int(p.Index) >= width {
+		return fmt.Errorf("index %d out of range of a square of width %d", p.Index, width)
+	}
+	roots, orthogonalRoots := dah.RowRoots, dah.ColumnRoots
+	switch p.Axis {
+	case Axis_ROW:
+	case Axis_COL:
+		roots, orthogonalRoots = dah.ColumnRoots, dah.RowRoots
+	default:
+		return fmt.Errorf("invalid axis %d", p.Axis)
+	}
+	if len(p.Shares) != odsWidth {
+		return fmt.Errorf("expected %d shares, got %d", odsWidth, len(p.Shares))
+	}
+
+	axisShares := make([][]byte, width)
+	for _, s := range p.Shares {
+		if int(s.Position) >= width {
+			return fmt.Errorf("share position %d out of range of a square of width %d", s.Position, width)
+		}
+		if axisShares[s.Position] != nil {
+			return fmt.Errorf("duplicate share at position %d", s.Position)
+		}
+		if len(s.Share) != appconsts.ShareSize {
+			return fmt.Errorf("share at position %d has %d bytes, expected %d", s.Position, len(s.Share), appconsts.ShareSize)
+		}
+		if s.Proof == nil || s.Proof.Start != int32(p.Index) || s.Proof.End != int32(p.Index)+1 {
+			return fmt.Errorf("share at position %d isn't proven at index %d", s.Position, p.Index)
+		}
+		// the shares of the original data square are pushed with their own
+		// namespace, and the parity shares with the parity namespace.
+		ns := appns.ParitySharesNamespace.Bytes()
+		if int(s.Position) < odsWidth && int(p.Index) < odsWidth {
+			ns = s.Share[:appconsts.NamespaceSize]
+		}
+		nmtProof := nmt.NewInclusionProof(int(s.Proof.Start), int(s.Proof.End), s.Proof.Nodes, true)
+		if !nmtProof.VerifyInclusion(appconsts.NewBaseHashFunc(), ns, [][]byte{s.Share}, orthogonalRoots[s.Position]) {
+			return fmt.Errorf("invalid inclusion proof of the share at position %d", s.Position)
+		}
+		axisShares[s.Position] = s.Share
+	}
+
+	rebuilt, err := appconsts.DefaultCodec().Decode(axisShares)
+	if err != nil {
+		return fmt.Errorf("rebuilding the shares: %w", err)
+	}
+	// a rebuilt row or column that can't be committed to, e.g. because of
+	// unordered namespaces, can't match the root of the header either.
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(odsWidth), uint(p.Index))
+	if commits(&tree, rebuilt, roots[p.Index]) {
+		return ErrNoBadEncoding
+	}
+	return nil
+}
+
+// commits returns true if the tree of the shares has the root.
+func commits(tree rsmt2d.Tree, shares [][]byte, root []byte) bool {
+	for _, share := range shares {
+		if err := tree.Push(share); err != nil {
+			return false
+		}
+	}
+	treeRoot, err := tree.Root()
+	return err == nil && bytes.Equal(treeRoot, root)
+}
+
+func axisShares(eds *rsmt2d.ExtendedDataSquare, axis rsmt2d.Axis, index uint) [][]byte {
+	if axis == rsmt2d.Row {
+		return eds.Row(index)
+	}
+	return eds.Col(index)
+}
+
+func hasMissingShares(shares [][]byte) bool {
+	for _, share := range shares {
+		if share == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func orthogonalAxis(axis rsmt2d.Axis) rsmt2d.Axis {
+	if axis == rsmt2d.Row {
+		return rsmt2d.Col
+	}
+	return rsmt2d.Row
+}
+
+func toProtoAxis(axis rsmt2d.Axis) Axis {
+	if axis == rsmt2d.Row {
+		return Axis_ROW
+	}
+	return Axis_COL
+}
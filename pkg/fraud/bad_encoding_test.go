@@ -0,0 +1,157 @@
+package fraud_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	"github.com/sunrise-zone/sunrise-app/pkg/fraud"
+	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+	"github.com/sunrise-zone/sunrise-app/pkg/wrapper"
+	"github.com/sunrise-zone/sunrise-app/test/util/malicious"
+
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/stretchr/testify/require"
+)
+
+const odsWidth = 4
+
+func TestDetectBadEncoding(t *testing.T) {
+	t.Run("correctly encoded square", func(t *testing.T) {
+		eds, err := da.ExtendShares(randShares(t, odsWidth*odsWidth))
+		require.NoError(t, err)
+		dah, err := da.NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+
+		received := importSquare(t, eds.Flattened(), wrapper.NewConstructor(odsWidth))
+		befp, err := fraud.DetectBadEncoding(1, received, &dah, wrapper.NewConstructor(odsWidth))
+		require.NoError(t, err)
+		require.Nil(t, befp)
+
+		// a proof of a correctly encoded row isn't valid
+		befp, err = fraud.NewBadEncodingProof(1, received, &dah, rsmt2d.Row, 1, wrapper.NewConstructor(odsWidth))
+		require.NoError(t, err)
+		require.ErrorIs(t, befp.Validate(&dah), fraud.ErrNoBadEncoding)
+	})
+
+	t.Run("corrupted parity share", func(t *testing.T) {
+		flattened := correctlyExtended(t)
+		flattened[2*odsWidth-1] = randParityShare(t)
+		testBadEncoding(t, flattened)
+	})
+
+	t.Run("corrupted original share", func(t *testing.T) {
+		flattened := correctlyExtended(t)
+		ns := appns.MustNewV0(bytes.Repeat([]byte{0xee}, appns.NamespaceVersionZeroIDSize))
+		// the namespace of the share isn't ordered in its row and column,
+		// which only the malicious tree can commit to.
+		flattened[2*odsWidth+1] = randShare(t, ns)
+		testBadEncoding(t, flattened)
+	})
+
+	t.Run("missing shares", func(t *testing.T) {
+		flattened := correctlyExtended(t)
+		flattened[2*odsWidth-1] = randParityShare(t)
+		dah := maliciousHeader(t, flattened)
+		// the parity share is recomputed from the other shares of its
+		// column, which doesn't match its row.
+		flattened[2*odsWidth-1] = nil
+		received := importSquare(t, flattened, wrapper.NewConstructor(odsWidth))
+		befp, err := fraud.DetectBadEncoding(1, received, &dah, wrapper.NewConstructor(odsWidth))
+		require.NoError(t, err)
+		require.NotNil(t, befp)
+		require.NoError(t, befp.Validate(&dah))
+	})
+}
+
+// testBadEncoding checks that a bad encoding proof of the square, committed
+// to with the malicious tree, is detected and valid, and that it can't be
+// tampered with.
+func testBadEncoding(t *testing.T, flattened [][]byte) {
+	dah := maliciousHeader(t, flattened)
+	received := importSquare(t, flattened, wrapper.NewConstructor(odsWidth))
+	befp, err := fraud.DetectBadEncoding(1, received, &dah, wrapper.NewConstructor(odsWidth))
+	require.NoError(t, err)
+	require.NotNil(t, befp)
+	require.Len(t, befp.Shares, odsWidth)
+	require.NoError(t, befp.Validate(&dah))
+
+	// the proof is valid once transmitted
+	bz, err := befp.Marshal()
+	require.NoError(t, err)
+	var decoded fraud.BadEncodingProof
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.NoError(t, decoded.Validate(&dah))
+
+	// but not against another header
+	honest, err := da.ExtendShares(randShares(t, odsWidth*odsWidth))
+	require.NoError(t, err)
+	honestDAH, err := da.NewDataAvailabilityHeader(honest)
+	require.NoError(t, err)
+	require.Error(t, befp.Validate(&honestDAH))
+
+	// nor with a tampered share
+	var tampered fraud.BadEncodingProof
+	require.NoError(t, tampered.Unmarshal(bz))
+	tampered.Shares[0].Share[appconsts.ShareSize-1]++
+	require.Error(t, tampered.Validate(&dah))
+
+	// nor with too few shares
+	var truncated fraud.BadEncodingProof
+	require.NoError(t, truncated.Unmarshal(bz))
+	truncated.Shares = truncated.Shares[1:]
+	require.Error(t, truncated.Validate(&dah))
+}
+
+// correctlyExtended returns the shares of a correctly extended square.
+func correctlyExtended(t *testing.T) [][]byte {
+	eds, err := da.ExtendShares(randShares(t, odsWidth*odsWidth))
+	require.NoError(t, err)
+	return eds.Flattened()
+}
+
+// maliciousHeader returns the data availability header committing to the
+// shares of the extended square as is, with the malicious tree.
+func maliciousHeader(t *testing.T, flattened [][]byte) da.DataAvailabilityHeader {
+	eds := importSquare(t, flattened, malicious.NewConstructor(odsWidth))
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	return dah
+}
+
+func importSquare(t *testing.T, flattened [][]byte, treeFn rsmt2d.TreeConstructorFn) *rsmt2d.ExtendedDataSquare {
+	copied := make([][]byte, len(flattened))
+	for i, share := range flattened {
+		copied[i] = bytes.Clone(share)
+	}
+	eds, err := rsmt2d.ImportExtendedDataSquare(copied, appconsts.DefaultCodec(), treeFn)
+	require.NoError(t, err)
+	return eds
+}
+
+// randShares returns shares of random data in increasing namespaces.
+func randShares(t *testing.T, count int) [][]byte {
+	shares := make([][]byte, count)
+	for i := range shares {
+		ns := appns.MustNewV0(bytes.Repeat([]byte{byte(1 + i/2)}, appns.NamespaceVersionZeroIDSize))
+		shares[i] = randShare(t, ns)
+	}
+	return shares
+}
+
+func randShare(t *testing.T, ns appns.Namespace) []byte {
+	share := make([]byte, appconsts.ShareSize)
+	copy(share, ns.Bytes())
+	_, err := rand.Read(share[appconsts.NamespaceSize:])
+	require.NoError(t, err)
+	return share
+}
+
+func randParityShare(t *testing.T) []byte {
+	share := make([]byte, appconsts.ShareSize)
+	_, err := rand.Read(share)
+	require.NoError(t, err)
+	return share
+}
@@ -0,0 +1,752 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sunrise/core/v1/fraud/fraud.proto
+
+package fraud
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	proof "github.com/sunrise-zone/sunrise-app/pkg/proof"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Axis is a row or a column of an extended data square.
+type Axis int32
+
+const (
+	// ROW is a row of the extended data square.
+	Axis_ROW Axis = 0
+	// COL is a column of the extended data square.
+	Axis_COL Axis = 1
+)
+
+var Axis_name = map[int32]string{
+	0: "ROW",
+	1: "COL",
+}
+
+var Axis_value = map[string]int32{
+	"ROW": 0,
+	"COL": 1,
+}
+
+func (x Axis) String() string {
+	return proto.EnumName(Axis_name, int32(x))
+}
+
+func (Axis) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a6436b2704032f65, []int{0}
+}
+
+// BadEncodingProof proves that a row or a column of the extended data square
+// of a block isn't correctly erasure coded. It holds enough shares of the row
+// or column to rebuild it, each proven against the root of the orthogonal
+// column or row of the data availability header.
+type BadEncodingProof struct {
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// axis is the axis of the badly encoded row or column.
+	Axis Axis `protobuf:"varint,2,opt,name=axis,proto3,enum=sunrise.core.v1.fraud.Axis" json:"axis,omitempty"`
+	// index is the index of the badly encoded row or column.
+	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// shares are the shares of the row or column, ordered by position.
+	Shares []*ShareWithProof `protobuf:"bytes,4,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (m *BadEncodingProof) Reset()         { *m = BadEncodingProof{} }
+func (m *BadEncodingProof) String() string { return proto.CompactTextString(m) }
+func (*BadEncodingProof) ProtoMessage()    {}
+func (*BadEncodingProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6436b2704032f65, []int{0}
+}
+func (m *BadEncodingProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadEncodingProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadEncodingProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BadEncodingProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadEncodingProof.Merge(m, src)
+}
+func (m *BadEncodingProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadEncodingProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadEncodingProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadEncodingProof proto.InternalMessageInfo
+
+func (m *BadEncodingProof) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BadEncodingProof) GetAxis() Axis {
+	if m != nil {
+		return m.Axis
+	}
+	return Axis_ROW
+}
+
+func (m *BadEncodingProof) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BadEncodingProof) GetShares() []*ShareWithProof {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// ShareWithProof is a share of a row or a column of the extended data square,
+// with its NMT inclusion proof in the orthogonal column or row.
+type ShareWithProof struct {
+	// position is the position of the share in the row or column.
+	Position uint32 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	// share is the raw share.
+	Share []byte `protobuf:"bytes,2,opt,name=share,proto3" json:"share,omitempty"`
+	// proof is the inclusion proof of the share in the orthogonal column or
+	// row.
+	Proof *proof.NMTProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *ShareWithProof) Reset()         { *m = ShareWithProof{} }
+func (m *ShareWithProof) String() string { return proto.CompactTextString(m) }
+func (*ShareWithProof) ProtoMessage()    {}
+func (*ShareWithProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6436b2704032f65, []int{1}
+}
+func (m *ShareWithProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareWithProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareWithProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShareWithProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareWithProof.Merge(m, src)
+}
+func (m *ShareWithProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareWithProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareWithProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareWithProof proto.InternalMessageInfo
+
+func (m *ShareWithProof) GetPosition() uint32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *ShareWithProof) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+func (m *ShareWithProof) GetProof() *proof.NMTProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("sunrise.core.v1.fraud.Axis", Axis_name, Axis_value)
+	proto.RegisterType((*BadEncodingProof)(nil), "sunrise.core.v1.fraud.BadEncodingProof")
+	proto.RegisterType((*ShareWithProof)(nil), "sunrise.core.v1.fraud.ShareWithProof")
+}
+
+func init() { proto.RegisterFile("sunrise/core/v1/fraud/fraud.proto", fileDescriptor_a6436b2704032f65) }
+
+var fileDescriptor_a6436b2704032f65 = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xd1, 0x4a, 0xfb, 0x30,
+	0x14, 0xc6, 0x9b, 0x7f, 0xb7, 0xfd, 0x25, 0x73, 0x63, 0x04, 0x95, 0x32, 0xa1, 0xd6, 0x81, 0x50,
+	0x84, 0xa5, 0x6c, 0xe2, 0xa5, 0x17, 0x4e, 0xc4, 0x1b, 0x75, 0x12, 0x85, 0x81, 0x77, 0xdd, 0xda,
+	0xb5, 0x41, 0x6c, 0x42, 0xd2, 0x8d, 0xe9, 0x53, 0xf8, 0x28, 0x3e, 0x86, 0x97, 0xbb, 0xf4, 0x52,
+	0xd6, 0x17, 0x91, 0x24, 0x65, 0x20, 0xd3, 0x9b, 0x43, 0x3f, 0xce, 0xf7, 0xf5, 0xfc, 0x72, 0x0e,
+	0x3c, 0x94, 0xb3, 0x4c, 0x50, 0x19, 0x07, 0x13, 0x26, 0xe2, 0x60, 0xde, 0x0b, 0xa6, 0x22, 0x9c,
+	0x45, 0xa6, 0x62, 0x2e, 0x58, 0xce, 0xd0, 0x6e, 0x69, 0xc1, 0xca, 0x82, 0xe7, 0x3d, 0xac, 0x9b,
+	0xed, 0x8d, 0x24, 0x17, 0x8c, 0x4d, 0x4d, 0x35, 0xc9, 0xce, 0x3b, 0x80, 0xad, 0x41, 0x18, 0x5d,
+	0x66, 0x13, 0x16, 0xd1, 0x2c, 0xb9, 0x53, 0x2d, 0xb4, 0x07, 0x6b, 0x69, 0x4c, 0x93, 0x34, 0x77,
+	0x80, 0x07, 0x7c, 0x9b, 0x94, 0x0a, 0x05, 0xb0, 0x12, 0x2e, 0xa8, 0x74, 0xfe, 0x79, 0xc0, 0x6f,
+	0xf6, 0xf7, 0xf1, 0xaf, 0x53, 0xf1, 0xf9, 0x82, 0x4a, 0xa2, 0x8d, 0x68, 0x07, 0x56, 0x69, 0x16,
+	0xc5, 0x0b, 0xc7, 0xf6, 0x80, 0xdf, 0x20, 0x46, 0xa0, 0x33, 0x58, 0x93, 0x69, 0x28, 0x62, 0xe9,
+	0x54, 0x3c, 0xdb, 0xaf, 0xf7, 0x8f, 0xfe, 0xf8, 0xd1, 0xbd, 0x32, 0x8d, 0x68, 0x9e, 0x6a, 0x2a,
+	0x52, 0x86, 0x3a, 0x2f, 0xb0, 0xf9, 0xb3, 0x83, 0xda, 0x70, 0x8b, 0x33, 0x49, 0x73, 0xca, 0x32,
+	0x4d, 0xdc, 0x20, 0x6b, 0xad, 0x10, 0x74, 0x4e, 0x43, 0x6f, 0x13, 0x23, 0xd0, 0x29, 0xac, 0xea,
+	0x2d, 0x68, 0xb0, 0x7a, 0xff, 0x60, 0x83, 0xc0, 0xec, 0xe8, 0xf6, 0xe6, 0xc1, 0xcc, 0x36, 0xee,
+	0x63, 0x07, 0x56, 0xd4, 0xeb, 0xd0, 0x7f, 0x68, 0x93, 0xe1, 0xa8, 0x65, 0xa9, 0x8f, 0x8b, 0xe1,
+	0x75, 0x0b, 0x0c, 0xae, 0x3e, 0x56, 0x2e, 0x58, 0xae, 0x5c, 0xf0, 0xb5, 0x72, 0xc1, 0x5b, 0xe1,
+	0x5a, 0xcb, 0xc2, 0xb5, 0x3e, 0x0b, 0xd7, 0x7a, 0xec, 0x26, 0x34, 0x4f, 0x67, 0x63, 0x3c, 0x61,
+	0xcf, 0x41, 0x39, 0xa5, 0xfb, 0xca, 0xb2, 0x78, 0x2d, 0x42, 0xce, 0x03, 0xfe, 0x94, 0x98, 0x83,
+	0x8e, 0x6b, 0xfa, 0x2e, 0x27, 0xdf, 0x03, 0x00, 0x26, 0x9d, 0x3c, 0xd1, 0xf6, 0x01, 0x00, 0x00,
+}
+
+func (m *BadEncodingProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BadEncodingProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadEncodingProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFraud(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Index != 0 {
+		i = encodeVarintFraud(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Axis != 0 {
+		i = encodeVarintFraud(dAtA, i, uint64(m.Axis))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintFraud(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShareWithProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShareWithProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareWithProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFraud(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintFraud(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Position != 0 {
+		i = encodeVarintFraud(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFraud(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFraud(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BadEncodingProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFraud(uint64(m.Height))
+	}
+	if m.Axis != 0 {
+		n += 1 + sovFraud(uint64(m.Axis))
+	}
+	if m.Index != 0 {
+		n += 1 + sovFraud(uint64(m.Index))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovFraud(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShareWithProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != 0 {
+		n += 1 + sovFraud(uint64(m.Position))
+	}
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovFraud(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovFraud(uint64(l))
+	}
+	return n
+}
+
+func sovFraud(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFraud(x uint64) (n int) {
+	return sovFraud(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BadEncodingProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFraud
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BadEncodingProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BadEncodingProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Axis", wireType)
+			}
+			m.Axis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Axis |= Axis(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFraud
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, &ShareWithProof{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFraud(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareWithProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFraud
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareWithProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareWithProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFraud
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFraud
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &proof.NMTProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFraud(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFraud(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFraud
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFraud
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFraud
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFraud
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFraud        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFraud          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFraud = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,6 +6,7 @@ import (
 	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
 	"github.com/sunrise-zone/sunrise-app/pkg/blob"
 	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+	"github.com/sunrise-zone/sunrise-app/pkg/parallel"
 	appshares "github.com/sunrise-zone/sunrise-app/pkg/shares"
 
 	"github.com/celestiaorg/nmt"
@@ -13,7 +14,8 @@ import (
 )
 
 // CreateCommitment generates the share commitment for a given blob.
-// See [data square layout rationale] and [blob share commitment rules].
+// See [data square layout rationale] and [blob share commitment rules]. The
+// subtree roots of the blob are computed concurrently.
 //
 // [data square layout rationale]: ../../specs/src/specs/data_square_layout.md
 // [blob share commitment rules]: ../../specs/src/specs/data_square_layout.md#blob-share-commitment-rules
@@ -46,10 +48,10 @@ func CreateCommitment(blob *blob.Blob) ([]byte, error) {
 
 	// create the commitments by pushing each leaf set onto an nmt
 	subTreeRoots := make([][]byte, len(leafSets))
-	for i, set := range leafSets {
+	err = forEach(len(leafSets), func(i int) error {
 		// create the nmt todo(evan) use nmt wrapper
 		tree := nmt.New(sha256.New(), nmt.NamespaceIDSize(appns.NamespaceSize), nmt.IgnoreMaxNamespace(true))
-		for _, leaf := range set {
+		for _, leaf := range leafSets[i] {
 			// the namespace must be added again here even though it is already
 			// included in the leaf to ensure that the hash will match that of
 			// the nmt wrapper (pkg/wrapper). Each namespace is added to keep
@@ -60,33 +62,62 @@ func CreateCommitment(blob *blob.Blob) ([]byte, error) {
 			nsLeaf = append(nsLeaf, namespace.Bytes()...)
 			nsLeaf = append(nsLeaf, leaf...)
 
-			err = tree.Push(nsLeaf)
+			err := tree.Push(nsLeaf)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
 		// add the root
 		root, err := tree.Root()
 		if err != nil {
-			return nil, err
+			return err
 		}
 		subTreeRoots[i] = root
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return merkle.HashFromByteSlices(subTreeRoots), nil
 }
 
+// CreateCommitments generates the share commitments of the given blobs, in
+// the same order. The commitments, and the subtree roots of each blob, are
+// computed concurrently by the workers shared across the process, see
+// parallel.ForEach. If several blobs are invalid, the error of the first one
+// is returned.
 func CreateCommitments(blobs []*blob.Blob) ([][]byte, error) {
 	commitments := make([][]byte, len(blobs))
-	for i, blob := range blobs {
-		commitment, err := CreateCommitment(blob)
+	err := forEach(len(blobs), func(i int) error {
+		commitment, err := CreateCommitment(blobs[i])
 		if err != nil {
-			return nil, err
+			return err
 		}
 		commitments[i] = commitment
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return commitments, nil
 }
 
+// forEach calls fn for every index in [0, n) concurrently, and returns the
+// error of the lowest index, if any, so that the result doesn't depend on the
+// scheduling of the workers.
+func forEach(n int, fn func(i int) error) error {
+	errs := make([]error, n)
+	parallel.ForEach(n, func(i int) {
+		errs[i] = fn(i)
+	})
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // MerkleMountainRangeSizes returns the sizes (number of leaf nodes) of the
 // trees in a merkle mountain range constructed for a given totalSize and
 // maxTreeSize.
@@ -2,12 +2,17 @@ package inclusion_test
 
 import (
 	"bytes"
+	"fmt"
+	"runtime"
 	"testing"
 
 	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
 	"github.com/sunrise-zone/sunrise-app/pkg/blob"
 	"github.com/sunrise-zone/sunrise-app/pkg/inclusion"
 	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+	"github.com/sunrise-zone/sunrise-app/test/util/blobfactory"
+
+	tmrand "github.com/cometbft/cometbft/libs/rand"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -72,6 +77,7 @@ func TestCreateCommitment(t *testing.T) {
 		expected     []byte
 		expectErr    bool
 		shareVersion uint8
+		signer       []byte
 	}
 	tests := []test{
 		{
@@ -81,12 +87,27 @@ func TestCreateCommitment(t *testing.T) {
 			expected:     []byte{0x3b, 0x9e, 0x78, 0xb6, 0x64, 0x8e, 0xc1, 0xa2, 0x41, 0x92, 0x5b, 0x31, 0xda, 0x2e, 0xcb, 0x50, 0xbf, 0xc6, 0xf4, 0xad, 0x55, 0x2d, 0x32, 0x79, 0x92, 0x8c, 0xa1, 0x3e, 0xbe, 0xba, 0x8c, 0x2b},
 			shareVersion: appconsts.ShareVersionZero,
 		},
+		{
+			name:         "blob of share version one embeds the signer",
+			namespace:    ns1,
+			blob:         bytes.Repeat([]byte{0xFF}, 3*appconsts.ShareSize),
+			expected:     []byte{0xf6, 0xc6, 0x27, 0x4d, 0x90, 0xba, 0xc3, 0x37, 0x6c, 0xf6, 0x77, 0x45, 0x22, 0x2e, 0xf3, 0xe8, 0xc9, 0x7d, 0x95, 0xaa, 0xed, 0xe9, 0x62, 0xe7, 0x37, 0xfd, 0xc3, 0xc9, 0x14, 0xf, 0xbf, 0xc6},
+			shareVersion: appconsts.ShareVersionOne,
+			signer:       bytes.Repeat([]byte{0x1}, appconsts.SignerSize),
+		},
+		{
+			name:         "blob of share version one without a signer should return error",
+			namespace:    ns1,
+			blob:         bytes.Repeat([]byte{0xFF}, 3*appconsts.ShareSize),
+			expectErr:    true,
+			shareVersion: appconsts.ShareVersionOne,
+		},
 		{
 			name:         "blob with unsupported share version should return error",
 			namespace:    ns1,
 			blob:         bytes.Repeat([]byte{0xFF}, 12*appconsts.ShareSize),
 			expectErr:    true,
-			shareVersion: uint8(1), // unsupported share version
+			shareVersion: uint8(2), // unsupported share version
 		},
 	}
 	for _, tt := range tests {
@@ -96,6 +117,7 @@ func TestCreateCommitment(t *testing.T) {
 				Data:             tt.blob,
 				ShareVersion:     uint32(tt.shareVersion),
 				NamespaceVersion: uint32(tt.namespace.Version),
+				Signer:           tt.signer,
 			}
 			res, err := inclusion.CreateCommitment(blob)
 			if tt.expectErr {
@@ -107,3 +129,62 @@ func TestCreateCommitment(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateCommitments(t *testing.T) {
+	rand := tmrand.NewRand()
+	blobs := blobfactory.ManyRandBlobs(rand, 1, 100, appconsts.ContinuationSparseShareContentSize*128, 1000, 10_000, 100_000, 5)
+
+	commitments, err := inclusion.CreateCommitments(blobs)
+	require.NoError(t, err)
+	require.Len(t, commitments, len(blobs))
+	// the commitments are in the order of the blobs
+	for i, b := range blobs {
+		expected, err := inclusion.CreateCommitment(b)
+		require.NoError(t, err)
+		assert.Equal(t, expected, commitments[i])
+	}
+
+	single, err := inclusion.CreateCommitments(blobs[3:4])
+	require.NoError(t, err)
+	assert.Equal(t, commitments[3:4], single)
+
+	// the error of the first invalid blob is returned
+	invalid := append([]*blob.Blob{}, blobs...)
+	invalid[2] = &blob.Blob{NamespaceId: blobs[2].NamespaceId, ShareVersion: 2, Data: []byte{1}}
+	invalid[5] = &blob.Blob{NamespaceId: blobs[5].NamespaceId}
+	_, expectedErr := inclusion.CreateCommitment(invalid[2])
+	require.Error(t, expectedErr)
+	for i := 0; i < 10; i++ {
+		_, err = inclusion.CreateCommitments(invalid)
+		assert.Equal(t, expectedErr, err)
+	}
+}
+
+func BenchmarkCreateCommitments(b *testing.B) {
+	rand := tmrand.NewRand()
+	for _, blobCount := range []int{1, 10, 100} {
+		for _, blobSize := range []int{1_000, 100_000, 1_000_000} {
+			sizes := make([]int, blobCount)
+			for i := range sizes {
+				sizes[i] = blobSize
+			}
+			blobs := blobfactory.ManyRandBlobs(rand, sizes...)
+			b.Run(fmt.Sprintf("blobCount=%d/blobSize=%d/parallel", blobCount, blobSize), func(b *testing.B) {
+				b.SetBytes(int64(blobCount * blobSize))
+				for i := 0; i < b.N; i++ {
+					_, err := inclusion.CreateCommitments(blobs)
+					require.NoError(b, err)
+				}
+			})
+			// the baseline computes the commitments on a single CPU
+			b.Run(fmt.Sprintf("blobCount=%d/blobSize=%d/serial", blobCount, blobSize), func(b *testing.B) {
+				defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+				b.SetBytes(int64(blobCount * blobSize))
+				for i := 0; i < b.N; i++ {
+					_, err := inclusion.CreateCommitments(blobs)
+					require.NoError(b, err)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Package parallel runs independent computations over a pool of workers.
+package parallel
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// busyHelpers is the number of goroutines helping the callers of ForEach
+// across the process. They are bounded by the number of usable CPUs, so that
+// nested calls don't multiply the workers: once all the helpers are busy,
+// the calls run on the goroutine of their caller only.
+var busyHelpers int64
+
+// acquireHelper reserves a helper if fewer than the usable CPUs, besides the
+// one of the caller, are busy.
+func acquireHelper() bool {
+	max := int64(runtime.GOMAXPROCS(0) - 1)
+	for {
+		busy := atomic.LoadInt64(&busyHelpers)
+		if busy >= max {
+			return false
+		}
+		if atomic.CompareAndSwapInt64(&busyHelpers, busy, busy+1) {
+			return true
+		}
+	}
+}
+
+func releaseHelper() {
+	atomic.AddInt64(&busyHelpers, -1)
+}
+
+// ForEach calls fn for every index in [0, n) from the goroutine of the caller
+// and the helpers available, up to as many workers as there are usable CPUs
+// in the whole process. A panic of fn is propagated to the caller, so that
+// it can be recovered like in a sequential loop.
+func ForEach(n int, fn func(i int)) {
+	var (
+		next      int64 = -1
+		stopped   int32
+		wg        sync.WaitGroup
+		panicOnce sync.Once
+		panicErr  interface{}
+	)
+	work := func() {
+		defer func() {
+			if err := recover(); err != nil {
+				panicOnce.Do(func() { panicErr = err })
+				// the remaining indexes are skipped
+				atomic.StoreInt32(&stopped, 1)
+			}
+		}()
+		for atomic.LoadInt32(&stopped) == 0 {
+			i := int(atomic.AddInt64(&next, 1))
+			if i >= n {
+				return
+			}
+			fn(i)
+		}
+	}
+
+	for h := 1; h < n && acquireHelper(); h++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer releaseHelper()
+			work()
+		}()
+	}
+	work()
+	wg.Wait()
+	if panicErr != nil {
+		panic(panicErr)
+	}
+}
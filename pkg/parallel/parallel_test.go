@@ -0,0 +1,63 @@
+package parallel_test
+
+import (
+	"runtime"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/parallel"
+)
+
+func TestForEach(t *testing.T) {
+	const n = 100
+	var calls [n]int32
+	parallel.ForEach(n, func(i int) {
+		atomic.AddInt32(&calls[i], 1)
+	})
+	for i := range calls {
+		require.Equal(t, int32(1), calls[i])
+	}
+
+	// nothing to run
+	parallel.ForEach(0, func(int) { t.Fatal("unexpected call") })
+
+	// the panics are propagated to the caller
+	require.PanicsWithValue(t, "panic", func() {
+		parallel.ForEach(n, func(i int) {
+			if i == n/2 {
+				panic("panic")
+			}
+		})
+	})
+}
+
+// TestForEachNested checks that the nested calls share the workers, instead
+// of running as many workers as there are usable CPUs each.
+func TestForEachNested(t *testing.T) {
+	const n = 50
+	var (
+		calls           [n][n]int32
+		active, maxSeen int32
+	)
+	parallel.ForEach(n, func(i int) {
+		parallel.ForEach(n, func(j int) {
+			running := atomic.AddInt32(&active, 1)
+			defer atomic.AddInt32(&active, -1)
+			for {
+				seen := atomic.LoadInt32(&maxSeen)
+				if running <= seen || atomic.CompareAndSwapInt32(&maxSeen, seen, running) {
+					break
+				}
+			}
+			atomic.AddInt32(&calls[i][j], 1)
+		})
+	})
+	for i := range calls {
+		for j := range calls[i] {
+			require.Equal(t, int32(1), calls[i][j])
+		}
+	}
+	require.LessOrEqual(t, int(maxSeen), runtime.GOMAXPROCS(0))
+}
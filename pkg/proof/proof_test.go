@@ -2,9 +2,14 @@ package proof_test
 
 import (
 	"bytes"
+	"fmt"
 	"testing"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmrand "github.com/cometbft/cometbft/libs/rand"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/sunrise-zone/sunrise-app/test/util/blobfactory"
 	"github.com/sunrise-zone/sunrise-app/test/util/testfactory"
@@ -257,3 +262,48 @@ func TestAllSharesInclusionProof(t *testing.T) {
 	require.NoError(t, err)
 	assert.NoError(t, proof.Validate(dataRoot))
 }
+
+// TestQueryTxInclusionProofWithExtendedCommit checks that the indexes of the
+// transactions of a block led by the extended commit tx, when vote extensions
+// are enabled, are the ones of the block.
+func TestQueryTxInclusionProofWithExtendedCommit(t *testing.T) {
+	squareTxs := testfactory.GenerateRandomTxs(10, 100).ToSliceOfBytes()
+	dataSquare, err := square.Construct(squareTxs, appconsts.LatestVersion, appconsts.SquareSizeUpperBound(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	extCommitTx, err := da.MarshalExtendedCommitTx([]byte("extended commit info"))
+	require.NoError(t, err)
+	blockTxs, err := square.AppendBlockInfo(append([][]byte{extCommitTx}, squareTxs...), dah.Hash(), uint64(dataSquare.Size()), appconsts.LatestVersion)
+	require.NoError(t, err)
+	block := cmtproto.Block{Data: cmtproto.Data{Txs: blockTxs}}
+	block.Header.Version.App = appconsts.LatestVersion
+	blockBz, err := block.Marshal()
+	require.NoError(t, err)
+
+	query := func(index int) ([]byte, error) {
+		return proof.QueryTxInclusionProof(sdk.Context{}, []string{fmt.Sprint(index)}, abci.RequestQuery{Data: blockBz})
+	}
+
+	// the extended commit tx isn't part of the square
+	_, err = query(0)
+	require.Error(t, err)
+	for i := range squareTxs {
+		rawProof, err := query(i + 1)
+		require.NoError(t, err)
+		var pProof cmtproto.ShareProof
+		require.NoError(t, pProof.Unmarshal(rawProof))
+		txProof, err := types.ShareProofFromProto(pProof)
+		require.NoError(t, err)
+		require.NoError(t, txProof.Validate(dah.Hash()))
+
+		expected, err := proof.NewTxInclusionProof(squareTxs, uint64(i), appconsts.LatestVersion)
+		require.NoError(t, err)
+		require.Equal(t, expected, txProof)
+	}
+	_, err = query(len(squareTxs) + 1)
+	require.Error(t, err)
+}
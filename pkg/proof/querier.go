@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
 	"github.com/sunrise-zone/sunrise-app/pkg/shares"
 	"github.com/sunrise-zone/sunrise-app/pkg/square"
 
@@ -47,8 +48,22 @@ func QueryTxInclusionProof(_ sdk.Context, path []string, req abci.RequestQuery)
 		panic(fmt.Errorf("error from proto block: %w", err))
 	}
 
+	// strip the block info that the proposer appended to the block data
+	txs, _, _, err := square.ExtractBlockInfo(data.Txs.ToSliceOfBytes(), pbb.Header.Version.App)
+	if err != nil {
+		return nil, err
+	}
+	// the extended commit tx leading the block was stripped too, as it isn't
+	// part of the square, so the index is shifted to the one in the square
+	if len(data.Txs) > 0 && da.IsExtendedCommitTx(data.Txs[0]) {
+		if index == 0 {
+			return nil, fmt.Errorf("tx 0 is the extended commit tx, which isn't part of the square")
+		}
+		index--
+	}
+
 	// create and marshal the tx inclusion proof, which we return in the form of []byte
-	shareProof, err := NewTxInclusionProof(data.Txs.ToSliceOfBytes(), uint64(index), pbb.Header.Version.App)
+	shareProof, err := NewTxInclusionProof(txs, uint64(index), pbb.Header.Version.App)
 	if err != nil {
 		return nil, err
 	}
@@ -88,10 +103,15 @@ func QueryShareInclusionProof(_ sdk.Context, path []string, req abci.RequestQuer
 		return nil, fmt.Errorf("error reading block: %w", err)
 	}
 
+	txs, _, _, err := square.ExtractBlockInfo(pbb.Data.Txs, pbb.Header.Version.App)
+	if err != nil {
+		return nil, err
+	}
+
 	// construct the data square from the block data. As we don't have
 	// access to the application's state machine we use the upper bound
 	// square size instead of the square size dictated from governance
-	dataSquare, err := square.Construct(pbb.Data.Txs, pbb.Header.Version.App, appconsts.SquareSizeUpperBound(pbb.Header.Version.App))
+	dataSquare, err := square.Construct(txs, pbb.Header.Version.App, appconsts.SquareSizeUpperBound(pbb.Header.Version.App))
 	if err != nil {
 		return nil, err
 	}
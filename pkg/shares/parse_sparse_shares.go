@@ -57,6 +57,9 @@ func parseSparseShares(shares []Share, supportedShareVersions []uint8) (blobs []
 				return nil, err
 			}
 			blob := blob.New(ns, data, version)
+			if signer := share.Signer(); signer != nil {
+				blob.Signer = append([]byte(nil), signer...)
+			}
 			sequences = append(sequences, sequence{
 				blob:        blob,
 				sequenceLen: sequenceLen,
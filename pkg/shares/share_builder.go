@@ -3,6 +3,7 @@ package shares
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
 
 	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
 	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
@@ -86,6 +87,9 @@ func (b *Builder) IsEmptyShare() bool {
 	}
 	if b.isFirstShare {
 		expectedLen += appconsts.SequenceLenBytes
+		if !b.isCompactShare && b.shareVersion == appconsts.ShareVersionOne {
+			expectedLen += appconsts.SignerSize
+		}
 	}
 	return len(b.rawShareData) == expectedLen
 }
@@ -169,6 +173,29 @@ func (b *Builder) WriteSequenceLen(sequenceLen uint32) error {
 	return nil
 }
 
+// WriteSigner writes the signer of the blob to the first sparse share of share
+// version one. It must be called after the sequence length is written and
+// before any data is added.
+func (b *Builder) WriteSigner(signer []byte) error {
+	if b == nil {
+		return errors.New("the builder object is not initialized (is nil)")
+	}
+	if !b.isFirstShare || b.isCompactShare {
+		return errors.New("not the first sparse share")
+	}
+	if b.shareVersion != appconsts.ShareVersionOne {
+		return fmt.Errorf("share version %d does not support a signer", b.shareVersion)
+	}
+	if len(signer) != appconsts.SignerSize {
+		return fmt.Errorf("signer must be %d bytes", appconsts.SignerSize)
+	}
+	if len(b.rawShareData) != appconsts.NamespaceSize+appconsts.ShareInfoBytes+appconsts.SequenceLenBytes {
+		return errors.New("the signer must be written before the data")
+	}
+	b.rawShareData = append(b.rawShareData, signer...)
+	return nil
+}
+
 // FlipSequenceStart flips the sequence start indicator of the share provided
 func (b *Builder) FlipSequenceStart() {
 	infoByteIndex := b.indexOfInfoBytes()
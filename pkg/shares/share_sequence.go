@@ -97,7 +97,7 @@ func numberOfSharesNeeded(firstShare Share) (sharesUsed int, err error) {
 	if isCompact {
 		return CompactSharesNeeded(int(sequenceLen)), nil
 	}
-	return SparseSharesNeeded(sequenceLen), nil
+	return SparseSharesNeeded(sequenceLen, firstShare.containsSigner()), nil
 }
 
 // CompactSharesNeeded returns the number of compact shares needed to store a
@@ -122,17 +122,23 @@ func CompactSharesNeeded(sequenceLen int) (sharesNeeded int) {
 }
 
 // SparseSharesNeeded returns the number of shares needed to store a sequence of
-// length sequenceLen.
-func SparseSharesNeeded(sequenceLen uint32) (sharesNeeded int) {
+// length sequenceLen. The first share of a sequence containing a signer, i.e.
+// of share version one, has SignerSize bytes less available for the data.
+func SparseSharesNeeded(sequenceLen uint32, containsSigner bool) (sharesNeeded int) {
 	if sequenceLen == 0 {
 		return 0
 	}
 
-	if sequenceLen < appconsts.FirstSparseShareContentSize {
+	firstShareContentSize := appconsts.FirstSparseShareContentSize
+	if containsSigner {
+		firstShareContentSize -= appconsts.SignerSize
+	}
+
+	if sequenceLen < uint32(firstShareContentSize) {
 		return 1
 	}
 
-	bytesAvailable := appconsts.FirstSparseShareContentSize
+	bytesAvailable := firstShareContentSize
 	sharesNeeded++
 	for uint32(bytesAvailable) < sequenceLen {
 		bytesAvailable += appconsts.ContinuationSparseShareContentSize
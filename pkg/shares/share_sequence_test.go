@@ -103,24 +103,28 @@ func TestCompactSharesNeeded(t *testing.T) {
 
 func TestSparseSharesNeeded(t *testing.T) {
 	type testCase struct {
-		sequenceLen uint32
-		want        int
+		sequenceLen    uint32
+		containsSigner bool
+		want           int
 	}
 	testCases := []testCase{
-		{0, 0},
-		{1, 1},
-		{2, 1},
-		{appconsts.FirstSparseShareContentSize, 1},
-		{appconsts.FirstSparseShareContentSize + 1, 2},
-		{appconsts.FirstSparseShareContentSize + appconsts.ContinuationSparseShareContentSize, 2},
-		{appconsts.FirstSparseShareContentSize + appconsts.ContinuationCompactShareContentSize*2, 3},
-		{appconsts.FirstSparseShareContentSize + appconsts.ContinuationCompactShareContentSize*99, 100},
-		{1000, 3},
-		{10000, 21},
-		{100000, 208},
+		{0, false, 0},
+		{1, false, 1},
+		{2, false, 1},
+		{appconsts.FirstSparseShareContentSize, false, 1},
+		{appconsts.FirstSparseShareContentSize + 1, false, 2},
+		{appconsts.FirstSparseShareContentSize + appconsts.ContinuationSparseShareContentSize, false, 2},
+		{appconsts.FirstSparseShareContentSize + appconsts.ContinuationCompactShareContentSize*2, false, 3},
+		{appconsts.FirstSparseShareContentSize + appconsts.ContinuationCompactShareContentSize*99, false, 100},
+		{1000, false, 3},
+		{10000, false, 21},
+		{100000, false, 208},
+		{appconsts.FirstSparseShareContentSize - appconsts.SignerSize, true, 1},
+		{appconsts.FirstSparseShareContentSize - appconsts.SignerSize + 1, true, 2},
+		{appconsts.FirstSparseShareContentSize, true, 2},
 	}
 	for _, tc := range testCases {
-		got := SparseSharesNeeded(tc.sequenceLen)
+		got := SparseSharesNeeded(tc.sequenceLen, tc.containsSigner)
 		assert.Equal(t, tc.want, got)
 	}
 }
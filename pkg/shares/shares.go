@@ -111,6 +111,34 @@ func (s *Share) SequenceLen() (sequenceLen uint32, err error) {
 	return binary.BigEndian.Uint32(s.data[start:end]), nil
 }
 
+// Signer returns the signer embedded in the first sparse share of a blob of
+// share version one. It returns nil for the other shares.
+func (s *Share) Signer() []byte {
+	if !s.containsSigner() {
+		return nil
+	}
+	start := appconsts.NamespaceSize + appconsts.ShareInfoBytes + appconsts.SequenceLenBytes
+	end := start + appconsts.SignerSize
+	if len(s.data) < end {
+		return nil
+	}
+	return s.data[start:end]
+}
+
+// containsSigner returns true if the share is the first sparse share of a
+// blob of share version one.
+func (s *Share) containsSigner() bool {
+	infoByte, err := s.InfoByte()
+	if err != nil {
+		return false
+	}
+	isCompact, err := s.IsCompactShare()
+	if err != nil {
+		return false
+	}
+	return infoByte.IsSequenceStart() && !isCompact && infoByte.Version() == appconsts.ShareVersionOne
+}
+
 // IsPadding returns whether this *share is padding or not.
 func (s *Share) IsPadding() (bool, error) {
 	isNamespacePadding, err := s.isNamespacePadding()
@@ -188,6 +216,9 @@ func (s *Share) rawDataStartIndex() int {
 	if isCompact {
 		index += appconsts.CompactShareReservedBytes
 	}
+	if s.containsSigner() {
+		index += appconsts.SignerSize
+	}
 	return index
 }
 
@@ -233,6 +264,9 @@ func (s *Share) rawDataStartIndexUsingReserved() (int, error) {
 		}
 		return int(reservedBytes), nil
 	}
+	if s.containsSigner() {
+		index += appconsts.SignerSize
+	}
 	return index, nil
 }
 
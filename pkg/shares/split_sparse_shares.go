@@ -43,6 +43,12 @@ func (sss *SparseShareSplitter) Write(blob *blob.Blob) error {
 	if err := b.WriteSequenceLen(uint32(len(rawData))); err != nil {
 		return err
 	}
+	// the first share of share version one embeds the signer of the blob
+	if uint8(blob.ShareVersion) == appconsts.ShareVersionOne {
+		if err := b.WriteSigner(blob.Signer); err != nil {
+			return err
+		}
+	}
 
 	for rawData != nil {
 
@@ -87,11 +93,9 @@ func (sss *SparseShareSplitter) WriteNamespacePaddingShares(count int) error {
 	if err != nil {
 		return err
 	}
-	lastBlobInfo, err := lastBlob.InfoByte()
-	if err != nil {
-		return err
-	}
-	nsPaddingShares, err := NamespacePaddingShares(lastBlobNs, lastBlobInfo.Version(), count)
+	// the padding shares don't have a signer, so they use share version zero
+	// regardless of the share version of the last blob.
+	nsPaddingShares, err := NamespacePaddingShares(lastBlobNs, appconsts.ShareVersionZero, count)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestSparseShareSplitter tests that the spare share splitter can split blobs
@@ -57,6 +58,39 @@ func TestWriteNamespacePaddingShares(t *testing.T) {
 	assert.Equal(t, info.Version(), appconsts.ShareVersionZero)
 }
 
+// TestSparseShareSplitterSigner tests that the first share of a blob of share
+// version one embeds the signer, which is recovered when parsing the shares.
+func TestSparseShareSplitterSigner(t *testing.T) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	signer := bytes.Repeat([]byte{2}, appconsts.SignerSize)
+	data := bytes.Repeat([]byte{3}, appconsts.FirstSparseShareContentSize)
+	blob1 := blob.NewV1(ns1, data, signer)
+
+	sss := NewSparseShareSplitter()
+	require.NoError(t, sss.Write(blob1))
+	require.NoError(t, sss.WriteNamespacePaddingShares(1))
+
+	// the signer takes up room in the first share, so the data spans two shares
+	got := sss.Export()
+	require.Len(t, got, 3)
+	assert.Equal(t, SparseSharesNeeded(uint32(len(data)), true), 2)
+	assert.Equal(t, signer, got[0].Signer())
+	assert.Nil(t, got[1].Signer())
+
+	// the padding shares don't embed a signer
+	info, err := got[2].InfoByte()
+	require.NoError(t, err)
+	assert.Equal(t, appconsts.ShareVersionZero, info.Version())
+
+	blobs, err := parseSparseShares(got, appconsts.SupportedShareVersions)
+	require.NoError(t, err)
+	require.Len(t, blobs, 1)
+	assert.Equal(t, blob1, blobs[0])
+
+	// a blob of share version one must have a signer
+	assert.Error(t, sss.Write(blob.NewV1(ns1, data, nil)))
+}
+
 func newBlob(ns appns.Namespace, shareVersion uint8) *blob.Blob {
 	return blob.New(ns, []byte("data"), shareVersion)
 }
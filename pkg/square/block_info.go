@@ -0,0 +1,83 @@
+package square
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+)
+
+// squareSizeLen is the length of the big endian encoded square size trailing
+// the transactions of the blocks prior to v3.
+const squareSizeLen = 8
+
+// AppendBlockInfo appends the data root and the square size of a block to its
+// transactions. Starting with v3 they are carried by a single block info
+// transaction, and before by two trailing pseudo transactions. See
+// appconsts.UsesBlockInfoTx.
+func AppendBlockInfo(txs [][]byte, dataRoot []byte, squareSize uint64, appVersion uint64) ([][]byte, error) {
+	if appconsts.UsesBlockInfoTx(appVersion) {
+		infoTx, err := da.MarshalBlockInfoTx(dataRoot, squareSize)
+		if err != nil {
+			return nil, err
+		}
+		return append(txs, infoTx), nil
+	}
+
+	squareSizeBigEndian := make([]byte, squareSizeLen)
+	binary.BigEndian.PutUint64(squareSizeBigEndian, squareSize)
+	return append(txs, dataRoot, squareSizeBigEndian), nil
+}
+
+// ExtractBlockInfo separates the transactions of a block from its data root and
+// square size, appended by AppendBlockInfo. The transactions returned are the
+// ones used to construct the square: the extended commit transaction that
+// leads the blocks when vote extensions are enabled isn't part of the square
+// and is left out too.
+func ExtractBlockInfo(blockTxs [][]byte, appVersion uint64) (txs [][]byte, dataRoot []byte, squareSize uint64, err error) {
+	txs, dataRoot, squareSize, err = extractBlockInfo(blockTxs, appVersion)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	offset := 0
+	if len(txs) > 0 && da.IsExtendedCommitTx(txs[0]) {
+		offset = 1
+	}
+	for i, tx := range txs[offset:] {
+		if da.IsExtendedCommitTx(tx) {
+			return nil, nil, 0, fmt.Errorf("unexpected extended commit tx at index %d", offset+i)
+		}
+	}
+	return txs[offset:], dataRoot, squareSize, nil
+}
+
+func extractBlockInfo(blockTxs [][]byte, appVersion uint64) (txs [][]byte, dataRoot []byte, squareSize uint64, err error) {
+	length := len(blockTxs)
+	if appconsts.UsesBlockInfoTx(appVersion) {
+		if length == 0 {
+			return nil, nil, 0, errors.New("txs must contain the block info tx at the end")
+		}
+		info, isBlockInfo := da.UnmarshalBlockInfoTx(blockTxs[length-1])
+		if !isBlockInfo {
+			return nil, nil, 0, errors.New("the last tx must be the block info tx")
+		}
+		txs = blockTxs[:length-1]
+		for i, tx := range txs {
+			if da.IsBlockInfoTx(tx) {
+				return nil, nil, 0, fmt.Errorf("unexpected block info tx at index %d", i)
+			}
+		}
+		return txs, info.DataRoot, info.SquareSize, nil
+	}
+
+	if length < 2 {
+		return nil, nil, 0, errors.New("txs must contain the data hash and the square size at the end, and its length must not be lower than 2")
+	}
+	squareSizeBigEndian := blockTxs[length-1]
+	if len(squareSizeBigEndian) != squareSizeLen {
+		return nil, nil, 0, fmt.Errorf("the square size must be %d bytes, got %d", squareSizeLen, len(squareSizeBigEndian))
+	}
+	return blockTxs[:length-2], blockTxs[length-2], binary.BigEndian.Uint64(squareSizeBigEndian), nil
+}
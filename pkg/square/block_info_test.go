@@ -0,0 +1,103 @@
+package square_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sunrise-zone/sunrise-app/app/encoding"
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	v2 "github.com/sunrise-zone/sunrise-app/pkg/appconsts/v2"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	"github.com/sunrise-zone/sunrise-app/pkg/shares"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+	"github.com/sunrise-zone/sunrise-app/test/util"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockInfoRoundTrip(t *testing.T) {
+	txs := [][]byte{[]byte("tx1"), []byte("tx2")}
+	dataRoot := bytes.Repeat([]byte{0xab}, 32)
+
+	for _, appVersion := range []uint64{v2.Version, appconsts.LatestVersion} {
+		blockTxs, err := square.AppendBlockInfo(append([][]byte{}, txs...), dataRoot, 16, appVersion)
+		require.NoError(t, err)
+		if appconsts.UsesBlockInfoTx(appVersion) {
+			require.Len(t, blockTxs, len(txs)+1)
+			require.True(t, da.IsBlockInfoTx(blockTxs[len(blockTxs)-1]))
+		} else {
+			require.Len(t, blockTxs, len(txs)+2)
+		}
+
+		gotTxs, gotRoot, gotSize, err := square.ExtractBlockInfo(blockTxs, appVersion)
+		require.NoError(t, err)
+		require.Equal(t, txs, gotTxs)
+		require.Equal(t, dataRoot, gotRoot)
+		require.Equal(t, uint64(16), gotSize)
+	}
+}
+
+func TestExtractBlockInfoExtendedCommit(t *testing.T) {
+	txs := [][]byte{[]byte("tx1"), []byte("tx2")}
+	dataRoot := bytes.Repeat([]byte{0xab}, 32)
+	extCommitTx, err := da.MarshalExtendedCommitTx([]byte("extended commit info"))
+	require.NoError(t, err)
+
+	// the extended commit tx leading the block isn't part of the square
+	for _, appVersion := range []uint64{v2.Version, appconsts.LatestVersion} {
+		blockTxs, err := square.AppendBlockInfo(append([][]byte{extCommitTx}, txs...), dataRoot, 16, appVersion)
+		require.NoError(t, err)
+		gotTxs, gotRoot, _, err := square.ExtractBlockInfo(blockTxs, appVersion)
+		require.NoError(t, err)
+		require.Equal(t, txs, gotTxs)
+		require.Equal(t, dataRoot, gotRoot)
+	}
+}
+
+func TestExtractBlockInfoErrors(t *testing.T) {
+	dataRoot := bytes.Repeat([]byte{0xab}, 32)
+	infoTx, err := da.MarshalBlockInfoTx(dataRoot, 4)
+	require.NoError(t, err)
+	extCommitTx, err := da.MarshalExtendedCommitTx([]byte("extended commit info"))
+	require.NoError(t, err)
+
+	type test struct {
+		name       string
+		blockTxs   [][]byte
+		appVersion uint64
+	}
+	tests := []test{
+		{"empty block", nil, appconsts.LatestVersion},
+		{"missing block info tx", [][]byte{[]byte("tx1")}, appconsts.LatestVersion},
+		{"misplaced block info tx", [][]byte{infoTx, infoTx}, appconsts.LatestVersion},
+		{"misplaced extended commit tx", [][]byte{[]byte("tx1"), extCommitTx, infoTx}, appconsts.LatestVersion},
+		{"duplicate extended commit tx", [][]byte{extCommitTx, extCommitTx, infoTx}, appconsts.LatestVersion},
+		{"legacy block too short", [][]byte{dataRoot}, v2.Version},
+		{"legacy square size of wrong length", [][]byte{dataRoot, {4}}, v2.Version},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, _, err := square.ExtractBlockInfo(tt.blockTxs, tt.appVersion)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestDeconstructBlock(t *testing.T) {
+	encCfg := encoding.MakeConfig(util.ModuleBasics)
+	dataSquare := square.EmptySquare()
+
+	blockTxs, err := square.DeconstructBlock(dataSquare, encCfg.TxConfig.TxDecoder(), appconsts.LatestVersion)
+	require.NoError(t, err)
+
+	gotTxs, dataRoot, squareSize, err := square.ExtractBlockInfo(blockTxs.ToSliceOfBytes(), appconsts.LatestVersion)
+	require.NoError(t, err)
+	require.Empty(t, gotTxs)
+	require.Equal(t, uint64(dataSquare.Size()), squareSize)
+
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	require.Equal(t, dah.Hash(), dataRoot)
+}
@@ -88,8 +88,12 @@ func (b *Builder) AppendTx(tx []byte) bool {
 }
 
 // AppendBlobTx attempts to allocate the blob transaction to the square. It returns false if there is not
-// enough space in the square to fit the transaction.
+// enough space in the square to fit the transaction. The share indexes of the wrapped transaction follow
+// the order of the blobs in the blob transaction, across all of its PFBs.
 func (b *Builder) AppendBlobTx(blobTx blob.BlobTx) bool {
+	if len(blobTx.MsgIndexes) != 0 && len(blobTx.MsgIndexes) != len(blobTx.Blobs) {
+		return false
+	}
 	iw := &coretypes.IndexWrapper{
 		Tx:           blobTx.Tx,
 		TypeId:       consts.ProtoIndexWrapperTypeID,
@@ -103,6 +107,9 @@ func (b *Builder) AppendBlobTx(blobTx blob.BlobTx) bool {
 	maxBlobShareCount := 0
 	for idx, blob := range blobTx.Blobs {
 		blobElements[idx] = newElement(blob, len(b.Pfbs), idx, b.subtreeRootThreshold)
+		if len(blobTx.MsgIndexes) != 0 {
+			blobElements[idx].MsgIndex = int(blobTx.MsgIndexes[idx])
+		}
 		maxBlobShareCount += blobElements[idx].maxShareOffset()
 	}
 
@@ -125,8 +132,6 @@ func (b *Builder) Export() (Square, error) {
 	}
 
 	// calculate the square size.
-	// NOTE: A future optimization could be to recalculate the currentSize based on the actual
-	// interblob padding used when the blobs are correctly ordered instead of using worst case padding.
 	ss := inclusion.BlobMinSquareSize(b.currentSize)
 
 	// Sort the blobs by namespace. This uses SliceStable to preserve the order
@@ -136,6 +141,14 @@ func (b *Builder) Export() (Square, error) {
 		return bytes.Compare(b.Blobs[i].Blob.Namespace().Bytes(), b.Blobs[j].Blob.Namespace().Bytes()) < 0
 	})
 
+	// starting with v3, the blobs of a namespace are ordered to minimize the
+	// padding between them, and the square size is recalculated from the
+	// actual padding instead of the worst case padding.
+	if appconsts.MinimizesBlobPadding(b.appVersion) {
+		end := layoutBlobs(b.Blobs, b.TxCounter.Size()+b.PfbCounter.Size(), b.subtreeRootThreshold)
+		ss = inclusion.BlobMinSquareSize(end)
+	}
+
 	// write all the regular transactions into compact shares
 	txWriter := shares.NewCompactShareSplitter(namespace.TxNamespace, appconsts.ShareVersionZero)
 	for _, tx := range b.Txs {
@@ -365,15 +378,18 @@ func (b *Builder) IsEmpty() bool {
 }
 
 type Element struct {
-	Blob       *blob.Blob
-	PfbIndex   int
-	BlobIndex  int
+	Blob      *blob.Blob
+	PfbIndex  int
+	BlobIndex int
+	// MsgIndex is the index of the PFB paying for the blob in the messages
+	// of the transaction.
+	MsgIndex   int
 	NumShares  int
 	MaxPadding int
 }
 
 func newElement(blob *blob.Blob, pfbIndex, blobIndex, subtreeRootThreshold int) *Element {
-	numShares := shares.SparseSharesNeeded(uint32(len(blob.Data)))
+	numShares := shares.SparseSharesNeeded(uint32(len(blob.Data)), uint8(blob.ShareVersion) == appconsts.ShareVersionOne)
 	return &Element{
 		Blob:      blob,
 		PfbIndex:  pfbIndex,
@@ -9,6 +9,7 @@ import (
 
 	"github.com/sunrise-zone/sunrise-app/app/encoding"
 	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	v2 "github.com/sunrise-zone/sunrise-app/pkg/appconsts/v2"
 	"github.com/sunrise-zone/sunrise-app/pkg/blob"
 	ns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
 	"github.com/sunrise-zone/sunrise-app/pkg/shares"
@@ -271,7 +272,9 @@ func TestSquareBlobPostions(t *testing.T) {
 	ns3 := ns.MustNewV0(bytes.Repeat([]byte{3}, ns.NamespaceVersionZeroIDSize))
 
 	type test struct {
-		squareSize      int
+		squareSize int
+		// appVersion defaults to the latest version
+		appVersion      uint64
 		blobTxs         [][]byte
 		expectedIndexes [][]uint32
 	}
@@ -408,6 +411,7 @@ func TestSquareBlobPostions(t *testing.T) {
 		},
 		{
 			squareSize: 16,
+			appVersion: v2.Version,
 			blobTxs: generateBlobTxsWithNamespaces(
 				t,
 				[]ns.Namespace{ns1, ns1},
@@ -416,10 +420,24 @@ func TestSquareBlobPostions(t *testing.T) {
 			// There should be one share padding between the two blobs
 			expectedIndexes: [][]uint32{{2}, {4}},
 		},
+		{
+			squareSize: 16,
+			blobTxs: generateBlobTxsWithNamespaces(
+				t,
+				[]ns.Namespace{ns1, ns1},
+				[][]int{{100}, {shares.AvailableBytesFromSparseShares(appconsts.DefaultSubtreeRootThreshold) + 1}},
+			),
+			// The second blob is laid out first so that there is no padding
+			expectedIndexes: [][]uint32{{67}, {2}},
+		},
 	}
 	for i, tt := range tests {
 		t.Run(fmt.Sprintf("case%d", i), func(t *testing.T) {
-			builder, err := square.NewBuilder(tt.squareSize, appconsts.LatestVersion)
+			appVersion := tt.appVersion
+			if appVersion == 0 {
+				appVersion = appconsts.LatestVersion
+			}
+			builder, err := square.NewBuilder(tt.squareSize, appVersion)
 			require.NoError(t, err)
 			for _, tx := range tt.blobTxs {
 				blobTx, isBlobTx := blob.UnmarshalBlobTx(tx)
@@ -0,0 +1,99 @@
+package square
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/inclusion"
+)
+
+// layoutBlobs orders the blobs, sorted by namespace, so as to minimize the
+// padding between them when they are laid out from the cursor following the
+// blob share commitment rules. Only the order of the blobs within a namespace
+// changes. It returns the index of the share following the last blob.
+//
+// As the index at which a blob can start never decreases when the cursor
+// increases, ending the blobs of each namespace as early as possible minimizes
+// the padding of the whole square. The order is a function of the blobs only,
+// so that laying them out again gives the same square.
+func layoutBlobs(elements []*Element, cursor, subtreeRootThreshold int) int {
+	for start := 0; start < len(elements); {
+		ns := elements[start].Blob.Namespace().Bytes()
+		end := start + 1
+		for end < len(elements) && bytes.Equal(elements[end].Blob.Namespace().Bytes(), ns) {
+			end++
+		}
+		cursor = layoutNamespace(elements[start:end], cursor, subtreeRootThreshold)
+		start = end
+	}
+	return cursor
+}
+
+// layoutNamespace orders the blobs of a namespace to end them as early as
+// possible from the cursor, and returns the index of the share following the
+// last blob. The blobs are placed by priority unless placing them by least
+// padding first ends them earlier.
+func layoutNamespace(elements []*Element, cursor, subtreeRootThreshold int) int {
+	sort.Slice(elements, func(i, j int) bool {
+		if elements[i].PfbIndex != elements[j].PfbIndex {
+			return elements[i].PfbIndex < elements[j].PfbIndex
+		}
+		return elements[i].BlobIndex < elements[j].BlobIndex
+	})
+	byPriorityEnd := layoutEnd(elements, cursor, subtreeRootThreshold)
+
+	packed := leastPaddingOrder(elements, cursor, subtreeRootThreshold)
+	packedEnd := layoutEnd(packed, cursor, subtreeRootThreshold)
+	if packedEnd < byPriorityEnd {
+		copy(elements, packed)
+		return packedEnd
+	}
+	return byPriorityEnd
+}
+
+// leastPaddingOrder returns the blobs in the order obtained by placing, at
+// each step, the blob needing the least padding at the cursor. Ties are broken
+// in favour of the widest subtrees, which are the hardest to align, then by
+// priority.
+func leastPaddingOrder(elements []*Element, cursor, subtreeRootThreshold int) []*Element {
+	// the blobs are grouped by subtree width, as the padding only depends on
+	// it, so that a blob is chosen among a handful of widths.
+	byWidth := make(map[int][]*Element)
+	widths := make([]int, 0)
+	for _, element := range elements {
+		width := inclusion.SubTreeWidth(element.NumShares, subtreeRootThreshold)
+		if _, ok := byWidth[width]; !ok {
+			widths = append(widths, width)
+		}
+		byWidth[width] = append(byWidth[width], element)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(widths)))
+
+	ordered := make([]*Element, 0, len(elements))
+	for len(ordered) < len(elements) {
+		bestWidth, bestPadding := 0, -1
+		for _, width := range widths {
+			if len(byWidth[width]) == 0 {
+				continue
+			}
+			padding := (width - cursor%width) % width
+			if bestPadding == -1 || padding < bestPadding {
+				bestWidth, bestPadding = width, padding
+			}
+		}
+		element := byWidth[bestWidth][0]
+		byWidth[bestWidth] = byWidth[bestWidth][1:]
+		ordered = append(ordered, element)
+		cursor += bestPadding + element.NumShares
+	}
+	return ordered
+}
+
+// layoutEnd returns the index of the share following the last blob when the
+// blobs are laid out in order from the cursor.
+func layoutEnd(elements []*Element, cursor, subtreeRootThreshold int) int {
+	for _, element := range elements {
+		cursor = inclusion.NextShareIndex(cursor, element.NumShares, subtreeRootThreshold) + element.NumShares
+	}
+	return cursor
+}
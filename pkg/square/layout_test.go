@@ -0,0 +1,135 @@
+package square_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	v2 "github.com/sunrise-zone/sunrise-app/pkg/appconsts/v2"
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	"github.com/sunrise-zone/sunrise-app/pkg/inclusion"
+	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobLayout(t *testing.T) {
+	const maxSquareSize = 32
+	// txA has a subtree width of 4, and txB of 1
+	txA := testBlobTx(t, "A", 252)
+	txB := testBlobTx(t, "B", 3)
+	txs := [][]byte{txA, txB}
+
+	// by priority, txB follows the 3 shares of padding aligning txA
+	prioritySquare, priorityTxs, err := square.Build(txs, v2.Version, maxSquareSize)
+	require.NoError(t, err)
+	require.Equal(t, txs, priorityTxs)
+	require.Equal(t, []uint32{4, 256}, shareIndexes(t, priorityTxs, v2.Version, maxSquareSize))
+	require.Equal(t, 32, prioritySquare.Size())
+
+	// txB fills the padding aligning txA, so that both fit in a smaller square
+	dataSquare, packedTxs, err := square.Build(txs, appconsts.LatestVersion, maxSquareSize)
+	require.NoError(t, err)
+	require.Equal(t, txs, packedTxs)
+	require.Equal(t, []uint32{4, 1}, shareIndexes(t, packedTxs, appconsts.LatestVersion, maxSquareSize))
+	require.Equal(t, 16, dataSquare.Size())
+	padding, err := dataSquare.PaddingShares()
+	require.NoError(t, err)
+	require.Zero(t, padding)
+
+	constructed, err := square.Construct(packedTxs, appconsts.LatestVersion, maxSquareSize)
+	require.NoError(t, err)
+	require.True(t, dataSquare.Equals(constructed))
+}
+
+// FuzzBlobLayout checks that the blobs laid out to minimize the padding
+// - follow the namespace order and the blob share commitment rules
+// - never end after the blobs laid out by priority, in a greater square
+// - give the same square when the square is constructed again.
+func FuzzBlobLayout(f *testing.F) {
+	f.Add(int64(1), 10)
+	f.Add(int64(2), 50)
+	f.Add(int64(3), 200)
+	f.Fuzz(func(t *testing.T, seed int64, blobCount int) {
+		if blobCount < 0 || blobCount > 1000 {
+			t.Skip()
+		}
+		maxSquareSize := appconsts.DefaultSquareSizeUpperBound
+		txs := randomLayoutBlobTxs(t, rand.New(rand.NewSource(seed)), blobCount)
+
+		prioritySquare, priorityTxs, err := square.Build(txs, v2.Version, maxSquareSize)
+		require.NoError(t, err)
+		dataSquare, packedTxs, err := square.Build(txs, appconsts.LatestVersion, maxSquareSize)
+		require.NoError(t, err)
+		require.Equal(t, priorityTxs, packedTxs)
+		require.LessOrEqual(t, dataSquare.Size(), prioritySquare.Size())
+
+		priorityBuilder := exportedBuilder(t, priorityTxs, v2.Version, maxSquareSize)
+		builder := exportedBuilder(t, packedTxs, appconsts.LatestVersion, maxSquareSize)
+		require.LessOrEqual(t, checkBlobLayout(t, builder), checkBlobLayout(t, priorityBuilder))
+
+		constructed, err := square.Construct(packedTxs, appconsts.LatestVersion, maxSquareSize)
+		require.NoError(t, err)
+		require.True(t, dataSquare.Equals(constructed))
+		again, err := builder.Export()
+		require.NoError(t, err)
+		require.True(t, dataSquare.Equals(again))
+	})
+}
+
+// randomLayoutBlobTxs returns blob txs with a blob of a random size, among
+// which many need padding to be aligned, in one of a few namespaces.
+func randomLayoutBlobTxs(t *testing.T, rand *rand.Rand, count int) [][]byte {
+	txs := make([][]byte, count)
+	for i := range txs {
+		ns := appns.MustNewV0(bytes.Repeat([]byte{byte(1 + rand.Intn(3))}, appns.NamespaceVersionZeroIDSize))
+		numShares := 1 + rand.Intn(8)
+		if rand.Intn(2) == 0 {
+			numShares = 1 + rand.Intn(600)
+		}
+		data := bytes.Repeat([]byte{2}, numShares*appconsts.ContinuationSparseShareContentSize)
+		tx, err := blob.MarshalBlobTx([]byte(fmt.Sprint(i)), blob.New(ns, data, appconsts.ShareVersionZero))
+		require.NoError(t, err)
+		txs[i] = tx
+	}
+	return txs
+}
+
+func exportedBuilder(t *testing.T, txs [][]byte, appVersion uint64, maxSquareSize int) *square.Builder {
+	builder, err := square.NewBuilder(maxSquareSize, appVersion, txs...)
+	require.NoError(t, err)
+	_, err = builder.Export()
+	require.NoError(t, err)
+	return builder
+}
+
+// shareIndexes returns the share indexes of the blobs of the blob txs.
+func shareIndexes(t *testing.T, txs [][]byte, appVersion uint64, maxSquareSize int) []uint32 {
+	builder := exportedBuilder(t, txs, appVersion, maxSquareSize)
+	indexes := make([]uint32, 0, len(builder.Pfbs))
+	for _, pfb := range builder.Pfbs {
+		indexes = append(indexes, pfb.ShareIndexes...)
+	}
+	return indexes
+}
+
+// checkBlobLayout checks that the blobs of the exported builder are laid out
+// in namespace order, without overlapping, and at the indexes required by the
+// blob share commitment rules. It returns the index of the share following
+// the last blob.
+func checkBlobLayout(t *testing.T, builder *square.Builder) int {
+	end := builder.TxCounter.Size() + builder.PfbCounter.Size()
+	for i, element := range builder.Blobs {
+		if i > 0 {
+			require.LessOrEqual(t, bytes.Compare(builder.Blobs[i-1].Blob.Namespace().Bytes(), element.Blob.Namespace().Bytes()), 0)
+		}
+		index := int(builder.Pfbs[element.PfbIndex].ShareIndexes[element.BlobIndex])
+		require.GreaterOrEqual(t, index, end)
+		require.Zero(t, index%inclusion.SubTreeWidth(element.NumShares, builder.SubtreeRootThreshold()))
+		end = index + element.NumShares
+	}
+	return end
+}
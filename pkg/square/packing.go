@@ -0,0 +1,254 @@
+package square
+
+import (
+	"container/heap"
+	"fmt"
+	"math/bits"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	"github.com/sunrise-zone/sunrise-app/pkg/inclusion"
+	"github.com/sunrise-zone/sunrise-app/pkg/shares"
+)
+
+// TxInfo describes a transaction for the packing of a square.
+type TxInfo struct {
+	// Fee is the fee paid by the transaction.
+	Fee uint64
+	// Signer identifies the account whose transactions must be included in
+	// their relative order, without gaps, so that their nonces stay
+	// sequential.
+	Signer string
+}
+
+// TxInfoFunc returns the packing information of a transaction. It returns
+// false for the transactions that must be included first, in their order,
+// such as the transactions that don't pay fees.
+type TxInfoFunc func(tx []byte) (TxInfo, bool)
+
+// BuildFeeMaximizing builds a square that is never greater than maxSquareSize
+// like Build, but chooses the transactions that maximize the fees paid per
+// share instead of including them in the order given. The transactions
+// without packing information are included first, in the order given. The
+// other transactions are then included by decreasing fee per share, taking
+// into account the worst case padding of their blobs, and in the order given
+// for the transactions of a same signer: a transaction is left out if one of
+// the previous transactions of its signer is.
+//
+// The greedy Build is used as a fallback, when it includes as many
+// transactions without packing information and more fees, or the same fees in
+// a smaller square, and when the transactions without packing information
+// don't all fit in the square. Like for Build, the transactions
+// returned reconstruct the square with Construct.
+func BuildFeeMaximizing(txs [][]byte, appVersion uint64, maxSquareSize int, txInfo TxInfoFunc) (Square, [][]byte, error) {
+	txInfo = memoizeTxInfo(txInfo)
+	greedySquare, greedyTxs, greedyErr := Build(txs, appVersion, maxSquareSize)
+
+	packedSquare, packedTxs, err := buildPacked(txs, appVersion, maxSquareSize, txInfo)
+	if err != nil {
+		return greedySquare, greedyTxs, greedyErr
+	}
+	if greedyErr != nil {
+		return packedSquare, packedTxs, nil
+	}
+
+	// the transactions without packing information come first, then the fees
+	// and the size of the square.
+	greedyPinned, packedPinned := countPinned(greedyTxs, txInfo), countPinned(packedTxs, txInfo)
+	if packedPinned != greedyPinned {
+		if packedPinned > greedyPinned {
+			return packedSquare, packedTxs, nil
+		}
+		return greedySquare, greedyTxs, nil
+	}
+	greedyFee, packedFee := totalFee(greedyTxs, txInfo), totalFee(packedTxs, txInfo)
+	if packedFee > greedyFee || (packedFee == greedyFee && packedSquare.Size() < greedySquare.Size()) {
+		return packedSquare, packedTxs, nil
+	}
+	return greedySquare, greedyTxs, nil
+}
+
+// buildPacked builds the square of the transactions chosen by decreasing fee
+// per share. It returns an error if one of the transactions without packing
+// information doesn't fit, as they can't be left out.
+func buildPacked(txs [][]byte, appVersion uint64, maxSquareSize int, txInfo TxInfoFunc) (Square, [][]byte, error) {
+	builder, err := NewBuilder(maxSquareSize, appVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+	normalTxs := make([][]byte, 0, len(txs))
+	blobTxs := make([][]byte, 0, len(txs))
+	appendTx := func(tx []byte) bool {
+		blobTx, isBlobTx := blob.UnmarshalBlobTx(tx)
+		if isBlobTx {
+			if !builder.AppendBlobTx(blobTx) {
+				return false
+			}
+			blobTxs = append(blobTxs, tx)
+			return true
+		}
+		if !builder.AppendTx(tx) {
+			return false
+		}
+		normalTxs = append(normalTxs, tx)
+		return true
+	}
+
+	// the transactions without packing information are included first, and
+	// the others are grouped by signer.
+	chains := make(map[string]*txChain)
+	queue := make(txQueue, 0)
+	for i, tx := range txs {
+		info, ok := txInfo(tx)
+		if !ok {
+			if !appendTx(tx) {
+				return nil, nil, fmt.Errorf("transaction %d without packing information does not fit in the square", i)
+			}
+			continue
+		}
+		candidate := packingCandidate{
+			index:  i,
+			tx:     tx,
+			fee:    info.Fee,
+			shares: worstCaseSharesUsed(tx, builder.SubtreeRootThreshold()),
+		}
+		chain, ok := chains[info.Signer]
+		if !ok {
+			chain = &txChain{}
+			chains[info.Signer] = chain
+			queue = append(queue, chain)
+		}
+		chain.candidates = append(chain.candidates, candidate)
+	}
+
+	// include the head of the chain paying the most fee per share, until no
+	// chain is left. A chain is dropped as soon as its head doesn't fit.
+	heap.Init(&queue)
+	for queue.Len() > 0 {
+		chain := queue[0]
+		if !appendTx(chain.head().tx) {
+			heap.Pop(&queue)
+			continue
+		}
+		chain.next++
+		if chain.next == len(chain.candidates) {
+			heap.Pop(&queue)
+		} else {
+			heap.Fix(&queue, 0)
+		}
+	}
+
+	square, err := builder.Export()
+	return square, append(normalTxs, blobTxs...), err
+}
+
+// memoizeTxInfo returns a TxInfoFunc caching the results of txInfo, as
+// decoding the transactions is costly.
+func memoizeTxInfo(txInfo TxInfoFunc) TxInfoFunc {
+	type result struct {
+		info TxInfo
+		ok   bool
+	}
+	results := make(map[string]result)
+	return func(tx []byte) (TxInfo, bool) {
+		if r, ok := results[string(tx)]; ok {
+			return r.info, r.ok
+		}
+		info, ok := txInfo(tx)
+		results[string(tx)] = result{info: info, ok: ok}
+		return info, ok
+	}
+}
+
+// countPinned returns the number of transactions without packing information.
+func countPinned(txs [][]byte, txInfo TxInfoFunc) int {
+	count := 0
+	for _, tx := range txs {
+		if _, ok := txInfo(tx); !ok {
+			count++
+		}
+	}
+	return count
+}
+
+// totalFee returns the sum of the fees paid by the transactions, saturating
+// on overflow.
+func totalFee(txs [][]byte, txInfo TxInfoFunc) uint64 {
+	var total uint64
+	for _, tx := range txs {
+		if info, ok := txInfo(tx); ok {
+			sum, carry := bits.Add64(total, info.Fee, 0)
+			if carry != 0 {
+				return ^uint64(0)
+			}
+			total = sum
+		}
+	}
+	return total
+}
+
+// worstCaseSharesUsed returns an upper bound of the number of shares used by
+// the transaction, including the worst case padding of its blobs.
+func worstCaseSharesUsed(tx []byte, subtreeRootThreshold int) int {
+	blobTx, isBlobTx := blob.UnmarshalBlobTx(tx)
+	if !isBlobTx {
+		return shares.CompactSharesNeeded(len(tx))
+	}
+	sharesUsed := shares.CompactSharesNeeded(len(blobTx.Tx))
+	for _, b := range blobTx.Blobs {
+		blobShares := shares.SparseSharesNeeded(uint32(len(b.Data)), uint8(b.ShareVersion) == appconsts.ShareVersionOne)
+		sharesUsed += blobShares + inclusion.SubTreeWidth(blobShares, subtreeRootThreshold) - 1
+	}
+	return sharesUsed
+}
+
+// packingCandidate is a transaction that may be included in the square.
+type packingCandidate struct {
+	// index is the index of the transaction in the transactions given, used
+	// to break ties deterministically.
+	index  int
+	tx     []byte
+	fee    uint64
+	shares int
+}
+
+// txChain holds the transactions of a signer in their order.
+type txChain struct {
+	candidates []packingCandidate
+	// next is the index of the next candidate to include.
+	next int
+}
+
+func (c *txChain) head() packingCandidate {
+	return c.candidates[c.next]
+}
+
+// txQueue is a max heap of the chains by fee per share of their head.
+type txQueue []*txChain
+
+func (q txQueue) Len() int { return len(q) }
+
+func (q txQueue) Less(i, j int) bool {
+	a, b := q[i].head(), q[j].head()
+	// compare a.fee / a.shares with b.fee / b.shares without rounding
+	aHi, aLo := bits.Mul64(a.fee, uint64(b.shares))
+	bHi, bLo := bits.Mul64(b.fee, uint64(a.shares))
+	if aHi != bHi {
+		return aHi > bHi
+	}
+	if aLo != bLo {
+		return aLo > bLo
+	}
+	return a.index < b.index
+}
+
+func (q txQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *txQueue) Push(x interface{}) { *q = append(*q, x.(*txChain)) }
+
+func (q *txQueue) Pop() interface{} {
+	old := *q
+	chain := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return chain
+}
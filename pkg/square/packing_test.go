@@ -0,0 +1,143 @@
+package square_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+	"github.com/sunrise-zone/sunrise-app/pkg/shares"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testBlobTx returns a blob tx with a single blob of the given number of
+// shares.
+func testBlobTx(t *testing.T, name string, numShares int) []byte {
+	dataLen := numShares * appconsts.ContinuationSparseShareContentSize
+	for shares.SparseSharesNeeded(uint32(dataLen), false) > numShares {
+		dataLen -= 10
+	}
+	ns := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	tx, err := blob.MarshalBlobTx([]byte(name), blob.New(ns, bytes.Repeat([]byte{2}, dataLen), appconsts.ShareVersionZero))
+	require.NoError(t, err)
+	return tx
+}
+
+func TestBuildFeeMaximizing(t *testing.T) {
+	const maxSquareSize = 4
+	txA := testBlobTx(t, "A", 6)
+	txB := testBlobTx(t, "B", 6)
+	txC := testBlobTx(t, "C", 6)
+	txS1 := testBlobTx(t, "S1", 6)
+	txS2 := testBlobTx(t, "S2", 6)
+	pinned := testBlobTx(t, "pinned", 6)
+	extCommitTx, err := da.MarshalExtendedCommitTx([]byte("extended commit info"))
+	require.NoError(t, err)
+
+	infos := map[string]square.TxInfo{
+		string(txA):  {Fee: 10, Signer: "a"},
+		string(txB):  {Fee: 1000, Signer: "b"},
+		string(txC):  {Fee: 500, Signer: "c"},
+		string(txS1): {Fee: 1, Signer: "s"},
+		string(txS2): {Fee: 10_000, Signer: "s"},
+	}
+	txInfo := func(tx []byte) (square.TxInfo, bool) {
+		info, ok := infos[string(tx)]
+		return info, ok
+	}
+
+	// txNames returns the names of the txs, for readable assertions.
+	txNames := func(txs [][]byte) []string {
+		names := make([]string, len(txs))
+		for i, tx := range txs {
+			names[i] = string(tx)
+			if blobTx, isBlobTx := blob.UnmarshalBlobTx(tx); isBlobTx {
+				names[i] = string(blobTx.Tx)
+			}
+		}
+		return names
+	}
+
+	type test struct {
+		name     string
+		txs      [][]byte
+		expected [][]byte
+	}
+	tests := []test{
+		{
+			name:     "higher fees per share are included first",
+			txs:      [][]byte{txA, txB, txC},
+			expected: [][]byte{txB, txC},
+		},
+		{
+			name: "txs of a same signer stay in order without gaps",
+			txs:  [][]byte{txS1, txC, txS2},
+			// txS2 pays the most but can't be included without txS1
+			expected: [][]byte{txS1, txC},
+		},
+		{
+			name: "txs without info are included first",
+			// the greedy builder would include more fees with txA and txB
+			txs:      [][]byte{txA, txB, pinned},
+			expected: [][]byte{pinned, txB},
+		},
+		{
+			name:     "the extended commit tx stays at index 0",
+			txs:      [][]byte{extCommitTx, txA, txB, txC},
+			expected: [][]byte{extCommitTx, txB, txC},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataSquare, txs, err := square.BuildFeeMaximizing(tt.txs, appconsts.LatestVersion, maxSquareSize, txInfo)
+			require.NoError(t, err)
+			require.Equal(t, txNames(tt.expected), txNames(txs))
+
+			// the square is deterministic and reconstructed from the txs
+			again, againTxs, err := square.BuildFeeMaximizing(tt.txs, appconsts.LatestVersion, maxSquareSize, txInfo)
+			require.NoError(t, err)
+			require.Equal(t, txs, againTxs)
+			require.True(t, dataSquare.Equals(again))
+
+			constructed, err := square.Construct(txs, appconsts.LatestVersion, maxSquareSize)
+			require.NoError(t, err)
+			require.True(t, dataSquare.Equals(constructed))
+		})
+	}
+
+	t.Run("txs without info are never left out", func(t *testing.T) {
+		// the extended commit tx doesn't fit in the square, so the packed
+		// square is discarded for the one of the greedy builder, which
+		// includes less fees
+		largeExtCommitTx, err := da.MarshalExtendedCommitTx(bytes.Repeat([]byte{1}, maxSquareSize*maxSquareSize*appconsts.ShareSize))
+		require.NoError(t, err)
+		txs := [][]byte{largeExtCommitTx, txA, txB, txC}
+		greedySquare, greedyTxs, err := square.Build(txs, appconsts.LatestVersion, maxSquareSize)
+		require.NoError(t, err)
+		require.Equal(t, txNames([][]byte{txA, txB}), txNames(greedyTxs))
+		dataSquare, packedTxs, err := square.BuildFeeMaximizing(txs, appconsts.LatestVersion, maxSquareSize, txInfo)
+		require.NoError(t, err)
+		require.Equal(t, txNames(greedyTxs), txNames(packedTxs))
+		require.True(t, greedySquare.Equals(dataSquare))
+	})
+
+	t.Run("greedy builder is the fallback", func(t *testing.T) {
+		txs := make([][]byte, 0, 5)
+		for i := 0; i < cap(txs); i++ {
+			tx := testBlobTx(t, fmt.Sprintf("equal%d", i), 3)
+			infos[string(tx)] = square.TxInfo{Fee: 100, Signer: fmt.Sprint(i)}
+			txs = append(txs, tx)
+		}
+		greedySquare, greedyTxs, err := square.Build(txs, appconsts.LatestVersion, maxSquareSize)
+		require.NoError(t, err)
+		dataSquare, packedTxs, err := square.BuildFeeMaximizing(txs, appconsts.LatestVersion, maxSquareSize, txInfo)
+		require.NoError(t, err)
+		require.Equal(t, txNames(greedyTxs), txNames(packedTxs))
+		require.True(t, greedySquare.Equals(dataSquare))
+	})
+}
@@ -62,7 +62,9 @@ func Construct(txs [][]byte, appVersion uint64, maxSquareSize int) (Square, erro
 //
 // This method uses the wrapped pfbs in the PFB namespace to identify and
 // decode the blobs. Data that may be included in the square but isn't
-// recognised by the square construction algorithm will be ignored
+// recognised by the square construction algorithm will be ignored. The data
+// root and the square size carried by the block aren't part of the square; see
+// DeconstructBlock.
 func Deconstruct(s Square, decoder types.TxDecoder) (core.Txs, error) {
 	if s.IsEmpty() {
 		return []core.Tx{}, nil
@@ -119,21 +121,38 @@ func Deconstruct(s Square, decoder types.TxDecoder) (core.Txs, error) {
 		if err != nil {
 			return nil, err
 		}
+		// the blobs of the PFBs are grouped by message, in the order of the
+		// messages, so the share indexes follow the blob sizes of the PFBs.
 		pfbMsgs := pfbTx.GetMsgs()
-		if len(pfbMsgs) != 1 {
-			return nil, fmt.Errorf("expected PFB to have 1 message, but got %d", len(pfbMsgs))
+		if len(pfbMsgs) == 0 {
+			return nil, fmt.Errorf("expected PFB to have at least 1 message")
 		}
-		pfb, isPfb := pfbMsgs[0].(*blobtypes.MsgPayForBlobs)
-		if !isPfb {
-			return nil, fmt.Errorf("expected PFB message, but got %T", pfbMsgs[0])
+		var (
+			blobSizes     []uint32
+			shareVersions []uint32
+			msgIndexes    []uint32
+		)
+		for j, msg := range pfbMsgs {
+			pfb, isPfb := blobtypes.UnwrapPFB(msg)
+			if !isPfb {
+				return nil, fmt.Errorf("expected PFB message, but got %T", msg)
+			}
+			blobSizes = append(blobSizes, pfb.BlobSizes...)
+			shareVersions = append(shareVersions, pfb.ShareVersions...)
+			for range pfb.BlobSizes {
+				msgIndexes = append(msgIndexes, uint32(j))
+			}
 		}
-		if len(pfb.BlobSizes) != len(wpfb.ShareIndexes) {
-			return nil, fmt.Errorf("expected PFB to have %d blob sizes, but got %d", len(wpfb.ShareIndexes), len(pfb.BlobSizes))
+		if len(blobSizes) != len(wpfb.ShareIndexes) {
+			return nil, fmt.Errorf("expected PFB to have %d blob sizes, but got %d", len(wpfb.ShareIndexes), len(blobSizes))
+		}
+		if len(shareVersions) != len(blobSizes) {
+			return nil, fmt.Errorf("expected PFB to have %d share versions, but got %d", len(blobSizes), len(shareVersions))
 		}
 
 		blobs := make([]*blob.Blob, len(wpfb.ShareIndexes))
 		for j, shareIndex := range wpfb.ShareIndexes {
-			end := int(shareIndex) + shares.SparseSharesNeeded(pfb.BlobSizes[j])
+			end := int(shareIndex) + shares.SparseSharesNeeded(blobSizes[j], uint8(shareVersions[j]) == appconsts.ShareVersionOne)
 			parsedBlobs, err := shares.ParseBlobs(s[shareIndex:end])
 			if err != nil {
 				return nil, err
@@ -145,7 +164,12 @@ func Deconstruct(s Square, decoder types.TxDecoder) (core.Txs, error) {
 			blobs[j] = parsedBlobs[0]
 		}
 
-		tx, err := blob.MarshalBlobTx(wpfb.Tx, blobs...)
+		var tx []byte
+		if len(pfbMsgs) == 1 {
+			tx, err = blob.MarshalBlobTx(wpfb.Tx, blobs...)
+		} else {
+			tx, err = blob.MarshalBlobTxWithIndexes(wpfb.Tx, blobs, msgIndexes)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -155,6 +179,32 @@ func Deconstruct(s Square, decoder types.TxDecoder) (core.Txs, error) {
 	return txs, nil
 }
 
+// DeconstructBlock takes a square and returns the ordered list of transactions
+// of the block that constructed that square, i.e. the transactions returned by
+// Deconstruct followed by the data root and the square size of the square, as
+// appended by AppendBlockInfo for the app version.
+func DeconstructBlock(s Square, decoder types.TxDecoder, appVersion uint64) (core.Txs, error) {
+	txs, err := Deconstruct(s, decoder)
+	if err != nil {
+		return nil, err
+	}
+
+	eds, err := da.ExtendShares(shares.ToBytes(s))
+	if err != nil {
+		return nil, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTxs, err := AppendBlockInfo(txs.ToSliceOfBytes(), dah.Hash(), uint64(s.Size()), appVersion)
+	if err != nil {
+		return nil, err
+	}
+	return core.ToTxs(blockTxs), nil
+}
+
 // TxShareRange returns the range of share indexes that the tx, specified by txIndex, occupies.
 // The range is end exclusive.
 func TxShareRange(txs [][]byte, txIndex int, appVersion uint64) (shares.Range, error) {
@@ -217,6 +267,23 @@ func (s Square) Equals(other Square) bool {
 	return true
 }
 
+// PaddingShares returns the number of padding shares of the square: the
+// namespace padding shares between the blobs, the reserved padding shares and
+// the tail padding shares.
+func (s Square) PaddingShares() (int, error) {
+	count := 0
+	for i := range s {
+		isPadding, err := s[i].IsPadding()
+		if err != nil {
+			return 0, err
+		}
+		if isPadding {
+			count++
+		}
+	}
+	return count, nil
+}
+
 // WrappedPFBs returns the wrapped PFBs in a square
 func (s Square) WrappedPFBs() (core.Txs, error) {
 	wpfbShareRange, err := shares.GetShareRangeForNamespace(s, namespace.PayForBlobNamespace)
@@ -80,7 +80,7 @@ func FuzzSquare(f *testing.F) {
 			require.True(t, ok)
 
 			for blobIndex, shareIndex := range wpfb.ShareIndexes {
-				commitment, err := inclusion.GetCommitment(cacher, dah, int(shareIndex), shares.SparseSharesNeeded(pfb.BlobSizes[blobIndex]), appconsts.DefaultSubtreeRootThreshold)
+				commitment, err := inclusion.GetCommitment(cacher, dah, int(shareIndex), shares.SparseSharesNeeded(pfb.BlobSizes[blobIndex], uint8(pfb.ShareVersions[blobIndex]) == appconsts.ShareVersionOne), appconsts.DefaultSubtreeRootThreshold)
 				require.NoError(t, err)
 				require.Equal(t, pfb.ShareCommitments[blobIndex], commitment)
 			}
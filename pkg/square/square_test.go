@@ -22,6 +22,7 @@ import (
 
 	"github.com/celestiaorg/rsmt2d"
 	coretypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -222,6 +223,65 @@ func TestSquareDeconstruct(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, txs, recomputedTxs.ToSliceOfBytes())
 	})
+	t.Run("MultiplePFBs", func(t *testing.T) {
+		blobtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+		var (
+			msgs       []sdk.Msg
+			blobs      []*blob.Blob
+			msgIndexes []uint32
+		)
+		for i := 0; i < 3; i++ {
+			msgBlobs := blobfactory.ManyRandBlobs(rand, 100, 2000)
+			pfb, err := blobtypes.NewMsgPayForBlobs(sdk.AccAddress(fmt.Sprintf("signer%d", i)).String(), msgBlobs...)
+			require.NoError(t, err)
+			msgs = append(msgs, pfb)
+			blobs = append(blobs, msgBlobs...)
+			for range msgBlobs {
+				msgIndexes = append(msgIndexes, uint32(i))
+			}
+		}
+		builder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		rawTx, err := encCfg.TxConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		blobTx, err := blob.MarshalBlobTxWithIndexes(rawTx, blobs, msgIndexes)
+		require.NoError(t, err)
+
+		txs := [][]byte{blobTx}
+		dataSquare, err := square.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+		require.NoError(t, err)
+		recomputedTxs, err := square.Deconstruct(dataSquare, encCfg.TxConfig.TxDecoder())
+		require.NoError(t, err)
+		require.Equal(t, txs, recomputedTxs.ToSliceOfBytes())
+
+		// the wrapped PFB has a share index for each blob of all the PFBs
+		wpfbs, err := dataSquare.WrappedPFBs()
+		require.NoError(t, err)
+		require.Len(t, wpfbs, 1)
+		wpfb, isWpfb := coretypes.UnmarshalIndexWrapper(wpfbs[0])
+		require.True(t, isWpfb)
+		require.Len(t, wpfb.ShareIndexes, len(blobs))
+	})
+	t.Run("ShareVersionOne", func(t *testing.T) {
+		blobtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+		signer := sdk.AccAddress(tmrand.Bytes(appconsts.SignerSize))
+		b := blob.NewV1(appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize)), tmrand.Bytes(2000), signer)
+		pfb, err := blobtypes.NewMsgPayForBlobs(signer.String(), b)
+		require.NoError(t, err)
+		builder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(pfb))
+		rawTx, err := encCfg.TxConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		blobTx, err := blob.MarshalBlobTx(rawTx, b)
+		require.NoError(t, err)
+
+		txs := [][]byte{blobTx}
+		dataSquare, err := square.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+		require.NoError(t, err)
+		recomputedTxs, err := square.Deconstruct(dataSquare, encCfg.TxConfig.TxDecoder())
+		require.NoError(t, err)
+		require.Equal(t, txs, recomputedTxs.ToSliceOfBytes())
+	})
 	t.Run("EmptySquare", func(t *testing.T) {
 		tx, err := square.Deconstruct(square.EmptySquare(), encCfg.TxConfig.TxDecoder())
 		require.NoError(t, err)
@@ -258,7 +318,7 @@ func TestSquareShareCommitments(t *testing.T) {
 		require.True(t, ok)
 
 		for blobIndex, shareIndex := range wpfb.ShareIndexes {
-			commitment, err := inclusion.GetCommitment(cacher, dah, int(shareIndex), shares.SparseSharesNeeded(pfb.BlobSizes[blobIndex]), appconsts.DefaultSubtreeRootThreshold)
+			commitment, err := inclusion.GetCommitment(cacher, dah, int(shareIndex), shares.SparseSharesNeeded(pfb.BlobSizes[blobIndex], uint8(pfb.ShareVersions[blobIndex]) == appconsts.ShareVersionOne), appconsts.DefaultSubtreeRootThreshold)
 			require.NoError(t, err)
 			require.Equal(t, pfb.ShareCommitments[blobIndex], commitment)
 		}
@@ -347,10 +347,9 @@ func (s *Signer) createSignature(builder client.TxBuilder, sequence uint64) ([]b
 		PubKey:        s.pk,
 	}
 
-	sdktypes.NewContext()
-
-	bytesToSign, err := s.enc.SignModeHandler().GetSignBytes(
-		ctx,
+	bytesToSign, err := authsigning.GetSignBytesAdapter(
+		context.Background(),
+		s.enc.SignModeHandler(),
 		signing.SignMode_SIGN_MODE_DIRECT,
 		signerData,
 		builder.GetTx(),
@@ -86,8 +86,129 @@ func (m *DataAvailabilityHeader) GetColumnRoots() [][]byte {
 	return nil
 }
 
+// BlockInfo carries the data root and the square size of a block, from the
+// proposer to the validators and the tools decoding the block data, as the
+// last transaction of the block. type_id identifies it among the transactions.
+type BlockInfo struct {
+	DataRoot   []byte `protobuf:"bytes,1,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	SquareSize uint64 `protobuf:"varint,2,opt,name=square_size,json=squareSize,proto3" json:"square_size,omitempty"`
+	TypeId     string `protobuf:"bytes,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+}
+
+func (m *BlockInfo) Reset()         { *m = BlockInfo{} }
+func (m *BlockInfo) String() string { return proto.CompactTextString(m) }
+func (*BlockInfo) ProtoMessage()    {}
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_261481137f193019, []int{1}
+}
+func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockInfo.Merge(m, src)
+}
+func (m *BlockInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockInfo proto.InternalMessageInfo
+
+func (m *BlockInfo) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func (m *BlockInfo) GetSquareSize() uint64 {
+	if m != nil {
+		return m.SquareSize
+	}
+	return 0
+}
+
+func (m *BlockInfo) GetTypeId() string {
+	if m != nil {
+		return m.TypeId
+	}
+	return ""
+}
+
+// ExtendedCommit carries the extended commit info of the previous height,
+// holding the vote extensions of the validators, from the proposer to the
+// validators as the first transaction of a block. It isn't part of the data
+// square. type_id identifies it among the transactions.
+type ExtendedCommit struct {
+	ExtendedCommitInfo []byte `protobuf:"bytes,1,opt,name=extended_commit_info,json=extendedCommitInfo,proto3" json:"extended_commit_info,omitempty"`
+	TypeId             string `protobuf:"bytes,2,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+}
+
+func (m *ExtendedCommit) Reset()         { *m = ExtendedCommit{} }
+func (m *ExtendedCommit) String() string { return proto.CompactTextString(m) }
+func (*ExtendedCommit) ProtoMessage()    {}
+func (*ExtendedCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_261481137f193019, []int{2}
+}
+func (m *ExtendedCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendedCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendedCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtendedCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendedCommit.Merge(m, src)
+}
+func (m *ExtendedCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendedCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendedCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendedCommit proto.InternalMessageInfo
+
+func (m *ExtendedCommit) GetExtendedCommitInfo() []byte {
+	if m != nil {
+		return m.ExtendedCommitInfo
+	}
+	return nil
+}
+
+func (m *ExtendedCommit) GetTypeId() string {
+	if m != nil {
+		return m.TypeId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DataAvailabilityHeader)(nil), "sunrise.core.v1.da.DataAvailabilityHeader")
+	proto.RegisterType((*BlockInfo)(nil), "sunrise.core.v1.da.BlockInfo")
+	proto.RegisterType((*ExtendedCommit)(nil), "sunrise.core.v1.da.ExtendedCommit")
 }
 
 func init() {
@@ -95,21 +216,28 @@ func init() {
 }
 
 var fileDescriptor_261481137f193019 = []byte{
-	// 213 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x2c, 0x2e, 0xcd, 0x2b,
-	0xca, 0x2c, 0x4e, 0xd5, 0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x49, 0xd4, 0x4f,
-	0x49, 0x2c, 0x49, 0x8c, 0x4f, 0x2c, 0x4b, 0xcc, 0xcc, 0x49, 0x4c, 0xca, 0xcc, 0xc9, 0x2c, 0xa9,
-	0x8c, 0xcf, 0x48, 0x4d, 0x4c, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82,
-	0x6a, 0xd1, 0x03, 0x69, 0xd1, 0x2b, 0x33, 0xd4, 0x4b, 0x49, 0x54, 0x8a, 0xe0, 0x12, 0x73, 0x49,
-	0x2c, 0x49, 0x74, 0x44, 0xd2, 0xe4, 0x01, 0xd6, 0x23, 0x24, 0xcd, 0xc5, 0x59, 0x94, 0x5f, 0x1e,
-	0x5f, 0x94, 0x9f, 0x5f, 0x52, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x13, 0xc4, 0x51, 0x94, 0x5f,
-	0x1e, 0x04, 0xe2, 0x0b, 0x29, 0x72, 0xf1, 0x24, 0xe7, 0xe7, 0x94, 0xe6, 0xe6, 0x41, 0xe5, 0x99,
-	0xc0, 0xf2, 0xdc, 0x10, 0x31, 0xb0, 0x12, 0xa7, 0xb0, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0x88, 0xb2, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87,
-	0x3a, 0x49, 0xb7, 0x2a, 0x3f, 0x2f, 0x15, 0xce, 0x49, 0x2c, 0x28, 0xd0, 0x07, 0x3b, 0x59, 0x1f,
-	0xd3, 0x93, 0x49, 0x6c, 0x60, 0x19, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xde, 0x15, 0xa4,
-	0x14, 0x01, 0x01, 0x00, 0x00,
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xbd, 0x4e, 0xeb, 0x30,
+	0x14, 0xc7, 0xeb, 0xf6, 0xaa, 0xf7, 0xd6, 0xad, 0xee, 0x60, 0x21, 0x88, 0x84, 0x14, 0x42, 0xa7,
+	0x2e, 0xc4, 0x54, 0xac, 0x2c, 0x14, 0x90, 0xe8, 0x1a, 0x24, 0x84, 0x60, 0xb0, 0x9c, 0xd8, 0xa5,
+	0x16, 0x49, 0x4e, 0x70, 0x9c, 0x96, 0xf6, 0x29, 0x78, 0x2c, 0xc6, 0x8e, 0x8c, 0xa8, 0x7d, 0x11,
+	0x64, 0x37, 0x42, 0x45, 0x8c, 0xe7, 0xf7, 0xff, 0xf0, 0xd1, 0x31, 0x1e, 0x96, 0x55, 0xae, 0x55,
+	0x29, 0x69, 0x02, 0x5a, 0xd2, 0xd9, 0x90, 0x0a, 0x4e, 0x05, 0x37, 0x9c, 0xf1, 0x19, 0x57, 0x29,
+	0x8f, 0x55, 0xaa, 0xcc, 0x82, 0x4d, 0x25, 0x17, 0x52, 0x87, 0x85, 0x06, 0x03, 0x84, 0xd4, 0x91,
+	0xd0, 0x46, 0xc2, 0xd9, 0x30, 0x14, 0xbc, 0x7f, 0x8f, 0xf7, 0xaf, 0xb8, 0xe1, 0x17, 0x3b, 0xa1,
+	0x1b, 0x97, 0x21, 0x87, 0xb8, 0xa3, 0x61, 0xce, 0x34, 0x80, 0x29, 0x3d, 0x14, 0xb4, 0x06, 0xbd,
+	0xe8, 0x9f, 0x86, 0x79, 0x64, 0x67, 0x72, 0x8c, 0x7b, 0x09, 0xa4, 0x55, 0x96, 0xd7, 0x7a, 0xd3,
+	0xe9, 0xdd, 0x2d, 0x73, 0x96, 0x7e, 0x8c, 0x3b, 0xa3, 0x14, 0x92, 0xe7, 0x71, 0x3e, 0x01, 0x5b,
+	0xe6, 0x96, 0xb3, 0x6e, 0x0f, 0x05, 0xc8, 0x96, 0x59, 0x60, 0xad, 0xe4, 0x08, 0x77, 0xcb, 0x97,
+	0x8a, 0x6b, 0xc9, 0x4a, 0xb5, 0x94, 0x5e, 0x33, 0x40, 0x83, 0x3f, 0x11, 0xde, 0xa2, 0x5b, 0xb5,
+	0x94, 0xe4, 0x00, 0xff, 0x35, 0x8b, 0x42, 0x32, 0x25, 0xbc, 0x56, 0x80, 0x06, 0x9d, 0xa8, 0x6d,
+	0xc7, 0xb1, 0xe8, 0x3f, 0xe2, 0xff, 0xd7, 0xaf, 0x46, 0xe6, 0x42, 0x8a, 0x4b, 0xc8, 0x32, 0x65,
+	0xc8, 0x29, 0xde, 0x93, 0x35, 0x61, 0x89, 0x43, 0x4c, 0xe5, 0x13, 0xa8, 0xdf, 0x24, 0xf2, 0x87,
+	0xdb, 0xad, 0xb6, 0x53, 0xde, 0xdc, 0x2d, 0x1f, 0xdd, 0xbd, 0xaf, 0x7d, 0xb4, 0x5a, 0xfb, 0xe8,
+	0x73, 0xed, 0xa3, 0xb7, 0x8d, 0xdf, 0x58, 0x6d, 0xfc, 0xc6, 0xc7, 0xc6, 0x6f, 0x3c, 0x9c, 0x3f,
+	0x29, 0x33, 0xad, 0xe2, 0x30, 0x81, 0x8c, 0xd6, 0x37, 0x3d, 0x59, 0x42, 0x2e, 0xbf, 0x07, 0x5e,
+	0x14, 0xd4, 0xdd, 0x9c, 0xfe, 0xfe, 0xa5, 0xb8, 0xed, 0x94, 0xb3, 0xaf, 0x01, 0x00, 0x5e, 0x12,
+	0xfa, 0x52, 0xc2, 0x01, 0x00, 0x00,
 }
 
 func (m *DataAvailabilityHeader) Marshal() (dAtA []byte, err error) {
@@ -153,6 +281,85 @@ func (m *DataAvailabilityHeader) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TypeId) > 0 {
+		i -= len(m.TypeId)
+		copy(dAtA[i:], m.TypeId)
+		i = encodeVarintDataAvailabilityHeader(dAtA, i, uint64(len(m.TypeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SquareSize != 0 {
+		i = encodeVarintDataAvailabilityHeader(dAtA, i, uint64(m.SquareSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintDataAvailabilityHeader(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtendedCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtendedCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtendedCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TypeId) > 0 {
+		i -= len(m.TypeId)
+		copy(dAtA[i:], m.TypeId)
+		i = encodeVarintDataAvailabilityHeader(dAtA, i, uint64(len(m.TypeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExtendedCommitInfo) > 0 {
+		i -= len(m.ExtendedCommitInfo)
+		copy(dAtA[i:], m.ExtendedCommitInfo)
+		i = encodeVarintDataAvailabilityHeader(dAtA, i, uint64(len(m.ExtendedCommitInfo)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDataAvailabilityHeader(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDataAvailabilityHeader(v)
 	base := offset
@@ -185,6 +392,43 @@ func (m *DataAvailabilityHeader) Size() (n int) {
 	return n
 }
 
+func (m *BlockInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovDataAvailabilityHeader(uint64(l))
+	}
+	if m.SquareSize != 0 {
+		n += 1 + sovDataAvailabilityHeader(uint64(m.SquareSize))
+	}
+	l = len(m.TypeId)
+	if l > 0 {
+		n += 1 + l + sovDataAvailabilityHeader(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtendedCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExtendedCommitInfo)
+	if l > 0 {
+		n += 1 + l + sovDataAvailabilityHeader(uint64(l))
+	}
+	l = len(m.TypeId)
+	if l > 0 {
+		n += 1 + l + sovDataAvailabilityHeader(uint64(l))
+	}
+	return n
+}
+
 func sovDataAvailabilityHeader(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -305,6 +549,257 @@ func (m *DataAvailabilityHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlockInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataAvailabilityHeader
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataAvailabilityHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDataAvailabilityHeader
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataAvailabilityHeader
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSize", wireType)
+			}
+			m.SquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataAvailabilityHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SquareSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataAvailabilityHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataAvailabilityHeader
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataAvailabilityHeader
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataAvailabilityHeader(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataAvailabilityHeader
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtendedCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataAvailabilityHeader
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendedCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendedCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedCommitInfo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataAvailabilityHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDataAvailabilityHeader
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataAvailabilityHeader
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedCommitInfo = append(m.ExtendedCommitInfo[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtendedCommitInfo == nil {
+				m.ExtendedCommitInfo = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataAvailabilityHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataAvailabilityHeader
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataAvailabilityHeader
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataAvailabilityHeader(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataAvailabilityHeader
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDataAvailabilityHeader(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
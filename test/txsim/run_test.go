@@ -124,7 +124,7 @@ func TestTxSimulator(t *testing.T) {
 			blocks, err := testnode.ReadBlockchain(context.Background(), rpcAddr)
 			require.NoError(t, err)
 			for _, block := range blocks {
-				msgs, err := testnode.DecodeBlockData(block.Data)
+				msgs, err := testnode.DecodeBlockData(block.Data, block.Version.App)
 				require.NoError(t, err, block.Height)
 				for _, msg := range msgs {
 					if _, ok := tc.expMessages[sdk.MsgTypeURL(msg)]; ok {
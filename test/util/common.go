@@ -2,6 +2,7 @@ package util
 
 import (
 	"bytes"
+	"context"
 	"testing"
 	"time"
 
@@ -20,11 +21,13 @@ import (
 	tmversion "github.com/cometbft/cometbft/proto/tendermint/version"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	ccodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	ccrypto "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/std"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -37,7 +40,6 @@ import (
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
-	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -207,14 +209,11 @@ func CreateTestEnvWithoutBlobstreamKeysInit(t *testing.T) TestInput {
 
 	cdc := MakeTestCodec()
 	marshaler := MakeTestMarshaler()
-
-	paramsKeeper := paramskeeper.NewKeeper(marshaler, cdc, keyParams, tkeyParams)
-	paramsKeeper.Subspace(authtypes.ModuleName)
-	paramsKeeper.Subspace(banktypes.ModuleName)
-	paramsKeeper.Subspace(stakingtypes.ModuleName)
-	paramsKeeper.Subspace(distrtypes.ModuleName)
-	paramsKeeper.Subspace(bstypes.DefaultParamspace)
-	paramsKeeper.Subspace(slashingtypes.ModuleName)
+	authority := authtypes.NewModuleAddress("gov").String()
+	bech32Config := sdk.GetConfig()
+	addrCodec := addresscodec.NewBech32Codec(bech32Config.GetBech32AccountAddrPrefix())
+	valAddrCodec := addresscodec.NewBech32Codec(bech32Config.GetBech32ValidatorAddrPrefix())
+	consAddrCodec := addresscodec.NewBech32Codec(bech32Config.GetBech32ConsensusAddrPrefix())
 
 	// this is also used to initialize module accounts for all the map keys
 	maccPerms := map[string][]string{
@@ -227,11 +226,12 @@ func CreateTestEnvWithoutBlobstreamKeysInit(t *testing.T) TestInput {
 
 	accountKeeper := authkeeper.NewAccountKeeper(
 		marshaler,
-		keyAcc, // target store
-		getSubspace(paramsKeeper, authtypes.ModuleName),
+		runtime.NewKVStoreService(keyAcc),
 		authtypes.ProtoBaseAccount, // prototype
 		maccPerms,
-		app.AccountAddressPrefix,
+		addrCodec,
+		bech32Config.GetBech32AccountAddrPrefix(),
+		authority,
 	)
 
 	blockedAddr := make(map[string]bool, len(maccPerms))
@@ -240,48 +240,54 @@ func CreateTestEnvWithoutBlobstreamKeysInit(t *testing.T) TestInput {
 	}
 	bankKeeper := bankkeeper.NewBaseKeeper(
 		marshaler,
-		keyBank,
+		runtime.NewKVStoreService(keyBank),
 		accountKeeper,
-		getSubspace(paramsKeeper, banktypes.ModuleName),
 		blockedAddr,
+		authority,
+		log.NewNopLogger(),
 	)
-	bankKeeper.SetParams(
+	err = bankKeeper.SetParams(
 		ctx,
 		banktypes.Params{
 			SendEnabled:        []*banktypes.SendEnabled{},
 			DefaultSendEnabled: true,
 		},
 	)
+	require.NoError(t, err)
 
-	stakingKeeper := stakingkeeper.NewKeeper(marshaler, keyStaking, accountKeeper, bankKeeper, getSubspace(paramsKeeper, stakingtypes.ModuleName))
-	stakingKeeper.SetParams(ctx, TestingStakeParams)
+	// set up module accounts before the keepers that check for them
+	for name, perms := range maccPerms {
+		mod := accountKeeper.NewAccount(ctx, authtypes.NewEmptyModuleAccount(name, perms...)).(sdk.ModuleAccountI)
+		accountKeeper.SetModuleAccount(ctx, mod)
+	}
 
-	distKeeper := distrkeeper.NewKeeper(marshaler, keyDistro, getSubspace(paramsKeeper, distrtypes.ModuleName), accountKeeper, bankKeeper, stakingKeeper, authtypes.FeeCollectorName)
-	distKeeper.Params.Set(ctx, distrtypes.DefaultParams())
+	stakingKeeper := stakingkeeper.NewKeeper(marshaler, runtime.NewKVStoreService(keyStaking), accountKeeper, bankKeeper, authority, valAddrCodec, consAddrCodec)
+	require.NoError(t, stakingKeeper.SetParams(ctx, TestingStakeParams))
+
+	distKeeper := distrkeeper.NewKeeper(marshaler, runtime.NewKVStoreService(keyDistro), accountKeeper, bankKeeper, stakingKeeper, authtypes.FeeCollectorName, authority)
+	require.NoError(t, distKeeper.Params.Set(ctx, distrtypes.DefaultParams()))
 
 	// set genesis items required for distribution
-	distKeeper.FeePool.Set(ctx, distrtypes.InitialFeePool())
+	require.NoError(t, distKeeper.FeePool.Set(ctx, distrtypes.InitialFeePool()))
 
 	// total supply to track this
 	totalSupply := sdk.NewCoins(sdk.NewInt64Coin("stake", 100000000))
 
 	// set up initial accounts
-	for name, perms := range maccPerms {
-		mod := authtypes.NewEmptyModuleAccount(name, perms...)
+	for name := range maccPerms {
 		if name == stakingtypes.NotBondedPoolName {
 			err = bankKeeper.MintCoins(ctx, bstypes.ModuleName, totalSupply)
 			require.NoError(t, err)
-			err = bankKeeper.SendCoinsFromModuleToModule(ctx, bstypes.ModuleName, mod.Name, totalSupply)
+			err = bankKeeper.SendCoinsFromModuleToModule(ctx, bstypes.ModuleName, name, totalSupply)
 			require.NoError(t, err)
 		} else if name == distrtypes.ModuleName {
 			// some big pot to pay out
 			amt := sdk.NewCoins(sdk.NewInt64Coin("stake", 500000))
 			err = bankKeeper.MintCoins(ctx, bstypes.ModuleName, amt)
 			require.NoError(t, err)
-			err = bankKeeper.SendCoinsFromModuleToModule(ctx, bstypes.ModuleName, mod.Name, amt)
+			err = bankKeeper.SendCoinsFromModuleToModule(ctx, bstypes.ModuleName, name, amt)
 			require.NoError(t, err)
 		}
-		accountKeeper.SetModuleAccount(ctx, mod)
 	}
 
 	stakeAddr := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
@@ -290,12 +296,13 @@ func CreateTestEnvWithoutBlobstreamKeysInit(t *testing.T) TestInput {
 
 	slashingKeeper := slashingkeeper.NewKeeper(
 		marshaler,
-		keySlashing,
-		&stakingKeeper,
-		getSubspace(paramsKeeper, slashingtypes.ModuleName).WithKeyTable(slashingtypes.ParamKeyTable()),
+		cdc,
+		runtime.NewKVStoreService(keySlashing),
+		stakingKeeper,
+		authority,
 	)
 
-	k := keeper.NewKeeper(marshaler, bsKey, getSubspace(paramsKeeper, bstypes.DefaultParamspace), &stakingKeeper)
+	k := keeper.NewKeeper(marshaler, runtime.NewKVStoreService(bsKey), log.NewNopLogger(), authority, stakingKeeper)
 	testBlobstreamParams := bstypes.DefaultGenesis().Params
 	k.SetParams(ctx, testBlobstreamParams)
 
@@ -303,7 +310,7 @@ func CreateTestEnvWithoutBlobstreamKeysInit(t *testing.T) TestInput {
 		stakingtypes.NewMultiStakingHooks(
 			distKeeper.Hooks(),
 			slashingKeeper.Hooks(),
-			k.Hooks(),
+			blobstreamHooks{k.Hooks()},
 		),
 	)
 	return TestInput{
@@ -340,17 +347,15 @@ func MakeTestCodec() *codec.LegacyAmino {
 	return cdc
 }
 
-// getSubspace returns a param subspace for a given module name.
-func getSubspace(k paramskeeper.Keeper, moduleName string) paramstypes.Subspace {
-	subspace, _ := k.GetSubspace(moduleName)
-	return subspace
-}
-
 // MakeTestMarshaler creates a proto codec for use in testing
 func MakeTestMarshaler() codec.Codec {
 	interfaceRegistry := codectypes.NewInterfaceRegistry()
 	std.RegisterInterfaces(interfaceRegistry)
 	ModuleBasics.RegisterInterfaces(interfaceRegistry)
+	authtypes.RegisterInterfaces(interfaceRegistry)
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	stakingtypes.RegisterInterfaces(interfaceRegistry)
+	distrtypes.RegisterInterfaces(interfaceRegistry)
 	bstypes.RegisterInterfaces(interfaceRegistry)
 	return codec.NewProtoCodec(interfaceRegistry)
 }
@@ -428,7 +433,7 @@ func NewTestMsgCreateValidator(
 	out, err := stakingtypes.NewMsgCreateValidator(
 		address.String(), pubKey, sdk.NewCoin("stake", amt),
 		stakingtypes.Description{
-			Moniker:         "",
+			Moniker:         "test",
 			Identity:        "",
 			Website:         "",
 			SecurityContact: "",
@@ -528,3 +533,52 @@ func ExecuteBlobstreamHeightsWithTime(ctx sdk.Context, bsKeeper keeper.Keeper, b
 	}
 	return ctx
 }
+
+// blobstreamHooks adapts the Blobstream hooks to the staking hooks interface.
+type blobstreamHooks struct{ h keeper.Hooks }
+
+var _ stakingtypes.StakingHooks = blobstreamHooks{}
+
+func (b blobstreamHooks) AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress) error {
+	return b.h.AfterValidatorCreated(sdk.UnwrapSDKContext(ctx), valAddr)
+}
+
+func (b blobstreamHooks) BeforeValidatorModified(ctx context.Context, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (b blobstreamHooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (b blobstreamHooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (b blobstreamHooks) AfterValidatorBeginUnbonding(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return b.h.AfterValidatorBeginUnbonding(sdk.UnwrapSDKContext(ctx), consAddr, valAddr)
+}
+
+func (b blobstreamHooks) BeforeDelegationCreated(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (b blobstreamHooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (b blobstreamHooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (b blobstreamHooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+func (b blobstreamHooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction sdkmath.LegacyDec) error {
+	return nil
+}
+
+func (b blobstreamHooks) AfterUnbondingInitiated(ctx context.Context, id uint64) error {
+	return nil
+}
@@ -58,6 +58,20 @@ func SetDataCommitmentWindow(codec codec.Codec, window uint64) Modifier {
 	}
 }
 
+// SetEVMAddresses registers the provided EVM addresses of the validators in the
+// blobstream module's genesis state, keeping the rest of that state.
+func SetEVMAddresses(codec codec.Codec, evmAddresses ...bstypes.EVMAddress) Modifier {
+	return func(state map[string]json.RawMessage) map[string]json.RawMessage {
+		blobstreamGenState := bstypes.DefaultGenesis()
+		if bz, ok := state[bstypes.ModuleName]; ok {
+			codec.MustUnmarshalJSON(bz, blobstreamGenState)
+		}
+		blobstreamGenState.EvmAddresses = append(blobstreamGenState.EvmAddresses, evmAddresses...)
+		state[bstypes.ModuleName] = codec.MustMarshalJSON(blobstreamGenState)
+		return state
+	}
+}
+
 // FundAccounts adds a set of accounts to the genesis and then sets their balance as provided.
 // This is good in the case where you have a separate keyring you want to test against and not
 // use the one generated by the testnet infra.
@@ -90,9 +90,12 @@ func TestMaliciousTestNode(t *testing.T) {
 	block, err := cctx.Client.Block(cctx.GoContext(), &inclusionHeight)
 	require.NoError(t, err)
 
+	txs, dataRoot, _, err := square.ExtractBlockInfo(block.Block.Txs.ToSliceOfBytes(), block.Block.Version.App)
+	require.NoError(t, err)
+
 	// check that we can recalculate the data root using the malicious code but
 	// not the correct code
-	s, err := Construct(block.Block.Txs.ToSliceOfBytes(), appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound, OutOfOrderExport)
+	s, err := Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound, OutOfOrderExport)
 	require.NoError(t, err)
 
 	rawSquare := shares.ToBytes(s)
@@ -101,9 +104,9 @@ func TestMaliciousTestNode(t *testing.T) {
 
 	dah, err := da.NewDataAvailabilityHeader(eds)
 	require.NoError(t, err)
-	require.Equal(t, block.Block.DataHash.Bytes(), dah.Hash())
+	require.Equal(t, dataRoot, dah.Hash())
 
-	correctSquare, err := square.Construct(block.Block.Txs.ToSliceOfBytes(), appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+	correctSquare, err := square.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
 	require.NoError(t, err)
 
 	goodEds, err := da.ExtendShares(shares.ToBytes(correctSquare))
@@ -111,5 +114,5 @@ func TestMaliciousTestNode(t *testing.T) {
 
 	goodDah, err := da.NewDataAvailabilityHeader(goodEds)
 	require.NoError(t, err)
-	require.NotEqual(t, block.Block.DataHash.Bytes(), goodDah.Hash())
+	require.NotEqual(t, dataRoot, goodDah.Hash())
 }
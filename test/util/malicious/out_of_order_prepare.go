@@ -7,6 +7,7 @@ import (
 	"github.com/sunrise-zone/sunrise-app/app/ante"
 	"github.com/sunrise-zone/sunrise-app/pkg/da"
 	"github.com/sunrise-zone/sunrise-app/pkg/shares"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
 )
 
 // OutOfOrderPrepareProposal fulfills the celestia-core version of the ABCI
@@ -61,7 +62,10 @@ func (a *App) OutOfOrderPrepareProposal(req abci.RequestPrepareProposal) (*abci.
 		panic(err)
 	}
 
-	txs = app.AppendInfoInTxs(txs, dah.Hash(), uint64(dataSquare.Size()))
+	txs, err = square.AppendBlockInfo(txs, dah.Hash(), uint64(dataSquare.Size()), a.BaseApp.AppVersion())
+	if err != nil {
+		panic(err)
+	}
 
 	// tendermint doesn't need to use any of the erasure data, as only the
 	// protobuf encoded version of the block data is gossiped.
@@ -3,6 +3,7 @@ package testnode
 import (
 	"time"
 
+	"github.com/sunrise-zone/sunrise-app/app"
 	"github.com/sunrise-zone/sunrise-app/cmd/sunrised/cmd"
 	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
 	"github.com/sunrise-zone/sunrise-app/test/util/genesis"
@@ -11,6 +12,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
 	srvtypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -112,6 +114,15 @@ func (c *Config) WithConsensusParams(params *tmproto.ConsensusParams) *Config {
 	return c
 }
 
+// use the address prefixes of the app, which its modules expect, as the
+// sunrised root command does.
+func init() {
+	cfg := sdk.GetConfig()
+	cfg.SetBech32PrefixForAccount(app.AccountAddressPrefix, app.AccountAddressPrefix+"pub")
+	cfg.SetBech32PrefixForValidator(app.AccountAddressPrefix+"valoper", app.AccountAddressPrefix+"valoperpub")
+	cfg.SetBech32PrefixForConsensusNode(app.AccountAddressPrefix+"valcons", app.AccountAddressPrefix+"valconspub")
+}
+
 // DefaultConfig returns the default configuration of a test node.
 func DefaultConfig() *Config {
 	cfg := &Config{}
@@ -129,10 +140,9 @@ func DefaultConfig() *Config {
 }
 
 func DefaultConsensusParams() *tmproto.ConsensusParams {
-	var cparams tmproto.ConsensusParams
+	cparams := app.DefaultConsensusParams()
 	cparams.Block.MaxBytes = appconsts.DefaultMaxBytes
-	cparams.Version.App = appconsts.LatestVersion
-	return &cparams
+	return cparams
 }
 
 func DefaultTendermintConfig() *tmconfig.Config {
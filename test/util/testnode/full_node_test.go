@@ -10,6 +10,7 @@ import (
 	"github.com/sunrise-zone/sunrise-app/app/encoding"
 	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
 	apprand "github.com/sunrise-zone/sunrise-app/pkg/random"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
 	"github.com/sunrise-zone/sunrise-app/test/util/genesis"
 	blobtypes "github.com/sunrise-zone/sunrise-app/x/blob/types"
 
@@ -92,7 +93,10 @@ func (s *IntegrationTestSuite) TestFillBlock() {
 
 		b, err := s.cctx.Client.Block(s.cctx.GoContext(), &res.Height)
 		require.NoError(err, squareSize)
-		require.Equal(uint64(squareSize), b.Block.SquareSize, squareSize)
+		// the square size is carried by the block info
+		_, _, blockSquareSize, err := square.ExtractBlockInfo(b.Block.Txs.ToSliceOfBytes(), b.Block.Version.App)
+		require.NoError(err, squareSize)
+		require.Equal(uint64(squareSize), blockSquareSize, squareSize)
 	}
 }
 
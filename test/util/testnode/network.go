@@ -6,6 +6,7 @@ import (
 	"net"
 	"testing"
 
+	"github.com/sunrise-zone/sunrise-app/app"
 	"github.com/sunrise-zone/sunrise-app/test/util/genesis"
 
 	"github.com/stretchr/testify/require"
@@ -26,6 +27,12 @@ func NewNetwork(t testing.TB, cfg *Config) (cctx Context, rpcAddr, grpcAddr stri
 	tmCfg.P2P.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", mustGetFreePort())
 	tmCfg.RPC.GRPCListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", mustGetFreePort())
 
+	// the validator queries the data commitments it signs in its vote
+	// extensions from its own RPC.
+	if cfg.AppOptions.Get(app.FlagBlobstreamRPCAddress) == nil {
+		cfg.AppOptions.Set(app.FlagBlobstreamRPCAddress, tmCfg.RPC.ListenAddress)
+	}
+
 	// initialize the genesis file and validator files for the first validator.
 	baseDir, err := genesis.InitFiles(t.TempDir(), tmCfg, cfg.Genesis, 0)
 	require.NoError(t, err)
@@ -45,6 +52,10 @@ func NewNetwork(t testing.TB, cfg *Config) (cctx Context, rpcAddr, grpcAddr stri
 	cctx, cleanupGRPC, err := StartGRPCServer(app, appCfg, cctx)
 	require.NoError(t, err)
 
+	// the queries of the node fail until its first block is committed.
+	_, err = cctx.WaitForHeight(1)
+	require.NoError(t, err)
+
 	t.Cleanup(func() {
 		t.Log("tearing down testnode")
 		err := stopNode()
@@ -7,6 +7,8 @@ import (
 	"github.com/sunrise-zone/sunrise-app/app"
 	"github.com/sunrise-zone/sunrise-app/app/encoding"
 	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	"github.com/sunrise-zone/sunrise-app/pkg/da"
+	"github.com/sunrise-zone/sunrise-app/pkg/square"
 
 	"github.com/cometbft/cometbft/rpc/client/http"
 	"github.com/cometbft/cometbft/types"
@@ -56,11 +58,17 @@ func ReadBlockHeights(ctx context.Context, rpcAddress string, fromHeight, toHeig
 	return blocks, nil
 }
 
-func DecodeBlockData(data types.Data) ([]sdk.Msg, error) {
+// DecodeBlockData returns the messages of the transactions of the block data,
+// leaving out the extended commit transaction and the block info.
+func DecodeBlockData(data types.Data, appVersion uint64) ([]sdk.Msg, error) {
 	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
 	decoder := encCfg.TxConfig.TxDecoder()
+	txs, _, err := squareTxs(data, appVersion)
+	if err != nil {
+		return nil, err
+	}
 	msgs := make([]sdk.Msg, 0)
-	for _, txBytes := range data.Txs {
+	for _, txBytes := range txs {
 		blobTx, isBlobTx := blob.UnmarshalBlobTx(txBytes)
 		if isBlobTx {
 			txBytes = blobTx.Tx
@@ -74,6 +82,20 @@ func DecodeBlockData(data types.Data) ([]sdk.Msg, error) {
 	return msgs, nil
 }
 
+// squareTxs returns the transactions of the block data that are part of its
+// square, along with the index of the first of them in the block.
+func squareTxs(data types.Data, appVersion uint64) ([][]byte, int, error) {
+	txs, _, _, err := square.ExtractBlockInfo(data.Txs.ToSliceOfBytes(), appVersion)
+	if err != nil {
+		return nil, 0, err
+	}
+	offset := 0
+	if len(data.Txs) > 0 && da.IsExtendedCommitTx(data.Txs[0]) {
+		offset = 1
+	}
+	return txs, offset, nil
+}
+
 func CalculateMeanGasFromRecentBlocks(ctx context.Context, rpcAddress, msgType string, blocks int64) (float64, int64, error) {
 	client, err := http.New(rpcAddress, "/websocket")
 	if err != nil {
@@ -112,8 +134,15 @@ func CalculateMeanGas(ctx context.Context, rpcAddress, msgType string, fromHeigh
 		if err != nil {
 			return average(), count, err
 		}
-		indices := make([]int, 0, len(resp.Block.Data.Txs))
-		for i, rawTx := range resp.Block.Data.Txs {
+		txs, offset, err := squareTxs(resp.Block.Data, resp.Block.Version.App)
+		if err != nil {
+			return average(), count, fmt.Errorf("reading block info (height: %d): %w", height, err)
+		}
+		indices := make([]int, 0, len(txs))
+		for i, rawTx := range txs {
+			if blobTx, isBlobTx := blob.UnmarshalBlobTx(rawTx); isBlobTx {
+				rawTx = blobTx.Tx
+			}
 			tx, err := decoder(rawTx)
 			if err != nil {
 				return average(), count, fmt.Errorf("decoding tx (height: %d): %w", height, err)
@@ -121,7 +150,7 @@ func CalculateMeanGas(ctx context.Context, rpcAddress, msgType string, fromHeigh
 			msgs := tx.GetMsgs()
 			// multi message transactions are not included
 			if len(msgs) == 1 && sdk.MsgTypeURL(msgs[0]) == msgType {
-				indices = append(indices, i)
+				indices = append(indices, offset+i)
 			}
 		}
 		if len(indices) > 0 {
@@ -31,6 +31,7 @@ func StartNode(tmNode *node.Node, cctx Context) (Context, func() error, error) {
 	coreClient := local.New(tmNode)
 
 	cctx.Context = cctx.WithClient(coreClient)
+	cctx.RpcClient = coreClient
 	cleanup := func() error {
 		err := tmNode.Stop()
 		if err != nil {
@@ -61,10 +62,14 @@ func StartGRPCServer(app srvtypes.Application, appCfg *srvconfig.Config, cctx Co
 	app.RegisterTendermintService(cctx.Context)
 
 	server, err := srvgrpc.NewGRPCServer(cctx.Context, app, appCfg.GRPC)
-	err = srvgrpc.StartGRPCServer(cctx.rootCtx, log.NewNopLogger(), appCfg.GRPC, server)
 	if err != nil {
 		return Context{}, emptycleanup, err
 	}
+	// the server is served until the root context is canceled or the server
+	// is stopped.
+	go func() {
+		_ = srvgrpc.StartGRPCServer(cctx.rootCtx, log.NewNopLogger(), appCfg.GRPC, server)
+	}()
 
 	nodeGRPCAddr := strings.Replace(appCfg.GRPC.Address, "0.0.0.0", "localhost", 1)
 	conn, err := grpc.Dial(nodeGRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -75,7 +80,7 @@ func StartGRPCServer(app srvtypes.Application, appCfg *srvconfig.Config, cctx Co
 	cctx.Context = cctx.WithGRPCClient(conn)
 
 	return cctx, func() error {
-		// grpcSrv.Stop()
+		server.Stop()
 		return nil
 	}, nil
 }
@@ -21,7 +21,7 @@ import (
 	"github.com/sunrise-zone/sunrise-app/x/blobgrant/types"
 )
 
-func GrantKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+func GrantKeeper(t testing.TB, feegrantKeeper types.FeegrantKeeper) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -38,6 +38,7 @@ func GrantKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
+		feegrantKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
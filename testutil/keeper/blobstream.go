@@ -22,6 +22,13 @@ import (
 )
 
 func StreamKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, ctx, _ := StreamKeeperWithStoreKey(t)
+	return k, ctx
+}
+
+// StreamKeeperWithStoreKey is like StreamKeeper, but also returns the store
+// key of the module, for tests that need to access the store directly.
+func StreamKeeperWithStoreKey(t testing.TB) (keeper.Keeper, sdk.Context, *storetypes.KVStoreKey) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -30,6 +37,7 @@ func StreamKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
 	cdc := codec.NewProtoCodec(registry)
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 
@@ -46,5 +54,5 @@ func StreamKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	// Initialize params
 	k.SetParams(ctx, types.DefaultParams())
 
-	return k, ctx
+	return k, ctx, storeKey
 }
@@ -22,27 +22,29 @@ func NewMinGasPFBDecorator(k BlobKeeper) MinGasPFBDecorator {
 }
 
 // AnteHandle implements the AnteHandler interface. It checks to see
-// if the transaction contains a MsgPayForBlobs and if so, checks that
-// the transaction has allocated enough gas.
+// if the transaction contains MsgPayForBlobs, including those executed by
+// authz MsgExec, and if so, checks that the transaction has allocated enough
+// gas for all of them.
 func (d MinGasPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	if ctx.IsReCheckTx() {
 		return next(ctx, tx, simulate)
 	}
 
-	var gasPerByte uint32
+	// the PFBs executed by authz MsgExec consume their gas like the
+	// top-level ones, so they are accounted for too.
+	pfbs := types.ExtractPFBs(tx.GetMsgs())
+	if len(pfbs) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	gasPerByte := d.k.GasPerBlobByte(ctx)
 	txGas := ctx.GasMeter().GasRemaining()
-	for _, m := range tx.GetMsgs() {
-		// NOTE: here we assume only one PFB per transaction
-		if pfb, ok := m.(*types.MsgPayForBlobs); ok {
-			if gasPerByte == 0 {
-				// lazily fetch the gas per byte param
-				gasPerByte = d.k.GasPerBlobByte(ctx)
-			}
-			gasToConsume := pfb.Gas(gasPerByte)
-			if gasToConsume > txGas {
-				return ctx, errors.Wrapf(sdkerrors.ErrInsufficientFee, "not enough gas to pay for blobs (minimum: %d, got: %d)", gasToConsume, txGas)
-			}
-		}
+	var gasToConsume uint64
+	for _, pfb := range pfbs {
+		gasToConsume += pfb.Gas(gasPerByte)
+	}
+	if gasToConsume > txGas {
+		return ctx, errors.Wrapf(sdkerrors.ErrInsufficientFee, "not enough gas to pay for blobs (minimum: %d, got: %d)", gasToConsume, txGas)
 	}
 
 	return next(ctx, tx, simulate)
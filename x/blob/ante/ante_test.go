@@ -13,6 +13,7 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/stretchr/testify/require"
 )
 
@@ -107,6 +108,41 @@ func TestPFBAnteHandler(t *testing.T) {
 	}
 }
 
+func TestPFBAnteHandlerNestedPFB(t *testing.T) {
+	txConfig := encoding.MakeConfig(util.ModuleBasics).TxConfig
+	// 2 shares = 1024 bytes = 10240 gas
+	pfb := &blob.MsgPayForBlobs{
+		Signer:    sdk.AccAddress("granter").String(),
+		BlobSizes: []uint32{uint32(shares.AvailableBytesFromSparseShares(2))},
+	}
+	exec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{pfb})
+
+	for _, tc := range []struct {
+		name    string
+		msgs    []sdk.Msg
+		txGas   uint64
+		wantErr bool
+	}{
+		{"nested pfb", []sdk.Msg{&exec}, 2 * appconsts.ShareSize * testGasPerBlobByte, false},
+		{"nested pfb not enough gas", []sdk.Msg{&exec}, 2*appconsts.ShareSize*testGasPerBlobByte - 1, true},
+		{"top-level and nested pfbs", []sdk.Msg{pfb, &exec}, 4 * appconsts.ShareSize * testGasPerBlobByte, false},
+		{"top-level and nested pfbs not enough gas", []sdk.Msg{pfb, &exec}, 4*appconsts.ShareSize*testGasPerBlobByte - 1, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			anteHandler := ante.NewMinGasPFBDecorator(mockBlobKeeper{})
+			ctx := sdk.Context{}.WithGasMeter(storetypes.NewGasMeter(tc.txGas)).WithIsCheckTx(true)
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+			_, err := anteHandler.AnteHandle(ctx, txBuilder.GetTx(), false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil })
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 type mockBlobKeeper struct{}
 
 func (mockBlobKeeper) GasPerBlobByte(_ context.Context) uint32 {
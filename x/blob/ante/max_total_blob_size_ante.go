@@ -28,11 +28,9 @@ func (d MaxTotalBlobSizeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	}
 
 	max := d.maxTotalBlobSize(ctx)
-	for _, m := range tx.GetMsgs() {
-		if pfb, ok := m.(*blobtypes.MsgPayForBlobs); ok {
-			if total := getTotal(pfb.BlobSizes); total > max {
-				return ctx, errors.Wrapf(blobtypes.ErrTotalBlobSizeTooLarge, "total blob size %d exceeds max %d", total, max)
-			}
+	for _, pfb := range blobtypes.ExtractPFBs(tx.GetMsgs()) {
+		if total := getTotal(pfb.BlobSizes); total > max {
+			return ctx, errors.Wrapf(blobtypes.ErrTotalBlobSizeTooLarge, "total blob size %d exceeds max %d", total, max)
 		}
 	}
 
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+)
+
+var _ authz.Authorization = &BlobAuthorization{}
+
+// NewBlobAuthorization creates a new BlobAuthorization for the namespaces, up
+// to maxBytes blob bytes.
+func NewBlobAuthorization(namespaces []appns.Namespace, maxBytes uint64) *BlobAuthorization {
+	return &BlobAuthorization{
+		Namespaces: namespacesToBytes(namespaces),
+		MaxBytes:   maxBytes,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a BlobAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgPayForBlobs{})
+}
+
+// Accept implements Authorization.Accept. The blobs must be in the allowed
+// namespaces, and their total size is deducted from the remaining bytes.
+func (a BlobAuthorization) Accept(_ context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	pfb, ok := msg.(*MsgPayForBlobs)
+	if !ok {
+		return authz.AcceptResponse{}, errors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	if len(a.Namespaces) > 0 {
+		for _, ns := range pfb.Namespaces {
+			if !slices.ContainsFunc(a.Namespaces, func(allowed []byte) bool { return bytes.Equal(allowed, ns) }) {
+				return authz.AcceptResponse{}, errors.Wrapf(sdkerrors.ErrUnauthorized, "namespace %X is not allowed", ns)
+			}
+		}
+	}
+
+	if a.MaxBytes == 0 {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+	var total uint64
+	for _, size := range pfb.BlobSizes {
+		total += uint64(size)
+	}
+	if total > a.MaxBytes {
+		return authz.AcceptResponse{}, errors.Wrapf(sdkerrors.ErrUnauthorized, "blob size %d exceeds the remaining %d bytes", total, a.MaxBytes)
+	}
+	remaining := a.MaxBytes - total
+	if remaining == 0 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	return authz.AcceptResponse{
+		Accept:  true,
+		Updated: &BlobAuthorization{Namespaces: a.Namespaces, MaxBytes: remaining},
+	}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a BlobAuthorization) ValidateBasic() error {
+	for _, bz := range a.Namespaces {
+		ns, err := appns.From(bz)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidNamespace, "%X: %s", bz, err)
+		}
+		if err := ValidateBlobNamespace(ns); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+	"github.com/sunrise-zone/sunrise-app/x/blob/types"
+)
+
+func TestBlobAuthorizationAccept(t *testing.T) {
+	allowed := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	other := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+	pfb := func(ns appns.Namespace, size uint32) *types.MsgPayForBlobs {
+		return &types.MsgPayForBlobs{Namespaces: [][]byte{ns.Bytes()}, BlobSizes: []uint32{size}}
+	}
+
+	auth := types.NewBlobAuthorization([]appns.Namespace{allowed}, 100)
+	require.NoError(t, auth.ValidateBasic())
+	assert.Equal(t, "/sunrise.blob.v1.MsgPayForBlobs", auth.MsgTypeURL())
+
+	// the size of the blobs is deducted from the remaining bytes.
+	res, err := auth.Accept(sdk.Context{}, pfb(allowed, 60))
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	assert.Equal(t, uint64(40), res.Updated.(*types.BlobAuthorization).MaxBytes)
+
+	// the authorization is removed once the bytes are spent.
+	res, err = res.Updated.Accept(sdk.Context{}, pfb(allowed, 40))
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	_, err = auth.Accept(sdk.Context{}, pfb(allowed, 101))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = auth.Accept(sdk.Context{}, pfb(other, 10))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = auth.Accept(sdk.Context{}, &banktypes.MsgSend{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	// all the namespaces are allowed, without limit, by default.
+	unlimited := types.NewBlobAuthorization(nil, 0)
+	res, err = unlimited.Accept(sdk.Context{}, pfb(other, 1000))
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+}
+
+func TestBlobAuthorizationValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewBlobAuthorization(nil, 0).ValidateBasic())
+	require.Error(t, types.NewBlobAuthorization([]appns.Namespace{appns.TxNamespace}, 0).ValidateBasic())
+	require.Error(t, (&types.BlobAuthorization{Namespaces: [][]byte{{1, 2}}}).ValidateBasic())
+}
@@ -3,12 +3,17 @@ package types
 import (
 	"bytes"
 
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
 	"github.com/sunrise-zone/sunrise-app/pkg/blob"
 	"github.com/sunrise-zone/sunrise-app/pkg/inclusion"
 	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
 	shares "github.com/sunrise-zone/sunrise-app/pkg/shares"
 
+	"cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // NewBlob creates a new coretypes.Blob from the provided data after performing
@@ -31,45 +36,131 @@ func NewBlob(ns appns.Namespace, data []byte, shareVersion uint8) (*blob.Blob, e
 	}, nil
 }
 
+// NewV1Blob creates a new blob of share version one, embedding the address of
+// the signer of the MsgPayForBlobs paying for it, after performing basic
+// stateless checks over it.
+func NewV1Blob(ns appns.Namespace, data []byte, signer sdk.AccAddress) (*blob.Blob, error) {
+	err := ValidateBlobNamespace(ns)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, ErrZeroBlobSize
+	}
+
+	if len(signer) != appconsts.SignerSize {
+		return nil, ErrInvalidBlobSigner.Wrapf("signer must be %d bytes", appconsts.SignerSize)
+	}
+
+	return blob.NewV1(ns, data, signer), nil
+}
+
 // ValidateBlobTx performs stateless checks on the BlobTx to ensure that the
-// blobs attached to the transaction are valid.
-func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx blob.BlobTx) error {
+// blobs attached to the transaction are valid. Starting with app version 3, a
+// BlobTx may contain several MsgPayForBlobs, up to
+// appconsts.MaxPFBsPerBlobTx, with the message paying for each blob given by
+// the message indexes of the BlobTx.
+func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx blob.BlobTx, appVersion uint64) error {
 	sdkTx, err := txcfg.TxDecoder()(bTx.Tx)
 	if err != nil {
 		return err
 	}
 
-	// TODO: remove this check once support for multiple sdk.Msgs in a BlobTx is
-	// supported.
 	msgs := sdkTx.GetMsgs()
-	if len(msgs) != 1 {
-		return ErrMultipleMsgsInBlobTx
-	}
-	msg := msgs[0]
-	msgPFB, ok := msg.(*MsgPayForBlobs)
-	if !ok {
+	if len(msgs) == 0 {
 		return ErrNoPFB
 	}
-	err = msgPFB.ValidateBasic()
-	if err != nil {
-		return err
+	maxPFBs := appconsts.MaxPFBsPerBlobTx(appVersion)
+	if len(msgs) > maxPFBs {
+		return ErrMultipleMsgsInBlobTx.Wrapf("%d messages, maximum %d", len(msgs), maxPFBs)
 	}
 
-	// perform basic checks on the blobs
-	sizes := make([]uint32, len(bTx.Blobs))
-	for i, pblob := range bTx.Blobs {
-		sizes[i] = uint32(len(pblob.Data))
+	// each PFB is either a message of the transaction, or executed by an
+	// authz MsgExec on behalf of the granter.
+	pfbs := make([]*MsgPayForBlobs, len(msgs))
+	for i, msg := range msgs {
+		msgPFB, ok := UnwrapPFB(msg)
+		if !ok {
+			// a blob transaction only holds PFBs
+			if len(msgs) > 1 || len(ExtractPFBs([]sdk.Msg{msg})) > 0 {
+				return ErrMultipleMsgsInBlobTx
+			}
+			return ErrNoPFB
+		}
+		err = msgPFB.ValidateBasic()
+		if err != nil {
+			return err
+		}
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			if _, err := sdk.AccAddressFromBech32(exec.Grantee); err != nil {
+				return errors.Wrap(sdkerrors.ErrInvalidAddress, "invalid grantee address")
+			}
+		}
+		pfbs[i] = msgPFB
+	}
+
+	// the blobs must be grouped by PFB, in the order of the messages, so that
+	// the BlobTx can be rebuilt from the square. The message indexes are
+	// omitted when there is a single PFB.
+	var expected []uint32
+	if len(pfbs) > 1 {
+		for i, msgPFB := range pfbs {
+			for range msgPFB.BlobSizes {
+				expected = append(expected, uint32(i))
+			}
+		}
 	}
+	if !equalSlices(bTx.MsgIndexes, expected) {
+		return ErrInvalidMsgIndexes.Wrapf("actual %v expected %v", bTx.MsgIndexes, expected)
+	}
+
 	err = ValidateBlobs(bTx.Blobs...)
 	if err != nil {
 		return err
 	}
+	latestShareVersion := appconsts.LatestShareVersion(appVersion)
+	for _, b := range bTx.Blobs {
+		if uint8(b.ShareVersion) > latestShareVersion {
+			return ErrUnsupportedShareVersion.Wrapf("share version %d is not supported by app version %d", b.ShareVersion, appVersion)
+		}
+	}
+
+	for i, msgPFB := range pfbs {
+		if err := validatePFBBlobs(msgPFB, bTx.BlobsForMsg(i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
+// validatePFBBlobs checks that the blobs match the sizes, namespaces, share
+// versions and share commitments declared in the msgPFB, and that the blobs of
+// share version one embed the signer of the msgPFB.
+func validatePFBBlobs(msgPFB *MsgPayForBlobs, blobs []*blob.Blob) error {
 	// check that the sizes in the blobTx match the sizes in the msgPFB
+	sizes := make([]uint32, len(blobs))
+	for i, pblob := range blobs {
+		sizes[i] = uint32(len(pblob.Data))
+	}
 	if !equalSlices(sizes, msgPFB.BlobSizes) {
 		return ErrBlobSizeMismatch.Wrapf("actual %v declared %v", sizes, msgPFB.BlobSizes)
 	}
 
+	signer, err := sdk.AccAddressFromBech32(msgPFB.Signer)
+	if err != nil {
+		return err
+	}
+	for i, b := range blobs {
+		if b.ShareVersion != msgPFB.ShareVersions[i] {
+			return ErrUnsupportedShareVersion.Wrapf("blob share version %d differs from the declared %d", b.ShareVersion, msgPFB.ShareVersions[i])
+		}
+		if uint8(b.ShareVersion) == appconsts.ShareVersionOne && !bytes.Equal(b.Signer, signer) {
+			return ErrInvalidBlobSigner.Wrapf("blob signer %X differs from the PFB signer %X", b.Signer, signer.Bytes())
+		}
+	}
+
 	for i, ns := range msgPFB.Namespaces {
 		msgPFBNamespace, err := appns.From(ns)
 		if err != nil {
@@ -78,7 +169,7 @@ func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx blob.BlobTx) error {
 
 		// this not only checks that the pfb namespaces match the ones in the blobs
 		// but that the namespace version and namespace id are valid
-		blobNamespace, err := appns.New(uint8(bTx.Blobs[i].NamespaceVersion), bTx.Blobs[i].NamespaceId)
+		blobNamespace, err := appns.New(uint8(blobs[i].NamespaceVersion), blobs[i].NamespaceId)
 		if err != nil {
 			return err
 		}
@@ -88,13 +179,14 @@ func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx blob.BlobTx) error {
 		}
 	}
 
-	// verify that the commitment of the blob matches that of the msgPFB
+	// verify that the commitment of the blob matches that of the msgPFB. The
+	// commitments of all the blobs are computed concurrently.
+	calculatedCommits, err := inclusion.CreateCommitments(blobs)
+	if err != nil {
+		return ErrCalculateCommitment
+	}
 	for i, commitment := range msgPFB.ShareCommitments {
-		calculatedCommit, err := inclusion.CreateCommitment(bTx.Blobs[i])
-		if err != nil {
-			return ErrCalculateCommitment
-		}
-		if !bytes.Equal(calculatedCommit, commitment) {
+		if !bytes.Equal(calculatedCommits[i], commitment) {
 			return ErrInvalidShareCommitment
 		}
 	}
@@ -105,7 +197,7 @@ func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx blob.BlobTx) error {
 func BlobTxSharesUsed(btx blob.BlobTx) int {
 	sharesUsed := 0
 	for _, blob := range btx.Blobs {
-		sharesUsed += shares.SparseSharesNeeded(uint32(len(blob.Data)))
+		sharesUsed += shares.SparseSharesNeeded(uint32(len(blob.Data)), uint8(blob.ShareVersion) == appconsts.ShareVersionOne)
 	}
 	return sharesUsed
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/sunrise-zone/sunrise-app/app"
 	"github.com/sunrise-zone/sunrise-app/app/encoding"
 	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	v2 "github.com/sunrise-zone/sunrise-app/pkg/appconsts/v2"
 	"github.com/sunrise-zone/sunrise-app/pkg/blob"
 	"github.com/sunrise-zone/sunrise-app/pkg/inclusion"
 	"github.com/sunrise-zone/sunrise-app/pkg/namespace"
@@ -238,10 +239,122 @@ func TestValidateBlobTx(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := types.ValidateBlobTx(encCfg.TxConfig, tt.getTx())
+			err := types.ValidateBlobTx(encCfg.TxConfig, tt.getTx(), appconsts.LatestVersion)
 			if tt.expectedErr != nil {
 				assert.ErrorIs(t, err, tt.expectedErr, tt.name)
 			}
 		})
 	}
 }
+
+func TestValidateBlobTxMultiplePFBs(t *testing.T) {
+	txConfig := encoding.MakeConfig(interfaceRegisterer(types.RegisterInterfaces)).TxConfig
+	signers := []sdk.AccAddress{sdk.AccAddress("signer1"), sdk.AccAddress("signer2")}
+	var (
+		msgs       []sdk.Msg
+		blobs      []*blob.Blob
+		msgIndexes []uint32
+	)
+	for i, signer := range signers {
+		msgBlobs := make([]*blob.Blob, i+1)
+		for j := range msgBlobs {
+			ns := namespace.MustNewV0(bytes.Repeat([]byte{byte(i + 1)}, namespace.NamespaceVersionZeroIDSize))
+			b, err := types.NewBlob(ns, bytes.Repeat([]byte{byte(j + 1)}, 100), appconsts.ShareVersionZero)
+			require.NoError(t, err)
+			msgBlobs[j] = b
+			msgIndexes = append(msgIndexes, uint32(i))
+		}
+		pfb, err := types.NewMsgPayForBlobs(signer.String(), msgBlobs...)
+		require.NoError(t, err)
+		msgs = append(msgs, pfb)
+		blobs = append(blobs, msgBlobs...)
+	}
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	tx, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	rawBlobTx, err := blob.MarshalBlobTxWithIndexes(tx, blobs, msgIndexes)
+	require.NoError(t, err)
+	bTx, isBlobTx := blob.UnmarshalBlobTx(rawBlobTx)
+	require.True(t, isBlobTx)
+	require.NoError(t, types.ValidateBlobTx(txConfig, bTx, appconsts.LatestVersion))
+
+	// versions prior to v3 only accept a single PFB.
+	require.ErrorIs(t, types.ValidateBlobTx(txConfig, bTx, v2.Version), types.ErrMultipleMsgsInBlobTx)
+
+	// the message indexes are required.
+	noIndexes := bTx
+	noIndexes.MsgIndexes = nil
+	require.ErrorIs(t, types.ValidateBlobTx(txConfig, noIndexes, appconsts.LatestVersion), types.ErrInvalidMsgIndexes)
+
+	// the message indexes are omitted with a single PFB.
+	single := blob.BlobTx{Tx: tx, Blobs: blobs[:1], MsgIndexes: []uint32{0}}
+	require.NoError(t, builder.SetMsgs(msgs[0]))
+	single.Tx, err = txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	require.ErrorIs(t, types.ValidateBlobTx(txConfig, single, appconsts.LatestVersion), types.ErrInvalidMsgIndexes)
+	single.MsgIndexes = nil
+	require.NoError(t, types.ValidateBlobTx(txConfig, single, appconsts.LatestVersion))
+
+	// the blobs must be grouped by message, in the order of the messages.
+	reordered := bTx
+	reordered.MsgIndexes = []uint32{1, 0, 1}
+	reordered.Blobs = []*blob.Blob{blobs[1], blobs[0], blobs[2]}
+	require.ErrorIs(t, types.ValidateBlobTx(txConfig, reordered, appconsts.LatestVersion), types.ErrInvalidMsgIndexes)
+
+	// the blobs must match the PFB paying for them.
+	swapped := bTx
+	swapped.Blobs = []*blob.Blob{blobs[0], blobs[2], blobs[1]}
+	require.ErrorIs(t, types.ValidateBlobTx(txConfig, swapped, appconsts.LatestVersion), types.ErrInvalidShareCommitment)
+
+	// the number of PFBs is limited.
+	tooMany := make([]sdk.Msg, appconsts.MaxPFBsPerBlobTx(appconsts.LatestVersion)+1)
+	for i := range tooMany {
+		tooMany[i] = msgs[0]
+	}
+	require.NoError(t, builder.SetMsgs(tooMany...))
+	tx, err = txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	require.ErrorIs(t, types.ValidateBlobTx(txConfig, blob.BlobTx{Tx: tx, Blobs: blobs[:1]}, appconsts.LatestVersion), types.ErrMultipleMsgsInBlobTx)
+}
+
+func TestValidateBlobTxSigner(t *testing.T) {
+	txConfig := encoding.MakeConfig(interfaceRegisterer(types.RegisterInterfaces)).TxConfig
+	signer := sdk.AccAddress(bytes.Repeat([]byte{1}, appconsts.SignerSize))
+	other := sdk.AccAddress(bytes.Repeat([]byte{2}, appconsts.SignerSize))
+	ns := namespace.MustNewV0(bytes.Repeat([]byte{1}, namespace.NamespaceVersionZeroIDSize))
+
+	blobTx := func(pfbSigner sdk.AccAddress, b *blob.Blob) blob.BlobTx {
+		pfb, err := types.NewMsgPayForBlobs(pfbSigner.String(), b)
+		require.NoError(t, err)
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(pfb))
+		tx, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return blob.BlobTx{Tx: tx, Blobs: []*blob.Blob{b}}
+	}
+
+	b, err := types.NewV1Blob(ns, bytes.Repeat([]byte{1}, 1000), signer)
+	require.NoError(t, err)
+	require.NoError(t, types.ValidateBlobTx(txConfig, blobTx(signer, b), appconsts.LatestVersion))
+
+	// share version one is only supported since v3.
+	require.ErrorIs(t, types.ValidateBlobTx(txConfig, blobTx(signer, b), v2.Version), types.ErrUnsupportedShareVersion)
+
+	// the signer of the blob must be the signer of the PFB.
+	require.ErrorIs(t, types.ValidateBlobTx(txConfig, blobTx(other, b), appconsts.LatestVersion), types.ErrInvalidBlobSigner)
+
+	// the blobs of share version one must have a signer.
+	_, err = types.NewV1Blob(ns, bytes.Repeat([]byte{1}, 1000), nil)
+	require.ErrorIs(t, err, types.ErrInvalidBlobSigner)
+	unsigned := *b
+	unsigned.Signer = nil
+	require.ErrorIs(t, types.ValidateBlobs(&unsigned), types.ErrInvalidBlobSigner)
+
+	// the blobs of share version zero must not have a signer.
+	signed, err := types.NewBlob(ns, bytes.Repeat([]byte{1}, 1000), appconsts.ShareVersionZero)
+	require.NoError(t, err)
+	signed.Signer = signer
+	require.ErrorIs(t, types.ValidateBlobs(signed), types.ErrInvalidBlobSigner)
+}
@@ -27,7 +27,7 @@ var (
 	ErrNoPFB                          = sdkerrors.Register(ModuleName, 11126, "no MsgPayForBlobs found in blob transaction")
 	ErrNamespaceMismatch              = sdkerrors.Register(ModuleName, 11127, "namespace of blob and its respective MsgPayForBlobs differ")
 	ErrProtoParsing                   = sdkerrors.Register(ModuleName, 11128, "failure to parse a transaction from its protobuf representation")
-	ErrMultipleMsgsInBlobTx           = sdkerrors.Register(ModuleName, 11129, "not yet supported: multiple sdk.Msgs found in BlobTx")
+	ErrMultipleMsgsInBlobTx           = sdkerrors.Register(ModuleName, 11129, "unsupported number of sdk.Msgs found in BlobTx")
 	ErrMismatchedNumberOfPFBComponent = sdkerrors.Register(ModuleName, 11130, "number of each component in a MsgPayForBlobs must be identical")
 	ErrNoBlobs                        = sdkerrors.Register(ModuleName, 11131, "no blobs provided")
 	ErrNoNamespaces                   = sdkerrors.Register(ModuleName, 11132, "no namespaces provided")
@@ -37,4 +37,6 @@ var (
 	ErrInvalidNamespace               = sdkerrors.Register(ModuleName, 11136, "invalid namespace")
 	ErrInvalidNamespaceVersion        = sdkerrors.Register(ModuleName, 11137, "invalid namespace version")
 	ErrTotalBlobSizeTooLarge          = sdkerrors.Register(ModuleName, 11138, "total blob size too large")
+	ErrInvalidMsgIndexes              = sdkerrors.Register(ModuleName, 11139, "blob message indexes don't match the MsgPayForBlobs of the BlobTx")
+	ErrInvalidBlobSigner              = sdkerrors.Register(ModuleName, 11140, "blob signer is invalid or differs from the signer of its MsgPayForBlobs")
 )
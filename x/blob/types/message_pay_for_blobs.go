@@ -113,7 +113,7 @@ func (msg *MsgPayForBlobs) ValidateBasic() error {
 	}
 
 	for _, v := range msg.ShareVersions {
-		if v != uint32(appconsts.ShareVersionZero) {
+		if !slices.Contains(appconsts.SupportedShareVersions, uint8(v)) {
 			return ErrUnsupportedShareVersion
 		}
 	}
@@ -133,16 +133,24 @@ func (msg *MsgPayForBlobs) ValidateBasic() error {
 }
 
 func (msg *MsgPayForBlobs) Gas(gasPerByte uint32) uint64 {
-	return GasToConsume(msg.BlobSizes, gasPerByte)
+	return gasToConsume(msg.BlobSizes, msg.ShareVersions, gasPerByte)
 }
 
 // GasToConsume works out the extra gas charged to pay for a set of blobs in a PFB.
 // Note that transactions will incur other gas costs, such as the signature verification
-// and reads to the user's account.
+// and reads to the user's account. The blobs are assumed to be of share version zero.
 func GasToConsume(blobSizes []uint32, gasPerByte uint32) uint64 {
+	return gasToConsume(blobSizes, nil, gasPerByte)
+}
+
+// gasToConsume works out the extra gas charged to pay for a set of blobs of the
+// given share versions, taking into account the signer embedded in the first
+// share of the blobs of share version one.
+func gasToConsume(blobSizes, shareVersions []uint32, gasPerByte uint32) uint64 {
 	var totalSharesUsed uint64
-	for _, size := range blobSizes {
-		totalSharesUsed += uint64(appshares.SparseSharesNeeded(size))
+	for i, size := range blobSizes {
+		containsSigner := i < len(shareVersions) && shareVersions[i] == uint32(appconsts.ShareVersionOne)
+		totalSharesUsed += uint64(appshares.SparseSharesNeeded(size, containsSigner))
 	}
 
 	return totalSharesUsed * appconsts.ShareSize * uint64(gasPerByte)
@@ -203,6 +211,15 @@ func ValidateBlobs(blobs ...*blob.Blob) error {
 		if !slices.Contains(appconsts.SupportedShareVersions, uint8(blob.ShareVersion)) {
 			return ErrUnsupportedShareVersion
 		}
+
+		// only the blobs of share version one embed their signer
+		if uint8(blob.ShareVersion) == appconsts.ShareVersionOne {
+			if len(blob.Signer) != appconsts.SignerSize {
+				return ErrInvalidBlobSigner.Wrapf("signer must be %d bytes", appconsts.SignerSize)
+			}
+		} else if len(blob.Signer) != 0 {
+			return ErrInvalidBlobSigner.Wrapf("signer is only supported by share version %d", appconsts.ShareVersionOne)
+		}
 	}
 
 	return nil
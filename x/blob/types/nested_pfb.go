@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// UnwrapPFB returns the MsgPayForBlobs paid by the message, which is either a
+// MsgPayForBlobs, or an authz MsgExec executing a single MsgPayForBlobs on
+// behalf of its granter.
+func UnwrapPFB(msg sdk.Msg) (*MsgPayForBlobs, bool) {
+	switch m := msg.(type) {
+	case *MsgPayForBlobs:
+		return m, true
+	case *authz.MsgExec:
+		msgs, err := m.GetMessages()
+		if err != nil || len(msgs) != 1 {
+			return nil, false
+		}
+		pfb, ok := msgs[0].(*MsgPayForBlobs)
+		return pfb, ok
+	default:
+		return nil, false
+	}
+}
+
+// ExtractPFBs returns all the MsgPayForBlobs of the messages, including those
+// executed by authz MsgExec at any depth. It is used to detect the PFBs that
+// must be paired with blobs, and to account for the gas of all of them.
+func ExtractPFBs(msgs []sdk.Msg) []*MsgPayForBlobs {
+	var pfbs []*MsgPayForBlobs
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *MsgPayForBlobs:
+			pfbs = append(pfbs, m)
+		case *authz.MsgExec:
+			// the messages that can't be unpacked fail in the message
+			// handler anyway.
+			nested, err := m.GetMessages()
+			if err != nil {
+				continue
+			}
+			pfbs = append(pfbs, ExtractPFBs(nested)...)
+		}
+	}
+	return pfbs
+}
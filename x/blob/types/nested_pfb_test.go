@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunrise-zone/sunrise-app/app/encoding"
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+	"github.com/sunrise-zone/sunrise-app/x/blob/types"
+)
+
+func TestUnwrapPFB(t *testing.T) {
+	grantee := sdk.AccAddress("grantee")
+	pfb := &types.MsgPayForBlobs{Signer: sdk.AccAddress("granter").String()}
+	send := &banktypes.MsgSend{}
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{pfb})
+	execMany := authz.NewMsgExec(grantee, []sdk.Msg{pfb, send})
+	execNested := authz.NewMsgExec(grantee, []sdk.Msg{&exec})
+
+	got, ok := types.UnwrapPFB(pfb)
+	require.True(t, ok)
+	assert.Equal(t, pfb, got)
+	got, ok = types.UnwrapPFB(&exec)
+	require.True(t, ok)
+	assert.Equal(t, pfb, got)
+
+	for _, msg := range []sdk.Msg{send, &execMany, &execNested} {
+		_, ok := types.UnwrapPFB(msg)
+		assert.False(t, ok)
+	}
+
+	assert.Empty(t, types.ExtractPFBs([]sdk.Msg{send}))
+	assert.Len(t, types.ExtractPFBs([]sdk.Msg{pfb, &exec}), 2)
+	assert.Len(t, types.ExtractPFBs([]sdk.Msg{&execMany}), 1)
+	assert.Len(t, types.ExtractPFBs([]sdk.Msg{&execNested}), 1)
+}
+
+// interfaceRegisterer registers the interfaces of a module with a function.
+type interfaceRegisterer func(codectypes.InterfaceRegistry)
+
+func (r interfaceRegisterer) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	r(registry)
+}
+
+func TestValidateBlobTxNestedPFB(t *testing.T) {
+	txConfig := encoding.MakeConfig(
+		interfaceRegisterer(authz.RegisterInterfaces),
+		interfaceRegisterer(banktypes.RegisterInterfaces),
+		interfaceRegisterer(types.RegisterInterfaces),
+	).TxConfig
+	grantee, granter := sdk.AccAddress("grantee"), sdk.AccAddress("granter")
+	ns := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	b, err := types.NewBlob(ns, []byte("data"), appconsts.ShareVersionZero)
+	require.NoError(t, err)
+	pfb, err := types.NewMsgPayForBlobs(granter.String(), b)
+	require.NoError(t, err)
+
+	blobTx := func(msgs ...sdk.Msg) blob.BlobTx {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		tx, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return blob.BlobTx{Tx: tx, Blobs: []*blob.Blob{b}}
+	}
+
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{pfb})
+	require.NoError(t, types.ValidateBlobTx(txConfig, blobTx(&exec), appconsts.LatestVersion))
+
+	// the blobs must match the share commitments of the nested PFB.
+	invalid := *pfb
+	invalid.ShareCommitments = [][]byte{bytes.Repeat([]byte{1}, 32)}
+	exec = authz.NewMsgExec(grantee, []sdk.Msg{&invalid})
+	require.ErrorIs(t, types.ValidateBlobTx(txConfig, blobTx(&exec), appconsts.LatestVersion), types.ErrInvalidShareCommitment)
+
+	// a MsgExec must only execute the PFB.
+	exec = authz.NewMsgExec(grantee, []sdk.Msg{pfb, &banktypes.MsgSend{}})
+	require.ErrorIs(t, types.ValidateBlobTx(txConfig, blobTx(&exec), appconsts.LatestVersion), types.ErrMultipleMsgsInBlobTx)
+	exec = authz.NewMsgExec(grantee, []sdk.Msg{&banktypes.MsgSend{}})
+	require.ErrorIs(t, types.ValidateBlobTx(txConfig, blobTx(&exec), appconsts.LatestVersion), types.ErrNoPFB)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	blobtypes "github.com/sunrise-zone/sunrise-app/x/blob/types"
+	"github.com/sunrise-zone/sunrise-app/x/blobgrant/types"
+)
+
+// BlobAllowedMessages are the messages whose fees can be paid with a blob
+// allowance.
+var BlobAllowedMessages = []string{sdk.MsgTypeURL(&blobtypes.MsgPayForBlobs{})}
+
+// FundBlobAllowance increases by amount the fee allowance granted by the
+// granter to the grantee to pay for blobs. The allowance is a fee grant
+// restricted to MsgPayForBlobs, which is created if the grantee has no
+// allowance from the granter. An existing allowance that isn't restricted to
+// MsgPayForBlobs isn't modified, and ErrIncompatibleAllowance is returned.
+func (k Keeper) FundBlobAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, amount sdk.Coins) error {
+	if !amount.IsValid() || amount.IsZero() {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "invalid allowance amount %s", amount)
+	}
+
+	existing, err := k.feegrantKeeper.GetAllowance(ctx, granter, grantee)
+	if err != nil {
+		if !errorsmod.IsOf(err, feegrant.ErrNoAllowance) {
+			return err
+		}
+		allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{SpendLimit: amount}, BlobAllowedMessages)
+		if err != nil {
+			return err
+		}
+		return k.feegrantKeeper.GrantAllowance(ctx, granter, grantee, allowance)
+	}
+
+	allowance, ok := existing.(*feegrant.AllowedMsgAllowance)
+	if !ok || !slices.Equal(allowance.AllowedMessages, BlobAllowedMessages) {
+		return errorsmod.Wrapf(types.ErrIncompatibleAllowance, "%s already has an allowance from %s", grantee, granter)
+	}
+	inner, err := allowance.GetAllowance()
+	if err != nil {
+		return err
+	}
+	basic, ok := inner.(*feegrant.BasicAllowance)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrIncompatibleAllowance, "%s already has an allowance from %s", grantee, granter)
+	}
+	if basic.SpendLimit.Empty() {
+		// the allowance is already unlimited.
+		return nil
+	}
+	funded, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{
+		SpendLimit: basic.SpendLimit.Add(amount...),
+		Expiration: basic.Expiration,
+	}, BlobAllowedMessages)
+	if err != nil {
+		return err
+	}
+	return k.feegrantKeeper.UpdateAllowance(ctx, granter, grantee, funded)
+}
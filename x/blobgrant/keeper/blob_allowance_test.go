@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunrise-zone/sunrise-app/testutil/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/blobgrant/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/blobgrant/types"
+)
+
+// mockFeegrantKeeper stores the allowances by granter and grantee.
+type mockFeegrantKeeper map[string]feegrant.FeeAllowanceI
+
+func (m mockFeegrantKeeper) GetAllowance(_ context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
+	allowance, ok := m[granter.String()+grantee.String()]
+	if !ok {
+		return nil, errorsmod.Wrap(feegrant.ErrNoAllowance, "allowance does not exist")
+	}
+	return allowance, nil
+}
+
+func (m mockFeegrantKeeper) GrantAllowance(_ context.Context, granter, grantee sdk.AccAddress, allowance feegrant.FeeAllowanceI) error {
+	if _, ok := m[granter.String()+grantee.String()]; ok {
+		return errorsmod.Wrap(feegrant.ErrFeeLimitExceeded, "fee allowance already exists")
+	}
+	m[granter.String()+grantee.String()] = allowance
+	return nil
+}
+
+func (m mockFeegrantKeeper) UpdateAllowance(_ context.Context, granter, grantee sdk.AccAddress, allowance feegrant.FeeAllowanceI) error {
+	m[granter.String()+grantee.String()] = allowance
+	return nil
+}
+
+func TestFundBlobAllowance(t *testing.T) {
+	feegrantKeeper := mockFeegrantKeeper{}
+	k, ctx := keepertest.GrantKeeper(t, feegrantKeeper)
+	granter, grantee := sdk.AccAddress("granter"), sdk.AccAddress("grantee")
+
+	spendLimit := func() sdk.Coins {
+		allowance, err := feegrantKeeper.GetAllowance(ctx, granter, grantee)
+		require.NoError(t, err)
+		msgAllowance, ok := allowance.(*feegrant.AllowedMsgAllowance)
+		require.True(t, ok)
+		assert.Equal(t, keeper.BlobAllowedMessages, msgAllowance.AllowedMessages)
+		inner, err := msgAllowance.GetAllowance()
+		require.NoError(t, err)
+		return inner.(*feegrant.BasicAllowance).SpendLimit
+	}
+
+	require.NoError(t, k.FundBlobAllowance(ctx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("usr", 100))))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usr", 100)), spendLimit())
+	require.NoError(t, k.FundBlobAllowance(ctx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("usr", 50))))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usr", 150)), spendLimit())
+
+	err := k.FundBlobAllowance(ctx, granter, grantee, sdk.Coins{})
+	assert.ErrorIs(t, err, types.ErrInvalidAmount)
+
+	// the allowances not restricted to the blobs are left as is.
+	other := sdk.AccAddress("other")
+	require.NoError(t, feegrantKeeper.GrantAllowance(ctx, granter, other, &feegrant.BasicAllowance{}))
+	err = k.FundBlobAllowance(ctx, granter, other, sdk.NewCoins(sdk.NewInt64Coin("usr", 100)))
+	assert.ErrorIs(t, err, types.ErrIncompatibleAllowance)
+}
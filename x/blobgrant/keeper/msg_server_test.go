@@ -12,7 +12,7 @@ import (
 )
 
 func setupMsgServer(t testing.TB) (keeper.Keeper, types.MsgServer, context.Context) {
-	k, ctx := keepertest.GrantKeeper(t)
+	k, ctx := keepertest.GrantKeeper(t, nil)
 	return k, keeper.NewMsgServerImpl(k), ctx
 }
 
@@ -18,7 +18,7 @@ func TestGenesis(t *testing.T) {
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
-	k, ctx := keepertest.GrantKeeper(t)
+	k, ctx := keepertest.GrantKeeper(t, nil)
 	grant.InitGenesis(ctx, k, genesisState)
 	got := grant.ExportGenesis(ctx, k)
 	require.NotNil(t, got)
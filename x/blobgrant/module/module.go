@@ -180,8 +180,9 @@ type ModuleInputs struct {
 	Config       *modulev1.Module
 	Logger       log.Logger
 
-	AccountKeeper types.AccountKeeper
-	BankKeeper    types.BankKeeper
+	AccountKeeper  types.AccountKeeper
+	BankKeeper     types.BankKeeper
+	FeegrantKeeper types.FeegrantKeeper
 }
 
 type ModuleOutputs struct {
@@ -202,6 +203,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.StoreService,
 		in.Logger,
 		authority.String(),
+		in.FeegrantKeeper,
 	)
 	m := NewAppModule(
 		in.Cdc,
@@ -10,4 +10,7 @@ import (
 var (
 	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+
+	ErrInvalidAmount         = sdkerrors.Register(ModuleName, 2, "invalid amount")
+	ErrIncompatibleAllowance = sdkerrors.Register(ModuleName, 3, "incompatible fee allowance")
 )
@@ -0,0 +1,55 @@
+package blobibc
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	blobibcv1 "github.com/sunrise-zone/sunrise-app/api/sunrise/blobibc/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: blobibcv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod:      "PendingBlob",
+					Use:            "pending-blob [id]",
+					Short:          "Shows a received blob waiting to be included",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "PendingBlobs",
+					Use:       "pending-blobs",
+					Short:     "Shows the received blobs waiting to be included",
+				},
+				{
+					RpcMethod:      "FeePayer",
+					Use:            "fee-payer [channel-id] [sender]",
+					Short:          "Shows the account paying the fees of the blobs received from a sender over a channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}, {ProtoField: "sender"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: blobibcv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "SendBlob",
+					Use:            "send-blob [channel-id] [namespace] [data] [fee]",
+					Short:          "Sends a blob to the counterparty chain of a channel, paying the fee on the counterparty",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}, {ProtoField: "namespace"}, {ProtoField: "data"}, {ProtoField: "fee"}},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
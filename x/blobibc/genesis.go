@@ -0,0 +1,37 @@
+package blobibc
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/blobibc/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/blobibc/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetPort(ctx, genState.PortId)
+	// only bind the port if it isn't already bound, e.g. when restarting
+	// from an exported genesis.
+	if !k.IsBound(ctx, genState.PortId) {
+		if err := k.BindPort(ctx, genState.PortId); err != nil {
+			panic("could not claim port capability: " + err.Error())
+		}
+	}
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+	for _, pending := range genState.PendingBlobs {
+		k.SetPendingBlob(ctx, pending)
+	}
+	k.SetNextPendingBlobID(ctx, genState.NextPendingBlobId)
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		PortId:            k.GetPort(ctx),
+		Params:            k.GetParams(ctx),
+		PendingBlobs:      k.GetPendingBlobs(ctx, 0),
+		NextPendingBlobId: k.GetNextPendingBlobID(ctx),
+	}
+}
@@ -0,0 +1,150 @@
+package blobibc_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunrise-zone/sunrise-app/app"
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+	blobtypes "github.com/sunrise-zone/sunrise-app/x/blob/types"
+	"github.com/sunrise-zone/sunrise-app/x/blobibc/types"
+)
+
+func init() {
+	cfg := sdk.GetConfig()
+	cfg.SetBech32PrefixForAccount(app.AccountAddressPrefix, app.AccountAddressPrefix+"pub")
+	cfg.SetBech32PrefixForValidator(app.AccountAddressPrefix+"valoper", app.AccountAddressPrefix+"valoperpub")
+	cfg.SetBech32PrefixForConsensusNode(app.AccountAddressPrefix+"valcons", app.AccountAddressPrefix+"valconspub")
+
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		a, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+		if err != nil {
+			panic(err)
+		}
+		return a, a.DefaultGenesis()
+	}
+}
+
+// setupBlobChannel opens a blobibc channel from the rollup chain to the DA
+// chain, and funds the fee payer of the sender of the rollup chain on the DA
+// chain.
+func setupBlobChannel(t *testing.T) (*ibctesting.Coordinator, *ibctesting.Path) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	rollup := coord.GetChain(ibctesting.GetChainID(1))
+	da := coord.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(rollup, da)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = types.PortID
+		endpoint.ChannelConfig.Version = types.Version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	coord.Setup(path)
+
+	daApp := da.App.(*app.App)
+	params := types.NewParams(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("0.002")), 2)
+	require.NoError(t, daApp.BlobIBCKeeper.SetParams(da.GetContext(), params))
+
+	feePayer := types.FeePayer(path.EndpointB.ChannelID, rollup.SenderAccount.GetAddress().String())
+	funds := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+	_, err := da.SendMsgs(banktypes.NewMsgSend(da.SenderAccount.GetAddress(), feePayer, funds))
+	require.NoError(t, err)
+	require.NoError(t, path.EndpointB.UpdateClient())
+	return coord, path
+}
+
+func TestSendBlob(t *testing.T) {
+	coord, path := setupBlobChannel(t)
+	rollup, da := path.EndpointA.Chain, path.EndpointB.Chain
+	rollupApp := rollup.App.(*app.App)
+	daApp := da.App.(*app.App)
+	sender := rollup.SenderAccount.GetAddress().String()
+	feePayer := types.FeePayer(path.EndpointB.ChannelID, sender)
+	ns := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	data := bytes.Repeat([]byte{2}, 100)
+
+	sendBlob := func(fee int64) channeltypes.Packet {
+		msg := types.NewMsgSendBlob(sender, path.EndpointA.ChannelID, ns.Bytes(), data, sdk.NewInt64Coin(sdk.DefaultBondDenom, fee), 0)
+		res, err := rollup.SendMsgs(msg)
+		require.NoError(t, err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+		require.NoError(t, err)
+		require.NoError(t, path.EndpointB.UpdateClient())
+		_, err = path.EndpointB.RecvPacketWithResult(packet)
+		require.NoError(t, err)
+		return packet
+	}
+
+	// the received blob is queued, and isn't acknowledged until included. The
+	// fee is charged to the fee payer of the sender.
+	packet := sendBlob(400)
+	_, found := daApp.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(da.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	require.False(t, found)
+	require.Equal(t, int64(600), daApp.BankKeeper.GetBalance(da.GetContext(), feePayer, sdk.DefaultBondDenom).Amount.Int64())
+
+	res, err := daApp.BlobIBCKeeper.PendingBlobs(da.GetContext(), &types.QueryPendingBlobsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.PendingBlobs, 1)
+	pending := res.PendingBlobs[0]
+	require.Equal(t, uint64(1), pending.Id)
+	require.Equal(t, ns.Bytes(), pending.Namespace)
+	require.Equal(t, data, pending.Data)
+
+	// the proposers include the blob with a PayForBlobs of the module.
+	rawTx, err := types.NewBlobTx(daApp.GetTxConfig(), pending, blobtypes.DefaultEstimateGas([]uint32{uint32(len(pending.Data))}))
+	require.NoError(t, err)
+	blobTx, isBlobTx := blob.UnmarshalBlobTx(rawTx)
+	require.True(t, isBlobTx)
+	require.NoError(t, blobtypes.ValidateBlobTx(daApp.GetTxConfig(), blobTx, appconsts.LatestVersion))
+	sdkTx, err := daApp.GetTxConfig().TxDecoder()(blobTx.Tx)
+	require.NoError(t, err)
+	id, ok := types.PendingBlobID(sdkTx)
+	require.True(t, ok)
+	require.Equal(t, pending.Id, id)
+	pfb := sdkTx.GetMsgs()[0].(*blobtypes.MsgPayForBlobs)
+	require.Equal(t, types.Signer().String(), pfb.Signer)
+
+	// the acknowledgement is written once the blob is included.
+	height := da.GetContext().BlockHeight()
+	require.NoError(t, daApp.BlobIBCKeeper.IncludeBlob(da.GetContext(), pending.Id, pfb.ShareCommitments[0]))
+	_, found = daApp.BlobIBCKeeper.GetPendingBlob(da.GetContext(), pending.Id)
+	require.False(t, found)
+	require.Error(t, daApp.BlobIBCKeeper.IncludeBlob(da.GetContext(), pending.Id, pfb.ShareCommitments[0]))
+	coord.CommitBlock(da)
+	require.NoError(t, path.EndpointA.UpdateClient())
+
+	ack := channeltypes.NewResultAcknowledgement(types.BlobAcknowledgement{
+		Height:          height,
+		ShareCommitment: pfb.ShareCommitments[0],
+	}.GetBytes())
+	require.NoError(t, path.EndpointA.AcknowledgePacket(packet, ack.Acknowledgement()))
+	require.False(t, rollupApp.IBCKeeper.ChannelKeeper.HasPacketCommitment(rollup.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence))
+
+	// the blobs with an insufficient fee are acknowledged with an error.
+	packet = sendBlob(1)
+	bz, found := daApp.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(da.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	require.True(t, found)
+	require.NotEqual(t, channeltypes.CommitAcknowledgement(ack.Acknowledgement()), bz)
+	require.Empty(t, daApp.BlobIBCKeeper.GetPendingBlobs(da.GetContext(), 0))
+
+	// the blobs exceeding the queue are acknowledged with an error.
+	sendBlob(200)
+	sendBlob(200)
+	packet = sendBlob(200)
+	_, found = daApp.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(da.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	require.True(t, found)
+	require.Len(t, daApp.BlobIBCKeeper.GetPendingBlobs(da.GetContext(), 0), 2)
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/sunrise-zone/sunrise-app/x/blobibc/types"
+)
+
+// Keeper queues the blobs received over IBC until the proposers include them
+// in a block, and sends the blobs posted to the counterparty chains.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	logger       log.Logger
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	ics4Wrapper  porttypes.ICS4Wrapper
+	portKeeper   types.PortKeeper
+	scopedKeeper types.ScopedKeeper
+	bankKeeper   types.BankKeeper
+	blobKeeper   types.BlobKeeper
+}
+
+// NewKeeper creates a new blobibc Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	logger log.Logger,
+	authority string,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	portKeeper types.PortKeeper,
+	scopedKeeper types.ScopedKeeper,
+	bankKeeper types.BankKeeper,
+	blobKeeper types.BlobKeeper,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
+	}
+
+	return Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		logger:       logger,
+		authority:    authority,
+		ics4Wrapper:  ics4Wrapper,
+		portKeeper:   portKeeper,
+		scopedKeeper: scopedKeeper,
+		bankKeeper:   bankKeeper,
+		blobKeeper:   blobKeeper,
+	}
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger() log.Logger {
+	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetPort returns the port identifier bound by the module.
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return string(store.Get([]byte(types.PortKey)))
+}
+
+// SetPort sets the port identifier bound by the module.
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set([]byte(types.PortKey), []byte(portID))
+}
+
+// IsBound returns true if the module already owns the capability of the port.
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort binds the port and claims its capability.
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// ClaimCapability claims the channel capability passed by the IBC module.
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
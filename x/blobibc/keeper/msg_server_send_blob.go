@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/blobibc/types"
+)
+
+func (k msgServer) SendBlob(goCtx context.Context, req *types.MsgSendBlob) (*types.MsgSendBlobResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sequence, err := k.Keeper.SendBlob(sdk.UnwrapSDKContext(goCtx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendBlobResponse{Sequence: sequence}, nil
+}
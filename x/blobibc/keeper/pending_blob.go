@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/blobibc/types"
+)
+
+// SetPendingBlob stores the pending blob.
+func (k Keeper) SetPendingBlob(ctx sdk.Context, pending types.PendingBlob) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.GetPendingBlobKey(pending.Id), k.cdc.MustMarshal(&pending))
+}
+
+// GetPendingBlob returns the pending blob with the provided ID.
+func (k Keeper) GetPendingBlob(ctx sdk.Context, id uint64) (types.PendingBlob, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.GetPendingBlobKey(id))
+	if bz == nil {
+		return types.PendingBlob{}, false
+	}
+	var pending types.PendingBlob
+	k.cdc.MustUnmarshal(bz, &pending)
+	return pending, true
+}
+
+// DeletePendingBlob removes the pending blob with the provided ID.
+func (k Keeper) DeletePendingBlob(ctx sdk.Context, id uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.GetPendingBlobKey(id))
+}
+
+// GetPendingBlobs returns up to limit pending blobs, in the order of the
+// queue. All the pending blobs are returned if limit is zero.
+func (k Keeper) GetPendingBlobs(ctx sdk.Context, limit int) []types.PendingBlob {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PendingBlobKeyPrefix))
+	defer iterator.Close()
+
+	pendingBlobs := []types.PendingBlob{}
+	for ; iterator.Valid() && (limit == 0 || len(pendingBlobs) < limit); iterator.Next() {
+		var pending types.PendingBlob
+		k.cdc.MustUnmarshal(iterator.Value(), &pending)
+		pendingBlobs = append(pendingBlobs, pending)
+	}
+	return pendingBlobs
+}
+
+// IteratePendingBlobs calls cb on the pending blobs, in the order of the
+// queue, until it returns true.
+func (k Keeper) IteratePendingBlobs(ctx sdk.Context, cb func(pending types.PendingBlob) (stop bool)) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PendingBlobKeyPrefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingBlob
+		k.cdc.MustUnmarshal(iterator.Value(), &pending)
+		if cb(pending) {
+			return
+		}
+	}
+}
+
+// countPendingBlobs returns the number of pending blobs, counting up to max.
+func (k Keeper) countPendingBlobs(ctx sdk.Context, max int) int {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PendingBlobKeyPrefix))
+	defer iterator.Close()
+
+	count := 0
+	for ; iterator.Valid() && count < max; iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// GetNextPendingBlobID returns the ID of the next received blob.
+func (k Keeper) GetNextPendingBlobID(ctx sdk.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get([]byte(types.NextPendingBlobIDKey))
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextPendingBlobID sets the ID of the next received blob.
+func (k Keeper) SetNextPendingBlobID(ctx sdk.Context, id uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set([]byte(types.NextPendingBlobIDKey), binary.BigEndian.AppendUint64(nil, id))
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sunrise-zone/sunrise-app/x/blobibc/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Keeper) PendingBlob(ctx context.Context, req *types.QueryPendingBlobRequest) (*types.QueryPendingBlobResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	pending, found := k.GetPendingBlob(sdk.UnwrapSDKContext(ctx), req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no pending blob %d", req.Id)
+	}
+	return &types.QueryPendingBlobResponse{PendingBlob: pending}, nil
+}
+
+func (k Keeper) PendingBlobs(ctx context.Context, req *types.QueryPendingBlobsRequest) (*types.QueryPendingBlobsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	store := prefix.NewStore(
+		runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)),
+		[]byte(types.PendingBlobKeyPrefix),
+	)
+	pendingBlobs := []types.PendingBlob{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pending types.PendingBlob
+		if err := k.cdc.Unmarshal(value, &pending); err != nil {
+			return err
+		}
+		pendingBlobs = append(pendingBlobs, pending)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPendingBlobsResponse{
+		PendingBlobs: pendingBlobs,
+		Pagination:   pageRes,
+	}, nil
+}
+
+func (k Keeper) FeePayer(_ context.Context, req *types.QueryFeePayerRequest) (*types.QueryFeePayerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Sender == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty sender")
+	}
+	return &types.QueryFeePayerResponse{Address: types.FeePayer(req.ChannelId, req.Sender).String()}, nil
+}
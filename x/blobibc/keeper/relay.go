@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/shares"
+	blobtypes "github.com/sunrise-zone/sunrise-app/x/blob/types"
+	"github.com/sunrise-zone/sunrise-app/x/blobibc/types"
+)
+
+// SendBlob sends the blob of the message to the counterparty chain of the
+// channel, and returns the sequence of the packet.
+func (k Keeper) SendBlob(ctx sdk.Context, msg *types.MsgSendBlob) (uint64, error) {
+	portID := k.GetPort(ctx)
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, msg.ChannelId))
+	if !ok {
+		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	timeout := msg.Timeout
+	if timeout == 0 {
+		timeout = types.DefaultRelativeTimeout
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(timeout).UnixNano())
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, portID, msg.ChannelId, clienttypes.ZeroHeight(), timeoutTimestamp, msg.PacketData().GetBytes())
+}
+
+// OnRecvPacket charges the fee of the received blob to the fee payer of the
+// sender, and queues the blob until a proposer includes it in a block. The
+// acknowledgement of the packet is written once the blob is included.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BlobPacketData) (uint64, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return 0, err
+	}
+	if size, max := len(data.Data), k.maxBlobSize(ctx); size > max {
+		return 0, errorsmod.Wrapf(types.ErrBlobTooLarge, "blob size %d exceeds max %d", size, max)
+	}
+	params := k.GetParams(ctx)
+	if k.countPendingBlobs(ctx, int(params.MaxPendingBlobs)) >= int(params.MaxPendingBlobs) {
+		return 0, errorsmod.Wrapf(types.ErrQueueFull, "%d blobs are pending", params.MaxPendingBlobs)
+	}
+
+	// the fee must cover the gas of a PayForBlobs paying for the blob, as
+	// estimated for the transactions of the users.
+	gas := blobtypes.GasToConsume([]uint32{uint32(len(data.Data))}, k.blobKeeper.GasPerBlobByte(ctx)) + blobtypes.PFBGasFixedCost
+	required := params.RequiredFee(gas)
+	if data.Fee.Denom != required.Denom || data.Fee.IsLT(required) {
+		return 0, errorsmod.Wrapf(types.ErrInsufficientFee, "got %s, required %s", data.Fee, required)
+	}
+	payer := types.FeePayer(packet.DestinationChannel, data.Sender)
+	if data.Fee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, authtypes.FeeCollectorName, sdk.NewCoins(data.Fee)); err != nil {
+			return 0, errorsmod.Wrapf(err, "fee payer %s", payer)
+		}
+	}
+
+	packetBz, err := packet.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	id := k.GetNextPendingBlobID(ctx)
+	k.SetPendingBlob(ctx, types.PendingBlob{
+		Id:        id,
+		Namespace: data.Namespace,
+		Data:      data.Data,
+		Packet:    packetBz,
+	})
+	k.SetNextPendingBlobID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBlobReceived,
+		sdk.NewAttribute(types.AttributeKeyPendingBlobID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+		sdk.NewAttribute(types.AttributeKeyChannelID, packet.DestinationChannel),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyNamespace, hex.EncodeToString(data.Namespace)),
+		sdk.NewAttribute(types.AttributeKeyBlobSize, strconv.Itoa(len(data.Data))),
+		sdk.NewAttribute(types.AttributeKeyFee, data.Fee.String()),
+	))
+	return id, nil
+}
+
+// IncludeBlob removes the pending blob included in the block, and writes the
+// acknowledgement of its packet with the height of the block and the share
+// commitment of the blob. The blob is removed even if the acknowledgement
+// can't be written, e.g. because the channel was closed.
+func (k Keeper) IncludeBlob(ctx sdk.Context, id uint64, shareCommitment []byte) error {
+	pending, found := k.GetPendingBlob(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrPendingBlobMissing, "pending blob %d", id)
+	}
+	k.DeletePendingBlob(ctx, id)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBlobIncluded,
+		sdk.NewAttribute(types.AttributeKeyPendingBlobID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyShareCommitment, hex.EncodeToString(shareCommitment)),
+	))
+
+	if err := k.writeAcknowledgement(ctx, pending, shareCommitment); err != nil {
+		k.Logger().Error("failed to write blob acknowledgement", "pending_blob_id", id, "err", err.Error())
+	}
+	return nil
+}
+
+func (k Keeper) writeAcknowledgement(ctx sdk.Context, pending types.PendingBlob, shareCommitment []byte) error {
+	packet, err := pending.ChannelPacket()
+	if err != nil {
+		return err
+	}
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(packet.DestinationPort, packet.DestinationChannel))
+	if !ok {
+		return errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+	ack := channeltypes.NewResultAcknowledgement(types.BlobAcknowledgement{
+		Height:          ctx.BlockHeight(),
+		ShareCommitment: shareCommitment,
+	}.GetBytes())
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// OnAcknowledgementPacket emits the result of the blob sent to the
+// counterparty chain.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BlobPacketData, ack channeltypes.Acknowledgement) error {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+		sdk.NewAttribute(types.AttributeKeyChannelID, packet.SourceChannel),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(ack.Success())),
+	}
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		var result types.BlobAcknowledgement
+		if err := types.ModuleCdc.UnmarshalJSON(resp.Result, &result); err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidPacket, "cannot unmarshal blob acknowledgement: %s", err)
+		}
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyShareCommitment, hex.EncodeToString(result.ShareCommitment)))
+	case *channeltypes.Acknowledgement_Error:
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, resp.Error))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeBlobAcknowledged, attributes...))
+	return nil
+}
+
+// OnTimeoutPacket emits the timeout of the blob sent to the counterparty
+// chain. The fee is only charged on the counterparty chain, so there is
+// nothing to refund.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.BlobPacketData) error {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBlobTimeout,
+		sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+		sdk.NewAttribute(types.AttributeKeyChannelID, packet.SourceChannel),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+	))
+	return nil
+}
+
+// maxBlobSize returns the size of the largest blob fitting in the largest
+// square along with the PayForBlobs paying for it.
+func (k Keeper) maxBlobSize(ctx sdk.Context) int {
+	squareSize := min(appconsts.SquareSizeUpperBound(ctx.BlockHeader().Version.App), int(k.blobKeeper.GovMaxSquareSize(ctx)))
+	return shares.AvailableBytesFromSparseShares(squareSize*squareSize - 1)
+}
@@ -0,0 +1,21 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/blobibc module sentinel errors
+var (
+	ErrInvalidSigner      = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidVersion     = sdkerrors.Register(ModuleName, 2, "invalid blobibc version")
+	ErrInvalidPacket      = sdkerrors.Register(ModuleName, 3, "invalid blob packet")
+	ErrInsufficientFee    = sdkerrors.Register(ModuleName, 4, "insufficient fee")
+	ErrBlobTooLarge       = sdkerrors.Register(ModuleName, 5, "blob too large")
+	ErrQueueFull          = sdkerrors.Register(ModuleName, 6, "pending blob queue is full")
+	ErrPendingBlobMissing = sdkerrors.Register(ModuleName, 7, "pending blob not found")
+	ErrInvalidParams      = sdkerrors.Register(ModuleName, 8, "invalid params")
+	ErrInvalidTimeout     = sdkerrors.Register(ModuleName, 9, "invalid timeout")
+	ErrDuplicate          = sdkerrors.Register(ModuleName, 10, "duplicate")
+)
@@ -0,0 +1,20 @@
+package types
+
+// blobibc events
+const (
+	EventTypeBlobReceived     = "blob_received"
+	EventTypeBlobIncluded     = "blob_included"
+	EventTypeBlobAcknowledged = "blob_acknowledged"
+	EventTypeBlobTimeout      = "blob_timeout"
+
+	AttributeKeyPendingBlobID   = "pending_blob_id"
+	AttributeKeySender          = "sender"
+	AttributeKeyChannelID       = "channel_id"
+	AttributeKeySequence        = "sequence"
+	AttributeKeyNamespace       = "namespace"
+	AttributeKeyBlobSize        = "blob_size"
+	AttributeKeyFee             = "fee"
+	AttributeKeyShareCommitment = "share_commitment"
+	AttributeKeySuccess         = "success"
+	AttributeKeyError           = "error"
+)
@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		PortId:            PortID,
+		Params:            DefaultParams(),
+		PendingBlobs:      []PendingBlob{},
+		NextPendingBlobId: 1,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	ids := make(map[uint64]struct{}, len(gs.PendingBlobs))
+	for _, pending := range gs.PendingBlobs {
+		if _, ok := ids[pending.Id]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "pending blob %d", pending.Id)
+		}
+		ids[pending.Id] = struct{}{}
+		if pending.Id >= gs.NextPendingBlobId {
+			return errorsmod.Wrapf(ErrInvalidPacket, "pending blob %d not below the next ID %d", pending.Id, gs.NextPendingBlobId)
+		}
+		if err := pending.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
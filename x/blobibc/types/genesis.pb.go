@@ -0,0 +1,478 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sunrise/blobibc/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the blobibc module's genesis state.
+type GenesisState struct {
+	PortId       string        `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	Params       Params        `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	PendingBlobs []PendingBlob `protobuf:"bytes,3,rep,name=pending_blobs,json=pendingBlobs,proto3" json:"pending_blobs"`
+	// next_pending_blob_id is the ID of the next received blob.
+	NextPendingBlobId uint64 `protobuf:"varint,4,opt,name=next_pending_blob_id,json=nextPendingBlobId,proto3" json:"next_pending_blob_id,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c27135505ed71b3f, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetPendingBlobs() []PendingBlob {
+	if m != nil {
+		return m.PendingBlobs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextPendingBlobId() uint64 {
+	if m != nil {
+		return m.NextPendingBlobId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "sunrise.blobibc.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("sunrise/blobibc/v1/genesis.proto", fileDescriptor_c27135505ed71b3f) }
+
+var fileDescriptor_c27135505ed71b3f = []byte{
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x3f, 0x4b, 0x3b, 0x31,
+	0x18, 0xc7, 0x2f, 0xbf, 0x96, 0xfe, 0x68, 0x5a, 0x87, 0x86, 0x82, 0xa5, 0x43, 0x7a, 0x38, 0x1d,
+	0x82, 0x09, 0x6d, 0x67, 0x97, 0x2e, 0xd2, 0x41, 0x94, 0xba, 0xb9, 0x1c, 0x97, 0x5e, 0x38, 0x03,
+	0xbd, 0x24, 0x5c, 0xd2, 0x52, 0x7d, 0x15, 0xbe, 0x0c, 0x47, 0x5f, 0x46, 0xc7, 0x8e, 0x4e, 0x22,
+	0x77, 0x83, 0xbe, 0x0c, 0xb9, 0x3f, 0xd6, 0x03, 0xbb, 0x84, 0xe7, 0x79, 0xf2, 0xc9, 0x27, 0x5f,
+	0x1e, 0xe8, 0x9a, 0xb5, 0x4c, 0x84, 0xe1, 0x94, 0xad, 0x14, 0x13, 0x6c, 0x49, 0x37, 0x63, 0x1a,
+	0x71, 0xc9, 0x8d, 0x30, 0x44, 0x27, 0xca, 0x2a, 0x84, 0x2a, 0x82, 0x54, 0x04, 0xd9, 0x8c, 0x87,
+	0xbd, 0x20, 0x16, 0x52, 0xd1, 0xe2, 0x2c, 0xb1, 0x61, 0x3f, 0x52, 0x91, 0x2a, 0x4a, 0x9a, 0x57,
+	0xd5, 0xf4, 0x98, 0xfe, 0xc7, 0x53, 0x10, 0x67, 0x5f, 0x00, 0x76, 0xaf, 0xca, 0x0f, 0xef, 0x6c,
+	0x60, 0x39, 0x3a, 0x85, 0xff, 0xb5, 0x4a, 0xac, 0x2f, 0xc2, 0x01, 0x70, 0x81, 0xd7, 0x5e, 0xb4,
+	0xf2, 0x76, 0x1e, 0xa2, 0x4b, 0xd8, 0xd2, 0x41, 0x12, 0xc4, 0x66, 0xf0, 0xcf, 0x05, 0x5e, 0x67,
+	0x32, 0x24, 0x7f, 0x93, 0x91, 0xdb, 0x82, 0x98, 0xb5, 0x77, 0xef, 0x23, 0xe7, 0xe5, 0xf3, 0xf5,
+	0x1c, 0x2c, 0xaa, 0x47, 0xe8, 0x06, 0x9e, 0x68, 0x2e, 0x43, 0x21, 0x23, 0x3f, 0xe7, 0xcd, 0xa0,
+	0xe1, 0x36, 0xbc, 0xce, 0x64, 0x74, 0xd4, 0x52, 0x82, 0xb3, 0x95, 0x62, 0x75, 0x55, 0x57, 0xff,
+	0xce, 0x0d, 0xa2, 0xb0, 0x2f, 0xf9, 0xd6, 0xfa, 0x75, 0x6b, 0x9e, 0xba, 0xe9, 0x02, 0xaf, 0xb9,
+	0xe8, 0xe5, 0x77, 0x35, 0xcf, 0x3c, 0x9c, 0x5d, 0xef, 0x52, 0x0c, 0xf6, 0x29, 0x06, 0x1f, 0x29,
+	0x06, 0xcf, 0x19, 0x76, 0xf6, 0x19, 0x76, 0xde, 0x32, 0xec, 0xdc, 0x4f, 0x23, 0x61, 0x1f, 0xd6,
+	0x8c, 0x2c, 0x55, 0x4c, 0xab, 0x38, 0x17, 0x4f, 0x4a, 0xf2, 0x43, 0x13, 0x68, 0x4d, 0xb7, 0x87,
+	0x25, 0xda, 0x47, 0xcd, 0x0d, 0x6b, 0x15, 0x0b, 0x9c, 0x7e, 0x0f, 0x00, 0x6c, 0xd0, 0x8c, 0xd8,
+	0xc3, 0x01, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextPendingBlobId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPendingBlobId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PendingBlobs) > 0 {
+		for iNdEx := len(m.PendingBlobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingBlobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingBlobs) > 0 {
+		for _, e := range m.PendingBlobs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPendingBlobId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPendingBlobId))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBlobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingBlobs = append(m.PendingBlobs, PendingBlob{})
+			if err := m.PendingBlobs[len(m.PendingBlobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPendingBlobId", wireType)
+			}
+			m.NextPendingBlobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPendingBlobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+	"github.com/sunrise-zone/sunrise-app/x/blobibc/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	ns := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	packet := channeltypes.NewPacket([]byte("data"), 1, types.PortID, "channel-0", types.PortID, "channel-1", clienttypes.ZeroHeight(), 100)
+	packetBz, err := packet.Marshal()
+	require.NoError(t, err)
+	valid := types.PendingBlob{Id: 1, Namespace: ns.Bytes(), Data: []byte("blob"), Packet: packetBz}
+
+	for _, tc := range []struct {
+		name    string
+		genesis func(*types.GenesisState)
+		err     error
+	}{
+		{"default", func(*types.GenesisState) {}, nil},
+		{"valid", func(gs *types.GenesisState) {
+			gs.PendingBlobs = []types.PendingBlob{valid}
+			gs.NextPendingBlobId = 2
+		}, nil},
+		{"duplicate pending blob", func(gs *types.GenesisState) {
+			gs.PendingBlobs = []types.PendingBlob{valid, valid}
+			gs.NextPendingBlobId = 2
+		}, types.ErrDuplicate},
+		{"id not below next id", func(gs *types.GenesisState) {
+			gs.PendingBlobs = []types.PendingBlob{valid}
+		}, types.ErrInvalidPacket},
+		{"reserved namespace", func(gs *types.GenesisState) {
+			invalid := valid
+			invalid.Namespace = appns.TxNamespace.Bytes()
+			gs.PendingBlobs = []types.PendingBlob{invalid}
+			gs.NextPendingBlobId = 2
+		}, types.ErrInvalidPacket},
+		{"invalid packet", func(gs *types.GenesisState) {
+			invalid := valid
+			invalid.Packet = []byte{1}
+			gs.PendingBlobs = []types.PendingBlob{invalid}
+			gs.NextPendingBlobId = 2
+		}, types.ErrInvalidPacket},
+		{"invalid params", func(gs *types.GenesisState) {
+			gs.Params.GasPrice = sdk.DecCoin{Denom: "usr", Amount: math.LegacyOneDec().Neg()}
+		}, types.ErrInvalidParams},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.DefaultGenesis()
+			tc.genesis(gs)
+			err := gs.Validate()
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "blobibc"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// PortID is the default port identifier that the module binds to.
+	PortID = ModuleName
+
+	// Version defines the current version of the blobibc application.
+	Version = "blobibc-1"
+)
+
+const (
+	// PortKey stores the port identifier bound by the module.
+	PortKey = "Port"
+
+	// ParamsKey stores the module parameters.
+	ParamsKey = "Params"
+
+	// PendingBlobKeyPrefix indexes the PendingBlob by ID, in the order of the
+	// queue.
+	PendingBlobKeyPrefix = "PendingBlob/"
+
+	// NextPendingBlobIDKey stores the ID of the next received blob.
+	NextPendingBlobIDKey = "NextPendingBlobID"
+)
+
+// DefaultRelativeTimeout is the timeout of the sent packets, relative to the
+// block time, when MsgSendBlob doesn't set one.
+const DefaultRelativeTimeout = 10 * time.Minute
+
+// GetPendingBlobKey returns the key of the pending blob with the provided ID.
+func GetPendingBlobKey(id uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(PendingBlobKeyPrefix), id)
+}
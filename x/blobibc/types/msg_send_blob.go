@@ -0,0 +1,45 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var _ sdk.Msg = &MsgSendBlob{}
+
+// NewMsgSendBlob creates a new MsgSendBlob instance.
+func NewMsgSendBlob(sender, channelID string, namespace, data []byte, fee sdk.Coin, timeout time.Duration) *MsgSendBlob {
+	return &MsgSendBlob{
+		Sender:    sender,
+		ChannelId: channelID,
+		Namespace: namespace,
+		Data:      data,
+		Fee:       fee,
+		Timeout:   timeout,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSendBlob) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+		return err
+	}
+	if err := m.PacketData().ValidateBasic(); err != nil {
+		return err
+	}
+	if m.Timeout < 0 {
+		return errorsmod.Wrapf(ErrInvalidTimeout, "negative timeout %s", m.Timeout)
+	}
+	return nil
+}
+
+// PacketData returns the data of the packet sent for the message.
+func (m *MsgSendBlob) PacketData() BlobPacketData {
+	return NewBlobPacketData(m.Namespace, m.Data, m.Fee, m.Sender)
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+	blobtypes "github.com/sunrise-zone/sunrise-app/x/blob/types"
+)
+
+// NewBlobPacketData creates a new BlobPacketData instance.
+func NewBlobPacketData(namespace, data []byte, fee sdk.Coin, sender string) BlobPacketData {
+	return BlobPacketData{
+		Namespace: namespace,
+		Data:      data,
+		Fee:       fee,
+		Sender:    sender,
+	}
+}
+
+// ValidateBasic performs the stateless checks of the packet data.
+func (d BlobPacketData) ValidateBasic() error {
+	if _, err := d.Blob(); err != nil {
+		return errorsmod.Wrap(ErrInvalidPacket, err.Error())
+	}
+	if err := d.Fee.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPacket, "invalid fee: %s", err)
+	}
+	if d.Sender == "" {
+		return errorsmod.Wrap(ErrInvalidPacket, "empty sender")
+	}
+	return nil
+}
+
+// Blob returns the blob of the packet, with the share version zero.
+func (d BlobPacketData) Blob() (*blob.Blob, error) {
+	ns, err := appns.From(d.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	b, err := blobtypes.NewBlob(ns, d.Data, appconsts.ShareVersionZero)
+	if err != nil {
+		return nil, err
+	}
+	return b, blobtypes.ValidateBlobs(b)
+}
+
+// GetBytes returns the JSON encoding of the packet data.
+func (d BlobPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&d))
+}
+
+// GetBytes returns the JSON encoding of the acknowledgement.
+func (a BlobAcknowledgement) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&a))
+}
+
+// FeePayer returns the account paying the fees of the blobs posted by the
+// sender over the receiving channel. The sender funds it, e.g. with a
+// transfer, before posting blobs.
+func FeePayer(channelID, sender string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(channelID+"/"+sender))
+}
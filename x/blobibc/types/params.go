@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+)
+
+// DefaultMaxPendingBlobs is the default maximum number of received blobs
+// waiting to be included.
+const DefaultMaxPendingBlobs = 100
+
+// NewParams creates a new Params instance
+func NewParams(gasPrice sdk.DecCoin, maxPendingBlobs uint32) Params {
+	return Params{
+		GasPrice:        gasPrice,
+		MaxPendingBlobs: maxPendingBlobs,
+	}
+}
+
+// DefaultParams returns a default set of parameters. The default gas price is
+// the default minimum gas price of the validators.
+func DefaultParams() Params {
+	return NewParams(
+		sdk.NewDecCoinFromDec(appconsts.BondDenom, math.LegacyMustNewDecFromStr("0.002")),
+		DefaultMaxPendingBlobs,
+	)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := p.GasPrice.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid gas price: %s", err)
+	}
+	if p.MaxPendingBlobs == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "max pending blobs must be positive")
+	}
+	return nil
+}
+
+// RequiredFee returns the minimum fee of the PayForBlobs consuming the gas.
+func (p Params) RequiredFee(gas uint64) sdk.Coin {
+	amount := p.GasPrice.Amount.MulInt64(int64(gas)).Ceil().TruncateInt()
+	return sdk.NewCoin(p.GasPrice.Denom, amount)
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/sunrise-zone/sunrise-app/pkg/appconsts"
+	"github.com/sunrise-zone/sunrise-app/pkg/blob"
+	appns "github.com/sunrise-zone/sunrise-app/pkg/namespace"
+	blobtypes "github.com/sunrise-zone/sunrise-app/x/blob/types"
+)
+
+// pendingBlobMemoPrefix prefixes the ID of the pending blob in the memo of
+// the transaction paying for it.
+const pendingBlobMemoPrefix = ModuleName + "/"
+
+// Blob returns the blob of the pending blob.
+func (b PendingBlob) Blob() (*blob.Blob, error) {
+	ns, err := appns.From(b.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return blobtypes.NewBlob(ns, b.Data, appconsts.ShareVersionZero)
+}
+
+// ChannelPacket returns the received packet of the pending blob.
+func (b PendingBlob) ChannelPacket() (channeltypes.Packet, error) {
+	var packet channeltypes.Packet
+	if err := packet.Unmarshal(b.Packet); err != nil {
+		return channeltypes.Packet{}, err
+	}
+	return packet, nil
+}
+
+// Validate performs a basic validation of the pending blob.
+func (b PendingBlob) Validate() error {
+	bl, err := b.Blob()
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidPacket, "pending blob %d: %s", b.Id, err)
+	}
+	if err := blobtypes.ValidateBlobs(bl); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPacket, "pending blob %d: %s", b.Id, err)
+	}
+	packet, err := b.ChannelPacket()
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidPacket, "pending blob %d: %s", b.Id, err)
+	}
+	if err := packet.ValidateBasic(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPacket, "pending blob %d: %s", b.Id, err)
+	}
+	return nil
+}
+
+// Signer returns the signer of the PayForBlobs of the pending blobs, the
+// module account.
+func Signer() sdk.AccAddress {
+	return authtypes.NewModuleAddress(ModuleName)
+}
+
+// NewBlobTx returns the encoded blob transaction paying for the pending blob,
+// which the proposer includes in the block. The transaction holds a single
+// PayForBlobs signed by the module account, without signatures nor fee as the
+// fee was paid when the blob was received, the gas limit and the ID of the
+// pending blob in its memo.
+func NewBlobTx(txConfig client.TxConfig, pending PendingBlob, gasLimit uint64) ([]byte, error) {
+	b, err := pending.Blob()
+	if err != nil {
+		return nil, err
+	}
+	msg, err := blobtypes.NewMsgPayForBlobs(Signer().String(), b)
+	if err != nil {
+		return nil, err
+	}
+	builder := txConfig.NewTxBuilder()
+	if err := builder.SetMsgs(msg); err != nil {
+		return nil, err
+	}
+	builder.SetGasLimit(gasLimit)
+	builder.SetMemo(fmt.Sprintf("%s%d", pendingBlobMemoPrefix, pending.Id))
+	tx, err := txConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	return blob.MarshalBlobTx(tx, b)
+}
+
+// PendingBlobID returns the ID of the pending blob paid by the transaction, if
+// it is the transaction of a pending blob, i.e. it holds a single PayForBlobs
+// signed by the module account.
+func PendingBlobID(tx sdk.Tx) (uint64, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return 0, false
+	}
+	pfb, ok := msgs[0].(*blobtypes.MsgPayForBlobs)
+	if !ok || pfb.Signer != Signer().String() {
+		return 0, false
+	}
+	memoTx, ok := tx.(sdk.TxWithMemo)
+	if !ok || !strings.HasPrefix(memoTx.GetMemo(), pendingBlobMemoPrefix) {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(strings.TrimPrefix(memoTx.GetMemo(), pendingBlobMemoPrefix), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
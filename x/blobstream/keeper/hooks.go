@@ -50,7 +50,7 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, addr sdk.ValAddress) error
 	if !h.k.IsEVMAddressUnique(ctx, defaultEvmAddr) {
 		return errors.Wrapf(types.ErrEVMAddressAlreadyExists, "create a validator with a different operator address to %s (pubkey collision)", addr.String())
 	}
-	h.k.SetEVMAddress(ctx, addr, defaultEvmAddr)
+	h.k.InitEVMAddress(ctx, addr, defaultEvmAddr)
 	return nil
 }
 
@@ -2,6 +2,10 @@ package keeper
 
 import (
 	"fmt"
+	"math"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
 
@@ -14,6 +18,9 @@ import (
 func (k Keeper) SetAttestationRequest(ctx sdk.Context, at types.AttestationRequestI) error {
 	k.StoreAttestation(ctx, at)
 	k.SetLatestAttestationNonce(ctx, at.GetNonce())
+	if valset, ok := at.(*types.Valset); ok {
+		k.ActivatePendingEVMAddresses(ctx, *valset)
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -25,12 +32,19 @@ func (k Keeper) SetAttestationRequest(ctx sdk.Context, at types.AttestationReque
 	return nil
 }
 
-// StoreAttestation saves the attestation in store. Should panic if overwriting
-// existing one.
+// StoreAttestation saves the attestation in store, and indexes it by type and
+// by the current block height. Should panic if overwriting existing one.
 func (k Keeper) StoreAttestation(ctx sdk.Context, at types.AttestationRequestI) {
+	k.StoreAttestationAtHeight(ctx, at, uint64(ctx.BlockHeight()))
+}
+
+// StoreAttestationAtHeight saves the attestation in store, and indexes it by
+// type and by the provided height, which is the height at which it was
+// requested. Should panic if overwriting existing one.
+func (k Keeper) StoreAttestationAtHeight(ctx sdk.Context, at types.AttestationRequestI, height uint64) {
 	nonce := at.GetNonce()
 	key := []byte(types.GetAttestationKey(nonce))
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx.Context()))
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 
 	if store.Has(key) {
 		panic("trying to overwrite existing attestation request")
@@ -41,6 +55,48 @@ func (k Keeper) StoreAttestation(ctx sdk.Context, at types.AttestationRequestI)
 		panic(err)
 	}
 	store.Set((key), b)
+	k.setAttestationIndexes(ctx, at, height)
+}
+
+// GetAttestationHeight returns the height at which the attestation was
+// requested, as recorded in its type index entry. Returns false if the
+// attestation isn't indexed.
+func (k Keeper) GetAttestationHeight(ctx sdk.Context, at types.AttestationRequestI) (uint64, bool) {
+	attestationType, err := types.AttestationTypeOf(at)
+	if err != nil {
+		return 0, false
+	}
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get([]byte(types.GetAttestationTypeIndexKey(attestationType, at.GetNonce())))
+	if bz == nil {
+		return 0, false
+	}
+	return UInt64FromBytes(bz), true
+}
+
+// setAttestationIndexes indexes the attestation nonce by attestation type and
+// by the provided height. The type index entry holds the height so that the
+// height index entry can be found when the attestation is deleted.
+func (k Keeper) setAttestationIndexes(ctx sdk.Context, at types.AttestationRequestI, height uint64) {
+	attestationType, err := types.AttestationTypeOf(at)
+	if err != nil {
+		panic(err)
+	}
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set([]byte(types.GetAttestationTypeIndexKey(attestationType, at.GetNonce())), types.UInt64Bytes(height))
+	store.Set([]byte(types.GetAttestationHeightIndexKey(height, at.GetNonce())), []byte{})
+}
+
+// deleteAttestationIndexes deletes the index entries of the attestation.
+func (k Keeper) deleteAttestationIndexes(ctx sdk.Context, attestationType types.AttestationType, nonce uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	typeKey := []byte(types.GetAttestationTypeIndexKey(attestationType, nonce))
+	bz := store.Get(typeKey)
+	if bz == nil {
+		return
+	}
+	store.Delete(typeKey)
+	store.Delete([]byte(types.GetAttestationHeightIndexKey(UInt64FromBytes(bz), nonce)))
 }
 
 // SetLatestAttestationNonce sets the latest attestation request nonce, since
@@ -54,14 +110,14 @@ func (k Keeper) SetLatestAttestationNonce(ctx sdk.Context, nonce uint64) {
 		panic("not incrementing latest attestation nonce correctly")
 	}
 
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx.Context()))
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store.Set([]byte(types.LatestAttestationNonce), types.UInt64Bytes(nonce))
 }
 
 // CheckLatestAttestationNonce returns true if the latest attestation request
 // nonce is declared in the store and false if it has not been initialized.
 func (k Keeper) CheckLatestAttestationNonce(ctx sdk.Context) bool {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx.Context()))
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	has := store.Has([]byte(types.LatestAttestationNonce))
 	return has
 }
@@ -72,7 +128,7 @@ func (k Keeper) CheckLatestAttestationNonce(ctx sdk.Context) bool {
 // To check if this value exists in store, use the `CheckLatestAttestationNonce`
 // method.
 func (k Keeper) GetLatestAttestationNonce(ctx sdk.Context) uint64 {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx.Context()))
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	bytes := store.Get([]byte(types.LatestAttestationNonce))
 	if bytes == nil {
 		panic("nil LatestAttestationNonce")
@@ -83,7 +139,7 @@ func (k Keeper) GetLatestAttestationNonce(ctx sdk.Context) uint64 {
 // CheckEarliestAvailableAttestationNonce returns true if the earliest available
 // attestation nonce has been initialized in store, and false if not.
 func (k Keeper) CheckEarliestAvailableAttestationNonce(ctx sdk.Context) bool {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx.Context()))
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	has := store.Has([]byte(types.EarliestAvailableAttestationNonce))
 	return has
 }
@@ -95,7 +151,7 @@ func (k Keeper) CheckEarliestAvailableAttestationNonce(ctx sdk.Context) bool {
 // won't be written to store until height = 1. To check if this value exists in
 // store, use the `CheckEarliestAvailableAttestationNonce` method.
 func (k Keeper) GetEarliestAvailableAttestationNonce(ctx sdk.Context) uint64 {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx.Context()))
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	bytes := store.Get([]byte(types.EarliestAvailableAttestationNonce))
 	if bytes == nil {
 		panic("nil earliest available attestation nonce")
@@ -107,14 +163,14 @@ func (k Keeper) GetEarliestAvailableAttestationNonce(ctx sdk.Context) uint64 {
 // nonce. The nonce is of the earliest available attestation in store that can
 // be retrieved.
 func (k Keeper) SetEarliestAvailableAttestationNonce(ctx sdk.Context, nonce uint64) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx.Context()))
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store.Set([]byte(types.EarliestAvailableAttestationNonce), types.UInt64Bytes(nonce))
 }
 
 // GetAttestationByNonce returns an attestation request by nonce. Returns (nil,
 // false, nil) if the attestation is not found.
 func (k Keeper) GetAttestationByNonce(ctx sdk.Context, nonce uint64) (types.AttestationRequestI, bool, error) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx.Context()))
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	bz := store.Get([]byte(types.GetAttestationKey(nonce)))
 	if bz == nil {
 		return nil, false, nil
@@ -127,15 +183,153 @@ func (k Keeper) GetAttestationByNonce(ctx sdk.Context, nonce uint64) (types.Atte
 	return at, true, nil
 }
 
-// DeleteAttestation deletes an attestation from state. Will do nothing if the
-// attestation doesn't exist in store.
+// DeleteAttestation deletes an attestation, along with its signatures, from
+// state. Will do nothing if the attestation doesn't exist in store.
 func (k Keeper) DeleteAttestation(ctx sdk.Context, nonce uint64) {
 	key := []byte(types.GetAttestationKey(nonce))
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx.Context()))
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	if !store.Has(key) {
 		// if the store doesn't have the needed attestation, then no need to do
 		// anything.
 		return
 	}
 	store.Delete(key)
+	// the type of the attestation is not known without decoding it, so the
+	// entries of both types are looked up.
+	k.deleteAttestationIndexes(ctx, types.ATTESTATION_TYPE_VALSET, nonce)
+	k.deleteAttestationIndexes(ctx, types.ATTESTATION_TYPE_DATA_COMMITMENT, nonce)
+	k.DeleteAttestationSignatures(ctx, nonce)
+}
+
+// GetAllAttestations returns all the attestations available in store, i.e.
+// the ones between the earliest available attestation nonce and the latest
+// attestation nonce, ordered by nonce.
+func (k Keeper) GetAllAttestations(ctx sdk.Context) ([]types.AttestationRequestI, error) {
+	if !k.CheckLatestAttestationNonce(ctx) {
+		return nil, nil
+	}
+	if !k.CheckEarliestAvailableAttestationNonce(ctx) {
+		return nil, types.ErrEarliestAvailableNonceStillNotInitialized
+	}
+	latestNonce := k.GetLatestAttestationNonce(ctx)
+	earliestNonce := k.GetEarliestAvailableAttestationNonce(ctx)
+	var attestations []types.AttestationRequestI
+	for nonce := earliestNonce; nonce <= latestNonce; nonce++ {
+		at, found, err := k.GetAttestationByNonce(ctx, nonce)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, errors.Wrapf(types.ErrAttestationNotFound, "nonce %d", nonce)
+		}
+		attestations = append(attestations, at)
+	}
+	return attestations, nil
+}
+
+// GetAttestationsFromNonce returns up to limit attestations, ordered by nonce,
+// starting from the provided nonce. If the attestation type is not
+// unspecified, only the attestations of that type are returned. Also returns
+// the nonce of the next attestation to query, or zero if there are none.
+func (k Keeper) GetAttestationsFromNonce(
+	ctx sdk.Context,
+	fromNonce uint64,
+	limit uint64,
+	attestationType types.AttestationType,
+) ([]types.AttestationRequestI, uint64, error) {
+	prefix := []byte(types.AttestationRequestKey)
+	if attestationType != types.ATTESTATION_TYPE_UNSPECIFIED {
+		prefix = []byte(types.GetAttestationTypeIndexPrefix(attestationType))
+	}
+	nonces := k.iterateNonces(ctx, prefix, fromNonce, math.MaxUint64, limit+1)
+
+	var nextNonce uint64
+	if uint64(len(nonces)) > limit {
+		nextNonce = nonces[limit]
+		nonces = nonces[:limit]
+	}
+	attestations := make([]types.AttestationRequestI, 0, len(nonces))
+	for _, nonce := range nonces {
+		at, found, err := k.GetAttestationByNonce(ctx, nonce)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !found {
+			return nil, 0, errors.Wrapf(types.ErrAttestationNotFound, "nonce %d", nonce)
+		}
+		attestations = append(attestations, at)
+	}
+	return attestations, nextNonce, nil
+}
+
+// GetValsetsInRange returns up to limit valsets, ordered by nonce, whose nonces
+// are between the provided nonces, inclusive. Also returns the nonce of the
+// next valset in the range, or zero if there are none.
+func (k Keeper) GetValsetsInRange(ctx sdk.Context, fromNonce, toNonce, limit uint64) ([]types.Valset, uint64, error) {
+	if fromNonce > toNonce {
+		return nil, 0, errors.Wrapf(types.ErrInvalidNonceRange, "from nonce %d is higher than to nonce %d", fromNonce, toNonce)
+	}
+	prefix := []byte(types.GetAttestationTypeIndexPrefix(types.ATTESTATION_TYPE_VALSET))
+	nonces := k.iterateNonces(ctx, prefix, fromNonce, toNonce, limit+1)
+
+	var nextNonce uint64
+	if uint64(len(nonces)) > limit {
+		nextNonce = nonces[limit]
+		nonces = nonces[:limit]
+	}
+	valsets := make([]types.Valset, 0, len(nonces))
+	for _, nonce := range nonces {
+		at, found, err := k.GetAttestationByNonce(ctx, nonce)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !found {
+			return nil, 0, errors.Wrapf(types.ErrAttestationNotFound, "nonce %d", nonce)
+		}
+		valset, ok := at.(*types.Valset)
+		if !ok {
+			return nil, 0, errors.Wrap(types.ErrAttestationNotValsetRequest, "couldn't cast attestation to valset")
+		}
+		valsets = append(valsets, *valset)
+	}
+	return valsets, nextNonce, nil
+}
+
+// GetAttestationNonceForHeight returns the nonce of the latest attestation
+// requested at or before the provided height. Returns false if no attestation
+// was requested at or before that height, or if they were all pruned.
+func (k Keeper) GetAttestationNonceForHeight(ctx sdk.Context, height uint64) (uint64, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix := []byte(types.AttestationHeightIndexKey)
+	end := storetypes.PrefixEndBytes(prefix)
+	if height < math.MaxUint64 {
+		end = []byte(types.GetAttestationHeightIndexKey(height+1, 0))
+	}
+	iterator := store.ReverseIterator(prefix, end)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 0, false
+	}
+	key := iterator.Key()
+	return UInt64FromBytes(key[len(key)-8:]), true
+}
+
+// iterateNonces returns up to limit nonces between the provided nonces,
+// inclusive, from the keys under the provided prefix, which must be suffixed
+// by the big endian encoding of the nonce.
+func (k Keeper) iterateNonces(ctx sdk.Context, prefix []byte, fromNonce, toNonce, limit uint64) []uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	start := append(append([]byte{}, prefix...), types.UInt64Bytes(fromNonce)...)
+	end := storetypes.PrefixEndBytes(prefix)
+	if toNonce < math.MaxUint64 {
+		end = append(append([]byte{}, prefix...), types.UInt64Bytes(toNonce+1)...)
+	}
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	var nonces []uint64
+	for ; iterator.Valid() && uint64(len(nonces)) < limit; iterator.Next() {
+		nonces = append(nonces, UInt64FromBytes(iterator.Key()[len(prefix):]))
+	}
+	return nonces
 }
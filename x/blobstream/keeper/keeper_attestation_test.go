@@ -1,12 +1,20 @@
 package keeper_test
 
 import (
+	"math"
 	"testing"
 
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	testutil "github.com/sunrise-zone/sunrise-app/test/util"
+	keepertest "github.com/sunrise-zone/sunrise-app/testutil/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/keeper"
 	blobstream "github.com/sunrise-zone/sunrise-app/x/blobstream/module"
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCheckLatestAttestationNonce(t *testing.T) {
@@ -40,3 +48,178 @@ func TestCheckEarliestAvailableAttestationNonce(t *testing.T) {
 	input.BlobstreamKeeper.CheckEarliestAvailableAttestationNonce(input.Context)
 	assert.Equal(t, blobstream.InitialEarliestAvailableAttestationNonce, input.BlobstreamKeeper.GetEarliestAvailableAttestationNonce(input.Context))
 }
+
+// setupIndexedAttestations requests a valset at nonce 1 and height 10, data
+// commitments at nonces 2 and 3 and height 20, a valset at nonce 4 and height
+// 30 and a data commitment at nonce 5 and height 40.
+func setupIndexedAttestations(t *testing.T) (keeper.Keeper, sdk.Context) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+
+	valset := func(nonce uint64, height int64) types.AttestationRequestI {
+		vs, err := types.NewValset(nonce, uint64(height), types.InternalBridgeValidators{
+			{Power: 1, EVMAddress: testutil.EVMAddrs[0]},
+		}, ctx.BlockTime())
+		require.NoError(t, err)
+		return vs
+	}
+	requests := []struct {
+		height int64
+		at     types.AttestationRequestI
+	}{
+		{10, valset(1, 10)},
+		{20, types.NewDataCommitment(2, 1, 11, ctx.BlockTime())},
+		{20, types.NewDataCommitment(3, 11, 21, ctx.BlockTime())},
+		{30, valset(4, 30)},
+		{40, types.NewDataCommitment(5, 21, 31, ctx.BlockTime())},
+	}
+	for _, req := range requests {
+		require.NoError(t, k.SetAttestationRequest(ctx.WithBlockHeight(req.height), req.at))
+	}
+	return k, ctx
+}
+
+func nonces(attestations []types.AttestationRequestI) []uint64 {
+	result := make([]uint64, len(attestations))
+	for i, at := range attestations {
+		result[i] = at.GetNonce()
+	}
+	return result
+}
+
+func TestGetAttestationsFromNonce(t *testing.T) {
+	k, ctx := setupIndexedAttestations(t)
+
+	tests := []struct {
+		name              string
+		fromNonce         uint64
+		limit             uint64
+		attestationType   types.AttestationType
+		expectedNonces    []uint64
+		expectedNextNonce uint64
+	}{
+		{"all", 0, 10, types.ATTESTATION_TYPE_UNSPECIFIED, []uint64{1, 2, 3, 4, 5}, 0},
+		{"first page", 1, 2, types.ATTESTATION_TYPE_UNSPECIFIED, []uint64{1, 2}, 3},
+		{"last page", 3, 3, types.ATTESTATION_TYPE_UNSPECIFIED, []uint64{3, 4, 5}, 0},
+		{"valsets", 0, 10, types.ATTESTATION_TYPE_VALSET, []uint64{1, 4}, 0},
+		{"data commitments page", 2, 1, types.ATTESTATION_TYPE_DATA_COMMITMENT, []uint64{2}, 3},
+		{"data commitments from nonce", 4, 10, types.ATTESTATION_TYPE_DATA_COMMITMENT, []uint64{5}, 0},
+		{"past the latest nonce", 6, 10, types.ATTESTATION_TYPE_UNSPECIFIED, []uint64{}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attestations, nextNonce, err := k.GetAttestationsFromNonce(ctx, tt.fromNonce, tt.limit, tt.attestationType)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedNonces, nonces(attestations))
+			assert.Equal(t, tt.expectedNextNonce, nextNonce)
+		})
+	}
+}
+
+func TestGetValsetsInRange(t *testing.T) {
+	k, ctx := setupIndexedAttestations(t)
+
+	valsets, nextNonce, err := k.GetValsetsInRange(ctx, 1, 5, 10)
+	require.NoError(t, err)
+	require.Len(t, valsets, 2)
+	assert.Equal(t, uint64(1), valsets[0].Nonce)
+	assert.Equal(t, uint64(4), valsets[1].Nonce)
+	assert.Zero(t, nextNonce)
+
+	valsets, nextNonce, err = k.GetValsetsInRange(ctx, 1, 5, 1)
+	require.NoError(t, err)
+	require.Len(t, valsets, 1)
+	assert.Equal(t, uint64(4), nextNonce)
+
+	valsets, _, err = k.GetValsetsInRange(ctx, 2, 3, 10)
+	require.NoError(t, err)
+	assert.Empty(t, valsets)
+
+	_, _, err = k.GetValsetsInRange(ctx, 3, 2, 10)
+	assert.ErrorIs(t, err, types.ErrInvalidNonceRange)
+}
+
+func TestGetAttestationNonceForHeight(t *testing.T) {
+	k, ctx := setupIndexedAttestations(t)
+
+	tests := []struct {
+		height        uint64
+		expectedNonce uint64
+		found         bool
+	}{
+		{9, 0, false},
+		{10, 1, true},
+		{19, 1, true},
+		{20, 3, true},
+		{35, 4, true},
+		{math.MaxUint64, 5, true},
+	}
+	for _, tt := range tests {
+		nonce, found := k.GetAttestationNonceForHeight(ctx, tt.height)
+		assert.Equal(t, tt.found, found, "height %d", tt.height)
+		assert.Equal(t, tt.expectedNonce, nonce, "height %d", tt.height)
+	}
+
+	// pruned attestations are removed from the indexes.
+	k.DeleteAttestation(ctx, 1)
+	_, found := k.GetAttestationNonceForHeight(ctx, 19)
+	assert.False(t, found)
+	valsets, _, err := k.GetValsetsInRange(ctx, 1, 5, 10)
+	require.NoError(t, err)
+	require.Len(t, valsets, 1)
+	assert.Equal(t, uint64(4), valsets[0].Nonce)
+}
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx, storeKey := keepertest.StreamKeeperWithStoreKey(t)
+	valset, err := types.NewValset(1, 10, types.InternalBridgeValidators{
+		{Power: 1, EVMAddress: testutil.EVMAddrs[0]},
+	}, ctx.BlockTime())
+	require.NoError(t, err)
+	k.SetEarliestAvailableAttestationNonce(ctx, 1)
+	for _, at := range []types.AttestationRequestI{
+		valset,
+		types.NewDataCommitment(2, 1, 21, ctx.BlockTime()),
+		types.NewDataCommitment(3, 21, 41, ctx.BlockTime()),
+	} {
+		require.NoError(t, k.SetAttestationRequest(ctx, at))
+	}
+
+	// drop the indexes to simulate a store written before they were added.
+	store := ctx.KVStore(storeKey)
+	for _, prefix := range []string{types.AttestationTypeIndexKey, types.AttestationHeightIndexKey} {
+		iterator := storetypes.KVStorePrefixIterator(store, []byte(prefix))
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		require.NoError(t, iterator.Close())
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	_, found := k.GetAttestationNonceForHeight(ctx, math.MaxUint64)
+	require.False(t, found)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	attestations, _, err := k.GetAttestationsFromNonce(ctx, 0, 10, types.ATTESTATION_TYPE_DATA_COMMITMENT)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2, 3}, nonces(attestations))
+	// valsets are indexed at their height, and data commitments at the last
+	// block of their range.
+	tests := []struct {
+		height        uint64
+		expectedNonce uint64
+	}{
+		{10, 1},
+		{19, 1},
+		{20, 2},
+		{40, 3},
+	}
+	for _, tt := range tests {
+		nonce, found := k.GetAttestationNonceForHeight(ctx, tt.height)
+		require.True(t, found, "height %d", tt.height)
+		assert.Equal(t, tt.expectedNonce, nonce, "height %d", tt.height)
+	}
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"math"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
+)
+
+// InitEVMAddress sets the EVM address of a validator that didn't have one, and
+// uses it right away to sign attestations.
+func (k Keeper) InitEVMAddress(ctx sdk.Context, valAddress sdk.ValAddress, evmAddress gethcommon.Address) {
+	k.SetEVMAddress(ctx, valAddress, evmAddress)
+	k.SetEVMAddressHistoryEntry(ctx, valAddress, evmAddress, 0)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.GetPendingEVMAddressKey(valAddress))
+}
+
+// RotateEVMAddress registers a new EVM address for the provided validator. The
+// new address is included in the next valset, and is only used to sign the
+// attestations signed by that valset onwards, so that the signatures of the
+// in-flight attestations remain verifiable against the previous address.
+func (k Keeper) RotateEVMAddress(ctx sdk.Context, valAddress sdk.ValAddress, evmAddress gethcommon.Address) {
+	k.SetEVMAddress(ctx, valAddress, evmAddress)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.GetPendingEVMAddressKey(valAddress), evmAddress.Bytes())
+}
+
+// SetEVMAddressHistoryEntry sets the EVM address used by the validator to sign
+// the attestations from the provided nonce.
+func (k Keeper) SetEVMAddressHistoryEntry(ctx sdk.Context, valAddress sdk.ValAddress, evmAddress gethcommon.Address, fromNonce uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.GetEVMAddressHistoryKey(valAddress, fromNonce), evmAddress.Bytes())
+}
+
+// GetEVMAddressForNonce returns the EVM address that the validator must use to
+// sign the attestation with the provided nonce.
+func (k Keeper) GetEVMAddressForNonce(ctx sdk.Context, valAddress sdk.ValAddress, nonce uint64) (gethcommon.Address, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix := types.GetEVMAddressHistoryPrefix(valAddress)
+	end := storetypes.PrefixEndBytes(prefix)
+	if nonce < math.MaxUint64 {
+		end = types.GetEVMAddressHistoryKey(valAddress, nonce+1)
+	}
+	iterator := store.ReverseIterator(prefix, end)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return gethcommon.Address{}, false
+	}
+	return gethcommon.BytesToAddress(iterator.Value()), true
+}
+
+// GetAllEVMAddressHistory returns the EVM address history of all the
+// validators, ordered by validator address and nonce.
+func (k Keeper) GetAllEVMAddressHistory(ctx sdk.Context) []types.EVMAddressHistoryEntry {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix := []byte(types.EVMAddressHistoryKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	var entries []types.EVMAddressHistoryEntry
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(prefix):]
+		valAddr := sdk.ValAddress(key[1 : 1+int(key[0])])
+		entries = append(entries, types.EVMAddressHistoryEntry{
+			ValidatorAddress: valAddr.String(),
+			EvmAddress:       gethcommon.BytesToAddress(iterator.Value()).Hex(),
+			FromNonce:        UInt64FromBytes(key[1+int(key[0]):]),
+		})
+	}
+	return entries
+}
+
+// GetAllPendingEVMAddresses returns the EVM addresses that will be used by
+// validators once the next valset is requested, ordered by validator address.
+func (k Keeper) GetAllPendingEVMAddresses(ctx sdk.Context) []types.EVMAddress {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix := []byte(types.PendingEVMAddressKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	var addresses []types.EVMAddress
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, types.EVMAddress{
+			ValidatorAddress: sdk.ValAddress(iterator.Key()[len(prefix):]).String(),
+			EvmAddress:       gethcommon.BytesToAddress(iterator.Value()).Hex(),
+		})
+	}
+	return addresses
+}
+
+// ActivatePendingEVMAddresses is called when a valset is requested, which
+// includes the pending EVM addresses of its members, and makes the pending EVM
+// addresses the ones used to sign the attestations signed by that valset
+// onwards.
+func (k Keeper) ActivatePendingEVMAddresses(ctx sdk.Context, valset types.Valset) {
+	// the first valset signs itself.
+	fromNonce := valset.Nonce + 1
+	if valset.Nonce == 1 {
+		fromNonce = 1
+	}
+	pending := k.GetAllPendingEVMAddresses(ctx)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	for _, evm := range pending {
+		valAddr, err := sdk.ValAddressFromBech32(evm.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetEVMAddressHistoryEntry(ctx, valAddr, gethcommon.HexToAddress(evm.EvmAddress), fromNonce)
+		store.Delete(types.GetPendingEVMAddressKey(valAddr))
+	}
+}
+
+// HasPendingEVMAddressOfMember returns true if a member of the provided valset
+// rotated its EVM address, in which case a new valset must be requested for
+// the new address to be used.
+func (k Keeper) HasPendingEVMAddressOfMember(ctx sdk.Context, valset types.Valset) bool {
+	members := valsetPowers(&valset)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix := []byte(types.PendingEVMAddressKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Key()[len(prefix):])
+		activeAddress, found := k.GetEVMAddressForNonce(ctx, valAddr, math.MaxUint64)
+		if !found {
+			continue
+		}
+		if _, ok := members[activeAddress]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testutil "github.com/sunrise-zone/sunrise-app/test/util"
+	keepertest "github.com/sunrise-zone/sunrise-app/testutil/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
+)
+
+func TestRotateEVMAddress(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	valAddr := testutil.ValAddrs[0]
+	// the EVM addresses registered by the test chain are activated by the
+	// first valset.
+	oldAddr := testutil.EVMAddrs[0]
+
+	valset, err := k.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	require.NoError(t, k.SetAttestationRequest(ctx, &valset))
+	assert.False(t, k.HasPendingEVMAddressOfMember(ctx, valset))
+
+	newAddr := gethcommon.BytesToAddress([]byte("rotated"))
+	k.RotateEVMAddress(ctx, valAddr, newAddr)
+
+	// the registered address is the new one, but it is only used once a
+	// valset includes it.
+	registered, found := k.GetEVMAddress(ctx, valAddr)
+	require.True(t, found)
+	assert.Equal(t, newAddr, registered)
+	active, found := k.GetEVMAddressForNonce(ctx, valAddr, math.MaxUint64)
+	require.True(t, found)
+	assert.Equal(t, oldAddr, active)
+	assert.True(t, k.HasPendingEVMAddressOfMember(ctx, valset))
+	assert.Len(t, k.GetAllPendingEVMAddresses(ctx), 1)
+
+	require.NoError(t, k.SetAttestationRequest(ctx, types.NewDataCommitment(2, 1, 11, ctx.BlockTime())))
+	next, err := k.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), next.Nonce)
+	require.NoError(t, k.SetAttestationRequest(ctx, &next))
+
+	assert.Empty(t, k.GetAllPendingEVMAddresses(ctx))
+	assert.False(t, k.HasPendingEVMAddressOfMember(ctx, next))
+	for nonce, expected := range map[uint64]gethcommon.Address{
+		1:              oldAddr,
+		2:              oldAddr,
+		3:              oldAddr,
+		4:              newAddr,
+		math.MaxUint64: newAddr,
+	} {
+		got, found := k.GetEVMAddressForNonce(ctx, valAddr, nonce)
+		require.True(t, found)
+		assert.Equal(t, expected, got, "nonce %d", nonce)
+	}
+
+	resp, err := k.EvmAddressForNonce(ctx, &types.QueryEvmAddressForNonceRequest{
+		ValidatorAddress: valAddr.String(),
+		Nonce:            3,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, oldAddr.Hex(), resp.EvmAddress)
+	_, err = k.EvmAddressForNonce(ctx, &types.QueryEvmAddressForNonceRequest{
+		ValidatorAddress: sdk.ValAddress("unknown").String(),
+		Nonce:            3,
+	})
+	assert.ErrorIs(t, err, types.ErrEVMAddressNotFound)
+}
+
+func TestFirstValsetActivatesPendingEVMAddresses(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	valAddr := testutil.ValAddrs[0]
+
+	newAddr := gethcommon.BytesToAddress([]byte("rotated"))
+	k.RotateEVMAddress(ctx, valAddr, newAddr)
+
+	// the first valset signs itself, so it is signed with the addresses it
+	// contains.
+	valset, err := k.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), valset.Nonce)
+	require.NoError(t, k.SetAttestationRequest(ctx, &valset))
+
+	got, found := k.GetEVMAddressForNonce(ctx, valAddr, 1)
+	require.True(t, found)
+	assert.Equal(t, newAddr, got)
+}
+
+func TestMigrate3to4(t *testing.T) {
+	k, ctx := keepertest.StreamKeeper(t)
+	valAddr := sdk.ValAddress("validator")
+	evmAddr := gethcommon.BytesToAddress([]byte("evm"))
+	k.SetEVMAddress(ctx, valAddr, evmAddr)
+	_, found := k.GetEVMAddressForNonce(ctx, valAddr, 1)
+	require.False(t, found)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate3to4(ctx))
+	got, found := k.GetEVMAddressForNonce(ctx, valAddr, 1)
+	require.True(t, found)
+	assert.Equal(t, evmAddr, got)
+	assert.Equal(t, []types.EVMAddressHistoryEntry{{
+		ValidatorAddress: valAddr.String(),
+		EvmAddress:       evmAddr.Hex(),
+		FromNonce:        0,
+	}}, k.GetAllEVMAddressHistory(ctx))
+}
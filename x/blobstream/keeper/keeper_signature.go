@@ -0,0 +1,303 @@
+package keeper
+
+import (
+	"sort"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
+)
+
+// SetAttestationSignature saves the attestation signature in store. It
+// overwrites any existing signature of the same attestation made by the same
+// EVM address.
+func (k Keeper) SetAttestationSignature(ctx sdk.Context, sig types.AttestationSignature) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	key := []byte(types.GetAttestationSignatureKey(sig.Nonce, gethcommon.HexToAddress(sig.EvmAddress)))
+	store.Set(key, k.cdc.MustMarshal(&sig))
+}
+
+// HasAttestationSignature returns true if the EVM address already signed the
+// attestation with the provided nonce.
+func (k Keeper) HasAttestationSignature(ctx sdk.Context, nonce uint64, evmAddress gethcommon.Address) bool {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return store.Has([]byte(types.GetAttestationSignatureKey(nonce, evmAddress)))
+}
+
+// GetAttestationSignatures returns all the signatures of the attestation with
+// the provided nonce, ordered by EVM address.
+func (k Keeper) GetAttestationSignatures(ctx sdk.Context, nonce uint64) []types.AttestationSignature {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.GetAttestationSignaturesPrefix(nonce)))
+	defer iterator.Close()
+	var signatures []types.AttestationSignature
+	for ; iterator.Valid(); iterator.Next() {
+		var sig types.AttestationSignature
+		k.cdc.MustUnmarshal(iterator.Value(), &sig)
+		signatures = append(signatures, sig)
+	}
+	return signatures
+}
+
+// GetAllAttestationSignatures returns the signatures of all the attestations,
+// ordered by nonce and EVM address.
+func (k Keeper) GetAllAttestationSignatures(ctx sdk.Context) []types.AttestationSignature {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.AttestationSignatureKey))
+	defer iterator.Close()
+	var signatures []types.AttestationSignature
+	for ; iterator.Valid(); iterator.Next() {
+		var sig types.AttestationSignature
+		k.cdc.MustUnmarshal(iterator.Value(), &sig)
+		signatures = append(signatures, sig)
+	}
+	return signatures
+}
+
+// DeleteAttestationSignatures deletes all the signatures of the attestation
+// with the provided nonce.
+func (k Keeper) DeleteAttestationSignatures(ctx sdk.Context, nonce uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.GetAttestationSignaturesPrefix(nonce)))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetSigningValset returns the valset whose members are expected to sign the
+// provided attestation, i.e. the latest valset before it. The first valset,
+// having no valset before it, is signed by its own members.
+func (k Keeper) GetSigningValset(ctx sdk.Context, at types.AttestationRequestI) (*types.Valset, error) {
+	if vs, ok := at.(*types.Valset); ok && vs.Nonce == 1 {
+		return vs, nil
+	}
+	return k.GetLatestValsetBeforeNonce(ctx, at.GetNonce())
+}
+
+// VerifyAttestationSignature checks that the signature was made by a member of
+// the signing valset over the sign bytes of an attestation available in
+// store.
+func (k Keeper) VerifyAttestationSignature(ctx sdk.Context, sig types.AttestationSignature) error {
+	if err := sig.ValidateBasic(); err != nil {
+		return err
+	}
+	at, found, err := k.GetAttestationByNonce(ctx, sig.Nonce)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.Wrapf(types.ErrAttestationNotFound, "nonce %d", sig.Nonce)
+	}
+	signBytes, err := types.AttestationSignBytes(at, sig.DataRootTupleRoot)
+	if err != nil {
+		return err
+	}
+	evmAddress := gethcommon.HexToAddress(sig.EvmAddress)
+	if err := types.VerifyAttestationSignature(signBytes, sig.Signature, evmAddress); err != nil {
+		return err
+	}
+	valset, err := k.GetSigningValset(ctx, at)
+	if err != nil {
+		return err
+	}
+	if _, ok := valsetPowers(valset)[evmAddress]; !ok {
+		return errors.Wrapf(
+			types.ErrInvalidAttestationSignature,
+			"%s is not a member of the valset signing nonce %d",
+			evmAddress.Hex(),
+			sig.Nonce,
+		)
+	}
+	return nil
+}
+
+// ValidateVoteExtension checks that all the signatures contained in the vote
+// extension are valid and were made using the EVM address that the validator
+// that cast the vote must use for their nonce.
+func (k Keeper) ValidateVoteExtension(
+	ctx sdk.Context,
+	consAddress sdk.ConsAddress,
+	ext types.AttestationVoteExtension,
+) error {
+	if err := ext.ValidateBasic(); err != nil {
+		return err
+	}
+	if len(ext.Signatures) == 0 {
+		return nil
+	}
+	valAddress, err := k.getValAddressByConsAddress(ctx, consAddress)
+	if err != nil {
+		return err
+	}
+	for _, sig := range ext.Signatures {
+		evmAddress, found := k.GetEVMAddressForNonce(ctx, valAddress, sig.Nonce)
+		if !found {
+			return errors.Wrapf(types.ErrEVMAddressNotFound, "validator %s at nonce %d", valAddress.String(), sig.Nonce)
+		}
+		if gethcommon.HexToAddress(sig.EvmAddress) != evmAddress {
+			return errors.Wrapf(
+				types.ErrInvalidVoteExtension,
+				"signature for nonce %d was not made by the validator's EVM address %s",
+				sig.Nonce,
+				evmAddress.Hex(),
+			)
+		}
+		if err := k.VerifyAttestationSignature(ctx, sig); err != nil {
+			return errors.Wrapf(err, "nonce %d", sig.Nonce)
+		}
+	}
+	return nil
+}
+
+// StoreVoteExtension saves the valid signatures of the vote extension cast by
+// the provided validator. Invalid signatures are skipped as the state might
+// have changed since the vote extension was verified. Returns the number of
+// saved signatures.
+func (k Keeper) StoreVoteExtension(
+	ctx sdk.Context,
+	consAddress sdk.ConsAddress,
+	ext types.AttestationVoteExtension,
+) int {
+	if err := ext.ValidateBasic(); err != nil || len(ext.Signatures) == 0 {
+		return 0
+	}
+	valAddress, err := k.getValAddressByConsAddress(ctx, consAddress)
+	if err != nil {
+		return 0
+	}
+	stored := 0
+	for _, sig := range ext.Signatures {
+		evmAddress, found := k.GetEVMAddressForNonce(ctx, valAddress, sig.Nonce)
+		if !found || gethcommon.HexToAddress(sig.EvmAddress) != evmAddress {
+			continue
+		}
+		if k.HasAttestationSignature(ctx, sig.Nonce, evmAddress) {
+			continue
+		}
+		if err := k.VerifyAttestationSignature(ctx, sig); err != nil {
+			k.Logger().Debug("skipping invalid attestation signature", "nonce", sig.Nonce, "err", err.Error())
+			continue
+		}
+		k.SetAttestationSignature(ctx, sig)
+		stored++
+	}
+	return stored
+}
+
+// GetPendingAttestations returns, ordered by nonce, up to `limit` attestations
+// that the provided EVM address is expected to sign and didn't sign yet.
+func (k Keeper) GetPendingAttestations(
+	ctx sdk.Context,
+	evmAddress gethcommon.Address,
+	limit int,
+) ([]types.AttestationRequestI, error) {
+	if !k.CheckLatestAttestationNonce(ctx) || !k.CheckEarliestAvailableAttestationNonce(ctx) {
+		return nil, nil
+	}
+	latestNonce := k.GetLatestAttestationNonce(ctx)
+	var pending []types.AttestationRequestI
+	for nonce := k.GetEarliestAvailableAttestationNonce(ctx); nonce <= latestNonce && len(pending) < limit; nonce++ {
+		if k.HasAttestationSignature(ctx, nonce, evmAddress) {
+			continue
+		}
+		at, found, err := k.GetAttestationByNonce(ctx, nonce)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, errors.Wrapf(types.ErrAttestationNotFound, "nonce %d", nonce)
+		}
+		valset, err := k.GetSigningValset(ctx, at)
+		if err != nil {
+			if errors.IsOf(err, sdkerrors.ErrNotFound) {
+				// the signing valset was pruned, so this attestation can't
+				// be signed anymore.
+				continue
+			}
+			return nil, err
+		}
+		if _, ok := valsetPowers(valset)[evmAddress]; !ok {
+			continue
+		}
+		pending = append(pending, at)
+	}
+	return pending, nil
+}
+
+// GetSignedAttestation returns the attestation with the provided nonce along
+// with the signatures of the signing valset members that attested to the same
+// sign bytes, provided that they hold at least two thirds of the valset power.
+// For data commitments, the signed data root tuple root is returned as well.
+func (k Keeper) GetSignedAttestation(
+	ctx sdk.Context,
+	nonce uint64,
+) (types.AttestationRequestI, []byte, []types.AttestationSignature, error) {
+	at, found, err := k.GetAttestationByNonce(ctx, nonce)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !found {
+		return nil, nil, nil, errors.Wrapf(types.ErrAttestationNotFound, "nonce %d", nonce)
+	}
+	valset, err := k.GetSigningValset(ctx, at)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	powers := valsetPowers(valset)
+
+	// validators might have signed different data root tuple roots, so the
+	// signatures are grouped by the signed root.
+	groups := make(map[string][]types.AttestationSignature)
+	groupPowers := make(map[string]uint64)
+	for _, sig := range k.GetAttestationSignatures(ctx, nonce) {
+		power, ok := powers[gethcommon.HexToAddress(sig.EvmAddress)]
+		if !ok {
+			continue
+		}
+		root := string(sig.DataRootTupleRoot)
+		groups[root] = append(groups[root], sig)
+		groupPowers[root] += power
+	}
+	roots := make([]string, 0, len(groups))
+	for root := range groups {
+		roots = append(roots, root)
+	}
+	sort.Strings(roots)
+	for _, root := range roots {
+		if groupPowers[root] >= valset.TwoThirdsThreshold() {
+			return at, []byte(root), groups[root], nil
+		}
+	}
+	return nil, nil, nil, errors.Wrapf(types.ErrAttestationNotSigned, "nonce %d", nonce)
+}
+
+func (k Keeper) getValAddressByConsAddress(ctx sdk.Context, consAddress sdk.ConsAddress) (sdk.ValAddress, error) {
+	validator, err := k.StakingKeeper.ValidatorByConsAddr(ctx, consAddress)
+	if err != nil {
+		return nil, err
+	}
+	valAddress, err := k.StakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidValAddress, err.Error())
+	}
+	return valAddress, nil
+}
+
+// valsetPowers maps the EVM addresses of the valset members to their power.
+func valsetPowers(valset *types.Valset) map[gethcommon.Address]uint64 {
+	powers := make(map[gethcommon.Address]uint64, len(valset.Members))
+	for _, member := range valset.Members {
+		powers[gethcommon.HexToAddress(member.EvmAddress)] = member.Power
+	}
+	return powers
+}
@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testutil "github.com/sunrise-zone/sunrise-app/test/util"
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
+)
+
+// setupSigningChain creates a five validators chain whose validators
+// registered EVM keys, along with a valset at nonce 1 and a data commitment
+// at nonce 2.
+func setupSigningChain(t *testing.T) (keeper.Keeper, sdk.Context, []*ecdsa.PrivateKey) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+
+	keys := make([]*ecdsa.PrivateKey, len(testutil.ValAddrs))
+	for i, valAddr := range testutil.ValAddrs {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		k.InitEVMAddress(ctx, valAddr, crypto.PubkeyToAddress(key.PublicKey))
+	}
+
+	valset, err := k.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), valset.Nonce)
+	require.NoError(t, k.SetAttestationRequest(ctx, &valset))
+	require.NoError(t, k.SetAttestationRequest(ctx, types.NewDataCommitment(2, 1, 11, ctx.BlockTime())))
+	return k, ctx, keys
+}
+
+func signAttestation(t *testing.T, k keeper.Keeper, ctx sdk.Context, nonce uint64, root []byte, key *ecdsa.PrivateKey) types.AttestationSignature {
+	at, found, err := k.GetAttestationByNonce(ctx, nonce)
+	require.NoError(t, err)
+	require.True(t, found)
+	signBytes, err := types.AttestationSignBytes(at, root)
+	require.NoError(t, err)
+	signature, err := types.SignAttestation(signBytes, key)
+	require.NoError(t, err)
+	return types.AttestationSignature{
+		Nonce:             nonce,
+		EvmAddress:        crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		DataRootTupleRoot: root,
+		Signature:         signature,
+	}
+}
+
+func consAddress(i int) sdk.ConsAddress {
+	return sdk.ConsAddress(testutil.ConsPubKeys[i].Address())
+}
+
+func TestStoreVoteExtension(t *testing.T) {
+	k, ctx, keys := setupSigningChain(t)
+
+	// three out of five validators with the same power is not enough to reach
+	// the two thirds threshold.
+	for i := 0; i < 3; i++ {
+		ext := types.AttestationVoteExtension{
+			Signatures: []types.AttestationSignature{signAttestation(t, k, ctx, 1, nil, keys[i])},
+		}
+		require.NoError(t, k.ValidateVoteExtension(ctx, consAddress(i), ext))
+		assert.Equal(t, 1, k.StoreVoteExtension(ctx, consAddress(i), ext))
+		// storing the same signature twice is a no-op.
+		assert.Equal(t, 0, k.StoreVoteExtension(ctx, consAddress(i), ext))
+	}
+	_, _, _, err := k.GetSignedAttestation(ctx, 1)
+	assert.ErrorIs(t, err, types.ErrAttestationNotSigned)
+
+	ext := types.AttestationVoteExtension{
+		Signatures: []types.AttestationSignature{signAttestation(t, k, ctx, 1, nil, keys[3])},
+	}
+	assert.Equal(t, 1, k.StoreVoteExtension(ctx, consAddress(3), ext))
+	at, root, signatures, err := k.GetSignedAttestation(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), at.GetNonce())
+	assert.Empty(t, root)
+	assert.Len(t, signatures, 4)
+}
+
+func TestSignedDataCommitment(t *testing.T) {
+	k, ctx, keys := setupSigningChain(t)
+
+	root := bytes.Repeat([]byte{1}, 32)
+	otherRoot := bytes.Repeat([]byte{2}, 32)
+	for i, key := range keys {
+		signedRoot := root
+		if i == 0 {
+			// a validator signing another root doesn't count towards the
+			// threshold of the root signed by the others.
+			signedRoot = otherRoot
+		}
+		ext := types.AttestationVoteExtension{
+			Signatures: []types.AttestationSignature{signAttestation(t, k, ctx, 2, signedRoot, key)},
+		}
+		assert.Equal(t, 1, k.StoreVoteExtension(ctx, consAddress(i), ext))
+	}
+
+	at, signedRoot, signatures, err := k.GetSignedAttestation(ctx, 2)
+	require.NoError(t, err)
+	assert.IsType(t, &types.DataCommitment{}, at)
+	assert.Equal(t, root, signedRoot)
+	assert.Len(t, signatures, 4)
+
+	// pruning the attestation also deletes its signatures.
+	k.DeleteAttestation(ctx, 2)
+	assert.Empty(t, k.GetAttestationSignatures(ctx, 2))
+}
+
+func TestValidateVoteExtension(t *testing.T) {
+	k, ctx, keys := setupSigningChain(t)
+
+	tests := []struct {
+		name string
+		ext  types.AttestationVoteExtension
+	}{
+		{
+			name: "signed with the EVM key of another validator",
+			ext: types.AttestationVoteExtension{
+				Signatures: []types.AttestationSignature{signAttestation(t, k, ctx, 1, nil, keys[1])},
+			},
+		},
+		{
+			name: "unknown nonce",
+			ext: types.AttestationVoteExtension{
+				Signatures: []types.AttestationSignature{func() types.AttestationSignature {
+					sig := signAttestation(t, k, ctx, 1, nil, keys[0])
+					sig.Nonce = 3
+					return sig
+				}()},
+			},
+		},
+		{
+			name: "data commitment without data root tuple root",
+			ext: types.AttestationVoteExtension{
+				Signatures: []types.AttestationSignature{func() types.AttestationSignature {
+					sig := signAttestation(t, k, ctx, 2, bytes.Repeat([]byte{1}, 32), keys[0])
+					sig.DataRootTupleRoot = nil
+					return sig
+				}()},
+			},
+		},
+		{
+			name: "signature over other sign bytes",
+			ext: types.AttestationVoteExtension{
+				Signatures: []types.AttestationSignature{func() types.AttestationSignature {
+					sig := signAttestation(t, k, ctx, 2, bytes.Repeat([]byte{1}, 32), keys[0])
+					sig.DataRootTupleRoot = bytes.Repeat([]byte{2}, 32)
+					return sig
+				}()},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, k.ValidateVoteExtension(ctx, consAddress(0), tt.ext))
+			assert.Equal(t, 0, k.StoreVoteExtension(ctx, consAddress(0), tt.ext))
+		})
+	}
+}
+
+func TestValidateVoteExtensionAfterEVMAddressRotation(t *testing.T) {
+	k, ctx, keys := setupSigningChain(t)
+
+	newKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	k.RotateEVMAddress(ctx, testutil.ValAddrs[0], crypto.PubkeyToAddress(newKey.PublicKey))
+
+	// the in-flight data commitment must still be signed with the previous
+	// address.
+	root := bytes.Repeat([]byte{1}, 32)
+	oldExt := types.AttestationVoteExtension{
+		Signatures: []types.AttestationSignature{signAttestation(t, k, ctx, 2, root, keys[0])},
+	}
+	require.NoError(t, k.ValidateVoteExtension(ctx, consAddress(0), oldExt))
+	newExt := types.AttestationVoteExtension{
+		Signatures: []types.AttestationSignature{signAttestation(t, k, ctx, 2, root, newKey)},
+	}
+	require.Error(t, k.ValidateVoteExtension(ctx, consAddress(0), newExt))
+
+	// the new address is used from the attestation following the valset that
+	// includes it.
+	valset, err := k.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), valset.Nonce)
+	require.NoError(t, k.SetAttestationRequest(ctx, &valset))
+	require.NoError(t, k.SetAttestationRequest(ctx, types.NewDataCommitment(4, 11, 21, ctx.BlockTime())))
+
+	oldExt = types.AttestationVoteExtension{
+		Signatures: []types.AttestationSignature{signAttestation(t, k, ctx, 3, nil, keys[0])},
+	}
+	require.NoError(t, k.ValidateVoteExtension(ctx, consAddress(0), oldExt))
+	oldExt = types.AttestationVoteExtension{
+		Signatures: []types.AttestationSignature{signAttestation(t, k, ctx, 4, root, keys[0])},
+	}
+	require.Error(t, k.ValidateVoteExtension(ctx, consAddress(0), oldExt))
+	newExt = types.AttestationVoteExtension{
+		Signatures: []types.AttestationSignature{signAttestation(t, k, ctx, 4, root, newKey)},
+	}
+	require.NoError(t, k.ValidateVoteExtension(ctx, consAddress(0), newExt))
+}
+
+func TestGetPendingAttestations(t *testing.T) {
+	k, ctx, keys := setupSigningChain(t)
+	evmAddress := crypto.PubkeyToAddress(keys[0].PublicKey)
+
+	pending, err := k.GetPendingAttestations(ctx, evmAddress, types.MaxAttestationSignaturesPerVoteExtension)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+
+	pending, err = k.GetPendingAttestations(ctx, evmAddress, 1)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, uint64(1), pending[0].GetNonce())
+
+	k.SetAttestationSignature(ctx, signAttestation(t, k, ctx, 1, nil, keys[0]))
+	pending, err = k.GetPendingAttestations(ctx, evmAddress, types.MaxAttestationSignaturesPerVoteExtension)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, uint64(2), pending[0].GetNonce())
+
+	// addresses that are not part of the signing valset have nothing to sign.
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	pending, err = k.GetPendingAttestations(ctx, crypto.PubkeyToAddress(other.PublicKey), types.MaxAttestationSignaturesPerVoteExtension)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
@@ -58,19 +58,36 @@ func (k Keeper) GetLatestValset(ctx sdk.Context) (*types.Valset, error) {
 	return &currentVs, err
 }
 
-// SetLatestUnBondingBlockHeight sets the latest unbonding block height. Note
-// this value is not saved to state or loaded at genesis. This value is reset to
-// zero on chain upgrade.
+// HasSignificantPowerDiff returns true if the power difference between the
+// current validator set and the provided valset is higher than the significant
+// power difference threshold param.
+func (k Keeper) HasSignificantPowerDiff(ctx sdk.Context, valset types.Valset) (bool, error) {
+	vs, err := k.GetCurrentValset(ctx)
+	if err != nil {
+		return false, err
+	}
+	intCurrMembers, err := types.BridgeValidators(vs.Members).ToInternal()
+	if err != nil {
+		return false, errors.Wrap(err, "invalid current valset members")
+	}
+	intLatestMembers, err := types.BridgeValidators(valset.Members).ToInternal()
+	if err != nil {
+		return false, errors.Wrap(err, "invalid latest valset members")
+	}
+	return intCurrMembers.PowerDiff(*intLatestMembers).GT(k.GetSignificantPowerDifferenceThreshold(ctx)), nil
+}
+
+// SetLatestUnBondingBlockHeight sets the latest unbonding block height. This
+// value is exported and loaded at genesis.
 func (k Keeper) SetLatestUnBondingBlockHeight(ctx sdk.Context, unbondingBlockHeight uint64) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx.Context()))
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store.Set([]byte(types.LatestUnBondingBlockHeight), types.UInt64Bytes(unbondingBlockHeight))
 }
 
 // GetLatestUnBondingBlockHeight returns the latest unbonding block height or
-// zero if not set. This value is not saved or loaded at genesis. This value is
-// reset to zero on chain upgrade.
+// zero if not set.
 func (k Keeper) GetLatestUnBondingBlockHeight(ctx sdk.Context) uint64 {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx.Context()))
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	bytes := store.Get([]byte(types.LatestUnBondingBlockHeight))
 
 	if len(bytes) == 0 {
@@ -114,7 +131,7 @@ func (k Keeper) GetCurrentValset(ctx sdk.Context) (types.Valset, error) {
 			// safely recover from this by deriving the default again.
 			ctx.Logger().Error("validator does not have an evm address set")
 			evmAddress = types.DefaultEvmAddress(val)
-			k.SetEVMAddress(ctx, val, evmAddress)
+			k.InitEVMAddress(ctx, val, evmAddress)
 		}
 
 		bv := types.BridgeValidator{Power: p.Uint64(), EvmAddress: evmAddress.Hex()}
@@ -217,12 +234,12 @@ func (k Keeper) GetLatestValsetBeforeNonce(ctx sdk.Context, nonce uint64) (*type
 }
 
 func (k Keeper) SetEVMAddress(ctx sdk.Context, valAddress sdk.ValAddress, evmAddress gethcommon.Address) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx.Context()))
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store.Set(types.GetEVMKey(valAddress), evmAddress.Bytes())
 }
 
 func (k Keeper) GetEVMAddress(ctx sdk.Context, valAddress sdk.ValAddress) (gethcommon.Address, bool) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx.Context()))
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	if !store.Has(types.GetEVMKey(valAddress)) {
 		return gethcommon.Address{}, false
 	}
@@ -234,7 +251,7 @@ func (k Keeper) GetEVMAddress(ctx sdk.Context, valAddress sdk.ValAddress) (gethc
 // includes the defaults we set validators when they initially create a validator
 // before registering
 func (k Keeper) IsEVMAddressUnique(ctx sdk.Context, evmAddress gethcommon.Address) bool {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx.Context()))
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	addrBytes := evmAddress.Bytes()
 	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.EvmAddress))
 	defer iterator.Close()
@@ -245,3 +262,21 @@ func (k Keeper) IsEVMAddressUnique(ctx sdk.Context, evmAddress gethcommon.Addres
 	}
 	return true
 }
+
+// GetAllEVMAddresses returns the EVM addresses of all the validators, ordered
+// by validator address.
+func (k Keeper) GetAllEVMAddresses(ctx sdk.Context) []types.EVMAddress {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix := []byte(types.EvmAddress)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	var addresses []types.EVMAddress
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Key()[len(prefix):])
+		addresses = append(addresses, types.EVMAddress{
+			ValidatorAddress: valAddr.String(),
+			EvmAddress:       gethcommon.BytesToAddress(iterator.Value()).Hex(),
+		})
+	}
+	return addresses
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 indexes the attestations in store by type and height. The
+// height at which the attestations were requested wasn't stored, so valsets
+// are indexed at their height and data commitments at the last block of their
+// range, which is the earliest height at which they could have been requested.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	attestations, err := m.keeper.GetAllAttestations(ctx)
+	if err != nil {
+		return err
+	}
+	for _, at := range attestations {
+		var height uint64
+		switch at := at.(type) {
+		case *types.Valset:
+			height = at.Height
+		case *types.DataCommitment:
+			height = at.EndBlock - 1
+		}
+		m.keeper.setAttestationIndexes(ctx, at, height)
+	}
+	return nil
+}
+
+// Migrate2to3 sets the significant power difference threshold, attestation
+// expiry time and max pruned attestations per block params, which replace the
+// previously hardcoded values, to their defaults. The data commitment window is
+// kept unless it was left unset.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	defaults := types.DefaultParams()
+	if params.DataCommitmentWindow == 0 {
+		params.DataCommitmentWindow = defaults.DataCommitmentWindow
+	}
+	params.SignificantPowerDifferenceThreshold = defaults.SignificantPowerDifferenceThreshold
+	params.AttestationExpiryTime = defaults.AttestationExpiryTime
+	params.MaxPrunedAttestationsPerBlock = defaults.MaxPrunedAttestationsPerBlock
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate3to4 records the registered EVM addresses in the EVM address history
+// as the ones used by their validators since the first attestation.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	for _, evm := range m.keeper.GetAllEVMAddresses(ctx) {
+		valAddr, err := sdk.ValAddressFromBech32(evm.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		m.keeper.SetEVMAddressHistoryEntry(ctx, valAddr, gethcommon.HexToAddress(evm.EvmAddress), 0)
+	}
+	return nil
+}
@@ -30,7 +30,13 @@ func (k msgServer) RegisterEvmAddress(goCtx context.Context, msg *types.MsgRegis
 		return nil, errors.Wrapf(types.ErrEVMAddressAlreadyExists, "address %s", msg.EvmAddress)
 	}
 
-	k.SetEVMAddress(ctx, valAddr, evmAddr)
+	if _, exists := k.GetEVMAddress(ctx, valAddr); !exists {
+		k.InitEVMAddress(ctx, valAddr, evmAddr)
+	} else {
+		// the new address is only used to sign attestations once it is part
+		// of a valset.
+		k.RotateEVMAddress(ctx, valAddr, evmAddr)
+	}
 
 	return &types.MsgRegisterEvmAddressResponse{}, nil
 }
@@ -14,10 +14,18 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
 	}
 
+	if err := req.Params.Validate(); err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	oldParams := k.GetParams(ctx)
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
+	if err := k.OnParamsChanged(ctx, oldParams, req.Params); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
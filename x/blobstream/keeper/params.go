@@ -2,6 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 
@@ -31,3 +36,54 @@ func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
 
 	return nil
 }
+
+// GetSignificantPowerDifferenceThreshold returns the threshold of change in the
+// validator set power that triggers the creation of a new valset request.
+func (k Keeper) GetSignificantPowerDifferenceThreshold(ctx context.Context) sdkmath.LegacyDec {
+	return k.GetParams(ctx).SignificantPowerDifferenceThreshold
+}
+
+// GetAttestationExpiryTime returns the time after which an attestation is
+// pruned from state.
+func (k Keeper) GetAttestationExpiryTime(ctx context.Context) time.Duration {
+	return k.GetParams(ctx).AttestationExpiryTime
+}
+
+// GetMaxPrunedAttestationsPerBlock returns the maximum number of expired
+// attestations pruned in a single block.
+func (k Keeper) GetMaxPrunedAttestationsPerBlock(ctx context.Context) uint64 {
+	return k.GetParams(ctx).MaxPrunedAttestationsPerBlock
+}
+
+// OnParamsChanged re-evaluates the need for a new valset request after a
+// params change, so that a lowered significant power difference threshold
+// takes effect right away instead of waiting for the next power change.
+func (k Keeper) OnParamsChanged(ctx sdk.Context, oldParams, newParams types.Params) error {
+	if !oldParams.SignificantPowerDifferenceThreshold.IsNil() &&
+		oldParams.SignificantPowerDifferenceThreshold.Equal(newParams.SignificantPowerDifferenceThreshold) {
+		return nil
+	}
+	if !k.CheckLatestAttestationNonce(ctx) || k.GetLatestAttestationNonce(ctx) == 0 {
+		// the first valset is requested by the end blocker.
+		return nil
+	}
+	latestValset, err := k.GetLatestValset(ctx)
+	if err != nil {
+		return err
+	}
+	significantPowerDiff, err := k.HasSignificantPowerDiff(ctx, *latestValset)
+	if err != nil {
+		if errors.Is(err, types.ErrNoValidators) {
+			return nil
+		}
+		return err
+	}
+	if !significantPowerDiff {
+		return nil
+	}
+	valset, err := k.GetCurrentValset(ctx)
+	if err != nil {
+		return err
+	}
+	return k.SetAttestationRequest(ctx, &valset)
+}
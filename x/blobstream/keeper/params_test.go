@@ -1,11 +1,15 @@
 package keeper_test
 
 import (
+	"math"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	testutil "github.com/sunrise-zone/sunrise-app/test/util"
 	keepertest "github.com/sunrise-zone/sunrise-app/testutil/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/keeper"
 	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
 )
 
@@ -16,3 +20,53 @@ func TestGetParams(t *testing.T) {
 	require.NoError(t, k.SetParams(ctx, params))
 	require.EqualValues(t, params, k.GetParams(ctx))
 }
+
+func TestOnParamsChanged(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+
+	// store a valset whose power differs from the current one by 3%.
+	valset, err := k.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	shift := uint64(math.MaxUint32) * 15 / 1000
+	valset.Members[0].Power -= shift
+	valset.Members[1].Power += shift
+	require.NoError(t, k.SetAttestationRequest(ctx, &valset))
+
+	params := k.GetParams(ctx)
+	require.NoError(t, k.OnParamsChanged(ctx, params, params))
+	require.Equal(t, uint64(1), k.GetLatestAttestationNonce(ctx))
+
+	// a threshold that is still higher than the power difference doesn't
+	// trigger a new valset.
+	newParams := params
+	newParams.SignificantPowerDifferenceThreshold = sdkmath.LegacyNewDecWithPrec(4, 2)
+	require.NoError(t, k.OnParamsChanged(ctx, params, newParams))
+	require.Equal(t, uint64(1), k.GetLatestAttestationNonce(ctx))
+
+	params = newParams
+	newParams.SignificantPowerDifferenceThreshold = sdkmath.LegacyNewDecWithPrec(1, 2)
+	require.NoError(t, k.SetParams(ctx, newParams))
+	require.NoError(t, k.OnParamsChanged(ctx, params, newParams))
+	require.Equal(t, uint64(2), k.GetLatestAttestationNonce(ctx))
+	latestValset, err := k.GetLatestValset(ctx)
+	require.NoError(t, err)
+	currentValset, err := k.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	require.Equal(t, currentValset.Members, latestValset.Members)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	k, ctx := keepertest.StreamKeeper(t)
+	require.NoError(t, k.SetParams(ctx, types.Params{}))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	// a data commitment window set before the migration is kept.
+	require.NoError(t, k.SetParams(ctx, types.Params{DataCommitmentWindow: 100}))
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+	params := k.GetParams(ctx)
+	require.Equal(t, uint64(100), params.DataCommitmentWindow)
+	require.NoError(t, params.Validate())
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/errors"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,3 +49,63 @@ func (k Keeper) LatestAttestationNonce(
 		Nonce: k.GetLatestAttestationNonce(sdk.UnwrapSDKContext(ctx)),
 	}, nil
 }
+
+func (k Keeper) SignedAttestation(
+	ctx context.Context,
+	request *types.QuerySignedAttestationRequest,
+) (*types.QuerySignedAttestationResponse, error) {
+	at, dataRootTupleRoot, signatures, err := k.GetSignedAttestation(sdk.UnwrapSDKContext(ctx), request.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	val, err := codectypes.NewAnyWithValue(at)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySignedAttestationResponse{
+		Attestation:       val,
+		DataRootTupleRoot: dataRootTupleRoot,
+		Signatures:        signatures,
+	}, nil
+}
+
+func (k Keeper) Attestations(
+	ctx context.Context,
+	request *types.QueryAttestationsRequest,
+) (*types.QueryAttestationsResponse, error) {
+	limit := request.Limit
+	if limit == 0 || limit > types.MaxAttestationsPerPage {
+		limit = types.MaxAttestationsPerPage
+	}
+	attestations, nextNonce, err := k.GetAttestationsFromNonce(
+		sdk.UnwrapSDKContext(ctx),
+		request.FromNonce,
+		limit,
+		request.TypeFilter,
+	)
+	if err != nil {
+		return nil, err
+	}
+	vals := make([]*codectypes.Any, len(attestations))
+	for i, at := range attestations {
+		vals[i], err = codectypes.NewAnyWithValue(at)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &types.QueryAttestationsResponse{
+		Attestations: vals,
+		NextNonce:    nextNonce,
+	}, nil
+}
+
+func (k Keeper) AttestationNonceForHeight(
+	ctx context.Context,
+	request *types.QueryAttestationNonceForHeightRequest,
+) (*types.QueryAttestationNonceForHeightResponse, error) {
+	nonce, found := k.GetAttestationNonceForHeight(sdk.UnwrapSDKContext(ctx), request.Height)
+	if !found {
+		return nil, errors.Wrapf(types.ErrAttestationNotFound, "no attestation at or before height %d", request.Height)
+	}
+	return &types.QueryAttestationNonceForHeightResponse{Nonce: nonce}, nil
+}
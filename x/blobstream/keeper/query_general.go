@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
 )
@@ -43,3 +44,18 @@ func (k Keeper) EvmAddress(goCtx context.Context, req *types.QueryEvmAddressRequ
 		EvmAddress: evmAddr.Hex(),
 	}, nil
 }
+
+func (k Keeper) EvmAddressForNonce(goCtx context.Context, req *types.QueryEvmAddressForNonceRequest) (*types.QueryEvmAddressForNonceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	evmAddr, exists := k.GetEVMAddressForNonce(ctx, valAddr, req.Nonce)
+	if !exists {
+		return nil, errors.Wrapf(types.ErrEVMAddressNotFound, "validator %s at nonce %d", req.ValidatorAddress, req.Nonce)
+	}
+	return &types.QueryEvmAddressForNonceResponse{
+		EvmAddress: evmAddr.Hex(),
+	}, nil
+}
@@ -20,3 +20,20 @@ func (k Keeper) LatestValsetRequestBeforeNonce(
 	}
 	return &types.QueryLatestValsetRequestBeforeNonceResponse{Valset: vs}, nil
 }
+
+// ValsetsInRange queries the valsets whose nonces are in the provided range
+func (k Keeper) ValsetsInRange(
+	c context.Context,
+	req *types.QueryValsetsInRangeRequest,
+) (*types.QueryValsetsInRangeResponse, error) {
+	valsets, nextNonce, err := k.GetValsetsInRange(
+		sdk.UnwrapSDKContext(c),
+		req.FromNonce,
+		req.ToNonce,
+		types.MaxAttestationsPerPage,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryValsetsInRangeResponse{Valsets: valsets, NextNonce: nextNonce}, nil
+}
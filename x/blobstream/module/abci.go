@@ -2,10 +2,8 @@ package blobstream
 
 import (
 	"errors"
-	"time"
 
 	sdkerrors "cosmossdk.io/errors"
-	sdkmath "cosmossdk.io/math"
 
 	"github.com/sunrise-zone/sunrise-app/x/blobstream/keeper"
 	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
@@ -13,20 +11,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-const (
-	oneDay  = 24 * time.Hour
-	oneWeek = 7 * oneDay
-	// AttestationExpiryTime is the expiration time of an attestation. When this
-	// much time has passed after an attestation has been published, it will be
-	// pruned from state.
-	AttestationExpiryTime = 3 * oneWeek // 3 weeks
-)
-
-// SignificantPowerDifferenceThreshold is the threshold of change in the
-// validator set power that would trigger the creation of a new valset
-// request.
-var SignificantPowerDifferenceThreshold = sdkmath.LegacyNewDecWithPrec(5, 2) // 0.05
-
 // EndBlocker is called at the end of every block.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// we always want to create the valset at first so that if there is a new
@@ -98,7 +82,8 @@ func handleValsetRequest(ctx sdk.Context, k keeper.Keeper) {
 
 	significantPowerDiff := false
 	if latestValset != nil {
-		vs, err := k.GetCurrentValset(ctx)
+		var err error
+		significantPowerDiff, err = k.HasSignificantPowerDiff(ctx, *latestValset)
 		if err != nil {
 			// this condition should only occur in the simulator ref :
 			// https://github.com/Gravity-Bridge/Gravity-Bridge/issues/35
@@ -110,20 +95,13 @@ func handleValsetRequest(ctx sdk.Context, k keeper.Keeper) {
 			}
 			panic(err)
 		}
-		intCurrMembers, err := types.BridgeValidators(vs.Members).ToInternal()
-		if err != nil {
-			panic(sdkerrors.Wrap(err, "invalid current valset members"))
-		}
-		intLatestMembers, err := types.BridgeValidators(latestValset.Members).ToInternal()
-		if err != nil {
-			panic(sdkerrors.Wrap(err, "invalid latest valset members"))
-		}
-
-		significantPowerDiff = intCurrMembers.PowerDiff(*intLatestMembers).GT(SignificantPowerDifferenceThreshold)
-
 	}
 
-	if (latestValset == nil) || (latestUnbondingHeight == uint64(ctx.BlockHeight())) || significantPowerDiff {
+	// a member that rotated its EVM address must keep signing with the
+	// previous one until a valset including the new one is requested.
+	evmAddressRotated := latestValset != nil && k.HasPendingEVMAddressOfMember(ctx, *latestValset)
+
+	if (latestValset == nil) || (latestUnbondingHeight == uint64(ctx.BlockHeight())) || significantPowerDiff || evmAddressRotated {
 		// if the conditions are true, put in a new validator set request to be
 		// signed and submitted to EVM
 		valset, err := k.GetCurrentValset(ctx)
@@ -138,7 +116,9 @@ func handleValsetRequest(ctx sdk.Context, k keeper.Keeper) {
 }
 
 // pruneAttestations runs basic checks on saved attestations to see if we need
-// to prune or not. Then, it prunes all expired attestations from state.
+// to prune or not. Then, it prunes the expired attestations from state, up to
+// the max pruned attestations per block param, so that the remaining ones are
+// pruned in the next blocks.
 func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
 	// If the attestation nonce hasn't been initialized yet, no pruning is
 	// required
@@ -151,10 +131,15 @@ func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
 	}
 
 	currentBlockTime := ctx.BlockTime()
+	expiryTime := k.GetAttestationExpiryTime(ctx)
 	latestAttestationNonce := k.GetLatestAttestationNonce(ctx)
 	earliestNonce := k.GetEarliestAvailableAttestationNonce(ctx)
+	pruningLimit := latestAttestationNonce
+	if maxPruned := k.GetMaxPrunedAttestationsPerBlock(ctx); latestAttestationNonce > earliestNonce+maxPruned {
+		pruningLimit = earliestNonce + maxPruned
+	}
 	var newEarliestAvailableNonce uint64
-	for newEarliestAvailableNonce = earliestNonce; newEarliestAvailableNonce < latestAttestationNonce; newEarliestAvailableNonce++ {
+	for newEarliestAvailableNonce = earliestNonce; newEarliestAvailableNonce < pruningLimit; newEarliestAvailableNonce++ {
 		newEarliestAttestation, found, err := k.GetAttestationByNonce(ctx, newEarliestAvailableNonce)
 		if err != nil {
 			ctx.Logger().Error("error getting attestation for pruning", "nonce", newEarliestAvailableNonce, "err", err.Error())
@@ -168,7 +153,7 @@ func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
 			ctx.Logger().Error("nil attestation for pruning", "nonce", newEarliestAvailableNonce)
 			return
 		}
-		attestationExpirationTime := newEarliestAttestation.BlockTime().Add(AttestationExpiryTime)
+		attestationExpirationTime := newEarliestAttestation.BlockTime().Add(expiryTime)
 		if attestationExpirationTime.After(currentBlockTime) {
 			// the current attestation is unexpired so subsequent ones are also
 			// unexpired persist the new earliest available attestation nonce
@@ -1,7 +1,11 @@
 package blobstream
 
 import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/sunrise-zone/sunrise-app/x/blobstream/keeper"
 	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
@@ -18,12 +22,70 @@ const (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
-	k.SetLatestAttestationNonce(ctx, InitialLatestAttestationNonce)
-	// The reason we're setting the earliest available nonce to 1 is because at
-	// chain startup, a new valset will always be created. Also, it's easier to
-	// set it once here rather than conditionally setting it in abci.EndBlocker
-	// which is executed on every block.
-	k.SetEarliestAvailableAttestationNonce(ctx, InitialEarliestAvailableAttestationNonce)
+	attestations, err := genState.UnpackAttestations()
+	if err != nil {
+		panic(err)
+	}
+	for i, at := range attestations {
+		if len(genState.AttestationHeights) == 0 {
+			k.StoreAttestation(ctx, at)
+			continue
+		}
+		k.StoreAttestationAtHeight(ctx, at, genState.AttestationHeights[i])
+	}
+
+	latestNonce := genState.LatestAttestationNonce
+	earliestNonce := genState.EarliestAvailableAttestationNonce
+	if earliestNonce == 0 {
+		// The reason we're setting the earliest available nonce to 1 is because
+		// at chain startup, a new valset will always be created. Also, it's
+		// easier to set it once here rather than conditionally setting it in
+		// abci.EndBlocker which is executed on every block.
+		latestNonce = InitialLatestAttestationNonce
+		earliestNonce = InitialEarliestAvailableAttestationNonce
+	}
+	k.SetLatestAttestationNonce(ctx, latestNonce)
+	k.SetEarliestAvailableAttestationNonce(ctx, earliestNonce)
+
+	if genState.LatestUnbondingHeight != 0 {
+		k.SetLatestUnBondingBlockHeight(ctx, genState.LatestUnbondingHeight)
+	}
+
+	for _, evm := range genState.EvmAddresses {
+		valAddr, err := sdk.ValAddressFromBech32(evm.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetEVMAddress(ctx, valAddr, gethcommon.HexToAddress(evm.EvmAddress))
+	}
+	if len(genState.EvmAddressHistory) == 0 {
+		// a genesis state without history uses the registered EVM addresses
+		// for all the nonces.
+		for _, evm := range genState.EvmAddresses {
+			valAddr, err := sdk.ValAddressFromBech32(evm.ValidatorAddress)
+			if err != nil {
+				panic(err)
+			}
+			k.SetEVMAddressHistoryEntry(ctx, valAddr, gethcommon.HexToAddress(evm.EvmAddress), 0)
+		}
+	}
+	for _, entry := range genState.EvmAddressHistory {
+		valAddr, err := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetEVMAddressHistoryEntry(ctx, valAddr, gethcommon.HexToAddress(entry.EvmAddress), entry.FromNonce)
+	}
+	for _, evm := range genState.PendingEvmAddresses {
+		valAddr, err := sdk.ValAddressFromBech32(evm.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.RotateEVMAddress(ctx, valAddr, gethcommon.HexToAddress(evm.EvmAddress))
+	}
+	for _, sig := range genState.AttestationSignatures {
+		k.SetAttestationSignature(ctx, sig)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -33,6 +95,38 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	if k.CheckLatestAttestationNonce(ctx) {
+		genesis.LatestAttestationNonce = k.GetLatestAttestationNonce(ctx)
+	}
+	if k.CheckEarliestAvailableAttestationNonce(ctx) {
+		genesis.EarliestAvailableAttestationNonce = k.GetEarliestAvailableAttestationNonce(ctx)
+	}
+
+	attestations, err := k.GetAllAttestations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Attestations = make([]*codectypes.Any, len(attestations))
+	genesis.AttestationHeights = make([]uint64, len(attestations))
+	for i, at := range attestations {
+		any, err := codectypes.NewAnyWithValue(at)
+		if err != nil {
+			panic(err)
+		}
+		genesis.Attestations[i] = any
+		height, found := k.GetAttestationHeight(ctx, at)
+		if !found {
+			panic(fmt.Sprintf("missing height index for attestation nonce %d", at.GetNonce()))
+		}
+		genesis.AttestationHeights[i] = height
+	}
+
+	genesis.LatestUnbondingHeight = k.GetLatestUnBondingBlockHeight(ctx)
+	genesis.EvmAddresses = k.GetAllEVMAddresses(ctx)
+	genesis.EvmAddressHistory = k.GetAllEVMAddressHistory(ctx)
+	genesis.PendingEvmAddresses = k.GetAllPendingEVMAddresses(ctx)
+	genesis.AttestationSignatures = k.GetAllAttestationSignatures(ctx)
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
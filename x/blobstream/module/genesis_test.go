@@ -2,6 +2,12 @@ package blobstream_test
 
 import (
 	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 
 	keepertest "github.com/sunrise-zone/sunrise-app/testutil/keeper"
 	"github.com/sunrise-zone/sunrise-app/testutil/nullify"
@@ -26,5 +32,153 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.Equal(t, stream.InitialLatestAttestationNonce, got.LatestAttestationNonce)
+	require.Equal(t, stream.InitialEarliestAvailableAttestationNonce, got.EarliestAvailableAttestationNonce)
+	require.NoError(t, got.Validate())
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesisRoundTrip(t *testing.T) {
+	blockTime := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	valset, err := types.NewValset(3, 10, types.InternalBridgeValidators{
+		{Power: 100, EVMAddress: gethcommon.HexToAddress("0x9c2B12b5a07FC6D719Ed7646e5041A7E85758329")},
+	}, blockTime)
+	require.NoError(t, err)
+	attestations := []types.AttestationRequestI{
+		types.NewDataCommitment(2, 1, 401, blockTime),
+		valset,
+		types.NewDataCommitment(4, 401, 801, blockTime.Add(time.Hour)),
+	}
+	anys := make([]*codectypes.Any, len(attestations))
+	for i, at := range attestations {
+		anys[i], err = codectypes.NewAnyWithValue(at)
+		require.NoError(t, err)
+	}
+
+	genesisState := types.GenesisState{
+		Params:                            types.DefaultParams(),
+		Attestations:                      anys,
+		LatestAttestationNonce:            4,
+		EarliestAvailableAttestationNonce: 2,
+		LatestUnbondingHeight:             7,
+		AttestationHeights:                []uint64{1, 10, 400},
+		EvmAddresses: []types.EVMAddress{
+			{
+				ValidatorAddress: sdk.ValAddress("validator1").String(),
+				EvmAddress:       gethcommon.HexToAddress("0x9c2B12b5a07FC6D719Ed7646e5041A7E85758329").Hex(),
+			},
+			{
+				ValidatorAddress: sdk.ValAddress("validator2").String(),
+				EvmAddress:       gethcommon.HexToAddress("0x27A1F8CE94187E4b043f4D57548EF2348Ed556c7").Hex(),
+			},
+		},
+		EvmAddressHistory: []types.EVMAddressHistoryEntry{
+			{
+				ValidatorAddress: sdk.ValAddress("validator1").String(),
+				EvmAddress:       gethcommon.HexToAddress("0x91DEd26b5f38B065FC0204c7929Da1b2A21877Ad").Hex(),
+				FromNonce:        0,
+			},
+			{
+				ValidatorAddress: sdk.ValAddress("validator2").String(),
+				EvmAddress:       gethcommon.HexToAddress("0x3d22f0C38251ebdBE92e14BBF1bd2067F1C3f7D7").Hex(),
+				FromNonce:        0,
+			},
+			{
+				ValidatorAddress: sdk.ValAddress("validator2").String(),
+				EvmAddress:       gethcommon.HexToAddress("0x27A1F8CE94187E4b043f4D57548EF2348Ed556c7").Hex(),
+				FromNonce:        4,
+			},
+		},
+		PendingEvmAddresses: []types.EVMAddress{
+			{
+				ValidatorAddress: sdk.ValAddress("validator1").String(),
+				EvmAddress:       gethcommon.HexToAddress("0x9c2B12b5a07FC6D719Ed7646e5041A7E85758329").Hex(),
+			},
+		},
+		AttestationSignatures: []types.AttestationSignature{
+			{
+				Nonce:             2,
+				EvmAddress:        gethcommon.HexToAddress("0x9c2B12b5a07FC6D719Ed7646e5041A7E85758329").Hex(),
+				DataRootTupleRoot: gethcommon.HexToHash("0x01").Bytes(),
+				Signature:         make([]byte, 65),
+			},
+			{
+				Nonce:      3,
+				EvmAddress: gethcommon.HexToAddress("0x9c2B12b5a07FC6D719Ed7646e5041A7E85758329").Hex(),
+				Signature:  make([]byte, 65),
+			},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	// the genesis block is far past the heights at which the attestations
+	// were requested.
+	k, ctx := keepertest.StreamKeeper(t)
+	ctx = ctx.WithBlockHeight(1000)
+	stream.InitGenesis(ctx, k, genesisState)
+
+	require.Equal(t, uint64(4), k.GetLatestAttestationNonce(ctx))
+	nonce, found := k.GetAttestationNonceForHeight(ctx, 9)
+	require.True(t, found)
+	require.Equal(t, uint64(2), nonce)
+	nonce, found = k.GetAttestationNonceForHeight(ctx, 399)
+	require.True(t, found)
+	require.Equal(t, uint64(3), nonce)
+	nonce, found = k.GetAttestationNonceForHeight(ctx, 1000)
+	require.True(t, found)
+	require.Equal(t, uint64(4), nonce)
+	require.Equal(t, uint64(2), k.GetEarliestAvailableAttestationNonce(ctx))
+	require.Equal(t, uint64(7), k.GetLatestUnBondingBlockHeight(ctx))
+	evmAddr, found := k.GetEVMAddress(ctx, sdk.ValAddress("validator2"))
+	require.True(t, found)
+	require.Equal(t, gethcommon.HexToAddress("0x27A1F8CE94187E4b043f4D57548EF2348Ed556c7"), evmAddr)
+	evmAddr, found = k.GetEVMAddressForNonce(ctx, sdk.ValAddress("validator2"), 3)
+	require.True(t, found)
+	require.Equal(t, gethcommon.HexToAddress("0x3d22f0C38251ebdBE92e14BBF1bd2067F1C3f7D7"), evmAddr)
+	evmAddr, found = k.GetEVMAddressForNonce(ctx, sdk.ValAddress("validator1"), 4)
+	require.True(t, found)
+	require.Equal(t, gethcommon.HexToAddress("0x91DEd26b5f38B065FC0204c7929Da1b2A21877Ad"), evmAddr)
+
+	got := stream.ExportGenesis(ctx, k)
+	require.NoError(t, got.Validate())
+	require.Equal(t, genesisState.Params, got.Params)
+	require.Equal(t, genesisState.LatestAttestationNonce, got.LatestAttestationNonce)
+	require.Equal(t, genesisState.EarliestAvailableAttestationNonce, got.EarliestAvailableAttestationNonce)
+	require.Equal(t, genesisState.LatestUnbondingHeight, got.LatestUnbondingHeight)
+	require.ElementsMatch(t, genesisState.EvmAddresses, got.EvmAddresses)
+	require.ElementsMatch(t, genesisState.EvmAddressHistory, got.EvmAddressHistory)
+	require.ElementsMatch(t, genesisState.PendingEvmAddresses, got.PendingEvmAddresses)
+	require.Equal(t, genesisState.AttestationSignatures, got.AttestationSignatures)
+	require.Equal(t, genesisState.AttestationHeights, got.AttestationHeights)
+	require.Len(t, k.GetAttestationSignatures(ctx, 2), 1)
+	gotAttestations, err := got.UnpackAttestations()
+	require.NoError(t, err)
+	require.Equal(t, attestations, gotAttestations)
+
+	// the exported state must survive a JSON round trip and be importable in
+	// a fresh store.
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	bz, err := cdc.MarshalJSON(got)
+	require.NoError(t, err)
+	var decoded types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(bz, &decoded))
+	require.NoError(t, decoded.Validate())
+
+	k2, ctx2 := keepertest.StreamKeeper(t)
+	ctx2 = ctx2.WithBlockHeight(2000)
+	stream.InitGenesis(ctx2, k2, decoded)
+	reexported := stream.ExportGenesis(ctx2, k2)
+	require.Equal(t, got.LatestAttestationNonce, reexported.LatestAttestationNonce)
+	require.Equal(t, got.EarliestAvailableAttestationNonce, reexported.EarliestAvailableAttestationNonce)
+	require.Equal(t, got.LatestUnbondingHeight, reexported.LatestUnbondingHeight)
+	require.ElementsMatch(t, got.EvmAddresses, reexported.EvmAddresses)
+	require.ElementsMatch(t, got.EvmAddressHistory, reexported.EvmAddressHistory)
+	require.ElementsMatch(t, got.PendingEvmAddresses, reexported.PendingEvmAddresses)
+	require.Equal(t, got.AttestationSignatures, reexported.AttestationSignatures)
+	require.Equal(t, got.AttestationHeights, reexported.AttestationHeights)
+	reexportedAttestations, err := reexported.UnpackAttestations()
+	require.NoError(t, err)
+	require.Equal(t, attestations, reexportedAttestations)
+}
@@ -0,0 +1,73 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	proxywrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/ERC1967Proxy.sol"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
+)
+
+// DeployBlobstream deploys the Blobstream contract behind an ERC1967 proxy,
+// initialized with the provided valset, and returns the address of the
+// proxy. The relayer then submits the attestations following the valset.
+func DeployBlobstream(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	client EVMClient,
+	valset types.Valset,
+) (gethcommon.Address, error) {
+	deployOpts := *opts
+	deployOpts.Context = ctx
+
+	logicAddress, tx, _, err := wrapper.DeployWrappers(&deployOpts, client)
+	if err != nil {
+		return gethcommon.Address{}, fmt.Errorf("deploying the Blobstream contract: %w", err)
+	}
+	if err := waitSuccessful(ctx, client, tx); err != nil {
+		return gethcommon.Address{}, err
+	}
+
+	hash, err := valset.Hash()
+	if err != nil {
+		return gethcommon.Address{}, err
+	}
+	blobstreamABI, err := wrapper.WrappersMetaData.GetAbi()
+	if err != nil {
+		return gethcommon.Address{}, err
+	}
+	initData, err := blobstreamABI.Pack(
+		"initialize",
+		new(big.Int).SetUint64(valset.Nonce),
+		new(big.Int).SetUint64(valset.TwoThirdsThreshold()),
+		hash,
+	)
+	if err != nil {
+		return gethcommon.Address{}, err
+	}
+	proxyAddress, tx, _, err := proxywrapper.DeployWrappers(&deployOpts, client, logicAddress, initData)
+	if err != nil {
+		return gethcommon.Address{}, fmt.Errorf("deploying the Blobstream proxy: %w", err)
+	}
+	if err := waitSuccessful(ctx, client, tx); err != nil {
+		return gethcommon.Address{}, err
+	}
+	return proxyAddress, nil
+}
+
+func waitSuccessful(ctx context.Context, client EVMClient, tx *ethtypes.Transaction) error {
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		return fmt.Errorf("waiting for transaction %s: %w", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s failed", tx.Hash().Hex())
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
+)
+
+// DefaultPollInterval is the default interval at which the relayer checks for
+// new signed attestations once it caught up with the chain.
+const DefaultPollInterval = 10 * time.Second
+
+// EVMClient is the client of the EVM chain on which the Blobstream contract is
+// deployed. It is implemented by the go-ethereum ethclient and simulated
+// backend.
+type EVMClient interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Relayer follows the attestations of the x/blobstream module, and submits
+// them to the Blobstream contract along with the signatures gathered in the
+// vote extensions, in nonce order.
+type Relayer struct {
+	logger   log.Logger
+	querier  types.QueryClient
+	unpacker codectypes.AnyUnpacker
+	client   EVMClient
+	contract *wrapper.Wrappers
+	// opts are the options used to sign and send the contract transactions.
+	opts         *bind.TransactOpts
+	pollInterval time.Duration
+}
+
+// NewRelayer returns a new Relayer submitting the attestations to the
+// Blobstream contract deployed at the provided address.
+func NewRelayer(
+	logger log.Logger,
+	querier types.QueryClient,
+	unpacker codectypes.AnyUnpacker,
+	client EVMClient,
+	contractAddress gethcommon.Address,
+	opts *bind.TransactOpts,
+	pollInterval time.Duration,
+) (*Relayer, error) {
+	contract, err := wrapper.NewWrappers(contractAddress, client)
+	if err != nil {
+		return nil, err
+	}
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+	return &Relayer{
+		logger:       logger,
+		querier:      querier,
+		unpacker:     unpacker,
+		client:       client,
+		contract:     contract,
+		opts:         opts,
+		pollInterval: pollInterval,
+	}, nil
+}
+
+// Start relays the attestations until the context is canceled. Failures are
+// logged and retried at the next poll.
+func (r *Relayer) Start(ctx context.Context) error {
+	for {
+		relayed, err := r.RelayNext(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			r.logger.Error("failed to relay attestation", "err", err.Error())
+		}
+		if relayed {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.pollInterval):
+		}
+	}
+}
+
+// RelayNext submits the attestation following the last one submitted to the
+// contract. Returns false if that attestation doesn't exist or isn't signed
+// yet.
+func (r *Relayer) RelayNext(ctx context.Context) (bool, error) {
+	lastNonce, err := r.contract.StateEventNonce(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return false, errors.Wrap(err, "querying the contract nonce")
+	}
+	nonce := lastNonce.Uint64() + 1
+
+	latest, err := r.querier.LatestAttestationNonce(ctx, &types.QueryLatestAttestationNonceRequest{})
+	if err != nil {
+		return false, err
+	}
+	if nonce > latest.Nonce {
+		return false, nil
+	}
+
+	signed, err := r.querier.SignedAttestation(ctx, &types.QuerySignedAttestationRequest{Nonce: nonce})
+	if err != nil {
+		if isNotSigned(err) {
+			r.logger.Debug("waiting for the attestation to be signed", "nonce", nonce)
+			return false, nil
+		}
+		return false, err
+	}
+	var at types.AttestationRequestI
+	if err := r.unpacker.UnpackAny(signed.Attestation, &at); err != nil {
+		return false, err
+	}
+	signingValset, err := r.querier.LatestValsetRequestBeforeNonce(
+		ctx,
+		&types.QueryLatestValsetRequestBeforeNonceRequest{Nonce: nonce},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	tx, err := r.submit(ctx, at, signed.DataRootTupleRoot, *signingValset.Valset, signed.Signatures)
+	if err != nil {
+		return false, errors.Wrapf(err, "nonce %d", nonce)
+	}
+	if err := waitSuccessful(ctx, r.client, tx); err != nil {
+		return false, errors.Wrapf(err, "nonce %d", nonce)
+	}
+	r.logger.Info("relayed attestation", "nonce", nonce, "tx", tx.Hash().Hex())
+	return true, nil
+}
+
+// submit sends the transaction submitting the attestation, signed by the
+// members of the signing valset, to the contract.
+func (r *Relayer) submit(
+	ctx context.Context,
+	at types.AttestationRequestI,
+	dataRootTupleRoot []byte,
+	signingValset types.Valset,
+	signatures []types.AttestationSignature,
+) (*ethtypes.Transaction, error) {
+	validators, sigs, err := ContractSignatures(signingValset, signatures)
+	if err != nil {
+		return nil, err
+	}
+	opts := *r.opts
+	opts.Context = ctx
+	switch at := at.(type) {
+	case *types.Valset:
+		hash, err := at.Hash()
+		if err != nil {
+			return nil, err
+		}
+		return r.contract.UpdateValidatorSet(
+			&opts,
+			new(big.Int).SetUint64(at.Nonce),
+			new(big.Int).SetUint64(signingValset.Nonce),
+			new(big.Int).SetUint64(at.TwoThirdsThreshold()),
+			hash,
+			validators,
+			sigs,
+		)
+	case *types.DataCommitment:
+		if len(dataRootTupleRoot) != gethcommon.HashLength {
+			return nil, fmt.Errorf("invalid data root tuple root length %d", len(dataRootTupleRoot))
+		}
+		return r.contract.SubmitDataRootTupleRoot(
+			&opts,
+			new(big.Int).SetUint64(at.Nonce),
+			new(big.Int).SetUint64(signingValset.Nonce),
+			gethcommon.BytesToHash(dataRootTupleRoot),
+			validators,
+			sigs,
+		)
+	default:
+		return nil, errors.Wrap(types.ErrUnknownAttestationType, fmt.Sprintf("%T", at))
+	}
+}
+
+// ContractSignatures returns the members of the signing valset along with
+// their signatures, in the format expected by the Blobstream contract. The
+// signatures are ordered as the members, and the members that didn't sign get
+// an empty signature, which the contract skips.
+func ContractSignatures(
+	signingValset types.Valset,
+	signatures []types.AttestationSignature,
+) ([]wrapper.Validator, []wrapper.Signature, error) {
+	byAddress := make(map[gethcommon.Address][]byte, len(signatures))
+	for _, sig := range signatures {
+		byAddress[gethcommon.HexToAddress(sig.EvmAddress)] = sig.Signature
+	}
+	validators := make([]wrapper.Validator, len(signingValset.Members))
+	sigs := make([]wrapper.Signature, len(signingValset.Members))
+	for i, member := range signingValset.Members {
+		addr := gethcommon.HexToAddress(member.EvmAddress)
+		validators[i] = wrapper.Validator{
+			Addr:  addr,
+			Power: new(big.Int).SetUint64(member.Power),
+		}
+		signature, ok := byAddress[addr]
+		if !ok {
+			continue
+		}
+		if len(signature) != crypto.SignatureLength {
+			return nil, nil, errors.Wrapf(
+				types.ErrInvalidAttestationSignature,
+				"invalid signature length %d for %s",
+				len(signature),
+				addr.Hex(),
+			)
+		}
+		var sig wrapper.Signature
+		copy(sig.R[:], signature[:32])
+		copy(sig.S[:], signature[32:64])
+		// the contract expects the recovery id as defined by the yellow
+		// paper.
+		sig.V = signature[64] + 27
+		sigs[i] = sig
+	}
+	return validators, sigs, nil
+}
+
+// isNotSigned returns true if the error was returned because the attestation
+// hasn't been signed yet. Errors returned over gRPC lose their type, so the
+// message is also checked.
+func isNotSigned(err error) bool {
+	return errors.IsOf(err, types.ErrAttestationNotSigned) ||
+		strings.Contains(err.Error(), types.ErrAttestationNotSigned.Error())
+}
@@ -0,0 +1,290 @@
+package relayer_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunrise-zone/sunrise-app/app"
+	"github.com/sunrise-zone/sunrise-app/app/encoding"
+	testutil "github.com/sunrise-zone/sunrise-app/test/util"
+	"github.com/sunrise-zone/sunrise-app/test/util/genesis"
+	"github.com/sunrise-zone/sunrise-app/test/util/testnode"
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/relayer"
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
+)
+
+// autoCommitBackend mines a block for every transaction sent to the simulated
+// backend, so that the relayer can wait for them to be mined.
+type autoCommitBackend struct {
+	*backends.SimulatedBackend
+}
+
+func (b autoCommitBackend) SendTransaction(ctx context.Context, tx *ethtypes.Transaction) error {
+	if err := b.SimulatedBackend.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	b.Commit()
+	return nil
+}
+
+func newSimulatedBackend(t *testing.T) (autoCommitBackend, *bind.TransactOpts) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	require.NoError(t, err)
+	balance, ok := new(big.Int).SetString("10000000000000000000", 10)
+	require.True(t, ok)
+	sim := backends.NewSimulatedBackend(core.GenesisAlloc{opts.From: {Balance: balance}}, 30_000_000)
+	t.Cleanup(func() { sim.Close() })
+	return autoCommitBackend{sim}, opts
+}
+
+// signAttestation stores the signatures of the provided validators over the
+// attestation, as if they were included in their vote extensions.
+func signAttestation(t *testing.T, k keeper.Keeper, ctx sdk.Context, nonce uint64, root []byte, keys map[int]*ecdsa.PrivateKey) {
+	at, found, err := k.GetAttestationByNonce(ctx, nonce)
+	require.NoError(t, err)
+	require.True(t, found)
+	signBytes, err := types.AttestationSignBytes(at, root)
+	require.NoError(t, err)
+	for i, key := range keys {
+		signature, err := types.SignAttestation(signBytes, key)
+		require.NoError(t, err)
+		ext := types.AttestationVoteExtension{Signatures: []types.AttestationSignature{{
+			Nonce:             nonce,
+			EvmAddress:        crypto.PubkeyToAddress(key.PublicKey).Hex(),
+			DataRootTupleRoot: root,
+			Signature:         signature,
+		}}}
+		consAddress := sdk.ConsAddress(testutil.ConsPubKeys[i].Address())
+		require.NoError(t, k.ValidateVoteExtension(ctx, consAddress, ext))
+		require.Equal(t, 1, k.StoreVoteExtension(ctx, consAddress, ext))
+	}
+}
+
+func TestRelayer(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+
+	keys := make(map[int]*ecdsa.PrivateKey, len(testutil.ValAddrs))
+	for i, valAddr := range testutil.ValAddrs {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		k.InitEVMAddress(ctx, valAddr, crypto.PubkeyToAddress(key.PublicKey))
+	}
+	// four out of five validators with the same power reach the two thirds
+	// threshold.
+	signers := func() map[int]*ecdsa.PrivateKey {
+		return map[int]*ecdsa.PrivateKey{0: keys[0], 1: keys[1], 2: keys[2], 3: keys[3]}
+	}
+
+	firstValset, err := k.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	require.NoError(t, k.SetAttestationRequest(ctx, &firstValset))
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, registry)
+	types.RegisterQueryServer(queryHelper, k)
+
+	backend, opts := newSimulatedBackend(t)
+	contractAddress, err := relayer.DeployBlobstream(context.Background(), opts, backend, firstValset)
+	require.NoError(t, err)
+	r, err := relayer.NewRelayer(
+		log.NewNopLogger(),
+		types.NewQueryClient(queryHelper),
+		registry,
+		backend,
+		contractAddress,
+		opts,
+		0,
+	)
+	require.NoError(t, err)
+	contract, err := wrapper.NewWrappers(contractAddress, backend)
+	require.NoError(t, err)
+
+	// nothing to relay as the contract was initialized with the first valset.
+	relayed, err := r.RelayNext(context.Background())
+	require.NoError(t, err)
+	assert.False(t, relayed)
+
+	// a data commitment isn't relayed until it is signed.
+	root := bytes.Repeat([]byte{1}, 32)
+	require.NoError(t, k.SetAttestationRequest(ctx, types.NewDataCommitment(2, 1, 11, ctx.BlockTime())))
+	relayed, err = r.RelayNext(context.Background())
+	require.NoError(t, err)
+	assert.False(t, relayed)
+	signAttestation(t, k, ctx, 2, root, signers())
+
+	// a valset including a rotated EVM address, followed by a data
+	// commitment signed using that address.
+	newKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	k.RotateEVMAddress(ctx, testutil.ValAddrs[0], crypto.PubkeyToAddress(newKey.PublicKey))
+	valset, err := k.GetCurrentValset(ctx)
+	require.NoError(t, err)
+	require.NoError(t, k.SetAttestationRequest(ctx, &valset))
+	signAttestation(t, k, ctx, 3, nil, signers())
+	keys[0] = newKey
+	otherRoot := bytes.Repeat([]byte{2}, 32)
+	require.NoError(t, k.SetAttestationRequest(ctx, types.NewDataCommitment(4, 11, 21, ctx.BlockTime())))
+	signAttestation(t, k, ctx, 4, otherRoot, signers())
+
+	for nonce := uint64(2); nonce <= 4; nonce++ {
+		relayed, err = r.RelayNext(context.Background())
+		require.NoError(t, err, "nonce %d", nonce)
+		require.True(t, relayed, "nonce %d", nonce)
+	}
+	relayed, err = r.RelayNext(context.Background())
+	require.NoError(t, err)
+	assert.False(t, relayed)
+
+	callOpts := &bind.CallOpts{}
+	nonce, err := contract.StateEventNonce(callOpts)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), nonce.Uint64())
+	gotRoot, err := contract.StateDataRootTupleRoots(callOpts, big.NewInt(2))
+	require.NoError(t, err)
+	assert.Equal(t, root, gotRoot[:])
+	gotRoot, err = contract.StateDataRootTupleRoots(callOpts, big.NewInt(4))
+	require.NoError(t, err)
+	assert.Equal(t, otherRoot, gotRoot[:])
+	checkpoint, err := contract.StateLastValidatorSetCheckpoint(callOpts)
+	require.NoError(t, err)
+	signBytes, err := valset.SignBytes()
+	require.NoError(t, err)
+	assert.Equal(t, signBytes, gethcommon.Hash(checkpoint))
+}
+
+// TestRelayerEndToEnd relays the data commitment signed by a testnode
+// validator in its vote extensions, querying the chain over gRPC.
+func TestRelayerEndToEnd(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping relayer end to end test in short mode.")
+	}
+
+	evmKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.ImportECDSA(evmKey, "")
+	require.NoError(t, err)
+
+	const dataCommitmentWindow = 10
+	ecfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	cfg := testnode.DefaultConfig()
+	record, err := cfg.Genesis.Keyring().Key(testnode.DefaultValidatorAccountName)
+	require.NoError(t, err)
+	valAddr, err := record.GetAddress()
+	require.NoError(t, err)
+	cfg.Genesis.WithModifiers(
+		genesis.SetDataCommitmentWindow(ecfg.Codec, dataCommitmentWindow),
+		genesis.SetEVMAddresses(ecfg.Codec, types.EVMAddress{
+			ValidatorAddress: sdk.ValAddress(valAddr).String(),
+			EvmAddress:       account.Address.Hex(),
+		}),
+	)
+	cfg.AppOptions.Set(app.FlagBlobstreamEVMKeystoreFile, account.URL.Path)
+	cctx, _, _ := testnode.NewNetwork(t, cfg)
+	querier := types.NewQueryClient(cctx.GRPCClient)
+
+	// wait for the first data commitment to be signed in the vote extensions
+	// of the validator.
+	var signed *types.QuerySignedAttestationResponse
+	require.Eventually(t, func() bool {
+		signed, err = querier.SignedAttestation(cctx.GoContext(), &types.QuerySignedAttestationRequest{Nonce: 2})
+		return err == nil
+	}, time.Minute, 100*time.Millisecond)
+	var at types.AttestationRequestI
+	require.NoError(t, ecfg.InterfaceRegistry.UnpackAny(signed.Attestation, &at))
+	dataCommitment, ok := at.(*types.DataCommitment)
+	require.True(t, ok)
+	require.Len(t, signed.Signatures, 1)
+	assert.Equal(t, account.Address.Hex(), signed.Signatures[0].EvmAddress)
+	// the signed root is the one of the DataCommitment RPC.
+	commitment, err := cctx.RpcClient.DataCommitment(cctx.GoContext(), dataCommitment.BeginBlock, dataCommitment.EndBlock)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(commitment.DataCommitment), signed.DataRootTupleRoot)
+
+	firstValset, err := querier.AttestationRequestByNonce(cctx.GoContext(), &types.QueryAttestationRequestByNonceRequest{Nonce: 1})
+	require.NoError(t, err)
+	var valset types.AttestationRequestI
+	require.NoError(t, ecfg.InterfaceRegistry.UnpackAny(firstValset.Attestation, &valset))
+	backend, opts := newSimulatedBackend(t)
+	contractAddress, err := relayer.DeployBlobstream(context.Background(), opts, backend, *valset.(*types.Valset))
+	require.NoError(t, err)
+	r, err := relayer.NewRelayer(log.NewNopLogger(), querier, ecfg.InterfaceRegistry, backend, contractAddress, opts, 0)
+	require.NoError(t, err)
+
+	relayed, err := r.RelayNext(context.Background())
+	require.NoError(t, err)
+	require.True(t, relayed)
+
+	contract, err := wrapper.NewWrappers(contractAddress, backend)
+	require.NoError(t, err)
+	callOpts := &bind.CallOpts{}
+	nonce, err := contract.StateEventNonce(callOpts)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), nonce.Uint64())
+	gotRoot, err := contract.StateDataRootTupleRoots(callOpts, big.NewInt(2))
+	require.NoError(t, err)
+	assert.Equal(t, []byte(commitment.DataCommitment), gotRoot[:])
+}
+
+func TestContractSignatures(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+	valset := types.Valset{
+		Nonce: 1,
+		Members: []types.BridgeValidator{
+			{Power: 10, EvmAddress: testutil.EVMAddrs[0].Hex()},
+			{Power: 20, EvmAddress: signer.Hex()},
+		},
+	}
+	signature, err := types.SignAttestation(gethcommon.Hash{1}, key)
+	require.NoError(t, err)
+
+	validators, sigs, err := relayer.ContractSignatures(valset, []types.AttestationSignature{{
+		Nonce:      2,
+		EvmAddress: signer.Hex(),
+		Signature:  signature,
+	}})
+	require.NoError(t, err)
+	require.Len(t, validators, 2)
+	require.Len(t, sigs, 2)
+	assert.Equal(t, testutil.EVMAddrs[0], validators[0].Addr)
+	assert.Equal(t, uint64(20), validators[1].Power.Uint64())
+	// members that didn't sign get an empty signature.
+	assert.Equal(t, wrapper.Signature{}, sigs[0])
+	assert.Equal(t, signature[:32], sigs[1].R[:])
+	assert.Equal(t, signature[32:64], sigs[1].S[:])
+	assert.Equal(t, signature[64]+27, sigs[1].V)
+
+	_, _, err = relayer.ContractSignatures(valset, []types.AttestationSignature{{
+		Nonce:      2,
+		EvmAddress: signer.Hex(),
+		Signature:  signature[:64],
+	}})
+	assert.ErrorIs(t, err, types.ErrInvalidAttestationSignature)
+}
@@ -3,10 +3,15 @@ package types
 import (
 	"time"
 
+	"cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/gogo/protobuf/proto"
 )
 
+// MaxAttestationsPerPage is the maximum number of attestations, and the default
+// one, returned by the paginated attestation queries.
+const MaxAttestationsPerPage = 100
+
 // AttestationRequestI is either a DataCommitment or a Valset. This was decided
 // as part of the universal nonce approach under:
 // https://github.com/sunrise-zone/sunrise-app/issues/468#issuecomment-1156887715
@@ -16,3 +21,15 @@ type AttestationRequestI interface {
 	GetNonce() uint64
 	BlockTime() time.Time
 }
+
+// AttestationTypeOf returns the type of the provided attestation.
+func AttestationTypeOf(at AttestationRequestI) (AttestationType, error) {
+	switch at.(type) {
+	case *Valset:
+		return ATTESTATION_TYPE_VALSET, nil
+	case *DataCommitment:
+		return ATTESTATION_TYPE_DATA_COMMITMENT, nil
+	default:
+		return ATTESTATION_TYPE_UNSPECIFIED, errors.Wrapf(ErrUnknownAttestationType, "%T", at)
+	}
+}
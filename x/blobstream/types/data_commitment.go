@@ -1,6 +1,13 @@
 package types
 
-import "time"
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
 
 var _ AttestationRequestI = &DataCommitment{}
 
@@ -22,3 +29,42 @@ func NewDataCommitment(
 func (m *DataCommitment) BlockTime() time.Time {
 	return m.Time
 }
+
+// SignBytes produces the bytes that validators are required to sign over for
+// the data commitment. The data root tuple root is the commitment over the
+// data roots of the blocks in the [BeginBlock, EndBlock) range, as returned by
+// the CometBFT `DataCommitment` RPC.
+func (m *DataCommitment) SignBytes(dataRootTupleRoot []byte) (ethcmn.Hash, error) {
+	if len(dataRootTupleRoot) != ethcmn.HashLength {
+		return ethcmn.Hash{}, fmt.Errorf(
+			"invalid data root tuple root length: expected %d, got %d",
+			ethcmn.HashLength,
+			len(dataRootTupleRoot),
+		)
+	}
+	return DataCommitmentTupleRootSignBytes(big.NewInt(int64(m.Nonce)), dataRootTupleRoot), nil
+}
+
+// DataCommitmentTupleRootSignBytes computes the digest that is signed over
+// for a data root tuple root. It mimics the `domainSeparateDataRootTupleRoot`
+// function used by the Blobstream contracts.
+func DataCommitmentTupleRootSignBytes(nonce *big.Int, commitment []byte) ethcmn.Hash {
+	var dataCommitment [32]uint8
+	copy(dataCommitment[:], commitment)
+
+	// only the encoded arguments are hashed, so the function selector is
+	// discarded.
+	bytes, err := InternalBlobstreamABI.Pack(
+		"domainSeparateDataRootTupleRoot",
+		DcDomainSeparator,
+		nonce,
+		dataCommitment,
+	)
+	// this should never happen outside of test since any case that could crash
+	// on encoding should be filtered above.
+	if err != nil {
+		panic(fmt.Sprintf("Error packing data commitment! %s/n", err))
+	}
+
+	return crypto.Keccak256Hash(bytes[4:])
+}
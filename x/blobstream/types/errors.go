@@ -32,4 +32,9 @@ var (
 	ErrEVMAddressNotHex                          = sdkerrors.Register(ModuleName, 36, "the provided evm address is not a valid hex address")
 	ErrEVMAddressAlreadyExists                   = sdkerrors.Register(ModuleName, 37, "the provided evm address already exists")
 	ErrEVMAddressNotFound                        = sdkerrors.Register(ModuleName, 38, "EVM address not found")
+	ErrInvalidGenesisNonces                      = sdkerrors.Register(ModuleName, 39, "invalid attestation nonces in genesis")
+	ErrInvalidAttestationSignature               = sdkerrors.Register(ModuleName, 40, "invalid attestation signature")
+	ErrInvalidVoteExtension                      = sdkerrors.Register(ModuleName, 41, "invalid attestation vote extension")
+	ErrAttestationNotSigned                      = sdkerrors.Register(ModuleName, 42, "attestation has not been signed by two thirds of the signing valset power")
+	ErrInvalidNonceRange                         = sdkerrors.Register(ModuleName, 43, "invalid attestation nonce range")
 )
@@ -1,17 +1,29 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"fmt"
+
+	"cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	// this line is used by starport scaffolding # genesis/types/import
 )
 
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
+		// the first attestation is created at height 1 with nonce 1, so the
+		// latest nonce starts at 0 and the earliest available one at 1.
+		LatestAttestationNonce:            0,
+		EarliestAvailableAttestationNonce: 1,
 	}
 }
 
@@ -19,6 +31,258 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.validateAttestations(); err != nil {
+		return err
+	}
+	if err := gs.validateEVMAddresses(); err != nil {
+		return err
+	}
+	if err := gs.validateEVMAddressHistory(); err != nil {
+		return err
+	}
+	if err := gs.validatePendingEVMAddresses(); err != nil {
+		return err
+	}
+	return gs.validateAttestationSignatures()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces so that
+// the attestations can be decoded from their JSON representation.
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range gs.Attestations {
+		var at AttestationRequestI
+		if err := unpacker.UnpackAny(any, &at); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackAttestations returns the unpacked attestations contained in the genesis
+// state.
+func (gs GenesisState) UnpackAttestations() ([]AttestationRequestI, error) {
+	attestations := make([]AttestationRequestI, len(gs.Attestations))
+	for i, any := range gs.Attestations {
+		if any == nil {
+			return nil, errors.Wrapf(ErrNilAttestation, "index %d", i)
+		}
+		at, ok := any.GetCachedValue().(AttestationRequestI)
+		if !ok {
+			return nil, errors.Wrapf(ErrUnknownAttestationType, "index %d: %s", i, any.TypeUrl)
+		}
+		attestations[i] = at
+	}
+	return attestations, nil
+}
+
+// validateAttestations checks that the attestations are exactly the ones
+// between the earliest available nonce and the latest nonce, ordered by
+// nonce and without gaps.
+func (gs GenesisState) validateAttestations() error {
+	earliest := gs.EarliestAvailableAttestationNonce
+	latest := gs.LatestAttestationNonce
+	if earliest == 0 {
+		// a genesis state that only carries the params is treated as a fresh
+		// chain.
+		if latest != 0 || len(gs.Attestations) != 0 || len(gs.AttestationHeights) != 0 {
+			return errors.Wrap(ErrInvalidGenesisNonces, "earliest available attestation nonce must be set when attestations exist")
+		}
+		return nil
+	}
+	if latest+1 < earliest {
+		return errors.Wrapf(
+			ErrInvalidGenesisNonces,
+			"latest attestation nonce %d is lower than earliest available attestation nonce %d",
+			latest,
+			earliest,
+		)
+	}
+	if expected := latest + 1 - earliest; uint64(len(gs.Attestations)) != expected {
+		return errors.Wrapf(
+			ErrInvalidGenesisNonces,
+			"expected %d attestations for nonces [%d, %d], got %d",
+			expected,
+			earliest,
+			latest,
+			len(gs.Attestations),
+		)
+	}
+
+	attestations, err := gs.UnpackAttestations()
+	if err != nil {
+		return err
+	}
+	for i, at := range attestations {
+		if expected := earliest + uint64(i); at.GetNonce() != expected {
+			return errors.Wrapf(
+				ErrInvalidGenesisNonces,
+				"attestation at index %d has nonce %d, expected %d",
+				i,
+				at.GetNonce(),
+				expected,
+			)
+		}
+		if err := validateAttestation(at); err != nil {
+			return errors.Wrapf(err, "attestation nonce %d", at.GetNonce())
+		}
+	}
+	return gs.validateAttestationHeights()
+}
 
-	return gs.Params.Validate()
+// validateAttestationHeights checks that the heights, when provided, match the
+// attestations and don't decrease, as attestations are requested in nonce
+// order.
+func (gs GenesisState) validateAttestationHeights() error {
+	if len(gs.AttestationHeights) == 0 {
+		return nil
+	}
+	if len(gs.AttestationHeights) != len(gs.Attestations) {
+		return errors.Wrapf(
+			ErrInvalidGenesisNonces,
+			"expected %d attestation heights, got %d",
+			len(gs.Attestations),
+			len(gs.AttestationHeights),
+		)
+	}
+	for i := 1; i < len(gs.AttestationHeights); i++ {
+		if gs.AttestationHeights[i] < gs.AttestationHeights[i-1] {
+			return errors.Wrapf(
+				ErrInvalidGenesisNonces,
+				"attestation height %d at index %d is lower than the previous height %d",
+				gs.AttestationHeights[i],
+				i,
+				gs.AttestationHeights[i-1],
+			)
+		}
+	}
+	return nil
+}
+
+func validateAttestation(at AttestationRequestI) error {
+	switch at := at.(type) {
+	case *Valset:
+		if len(at.Members) == 0 {
+			return errors.Wrap(ErrEmpty, "valset members")
+		}
+		if _, err := BridgeValidators(at.Members).ToInternal(); err != nil {
+			return errors.Wrap(ErrInvalidValset, err.Error())
+		}
+	case *DataCommitment:
+		if at.BeginBlock >= at.EndBlock {
+			return errors.Wrapf(
+				ErrInvalidDataCommitmentWindow,
+				"begin block %d is not lower than end block %d",
+				at.BeginBlock,
+				at.EndBlock,
+			)
+		}
+	default:
+		return errors.Wrap(ErrUnknownAttestationType, fmt.Sprintf("%T", at))
+	}
+	return nil
+}
+
+// validateEVMAddresses checks that the validator addresses are valid and
+// that both the validators and their EVM addresses are unique.
+func (gs GenesisState) validateEVMAddresses() error {
+	validators := make(map[string]struct{}, len(gs.EvmAddresses))
+	evmAddresses := make(map[gethcommon.Address]struct{}, len(gs.EvmAddresses))
+	for _, evm := range gs.EvmAddresses {
+		valAddr, err := sdk.ValAddressFromBech32(evm.ValidatorAddress)
+		if err != nil {
+			return errors.Wrap(ErrInvalidValAddress, err.Error())
+		}
+		if _, exists := validators[valAddr.String()]; exists {
+			return errors.Wrapf(ErrDuplicate, "validator %s has more than one EVM address", evm.ValidatorAddress)
+		}
+		validators[valAddr.String()] = struct{}{}
+
+		if !gethcommon.IsHexAddress(evm.EvmAddress) {
+			return errors.Wrapf(ErrEVMAddressNotHex, "validator %s: %s", evm.ValidatorAddress, evm.EvmAddress)
+		}
+		evmAddr := gethcommon.HexToAddress(evm.EvmAddress)
+		if _, exists := evmAddresses[evmAddr]; exists {
+			return errors.Wrapf(ErrEVMAddressAlreadyExists, "address %s", evm.EvmAddress)
+		}
+		evmAddresses[evmAddr] = struct{}{}
+	}
+	return nil
+}
+
+// validateEVMAddressHistory checks that the history entries are valid and
+// that a validator has at most one EVM address from a given nonce.
+func (gs GenesisState) validateEVMAddressHistory() error {
+	type historyKey struct {
+		validator string
+		fromNonce uint64
+	}
+	entries := make(map[historyKey]struct{}, len(gs.EvmAddressHistory))
+	for _, entry := range gs.EvmAddressHistory {
+		valAddr, err := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+		if err != nil {
+			return errors.Wrap(ErrInvalidValAddress, err.Error())
+		}
+		if !gethcommon.IsHexAddress(entry.EvmAddress) {
+			return errors.Wrapf(ErrEVMAddressNotHex, "validator %s: %s", entry.ValidatorAddress, entry.EvmAddress)
+		}
+		key := historyKey{validator: valAddr.String(), fromNonce: entry.FromNonce}
+		if _, exists := entries[key]; exists {
+			return errors.Wrapf(
+				ErrDuplicate,
+				"validator %s has more than one EVM address from nonce %d",
+				entry.ValidatorAddress,
+				entry.FromNonce,
+			)
+		}
+		entries[key] = struct{}{}
+	}
+	return nil
+}
+
+// validatePendingEVMAddresses checks that the pending EVM addresses are valid
+// and that a validator has at most one of them.
+func (gs GenesisState) validatePendingEVMAddresses() error {
+	validators := make(map[string]struct{}, len(gs.PendingEvmAddresses))
+	for _, evm := range gs.PendingEvmAddresses {
+		valAddr, err := sdk.ValAddressFromBech32(evm.ValidatorAddress)
+		if err != nil {
+			return errors.Wrap(ErrInvalidValAddress, err.Error())
+		}
+		if _, exists := validators[valAddr.String()]; exists {
+			return errors.Wrapf(ErrDuplicate, "validator %s has more than one pending EVM address", evm.ValidatorAddress)
+		}
+		validators[valAddr.String()] = struct{}{}
+		if !gethcommon.IsHexAddress(evm.EvmAddress) {
+			return errors.Wrapf(ErrEVMAddressNotHex, "validator %s: %s", evm.ValidatorAddress, evm.EvmAddress)
+		}
+	}
+	return nil
+}
+
+// validateAttestationSignatures checks that the signatures are valid, that
+// they sign available attestations, and that an EVM address signs an
+// attestation at most once.
+func (gs GenesisState) validateAttestationSignatures() error {
+	type signatureKey struct {
+		nonce      uint64
+		evmAddress gethcommon.Address
+	}
+	signatures := make(map[signatureKey]struct{}, len(gs.AttestationSignatures))
+	for _, sig := range gs.AttestationSignatures {
+		if err := sig.ValidateBasic(); err != nil {
+			return err
+		}
+		if sig.Nonce < gs.EarliestAvailableAttestationNonce || sig.Nonce > gs.LatestAttestationNonce {
+			return errors.Wrapf(ErrAttestationNotFound, "signature of unavailable nonce %d", sig.Nonce)
+		}
+		key := signatureKey{nonce: sig.Nonce, evmAddress: gethcommon.HexToAddress(sig.EvmAddress)}
+		if _, exists := signatures[key]; exists {
+			return errors.Wrapf(ErrDuplicate, "%s signed nonce %d more than once", sig.EvmAddress, sig.Nonce)
+		}
+		signatures[key] = struct{}{}
+	}
+	return nil
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,6 +30,33 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// attestations holds every attestation still available in store, i.e. the
+	// ones between the earliest available attestation nonce and the latest
+	// attestation nonce. Each one is either a Valset or a DataCommitment.
+	Attestations []*types.Any `protobuf:"bytes,2,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	// latest_attestation_nonce is the nonce of the latest attestation.
+	LatestAttestationNonce uint64 `protobuf:"varint,3,opt,name=latest_attestation_nonce,json=latestAttestationNonce,proto3" json:"latest_attestation_nonce,omitempty"`
+	// earliest_available_attestation_nonce is the nonce of the earliest
+	// attestation that has not been pruned.
+	EarliestAvailableAttestationNonce uint64 `protobuf:"varint,4,opt,name=earliest_available_attestation_nonce,json=earliestAvailableAttestationNonce,proto3" json:"earliest_available_attestation_nonce,omitempty"`
+	// latest_unbonding_height is the latest height at which a validator started
+	// unbonding.
+	LatestUnbondingHeight uint64 `protobuf:"varint,5,opt,name=latest_unbonding_height,json=latestUnbondingHeight,proto3" json:"latest_unbonding_height,omitempty"`
+	// evm_addresses holds the EVM addresses registered by validators.
+	EvmAddresses []EVMAddress `protobuf:"bytes,6,rep,name=evm_addresses,json=evmAddresses,proto3" json:"evm_addresses"`
+	// evm_address_history holds the EVM addresses used by validators to sign
+	// attestations, along with the nonce from which they are used.
+	EvmAddressHistory []EVMAddressHistoryEntry `protobuf:"bytes,7,rep,name=evm_address_history,json=evmAddressHistory,proto3" json:"evm_address_history"`
+	// pending_evm_addresses holds the EVM addresses registered by validators
+	// that will be used to sign attestations once the next valset is requested.
+	PendingEvmAddresses []EVMAddress `protobuf:"bytes,8,rep,name=pending_evm_addresses,json=pendingEvmAddresses,proto3" json:"pending_evm_addresses"`
+	// attestation_signatures holds the signatures of the available attestations
+	// collected from the vote extensions of the validators.
+	AttestationSignatures []AttestationSignature `protobuf:"bytes,9,rep,name=attestation_signatures,json=attestationSignatures,proto3" json:"attestation_signatures"`
+	// attestation_heights holds the height at which each attestation was
+	// requested, in the order of attestations. When empty, the attestations are
+	// indexed at the genesis height.
+	AttestationHeights []uint64 `protobuf:"varint,10,rep,packed,name=attestation_heights,json=attestationHeights,proto3" json:"attestation_heights,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,8 +99,195 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetAttestations() []*types.Any {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLatestAttestationNonce() uint64 {
+	if m != nil {
+		return m.LatestAttestationNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEarliestAvailableAttestationNonce() uint64 {
+	if m != nil {
+		return m.EarliestAvailableAttestationNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLatestUnbondingHeight() uint64 {
+	if m != nil {
+		return m.LatestUnbondingHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEvmAddresses() []EVMAddress {
+	if m != nil {
+		return m.EvmAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEvmAddressHistory() []EVMAddressHistoryEntry {
+	if m != nil {
+		return m.EvmAddressHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingEvmAddresses() []EVMAddress {
+	if m != nil {
+		return m.PendingEvmAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestationSignatures() []AttestationSignature {
+	if m != nil {
+		return m.AttestationSignatures
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestationHeights() []uint64 {
+	if m != nil {
+		return m.AttestationHeights
+	}
+	return nil
+}
+
+// EVMAddress associates a validator with the EVM address it uses to sign
+// attestations.
+type EVMAddress struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// evm_address is the HEX encoded EVM address.
+	EvmAddress string `protobuf:"bytes,2,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+}
+
+func (m *EVMAddress) Reset()         { *m = EVMAddress{} }
+func (m *EVMAddress) String() string { return proto.CompactTextString(m) }
+func (*EVMAddress) ProtoMessage()    {}
+func (*EVMAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d77699c1dc0f866f, []int{1}
+}
+func (m *EVMAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EVMAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EVMAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EVMAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMAddress.Merge(m, src)
+}
+func (m *EVMAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *EVMAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMAddress proto.InternalMessageInfo
+
+func (m *EVMAddress) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EVMAddress) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
+	}
+	return ""
+}
+
+// EVMAddressHistoryEntry defines the EVM address used by a validator to sign
+// the attestations from a given nonce, until the next entry of the validator.
+type EVMAddressHistoryEntry struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// evm_address is the HEX encoded EVM address.
+	EvmAddress string `protobuf:"bytes,2,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+	// from_nonce is the nonce of the first attestation signed using the EVM
+	// address.
+	FromNonce uint64 `protobuf:"varint,3,opt,name=from_nonce,json=fromNonce,proto3" json:"from_nonce,omitempty"`
+}
+
+func (m *EVMAddressHistoryEntry) Reset()         { *m = EVMAddressHistoryEntry{} }
+func (m *EVMAddressHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*EVMAddressHistoryEntry) ProtoMessage()    {}
+func (*EVMAddressHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d77699c1dc0f866f, []int{2}
+}
+func (m *EVMAddressHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EVMAddressHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EVMAddressHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EVMAddressHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMAddressHistoryEntry.Merge(m, src)
+}
+func (m *EVMAddressHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *EVMAddressHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMAddressHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMAddressHistoryEntry proto.InternalMessageInfo
+
+func (m *EVMAddressHistoryEntry) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EVMAddressHistoryEntry) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
+	}
+	return ""
+}
+
+func (m *EVMAddressHistoryEntry) GetFromNonce() uint64 {
+	if m != nil {
+		return m.FromNonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "sunrise.blobstream.v1.GenesisState")
+	proto.RegisterType((*EVMAddress)(nil), "sunrise.blobstream.v1.EVMAddress")
+	proto.RegisterType((*EVMAddressHistoryEntry)(nil), "sunrise.blobstream.v1.EVMAddressHistoryEntry")
 }
 
 func init() {
@@ -79,21 +295,47 @@ func init() {
 }
 
 var fileDescriptor_d77699c1dc0f866f = []byte{
-	// 221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x2e, 0xcd, 0x2b,
-	0xca, 0x2c, 0x4e, 0xd5, 0x4f, 0xca, 0xc9, 0x4f, 0x2a, 0x2e, 0x29, 0x4a, 0x4d, 0xcc, 0xd5, 0x2f,
-	0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x85, 0x2a, 0xd2, 0x43, 0x28, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb,
-	0xd7, 0x07, 0x93, 0x10, 0x95, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05,
-	0x15, 0x55, 0xc2, 0x6e, 0x49, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x0e, 0xa5, 0x00, 0x2e, 0x1e,
-	0x77, 0x88, 0xa5, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x0e, 0x5c, 0x6c, 0x10, 0x79, 0x09, 0x46,
-	0x05, 0x46, 0x0d, 0x6e, 0x23, 0x59, 0x3d, 0xac, 0x8e, 0xd0, 0x0b, 0x00, 0x2b, 0x72, 0xe2, 0x3c,
-	0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x7d, 0x4e, 0x01, 0x27, 0x1e,
-	0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17,
-	0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x96, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4,
-	0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x35, 0x55, 0xb7, 0x2a, 0x3f, 0x2f, 0x15, 0xce, 0x49, 0x2c, 0x28,
-	0xd0, 0xaf, 0x40, 0x76, 0x6d, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xa9, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x15, 0xfb, 0x8e, 0x35, 0x01, 0x00, 0x00,
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc7, 0x9b, 0xad, 0x14, 0xea, 0x0d, 0x89, 0xb9, 0xeb, 0x16, 0x26, 0xad, 0x6b, 0x0b, 0x87,
+	0x0a, 0xd4, 0x44, 0x1b, 0xd2, 0xc4, 0x91, 0x56, 0x9a, 0x18, 0x12, 0x8c, 0x91, 0x89, 0x1d, 0xe0,
+	0x50, 0x39, 0xad, 0x97, 0x5a, 0x4a, 0xec, 0x62, 0x3b, 0x11, 0x45, 0xe2, 0x1d, 0xe0, 0x2d, 0x76,
+	0xe4, 0xb0, 0x87, 0x98, 0x38, 0x4d, 0x9c, 0x38, 0x21, 0xb4, 0x1d, 0x78, 0x0d, 0x14, 0xdb, 0xa1,
+	0x19, 0x64, 0x42, 0x5c, 0xb8, 0x44, 0xb1, 0xbf, 0xdf, 0xdf, 0xc7, 0xbf, 0x3f, 0xb2, 0xc1, 0x1d,
+	0x11, 0x53, 0x4e, 0x04, 0x76, 0xfd, 0x90, 0xf9, 0x42, 0x72, 0x8c, 0x22, 0x37, 0xd9, 0x74, 0x03,
+	0x4c, 0xb1, 0x20, 0xc2, 0x99, 0x70, 0x26, 0x19, 0xac, 0x1b, 0x93, 0x33, 0x33, 0x39, 0xc9, 0xe6,
+	0xda, 0x12, 0x8a, 0x08, 0x65, 0xae, 0xfa, 0x6a, 0xe7, 0xda, 0x72, 0xc0, 0x02, 0xa6, 0x7e, 0xdd,
+	0xf4, 0xcf, 0xec, 0xde, 0x0e, 0x18, 0x0b, 0x42, 0xec, 0xaa, 0x95, 0x1f, 0x1f, 0xb9, 0x88, 0x4e,
+	0x33, 0x69, 0xc8, 0x44, 0xc4, 0xc4, 0x40, 0xc7, 0xe8, 0x85, 0x91, 0xda, 0xc5, 0xa9, 0x4d, 0x10,
+	0x47, 0x51, 0xe6, 0x69, 0x15, 0x7b, 0xe4, 0x74, 0x82, 0x8d, 0xa5, 0xfd, 0xb1, 0x02, 0x16, 0x1f,
+	0xeb, 0x72, 0x0e, 0x24, 0x92, 0x18, 0x3e, 0x02, 0x15, 0xcd, 0xb0, 0xad, 0xa6, 0xd5, 0x59, 0xd8,
+	0x5a, 0x77, 0x0a, 0xcb, 0x73, 0xf6, 0x95, 0xa9, 0x5f, 0x3d, 0xfd, 0xb6, 0x51, 0x3a, 0xfe, 0xf1,
+	0xe9, 0x9e, 0xe5, 0x99, 0x38, 0xf8, 0x02, 0x2c, 0x22, 0x29, 0xb1, 0x90, 0x48, 0x12, 0x46, 0x85,
+	0x3d, 0xd7, 0x9c, 0xef, 0x2c, 0x6c, 0x2d, 0x3b, 0xba, 0x4c, 0x27, 0x2b, 0xd3, 0xe9, 0xd1, 0x69,
+	0x7f, 0xf5, 0xf3, 0x49, 0xb7, 0xd6, 0x9b, 0xb9, 0x3d, 0xfc, 0x26, 0xc6, 0x42, 0x3e, 0xf1, 0x2e,
+	0x21, 0xe0, 0x43, 0x60, 0x87, 0x28, 0x5d, 0x0f, 0x72, 0xdb, 0x03, 0xca, 0xe8, 0x10, 0xdb, 0xf3,
+	0x4d, 0xab, 0x53, 0xf6, 0x56, 0xb4, 0x9e, 0x43, 0xed, 0xa5, 0x2a, 0x7c, 0x0e, 0xee, 0x62, 0xc4,
+	0x43, 0xa2, 0x62, 0x13, 0x44, 0x42, 0xe4, 0x87, 0xb8, 0x80, 0x52, 0x56, 0x94, 0x56, 0xe6, 0xed,
+	0x65, 0xd6, 0x3f, 0x80, 0xdb, 0x60, 0xd5, 0xa4, 0x12, 0x53, 0x9f, 0xd1, 0x11, 0xa1, 0xc1, 0x60,
+	0x8c, 0x49, 0x30, 0x96, 0xf6, 0x35, 0xc5, 0xa8, 0x6b, 0xf9, 0x65, 0xa6, 0xee, 0x2a, 0x11, 0x3e,
+	0x05, 0x37, 0x71, 0x12, 0x0d, 0xd0, 0x68, 0xc4, 0xb1, 0x10, 0x58, 0xd8, 0x15, 0xd5, 0x96, 0xd6,
+	0x15, 0xed, 0xdd, 0x39, 0x7c, 0xd6, 0xd3, 0xd6, 0x7e, 0x39, 0x6d, 0xb1, 0xb7, 0x88, 0x93, 0xa8,
+	0x97, 0x05, 0xc3, 0x21, 0xa8, 0xe5, 0x68, 0x83, 0x31, 0x11, 0x92, 0xf1, 0xa9, 0x7d, 0x5d, 0x31,
+	0xbb, 0x7f, 0x65, 0xee, 0x6a, 0xff, 0x0e, 0x95, 0x7c, 0x6a, 0xf8, 0x4b, 0x33, 0xbe, 0x51, 0xe1,
+	0x6b, 0x50, 0x9f, 0x60, 0x5d, 0xe1, 0xe5, 0xd4, 0x6f, 0xfc, 0x5b, 0xea, 0x35, 0x43, 0xd9, 0xc9,
+	0x57, 0x30, 0x06, 0x2b, 0xf9, 0x29, 0x08, 0x12, 0x50, 0x24, 0x63, 0x8e, 0x85, 0x5d, 0x55, 0xf4,
+	0xfb, 0x57, 0xd0, 0x73, 0x03, 0x39, 0xc8, 0x62, 0xcc, 0x39, 0x75, 0x54, 0xa0, 0x09, 0xe8, 0x82,
+	0x5a, 0xfe, 0x24, 0x3d, 0x2c, 0x61, 0x83, 0xe6, 0x7c, 0xa7, 0xec, 0xc1, 0x9c, 0xa4, 0x27, 0x25,
+	0xda, 0xef, 0x01, 0x98, 0xd5, 0x00, 0xf7, 0xc0, 0x52, 0x82, 0x42, 0x32, 0x42, 0x92, 0xf1, 0xac,
+	0x07, 0xea, 0x6e, 0x54, 0xfb, 0xad, 0x2f, 0x27, 0xdd, 0x75, 0x73, 0x2b, 0x0f, 0x33, 0x8f, 0x89,
+	0x3b, 0x90, 0x9c, 0xd0, 0xc0, 0xbb, 0x95, 0xfc, 0xb6, 0x0f, 0x37, 0xc0, 0x42, 0xae, 0x9b, 0xf6,
+	0x5c, 0x4a, 0xf2, 0xc0, 0xac, 0xfb, 0xed, 0x63, 0x0b, 0xac, 0x14, 0x8f, 0xea, 0xbf, 0xe7, 0x02,
+	0xd7, 0x01, 0x38, 0xe2, 0x2c, 0xba, 0x74, 0xd5, 0xaa, 0xe9, 0x8e, 0xba, 0x0c, 0xfd, 0xfd, 0xd3,
+	0xf3, 0x86, 0x75, 0x76, 0xde, 0xb0, 0xbe, 0x9f, 0x37, 0xac, 0x0f, 0x17, 0x8d, 0xd2, 0xd9, 0x45,
+	0xa3, 0xf4, 0xf5, 0xa2, 0x51, 0x7a, 0xb5, 0x1d, 0x10, 0x39, 0x8e, 0x7d, 0x67, 0xc8, 0x22, 0xd7,
+	0x0c, 0xb2, 0xfb, 0x8e, 0x51, 0xfc, 0x6b, 0x81, 0x26, 0x13, 0xf7, 0x6d, 0xfe, 0x61, 0x52, 0xaf,
+	0x92, 0x5f, 0x51, 0xcf, 0xc3, 0x83, 0x9f, 0x03, 0x00, 0x88, 0x19, 0x46, 0x9c, 0x7a, 0x05, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -116,6 +358,109 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AttestationHeights) > 0 {
+		dAtA2 := make([]byte, len(m.AttestationHeights)*10)
+		var j1 int
+		for _, num := range m.AttestationHeights {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.AttestationSignatures) > 0 {
+		for iNdEx := len(m.AttestationSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttestationSignatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PendingEvmAddresses) > 0 {
+		for iNdEx := len(m.PendingEvmAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingEvmAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.EvmAddressHistory) > 0 {
+		for iNdEx := len(m.EvmAddressHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmAddressHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.EvmAddresses) > 0 {
+		for iNdEx := len(m.EvmAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.LatestUnbondingHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestUnbondingHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EarliestAvailableAttestationNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EarliestAvailableAttestationNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LatestAttestationNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestAttestationNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -129,6 +474,85 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EVMAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EVMAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EVMAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EVMAddressHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EVMAddressHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EVMAddressHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FromNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -148,6 +572,89 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LatestAttestationNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LatestAttestationNonce))
+	}
+	if m.EarliestAvailableAttestationNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.EarliestAvailableAttestationNonce))
+	}
+	if m.LatestUnbondingHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LatestUnbondingHeight))
+	}
+	if len(m.EvmAddresses) > 0 {
+		for _, e := range m.EvmAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EvmAddressHistory) > 0 {
+		for _, e := range m.EvmAddressHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingEvmAddresses) > 0 {
+		for _, e := range m.PendingEvmAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AttestationSignatures) > 0 {
+		for _, e := range m.AttestationSignatures {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AttestationHeights) > 0 {
+		l = 0
+		for _, e := range m.AttestationHeights {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EVMAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *EVMAddressHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.FromNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.FromNonce))
+	}
 	return n
 }
 
@@ -219,6 +726,556 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &types.Any{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestAttestationNonce", wireType)
+			}
+			m.LatestAttestationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestAttestationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestAvailableAttestationNonce", wireType)
+			}
+			m.EarliestAvailableAttestationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarliestAvailableAttestationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestUnbondingHeight", wireType)
+			}
+			m.LatestUnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestUnbondingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddresses = append(m.EvmAddresses, EVMAddress{})
+			if err := m.EvmAddresses[len(m.EvmAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddressHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddressHistory = append(m.EvmAddressHistory, EVMAddressHistoryEntry{})
+			if err := m.EvmAddressHistory[len(m.EvmAddressHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingEvmAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingEvmAddresses = append(m.PendingEvmAddresses, EVMAddress{})
+			if err := m.PendingEvmAddresses[len(m.PendingEvmAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationSignatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationSignatures = append(m.AttestationSignatures, AttestationSignature{})
+			if err := m.AttestationSignatures[len(m.AttestationSignatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AttestationHeights = append(m.AttestationHeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AttestationHeights) == 0 {
+					m.AttestationHeights = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AttestationHeights = append(m.AttestationHeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationHeights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EVMAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EVMAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EVMAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EVMAddressHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EVMAddressHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EVMAddressHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromNonce", wireType)
+			}
+			m.FromNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,11 @@ package types_test
 
 import (
 	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
 
@@ -9,6 +14,17 @@ import (
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	blockTime := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	dc2 := mustAny(t, types.NewDataCommitment(2, 1, 401, blockTime))
+	dc3 := mustAny(t, types.NewDataCommitment(3, 401, 801, blockTime))
+	dc4 := mustAny(t, types.NewDataCommitment(4, 801, 1201, blockTime))
+	invalidDC := mustAny(t, types.NewDataCommitment(3, 801, 401, blockTime))
+	evmAddr1 := gethcommon.HexToAddress("0x9c2B12b5a07FC6D719Ed7646e5041A7E85758329").Hex()
+	evmAddr2 := gethcommon.HexToAddress("0x27A1F8CE94187E4b043f4D57548EF2348Ed556c7").Hex()
+	val1 := sdk.ValAddress("validator1").String()
+	val2 := sdk.ValAddress("validator2").String()
+	signature := make([]byte, 65)
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -20,13 +36,261 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				Params: types.DefaultParams(),
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "invalid params",
+			genState: &types.GenesisState{
+				Params: types.Params{},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state with attestations and EVM addresses",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultParams(),
+				Attestations:                      []*codectypes.Any{dc2, dc3},
+				LatestAttestationNonce:            3,
+				EarliestAvailableAttestationNonce: 2,
+				LatestUnbondingHeight:             10,
+				EvmAddresses: []types.EVMAddress{
+					{ValidatorAddress: val1, EvmAddress: evmAddr1},
+					{ValidatorAddress: val2, EvmAddress: evmAddr2},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "attestations without earliest available nonce",
+			genState: &types.GenesisState{
+				Params:                 types.DefaultParams(),
+				Attestations:           []*codectypes.Any{dc2},
+				LatestAttestationNonce: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "latest nonce lower than earliest available nonce",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultParams(),
+				LatestAttestationNonce:            1,
+				EarliestAvailableAttestationNonce: 3,
+			},
+			valid: false,
+		},
+		{
+			desc: "attestations count does not match nonces",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultParams(),
+				Attestations:                      []*codectypes.Any{dc2},
+				LatestAttestationNonce:            3,
+				EarliestAvailableAttestationNonce: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "attestations are not continuous",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultParams(),
+				Attestations:                      []*codectypes.Any{dc2, dc4},
+				LatestAttestationNonce:            3,
+				EarliestAvailableAttestationNonce: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid data commitment range",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultParams(),
+				Attestations:                      []*codectypes.Any{dc2, invalidDC},
+				LatestAttestationNonce:            3,
+				EarliestAvailableAttestationNonce: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid validator address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EvmAddresses: []types.EVMAddress{
+					{ValidatorAddress: "invalid", EvmAddress: evmAddr1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid EVM address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EvmAddresses: []types.EVMAddress{
+					{ValidatorAddress: val1, EvmAddress: "0xinvalid"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate validator",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EvmAddresses: []types.EVMAddress{
+					{ValidatorAddress: val1, EvmAddress: evmAddr1},
+					{ValidatorAddress: val1, EvmAddress: evmAddr2},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate EVM address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EvmAddresses: []types.EVMAddress{
+					{ValidatorAddress: val1, EvmAddress: evmAddr1},
+					{ValidatorAddress: val2, EvmAddress: evmAddr1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid EVM address history and pending EVM addresses",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EvmAddresses: []types.EVMAddress{
+					{ValidatorAddress: val1, EvmAddress: evmAddr2},
+				},
+				EvmAddressHistory: []types.EVMAddressHistoryEntry{
+					{ValidatorAddress: val1, EvmAddress: evmAddr1, FromNonce: 0},
+				},
+				PendingEvmAddresses: []types.EVMAddress{
+					{ValidatorAddress: val1, EvmAddress: evmAddr2},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate EVM address history entry",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EvmAddressHistory: []types.EVMAddressHistoryEntry{
+					{ValidatorAddress: val1, EvmAddress: evmAddr1, FromNonce: 3},
+					{ValidatorAddress: val1, EvmAddress: evmAddr2, FromNonce: 3},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid EVM address in history",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EvmAddressHistory: []types.EVMAddressHistoryEntry{
+					{ValidatorAddress: val1, EvmAddress: "not an address", FromNonce: 0},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate pending EVM address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingEvmAddresses: []types.EVMAddress{
+					{ValidatorAddress: val1, EvmAddress: evmAddr1},
+					{ValidatorAddress: val1, EvmAddress: evmAddr2},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid attestation signatures",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultParams(),
+				Attestations:                      []*codectypes.Any{dc2, dc3},
+				LatestAttestationNonce:            3,
+				EarliestAvailableAttestationNonce: 2,
+				AttestationSignatures: []types.AttestationSignature{
+					{Nonce: 2, EvmAddress: evmAddr1, Signature: signature},
+					{Nonce: 2, EvmAddress: evmAddr2, Signature: signature},
+					{Nonce: 3, EvmAddress: evmAddr1, Signature: signature},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "signature of an unavailable attestation",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultParams(),
+				Attestations:                      []*codectypes.Any{dc2, dc3},
+				LatestAttestationNonce:            3,
+				EarliestAvailableAttestationNonce: 2,
+				AttestationSignatures: []types.AttestationSignature{
+					{Nonce: 4, EvmAddress: evmAddr1, Signature: signature},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate attestation signature",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultParams(),
+				Attestations:                      []*codectypes.Any{dc2, dc3},
+				LatestAttestationNonce:            3,
+				EarliestAvailableAttestationNonce: 2,
+				AttestationSignatures: []types.AttestationSignature{
+					{Nonce: 2, EvmAddress: evmAddr1, Signature: signature},
+					{Nonce: 2, EvmAddress: evmAddr1, Signature: signature},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid attestation signature",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultParams(),
+				Attestations:                      []*codectypes.Any{dc2, dc3},
+				LatestAttestationNonce:            3,
+				EarliestAvailableAttestationNonce: 2,
+				AttestationSignatures: []types.AttestationSignature{
+					{Nonce: 2, EvmAddress: evmAddr1, Signature: signature[1:]},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid attestation heights",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultParams(),
+				Attestations:                      []*codectypes.Any{dc2, dc3},
+				LatestAttestationNonce:            3,
+				EarliestAvailableAttestationNonce: 2,
+				AttestationHeights:                []uint64{401, 801},
+			},
+			valid: true,
+		},
+		{
+			desc: "attestation heights count does not match attestations",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultParams(),
+				Attestations:                      []*codectypes.Any{dc2, dc3},
+				LatestAttestationNonce:            3,
+				EarliestAvailableAttestationNonce: 2,
+				AttestationHeights:                []uint64{401},
+			},
+			valid: false,
+		},
+		{
+			desc: "decreasing attestation heights",
+			genState: &types.GenesisState{
+				Params:                            types.DefaultParams(),
+				Attestations:                      []*codectypes.Any{dc2, dc3},
+				LatestAttestationNonce:            3,
+				EarliestAvailableAttestationNonce: 2,
+				AttestationHeights:                []uint64{801, 401},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -40,3 +304,10 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func mustAny(t *testing.T, at types.AttestationRequestI) *codectypes.Any {
+	t.Helper()
+	any, err := codectypes.NewAnyWithValue(at)
+	require.NoError(t, err)
+	return any
+}
@@ -4,6 +4,8 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 const (
@@ -42,6 +44,25 @@ const (
 
 	// EVMAddress indexes evm addresses by validator address
 	EvmAddress = "EvmAddress"
+
+	// AttestationSignatureKey indexes attestation signatures by nonce and
+	// signer EVM address
+	AttestationSignatureKey = "AttestationSignatureKey"
+
+	// AttestationTypeIndexKey indexes attestation nonces by attestation type
+	AttestationTypeIndexKey = "AttestationTypeIndexKey"
+
+	// AttestationHeightIndexKey indexes attestation nonces by the height at
+	// which the attestation was requested
+	AttestationHeightIndexKey = "AttestationHeightIndexKey"
+
+	// EVMAddressHistoryKey indexes the EVM addresses used by validators by
+	// validator address and the nonce from which they are used
+	EVMAddressHistoryKey = "EVMAddressHistoryKey"
+
+	// PendingEVMAddressKey indexes the EVM addresses waiting for the next
+	// valset to be used by validator address
+	PendingEVMAddressKey = "PendingEVMAddressKey"
 )
 
 // GetAttestationKey returns the following key format
@@ -51,6 +72,43 @@ func GetAttestationKey(nonce uint64) string {
 	return AttestationRequestKey + string(UInt64Bytes(nonce))
 }
 
+// GetAttestationSignaturesPrefix returns the prefix of all the signatures of
+// the attestation with the provided nonce:
+// prefix                   nonce
+// [AttestationSignatureKey][0 0 0 0 0 0 0 1]
+func GetAttestationSignaturesPrefix(nonce uint64) string {
+	return AttestationSignatureKey + string(UInt64Bytes(nonce))
+}
+
+// GetAttestationSignatureKey returns the following key format
+// prefix                   nonce               evm address
+// [AttestationSignatureKey][0 0 0 0 0 0 0 1][0x...]
+func GetAttestationSignatureKey(nonce uint64, evmAddress gethcommon.Address) string {
+	return GetAttestationSignaturesPrefix(nonce) + string(evmAddress.Bytes())
+}
+
+// GetAttestationTypeIndexPrefix returns the prefix of the nonces of all the
+// attestations of the provided type:
+// prefix                   type
+// [AttestationTypeIndexKey][1]
+func GetAttestationTypeIndexPrefix(attestationType AttestationType) string {
+	return AttestationTypeIndexKey + string([]byte{byte(attestationType)})
+}
+
+// GetAttestationTypeIndexKey returns the following key format
+// prefix                   type nonce
+// [AttestationTypeIndexKey][1][0 0 0 0 0 0 0 1]
+func GetAttestationTypeIndexKey(attestationType AttestationType, nonce uint64) string {
+	return GetAttestationTypeIndexPrefix(attestationType) + string(UInt64Bytes(nonce))
+}
+
+// GetAttestationHeightIndexKey returns the following key format
+// prefix                     height              nonce
+// [AttestationHeightIndexKey][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 1]
+func GetAttestationHeightIndexKey(height uint64, nonce uint64) string {
+	return AttestationHeightIndexKey + string(UInt64Bytes(height)) + string(UInt64Bytes(nonce))
+}
+
 func ConvertByteArrToString(value []byte) string {
 	var ret strings.Builder
 	for i := 0; i < len(value); i++ {
@@ -62,3 +120,25 @@ func ConvertByteArrToString(value []byte) string {
 func GetEVMKey(valAddress sdk.ValAddress) []byte {
 	return append([]byte(EvmAddress), valAddress...)
 }
+
+// GetEVMAddressHistoryPrefix returns the prefix of the EVM address history of
+// the provided validator:
+// prefix                length prefixed validator address
+// [EVMAddressHistoryKey][20][0x...]
+func GetEVMAddressHistoryPrefix(valAddress sdk.ValAddress) []byte {
+	return append([]byte(EVMAddressHistoryKey), address.MustLengthPrefix(valAddress)...)
+}
+
+// GetEVMAddressHistoryKey returns the following key format
+// prefix                length prefixed validator address from nonce
+// [EVMAddressHistoryKey][20][0x...][0 0 0 0 0 0 0 1]
+func GetEVMAddressHistoryKey(valAddress sdk.ValAddress, fromNonce uint64) []byte {
+	return append(GetEVMAddressHistoryPrefix(valAddress), UInt64Bytes(fromNonce)...)
+}
+
+// GetPendingEVMAddressKey returns the following key format
+// prefix                validator address
+// [PendingEVMAddressKey][0x...]
+func GetPendingEVMAddressKey(valAddress sdk.ValAddress) []byte {
+	return append([]byte(PendingEVMAddressKey), valAddress...)
+}
@@ -1,32 +1,134 @@
 package types
 
 import (
+	fmt "fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyDataCommitmentWindow                           = []byte("DataCommitmentWindow")
+	DefaultDataCommitmentWindow                uint64 = 400
+	KeySignificantPowerDifferenceThreshold            = []byte("SignificantPowerDifferenceThreshold")
+	DefaultSignificantPowerDifferenceThreshold        = sdkmath.LegacyNewDecWithPrec(5, 2) // 0.05
+	KeyAttestationExpiryTime                          = []byte("AttestationExpiryTime")
+	DefaultAttestationExpiryTime                      = 3 * 7 * 24 * time.Hour // 3 weeks
+	KeyMaxPrunedAttestationsPerBlock                  = []byte("MaxPrunedAttestationsPerBlock")
+	DefaultMaxPrunedAttestationsPerBlock       uint64 = 100
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	dataCommitmentWindow uint64,
+	significantPowerDifferenceThreshold sdkmath.LegacyDec,
+	attestationExpiryTime time.Duration,
+	maxPrunedAttestationsPerBlock uint64,
+) Params {
+	return Params{
+		DataCommitmentWindow:                dataCommitmentWindow,
+		SignificantPowerDifferenceThreshold: significantPowerDifferenceThreshold,
+		AttestationExpiryTime:               attestationExpiryTime,
+		MaxPrunedAttestationsPerBlock:       maxPrunedAttestationsPerBlock,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultDataCommitmentWindow,
+		DefaultSignificantPowerDifferenceThreshold,
+		DefaultAttestationExpiryTime,
+		DefaultMaxPrunedAttestationsPerBlock,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDataCommitmentWindow, &p.DataCommitmentWindow, validateDataCommitmentWindow),
+		paramtypes.NewParamSetPair(KeySignificantPowerDifferenceThreshold, &p.SignificantPowerDifferenceThreshold, validateSignificantPowerDifferenceThreshold),
+		paramtypes.NewParamSetPair(KeyAttestationExpiryTime, &p.AttestationExpiryTime, validateAttestationExpiryTime),
+		paramtypes.NewParamSetPair(KeyMaxPrunedAttestationsPerBlock, &p.MaxPrunedAttestationsPerBlock, validateMaxPrunedAttestationsPerBlock),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateDataCommitmentWindow(p.DataCommitmentWindow); err != nil {
+		return err
+	}
+	if err := validateSignificantPowerDifferenceThreshold(p.SignificantPowerDifferenceThreshold); err != nil {
+		return err
+	}
+	if err := validateAttestationExpiryTime(p.AttestationExpiryTime); err != nil {
+		return err
+	}
+	return validateMaxPrunedAttestationsPerBlock(p.MaxPrunedAttestationsPerBlock)
+}
+
+// validateDataCommitmentWindow validates the DataCommitmentWindow param
+func validateDataCommitmentWindow(v interface{}) error {
+	window, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if window == 0 {
+		return fmt.Errorf("data commitment window cannot be zero")
+	}
+
+	return nil
+}
+
+// validateSignificantPowerDifferenceThreshold validates the
+// SignificantPowerDifferenceThreshold param
+func validateSignificantPowerDifferenceThreshold(v interface{}) error {
+	threshold, ok := v.(sdkmath.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if threshold.IsNil() || !threshold.IsPositive() || threshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("significant power difference threshold must be in (0, 1]: %s", threshold)
+	}
+
+	return nil
+}
+
+// validateAttestationExpiryTime validates the AttestationExpiryTime param
+func validateAttestationExpiryTime(v interface{}) error {
+	expiryTime, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if expiryTime <= 0 {
+		return fmt.Errorf("attestation expiry time must be positive: %s", expiryTime)
+	}
+
+	return nil
+}
+
+// validateMaxPrunedAttestationsPerBlock validates the
+// MaxPrunedAttestationsPerBlock param
+func validateMaxPrunedAttestationsPerBlock(v interface{}) error {
+	maxPruned, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxPruned == 0 {
+		return fmt.Errorf("max pruned attestations per block cannot be zero")
+	}
+
 	return nil
 }
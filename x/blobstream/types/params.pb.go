@@ -4,19 +4,25 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +33,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 type Params struct {
 	DataCommitmentWindow uint64 `protobuf:"varint,1,opt,name=data_commitment_window,json=dataCommitmentWindow,proto3" json:"data_commitment_window,omitempty"`
+	// significant_power_difference_threshold is the threshold of change in the
+	// validator set power that triggers the creation of a new valset request.
+	SignificantPowerDifferenceThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=significant_power_difference_threshold,json=significantPowerDifferenceThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"significant_power_difference_threshold"`
+	// attestation_expiry_time is the time after which an attestation is pruned
+	// from state.
+	AttestationExpiryTime time.Duration `protobuf:"bytes,3,opt,name=attestation_expiry_time,json=attestationExpiryTime,proto3,stdduration" json:"attestation_expiry_time"`
+	// max_pruned_attestations_per_block bounds the number of expired
+	// attestations pruned in a single block.
+	MaxPrunedAttestationsPerBlock uint64 `protobuf:"varint,4,opt,name=max_pruned_attestations_per_block,json=maxPrunedAttestationsPerBlock,proto3" json:"max_pruned_attestations_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +84,20 @@ func (m *Params) GetDataCommitmentWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetAttestationExpiryTime() time.Duration {
+	if m != nil {
+		return m.AttestationExpiryTime
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPrunedAttestationsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunedAttestationsPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "sunrise.blobstream.v1.Params")
 }
@@ -78,22 +107,37 @@ func init() {
 }
 
 var fileDescriptor_2d58cbdf4837c26e = []byte{
-	// 227 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x2e, 0xcd, 0x2b,
-	0xca, 0x2c, 0x4e, 0xd5, 0x4f, 0xca, 0xc9, 0x4f, 0x2a, 0x2e, 0x29, 0x4a, 0x4d, 0xcc, 0xd5, 0x2f,
-	0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x85, 0xaa, 0xd1, 0x43, 0xa8, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7,
-	0x07, 0x93, 0x10, 0x95, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11,
-	0x55, 0x4a, 0xe1, 0x62, 0x0b, 0x00, 0x9b, 0x27, 0x64, 0xc2, 0x25, 0x96, 0x92, 0x58, 0x92, 0x18,
-	0x9f, 0x9c, 0x9f, 0x9b, 0x9b, 0x59, 0x92, 0x9b, 0x9a, 0x57, 0x12, 0x5f, 0x9e, 0x99, 0x97, 0x92,
-	0x5f, 0x2e, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x12, 0x24, 0x02, 0x92, 0x75, 0x86, 0x4b, 0x86, 0x83,
-	0xe5, 0xac, 0x54, 0x5e, 0x2c, 0x90, 0x67, 0xec, 0x7a, 0xbe, 0x41, 0x4b, 0x1a, 0xe6, 0xd8, 0x0a,
-	0x64, 0xe7, 0x42, 0xcc, 0x76, 0x0a, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
-	0x28, 0xb3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xa8, 0x09, 0xba,
-	0x55, 0xf9, 0x79, 0xa9, 0x70, 0x4e, 0x62, 0x41, 0x01, 0xaa, 0x91, 0x25, 0x95, 0x05, 0xa9, 0xc5,
-	0x49, 0x6c, 0x60, 0xe7, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xc2, 0xbe, 0x8b, 0x24,
-	0x01, 0x00, 0x00,
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xd1, 0xaa, 0x12, 0x46, 0x0c, 0x58, 0x2d, 0xa4, 0xad, 0x70, 0x4a, 0x41, 0x28, 0xaa,
+	0x54, 0x5b, 0x05, 0xd4, 0x81, 0x8d, 0x10, 0x24, 0x06, 0x06, 0xab, 0xaa, 0x84, 0xc4, 0x72, 0x9c,
+	0xed, 0x17, 0xe7, 0xd4, 0xdc, 0xbd, 0xd3, 0xdd, 0xb9, 0x49, 0xf8, 0x09, 0xb0, 0x30, 0x32, 0x21,
+	0x46, 0xc6, 0x0e, 0xfc, 0x88, 0x8e, 0x15, 0x13, 0x62, 0x28, 0x28, 0x19, 0xca, 0xcf, 0x40, 0x3e,
+	0x3b, 0xc1, 0x2c, 0xd6, 0x7b, 0xfe, 0x3e, 0x7d, 0xdf, 0x7b, 0xdf, 0x3d, 0x6f, 0xd7, 0x14, 0x52,
+	0x73, 0x03, 0x51, 0x32, 0xc2, 0xc4, 0x58, 0x0d, 0x4c, 0x44, 0xa7, 0x07, 0x91, 0x62, 0x9a, 0x09,
+	0x13, 0x2a, 0x8d, 0x16, 0xfd, 0x8d, 0x9a, 0x13, 0xfe, 0xe3, 0x84, 0xa7, 0x07, 0x5b, 0xb7, 0x98,
+	0xe0, 0x12, 0x23, 0xf7, 0xad, 0x98, 0x5b, 0xeb, 0x39, 0xe6, 0xe8, 0xca, 0xa8, 0xac, 0xea, 0xbf,
+	0x9b, 0x29, 0x1a, 0x81, 0x86, 0x56, 0x40, 0xd5, 0xd4, 0x50, 0x90, 0x23, 0xe6, 0x23, 0x88, 0x5c,
+	0x97, 0x14, 0x83, 0x28, 0x2b, 0x34, 0xb3, 0x1c, 0x65, 0x85, 0xef, 0x7e, 0x5e, 0xf1, 0xd6, 0x62,
+	0x37, 0x8b, 0xff, 0xc4, 0xbb, 0x9d, 0x31, 0xcb, 0x68, 0x8a, 0x42, 0x70, 0x2b, 0x40, 0x5a, 0x3a,
+	0xe6, 0x32, 0xc3, 0x71, 0x9b, 0xec, 0x90, 0xee, 0xea, 0xd1, 0x7a, 0x89, 0x3e, 0x5f, 0x82, 0xaf,
+	0x1d, 0xe6, 0x7f, 0x20, 0xde, 0x43, 0xc3, 0x73, 0xc9, 0x07, 0x3c, 0x65, 0xd2, 0x52, 0x85, 0x63,
+	0xd0, 0x34, 0xe3, 0x83, 0x01, 0x68, 0x90, 0x29, 0x50, 0x3b, 0xd4, 0x60, 0x86, 0x38, 0xca, 0xda,
+	0xd7, 0x76, 0x48, 0xf7, 0x7a, 0xef, 0xf0, 0xfc, 0xb2, 0xd3, 0xfa, 0x79, 0xd9, 0xd9, 0xae, 0xe6,
+	0x34, 0xd9, 0x49, 0xc8, 0x31, 0x12, 0xcc, 0x0e, 0xc3, 0x57, 0x90, 0xb3, 0x74, 0xda, 0x87, 0xf4,
+	0xfb, 0xb7, 0x7d, 0xaf, 0x5e, 0xa3, 0x0f, 0xe9, 0xd7, 0xab, 0xb3, 0x3d, 0x72, 0x74, 0xbf, 0xe1,
+	0x12, 0x97, 0x26, 0xfd, 0xa5, 0xc7, 0xf1, 0xc2, 0xc2, 0x7f, 0xeb, 0xdd, 0x61, 0xd6, 0x82, 0xb1,
+	0x6e, 0x47, 0x0a, 0x13, 0xc5, 0xf5, 0x94, 0x5a, 0x2e, 0xa0, 0xbd, 0xb2, 0x43, 0xba, 0x37, 0x1e,
+	0x6d, 0x86, 0x55, 0x20, 0xe1, 0x22, 0x90, 0xb0, 0x5f, 0x07, 0xd2, 0xbb, 0x59, 0x0e, 0xf6, 0xe9,
+	0x57, 0x87, 0x54, 0x7e, 0x1b, 0x0d, 0xa1, 0x17, 0x4e, 0xe7, 0x98, 0x0b, 0xf0, 0x5f, 0x7a, 0xf7,
+	0x04, 0x9b, 0x50, 0xa5, 0x0b, 0x09, 0x19, 0x6d, 0x70, 0x0c, 0x55, 0xa0, 0x69, 0x32, 0xc2, 0xf4,
+	0xa4, 0xbd, 0xea, 0x02, 0xbb, 0x2b, 0xd8, 0x24, 0x76, 0xbc, 0x67, 0x0d, 0x5a, 0x0c, 0xba, 0x57,
+	0x92, 0x9e, 0x3e, 0xf8, 0xf3, 0xa5, 0x43, 0xde, 0x5f, 0x9d, 0xed, 0x6d, 0x2f, 0x4e, 0x64, 0xd2,
+	0x3c, 0x92, 0xea, 0x55, 0x7a, 0xf1, 0xf9, 0x2c, 0x20, 0x17, 0xb3, 0x80, 0xfc, 0x9e, 0x05, 0xe4,
+	0xe3, 0x3c, 0x68, 0x5d, 0xcc, 0x83, 0xd6, 0x8f, 0x79, 0xd0, 0x7a, 0x73, 0x98, 0x73, 0x3b, 0x2c,
+	0x92, 0x30, 0x45, 0x11, 0xd5, 0x0a, 0xfb, 0xef, 0x50, 0xc2, 0xb2, 0x61, 0x4a, 0xfd, 0x2f, 0x69,
+	0xa7, 0x0a, 0x4c, 0xb2, 0xe6, 0x56, 0x7f, 0xfc, 0x77, 0x00, 0xdb, 0xe0, 0x46, 0xe7, 0x9a, 0x02,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -118,6 +162,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DataCommitmentWindow != that1.DataCommitmentWindow {
 		return false
 	}
+	if !this.SignificantPowerDifferenceThreshold.Equal(that1.SignificantPowerDifferenceThreshold) {
+		return false
+	}
+	if this.AttestationExpiryTime != that1.AttestationExpiryTime {
+		return false
+	}
+	if this.MaxPrunedAttestationsPerBlock != that1.MaxPrunedAttestationsPerBlock {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -140,6 +193,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPrunedAttestationsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPrunedAttestationsPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AttestationExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AttestationExpiryTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SignificantPowerDifferenceThreshold.Size()
+		i -= size
+		if _, err := m.SignificantPowerDifferenceThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.DataCommitmentWindow != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DataCommitmentWindow))
 		i--
@@ -168,6 +244,13 @@ func (m *Params) Size() (n int) {
 	if m.DataCommitmentWindow != 0 {
 		n += 1 + sovParams(uint64(m.DataCommitmentWindow))
 	}
+	l = m.SignificantPowerDifferenceThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AttestationExpiryTime)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxPrunedAttestationsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxPrunedAttestationsPerBlock))
+	}
 	return n
 }
 
@@ -225,6 +308,92 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignificantPowerDifferenceThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignificantPowerDifferenceThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AttestationExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedAttestationsPerBlock", wireType)
+			}
+			m.MaxPrunedAttestationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedAttestationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -211,22 +211,23 @@ func (m *QueryAttestationRequestByNonceResponse) GetAttestation() *types.Any {
 	return nil
 }
 
-// QueryLatestAttestationNonceRequest latest attestation nonce request
-type QueryLatestAttestationNonceRequest struct {
+// QuerySignedAttestationRequest
+type QuerySignedAttestationRequest struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *QueryLatestAttestationNonceRequest) Reset()         { *m = QueryLatestAttestationNonceRequest{} }
-func (m *QueryLatestAttestationNonceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestAttestationNonceRequest) ProtoMessage()    {}
-func (*QueryLatestAttestationNonceRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySignedAttestationRequest) Reset()         { *m = QuerySignedAttestationRequest{} }
+func (m *QuerySignedAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignedAttestationRequest) ProtoMessage()    {}
+func (*QuerySignedAttestationRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_199ea7b7663f6b25, []int{4}
 }
-func (m *QueryLatestAttestationNonceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySignedAttestationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestAttestationNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySignedAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestAttestationNonceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySignedAttestationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -236,35 +237,48 @@ func (m *QueryLatestAttestationNonceRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestAttestationNonceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestAttestationNonceRequest.Merge(m, src)
+func (m *QuerySignedAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignedAttestationRequest.Merge(m, src)
 }
-func (m *QueryLatestAttestationNonceRequest) XXX_Size() int {
+func (m *QuerySignedAttestationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestAttestationNonceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestAttestationNonceRequest.DiscardUnknown(m)
+func (m *QuerySignedAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignedAttestationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestAttestationNonceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySignedAttestationRequest proto.InternalMessageInfo
 
-// QueryLatestAttestationNonceResponse latest attestation nonce response
-type QueryLatestAttestationNonceResponse struct {
-	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+func (m *QuerySignedAttestationRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
 
-func (m *QueryLatestAttestationNonceResponse) Reset()         { *m = QueryLatestAttestationNonceResponse{} }
-func (m *QueryLatestAttestationNonceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestAttestationNonceResponse) ProtoMessage()    {}
-func (*QueryLatestAttestationNonceResponse) Descriptor() ([]byte, []int) {
+// QuerySignedAttestationResponse
+type QuerySignedAttestationResponse struct {
+	Attestation *types.Any `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	// data_root_tuple_root is the commitment signed by the validators if the
+	// attestation is a data commitment.
+	DataRootTupleRoot []byte `protobuf:"bytes,2,opt,name=data_root_tuple_root,json=dataRootTupleRoot,proto3" json:"data_root_tuple_root,omitempty"`
+	// signatures holds the signatures of the signing valset members that
+	// attested to the same sign bytes.
+	Signatures []AttestationSignature `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures"`
+}
+
+func (m *QuerySignedAttestationResponse) Reset()         { *m = QuerySignedAttestationResponse{} }
+func (m *QuerySignedAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignedAttestationResponse) ProtoMessage()    {}
+func (*QuerySignedAttestationResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_199ea7b7663f6b25, []int{5}
 }
-func (m *QueryLatestAttestationNonceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySignedAttestationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestAttestationNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySignedAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestAttestationNonceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySignedAttestationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -274,41 +288,63 @@ func (m *QueryLatestAttestationNonceResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestAttestationNonceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestAttestationNonceResponse.Merge(m, src)
+func (m *QuerySignedAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignedAttestationResponse.Merge(m, src)
 }
-func (m *QueryLatestAttestationNonceResponse) XXX_Size() int {
+func (m *QuerySignedAttestationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestAttestationNonceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestAttestationNonceResponse.DiscardUnknown(m)
+func (m *QuerySignedAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignedAttestationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestAttestationNonceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySignedAttestationResponse proto.InternalMessageInfo
 
-func (m *QueryLatestAttestationNonceResponse) GetNonce() uint64 {
+func (m *QuerySignedAttestationResponse) GetAttestation() *types.Any {
 	if m != nil {
-		return m.Nonce
+		return m.Attestation
 	}
-	return 0
+	return nil
 }
 
-// QueryEarliestAttestationNonceRequest earliest attestation nonce request
-type QueryEarliestAttestationNonceRequest struct {
+func (m *QuerySignedAttestationResponse) GetDataRootTupleRoot() []byte {
+	if m != nil {
+		return m.DataRootTupleRoot
+	}
+	return nil
 }
 
-func (m *QueryEarliestAttestationNonceRequest) Reset()         { *m = QueryEarliestAttestationNonceRequest{} }
-func (m *QueryEarliestAttestationNonceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEarliestAttestationNonceRequest) ProtoMessage()    {}
-func (*QueryEarliestAttestationNonceRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySignedAttestationResponse) GetSignatures() []AttestationSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+// QueryAttestationsRequest
+type QueryAttestationsRequest struct {
+	// from_nonce is the nonce of the first attestation to return. Pruned
+	// attestations are skipped.
+	FromNonce uint64 `protobuf:"varint,1,opt,name=from_nonce,json=fromNonce,proto3" json:"from_nonce,omitempty"`
+	// limit is the maximum number of attestations to return. Defaults to, and
+	// is capped at, 100.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// type_filter restricts the attestations to the provided type, if set.
+	TypeFilter AttestationType `protobuf:"varint,3,opt,name=type_filter,json=typeFilter,proto3,enum=sunrise.blobstream.v1.AttestationType" json:"type_filter,omitempty"`
+}
+
+func (m *QueryAttestationsRequest) Reset()         { *m = QueryAttestationsRequest{} }
+func (m *QueryAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsRequest) ProtoMessage()    {}
+func (*QueryAttestationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_199ea7b7663f6b25, []int{6}
 }
-func (m *QueryEarliestAttestationNonceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAttestationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEarliestAttestationNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEarliestAttestationNonceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -318,35 +354,59 @@ func (m *QueryEarliestAttestationNonceRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryEarliestAttestationNonceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEarliestAttestationNonceRequest.Merge(m, src)
+func (m *QueryAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsRequest.Merge(m, src)
 }
-func (m *QueryEarliestAttestationNonceRequest) XXX_Size() int {
+func (m *QueryAttestationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEarliestAttestationNonceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEarliestAttestationNonceRequest.DiscardUnknown(m)
+func (m *QueryAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEarliestAttestationNonceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestationsRequest proto.InternalMessageInfo
 
-// QueryEarliestAttestationNonceResponse earliest attestation nonce response
-type QueryEarliestAttestationNonceResponse struct {
-	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+func (m *QueryAttestationsRequest) GetFromNonce() uint64 {
+	if m != nil {
+		return m.FromNonce
+	}
+	return 0
 }
 
-func (m *QueryEarliestAttestationNonceResponse) Reset()         { *m = QueryEarliestAttestationNonceResponse{} }
-func (m *QueryEarliestAttestationNonceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEarliestAttestationNonceResponse) ProtoMessage()    {}
-func (*QueryEarliestAttestationNonceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAttestationsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *QueryAttestationsRequest) GetTypeFilter() AttestationType {
+	if m != nil {
+		return m.TypeFilter
+	}
+	return ATTESTATION_TYPE_UNSPECIFIED
+}
+
+// QueryAttestationsResponse
+type QueryAttestationsResponse struct {
+	Attestations []*types.Any `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	// next_nonce is the from_nonce of the next page. Zero if there are no more
+	// attestations.
+	NextNonce uint64 `protobuf:"varint,2,opt,name=next_nonce,json=nextNonce,proto3" json:"next_nonce,omitempty"`
+}
+
+func (m *QueryAttestationsResponse) Reset()         { *m = QueryAttestationsResponse{} }
+func (m *QueryAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsResponse) ProtoMessage()    {}
+func (*QueryAttestationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_199ea7b7663f6b25, []int{7}
 }
-func (m *QueryEarliestAttestationNonceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAttestationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEarliestAttestationNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEarliestAttestationNonceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -356,47 +416,49 @@ func (m *QueryEarliestAttestationNonceResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryEarliestAttestationNonceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEarliestAttestationNonceResponse.Merge(m, src)
+func (m *QueryAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsResponse.Merge(m, src)
 }
-func (m *QueryEarliestAttestationNonceResponse) XXX_Size() int {
+func (m *QueryAttestationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEarliestAttestationNonceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEarliestAttestationNonceResponse.DiscardUnknown(m)
+func (m *QueryAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEarliestAttestationNonceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestationsResponse proto.InternalMessageInfo
 
-func (m *QueryEarliestAttestationNonceResponse) GetNonce() uint64 {
+func (m *QueryAttestationsResponse) GetAttestations() []*types.Any {
 	if m != nil {
-		return m.Nonce
+		return m.Attestations
 	}
-	return 0
+	return nil
 }
 
-// QueryLatestValsetRequestBeforeNonceRequest latest Valset request before
-// universal nonce request
-type QueryLatestValsetRequestBeforeNonceRequest struct {
-	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+func (m *QueryAttestationsResponse) GetNextNonce() uint64 {
+	if m != nil {
+		return m.NextNonce
+	}
+	return 0
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) Reset() {
-	*m = QueryLatestValsetRequestBeforeNonceRequest{}
-}
-func (m *QueryLatestValsetRequestBeforeNonceRequest) String() string {
-	return proto.CompactTextString(m)
+// QueryAttestationNonceForHeightRequest
+type QueryAttestationNonceForHeightRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
-func (*QueryLatestValsetRequestBeforeNonceRequest) ProtoMessage() {}
-func (*QueryLatestValsetRequestBeforeNonceRequest) Descriptor() ([]byte, []int) {
+
+func (m *QueryAttestationNonceForHeightRequest) Reset()         { *m = QueryAttestationNonceForHeightRequest{} }
+func (m *QueryAttestationNonceForHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationNonceForHeightRequest) ProtoMessage()    {}
+func (*QueryAttestationNonceForHeightRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_199ea7b7663f6b25, []int{8}
 }
-func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAttestationNonceForHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestationNonceForHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestationNonceForHeightRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -406,47 +468,44 @@ func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Marshal(b []byte, deter
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest.Merge(m, src)
+func (m *QueryAttestationNonceForHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationNonceForHeightRequest.Merge(m, src)
 }
-func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Size() int {
+func (m *QueryAttestationNonceForHeightRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest.DiscardUnknown(m)
+func (m *QueryAttestationNonceForHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationNonceForHeightRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestationNonceForHeightRequest proto.InternalMessageInfo
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) GetNonce() uint64 {
+func (m *QueryAttestationNonceForHeightRequest) GetHeight() uint64 {
 	if m != nil {
-		return m.Nonce
+		return m.Height
 	}
 	return 0
 }
 
-// QueryLatestValsetRequestBeforeNonceResponse latest Valset request before
-// height response
-type QueryLatestValsetRequestBeforeNonceResponse struct {
-	Valset *Valset `protobuf:"bytes,1,opt,name=valset,proto3" json:"valset,omitempty"`
+// QueryAttestationNonceForHeightResponse
+type QueryAttestationNonceForHeightResponse struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) Reset() {
-	*m = QueryLatestValsetRequestBeforeNonceResponse{}
+func (m *QueryAttestationNonceForHeightResponse) Reset() {
+	*m = QueryAttestationNonceForHeightResponse{}
 }
-func (m *QueryLatestValsetRequestBeforeNonceResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*QueryLatestValsetRequestBeforeNonceResponse) ProtoMessage() {}
-func (*QueryLatestValsetRequestBeforeNonceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAttestationNonceForHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationNonceForHeightResponse) ProtoMessage()    {}
+func (*QueryAttestationNonceForHeightResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_199ea7b7663f6b25, []int{9}
 }
-func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAttestationNonceForHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestationNonceForHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestationNonceForHeightResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -456,41 +515,43 @@ func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Marshal(b []byte, dete
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse.Merge(m, src)
+func (m *QueryAttestationNonceForHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationNonceForHeightResponse.Merge(m, src)
 }
-func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Size() int {
+func (m *QueryAttestationNonceForHeightResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse.DiscardUnknown(m)
+func (m *QueryAttestationNonceForHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationNonceForHeightResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestationNonceForHeightResponse proto.InternalMessageInfo
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) GetValset() *Valset {
+func (m *QueryAttestationNonceForHeightResponse) GetNonce() uint64 {
 	if m != nil {
-		return m.Valset
+		return m.Nonce
 	}
-	return nil
+	return 0
 }
 
-// QueryLatestUnbondingHeightRequest
-type QueryLatestUnbondingHeightRequest struct {
+// QueryValsetsInRangeRequest
+type QueryValsetsInRangeRequest struct {
+	FromNonce uint64 `protobuf:"varint,1,opt,name=from_nonce,json=fromNonce,proto3" json:"from_nonce,omitempty"`
+	ToNonce   uint64 `protobuf:"varint,2,opt,name=to_nonce,json=toNonce,proto3" json:"to_nonce,omitempty"`
 }
 
-func (m *QueryLatestUnbondingHeightRequest) Reset()         { *m = QueryLatestUnbondingHeightRequest{} }
-func (m *QueryLatestUnbondingHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestUnbondingHeightRequest) ProtoMessage()    {}
-func (*QueryLatestUnbondingHeightRequest) Descriptor() ([]byte, []int) {
+func (m *QueryValsetsInRangeRequest) Reset()         { *m = QueryValsetsInRangeRequest{} }
+func (m *QueryValsetsInRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetsInRangeRequest) ProtoMessage()    {}
+func (*QueryValsetsInRangeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_199ea7b7663f6b25, []int{10}
 }
-func (m *QueryLatestUnbondingHeightRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryValsetsInRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestUnbondingHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValsetsInRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestUnbondingHeightRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValsetsInRangeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -500,35 +561,53 @@ func (m *QueryLatestUnbondingHeightRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestUnbondingHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestUnbondingHeightRequest.Merge(m, src)
+func (m *QueryValsetsInRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetsInRangeRequest.Merge(m, src)
 }
-func (m *QueryLatestUnbondingHeightRequest) XXX_Size() int {
+func (m *QueryValsetsInRangeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestUnbondingHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestUnbondingHeightRequest.DiscardUnknown(m)
+func (m *QueryValsetsInRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetsInRangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestUnbondingHeightRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryValsetsInRangeRequest proto.InternalMessageInfo
 
-// QueryLatestUnbondingHeightResponse
-type QueryLatestUnbondingHeightResponse struct {
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+func (m *QueryValsetsInRangeRequest) GetFromNonce() uint64 {
+	if m != nil {
+		return m.FromNonce
+	}
+	return 0
 }
 
-func (m *QueryLatestUnbondingHeightResponse) Reset()         { *m = QueryLatestUnbondingHeightResponse{} }
-func (m *QueryLatestUnbondingHeightResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestUnbondingHeightResponse) ProtoMessage()    {}
-func (*QueryLatestUnbondingHeightResponse) Descriptor() ([]byte, []int) {
+func (m *QueryValsetsInRangeRequest) GetToNonce() uint64 {
+	if m != nil {
+		return m.ToNonce
+	}
+	return 0
+}
+
+// QueryValsetsInRangeResponse
+type QueryValsetsInRangeResponse struct {
+	// valsets holds up to 100 valsets, ordered by nonce.
+	Valsets []Valset `protobuf:"bytes,1,rep,name=valsets,proto3" json:"valsets"`
+	// next_nonce is the from_nonce of the next page. Zero if there are no more
+	// valsets in the range.
+	NextNonce uint64 `protobuf:"varint,2,opt,name=next_nonce,json=nextNonce,proto3" json:"next_nonce,omitempty"`
+}
+
+func (m *QueryValsetsInRangeResponse) Reset()         { *m = QueryValsetsInRangeResponse{} }
+func (m *QueryValsetsInRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetsInRangeResponse) ProtoMessage()    {}
+func (*QueryValsetsInRangeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_199ea7b7663f6b25, []int{11}
 }
-func (m *QueryLatestUnbondingHeightResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryValsetsInRangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestUnbondingHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValsetsInRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestUnbondingHeightResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValsetsInRangeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -538,41 +617,48 @@ func (m *QueryLatestUnbondingHeightResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestUnbondingHeightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestUnbondingHeightResponse.Merge(m, src)
+func (m *QueryValsetsInRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetsInRangeResponse.Merge(m, src)
 }
-func (m *QueryLatestUnbondingHeightResponse) XXX_Size() int {
+func (m *QueryValsetsInRangeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestUnbondingHeightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestUnbondingHeightResponse.DiscardUnknown(m)
+func (m *QueryValsetsInRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetsInRangeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestUnbondingHeightResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryValsetsInRangeResponse proto.InternalMessageInfo
 
-func (m *QueryLatestUnbondingHeightResponse) GetHeight() uint64 {
+func (m *QueryValsetsInRangeResponse) GetValsets() []Valset {
 	if m != nil {
-		return m.Height
+		return m.Valsets
+	}
+	return nil
+}
+
+func (m *QueryValsetsInRangeResponse) GetNextNonce() uint64 {
+	if m != nil {
+		return m.NextNonce
 	}
 	return 0
 }
 
-// QueryLatestDataCommitmentRequest
-type QueryLatestDataCommitmentRequest struct {
+// QueryLatestAttestationNonceRequest latest attestation nonce request
+type QueryLatestAttestationNonceRequest struct {
 }
 
-func (m *QueryLatestDataCommitmentRequest) Reset()         { *m = QueryLatestDataCommitmentRequest{} }
-func (m *QueryLatestDataCommitmentRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestDataCommitmentRequest) ProtoMessage()    {}
-func (*QueryLatestDataCommitmentRequest) Descriptor() ([]byte, []int) {
+func (m *QueryLatestAttestationNonceRequest) Reset()         { *m = QueryLatestAttestationNonceRequest{} }
+func (m *QueryLatestAttestationNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestAttestationNonceRequest) ProtoMessage()    {}
+func (*QueryLatestAttestationNonceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_199ea7b7663f6b25, []int{12}
 }
-func (m *QueryLatestDataCommitmentRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLatestAttestationNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestDataCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLatestAttestationNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestDataCommitmentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLatestAttestationNonceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -582,35 +668,35 @@ func (m *QueryLatestDataCommitmentRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestDataCommitmentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestDataCommitmentRequest.Merge(m, src)
+func (m *QueryLatestAttestationNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestAttestationNonceRequest.Merge(m, src)
 }
-func (m *QueryLatestDataCommitmentRequest) XXX_Size() int {
+func (m *QueryLatestAttestationNonceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestDataCommitmentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestDataCommitmentRequest.DiscardUnknown(m)
+func (m *QueryLatestAttestationNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestAttestationNonceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestDataCommitmentRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLatestAttestationNonceRequest proto.InternalMessageInfo
 
-// QueryLatestDataCommitmentResponse
-type QueryLatestDataCommitmentResponse struct {
-	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
+// QueryLatestAttestationNonceResponse latest attestation nonce response
+type QueryLatestAttestationNonceResponse struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *QueryLatestDataCommitmentResponse) Reset()         { *m = QueryLatestDataCommitmentResponse{} }
-func (m *QueryLatestDataCommitmentResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestDataCommitmentResponse) ProtoMessage()    {}
-func (*QueryLatestDataCommitmentResponse) Descriptor() ([]byte, []int) {
+func (m *QueryLatestAttestationNonceResponse) Reset()         { *m = QueryLatestAttestationNonceResponse{} }
+func (m *QueryLatestAttestationNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestAttestationNonceResponse) ProtoMessage()    {}
+func (*QueryLatestAttestationNonceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_199ea7b7663f6b25, []int{13}
 }
-func (m *QueryLatestDataCommitmentResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryLatestAttestationNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestDataCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLatestAttestationNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestDataCommitmentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLatestAttestationNonceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -620,44 +706,41 @@ func (m *QueryLatestDataCommitmentResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestDataCommitmentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestDataCommitmentResponse.Merge(m, src)
-}
-func (m *QueryLatestDataCommitmentResponse) XXX_Size() int {
+func (m *QueryLatestAttestationNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestAttestationNonceResponse.Merge(m, src)
+}
+func (m *QueryLatestAttestationNonceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestDataCommitmentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestDataCommitmentResponse.DiscardUnknown(m)
+func (m *QueryLatestAttestationNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestAttestationNonceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestDataCommitmentResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLatestAttestationNonceResponse proto.InternalMessageInfo
 
-func (m *QueryLatestDataCommitmentResponse) GetDataCommitment() *DataCommitment {
+func (m *QueryLatestAttestationNonceResponse) GetNonce() uint64 {
 	if m != nil {
-		return m.DataCommitment
+		return m.Nonce
 	}
-	return nil
+	return 0
 }
 
-// QueryDataCommitmentRangeForHeightRequest
-type QueryDataCommitmentRangeForHeightRequest struct {
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+// QueryEarliestAttestationNonceRequest earliest attestation nonce request
+type QueryEarliestAttestationNonceRequest struct {
 }
 
-func (m *QueryDataCommitmentRangeForHeightRequest) Reset() {
-	*m = QueryDataCommitmentRangeForHeightRequest{}
-}
-func (m *QueryDataCommitmentRangeForHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDataCommitmentRangeForHeightRequest) ProtoMessage()    {}
-func (*QueryDataCommitmentRangeForHeightRequest) Descriptor() ([]byte, []int) {
+func (m *QueryEarliestAttestationNonceRequest) Reset()         { *m = QueryEarliestAttestationNonceRequest{} }
+func (m *QueryEarliestAttestationNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEarliestAttestationNonceRequest) ProtoMessage()    {}
+func (*QueryEarliestAttestationNonceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_199ea7b7663f6b25, []int{14}
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryEarliestAttestationNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEarliestAttestationNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEarliestAttestationNonceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -667,46 +750,35 @@ func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.Merge(m, src)
+func (m *QueryEarliestAttestationNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEarliestAttestationNonceRequest.Merge(m, src)
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Size() int {
+func (m *QueryEarliestAttestationNonceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.DiscardUnknown(m)
+func (m *QueryEarliestAttestationNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEarliestAttestationNonceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest proto.InternalMessageInfo
-
-func (m *QueryDataCommitmentRangeForHeightRequest) GetHeight() uint64 {
-	if m != nil {
-		return m.Height
-	}
-	return 0
-}
+var xxx_messageInfo_QueryEarliestAttestationNonceRequest proto.InternalMessageInfo
 
-// QueryDataCommitmentRangeForHeightResponse
-type QueryDataCommitmentRangeForHeightResponse struct {
-	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
+// QueryEarliestAttestationNonceResponse earliest attestation nonce response
+type QueryEarliestAttestationNonceResponse struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *QueryDataCommitmentRangeForHeightResponse) Reset() {
-	*m = QueryDataCommitmentRangeForHeightResponse{}
-}
-func (m *QueryDataCommitmentRangeForHeightResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*QueryDataCommitmentRangeForHeightResponse) ProtoMessage() {}
-func (*QueryDataCommitmentRangeForHeightResponse) Descriptor() ([]byte, []int) {
+func (m *QueryEarliestAttestationNonceResponse) Reset()         { *m = QueryEarliestAttestationNonceResponse{} }
+func (m *QueryEarliestAttestationNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEarliestAttestationNonceResponse) ProtoMessage()    {}
+func (*QueryEarliestAttestationNonceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_199ea7b7663f6b25, []int{15}
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEarliestAttestationNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEarliestAttestationNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEarliestAttestationNonceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -716,42 +788,47 @@ func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.Merge(m, src)
+func (m *QueryEarliestAttestationNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEarliestAttestationNonceResponse.Merge(m, src)
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Size() int {
+func (m *QueryEarliestAttestationNonceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.DiscardUnknown(m)
+func (m *QueryEarliestAttestationNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEarliestAttestationNonceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEarliestAttestationNonceResponse proto.InternalMessageInfo
 
-func (m *QueryDataCommitmentRangeForHeightResponse) GetDataCommitment() *DataCommitment {
+func (m *QueryEarliestAttestationNonceResponse) GetNonce() uint64 {
 	if m != nil {
-		return m.DataCommitment
+		return m.Nonce
 	}
-	return nil
+	return 0
 }
 
-// QueryEvmAddressRequest
-type QueryEvmAddressRequest struct {
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+// QueryLatestValsetRequestBeforeNonceRequest latest Valset request before
+// universal nonce request
+type QueryLatestValsetRequestBeforeNonceRequest struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *QueryEvmAddressRequest) Reset()         { *m = QueryEvmAddressRequest{} }
-func (m *QueryEvmAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEvmAddressRequest) ProtoMessage()    {}
-func (*QueryEvmAddressRequest) Descriptor() ([]byte, []int) {
+func (m *QueryLatestValsetRequestBeforeNonceRequest) Reset() {
+	*m = QueryLatestValsetRequestBeforeNonceRequest{}
+}
+func (m *QueryLatestValsetRequestBeforeNonceRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryLatestValsetRequestBeforeNonceRequest) ProtoMessage() {}
+func (*QueryLatestValsetRequestBeforeNonceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_199ea7b7663f6b25, []int{16}
 }
-func (m *QueryEvmAddressRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEvmAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEvmAddressRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -761,42 +838,47 @@ func (m *QueryEvmAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryEvmAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEvmAddressRequest.Merge(m, src)
+func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest.Merge(m, src)
 }
-func (m *QueryEvmAddressRequest) XXX_Size() int {
+func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEvmAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEvmAddressRequest.DiscardUnknown(m)
+func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEvmAddressRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest proto.InternalMessageInfo
 
-func (m *QueryEvmAddressRequest) GetValidatorAddress() string {
+func (m *QueryLatestValsetRequestBeforeNonceRequest) GetNonce() uint64 {
 	if m != nil {
-		return m.ValidatorAddress
+		return m.Nonce
 	}
-	return ""
+	return 0
 }
 
-// QueryEvmAddressResponse
-type QueryEvmAddressResponse struct {
-	EvmAddress string `protobuf:"bytes,1,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+// QueryLatestValsetRequestBeforeNonceResponse latest Valset request before
+// height response
+type QueryLatestValsetRequestBeforeNonceResponse struct {
+	Valset *Valset `protobuf:"bytes,1,opt,name=valset,proto3" json:"valset,omitempty"`
 }
 
-func (m *QueryEvmAddressResponse) Reset()         { *m = QueryEvmAddressResponse{} }
-func (m *QueryEvmAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEvmAddressResponse) ProtoMessage()    {}
-func (*QueryEvmAddressResponse) Descriptor() ([]byte, []int) {
+func (m *QueryLatestValsetRequestBeforeNonceResponse) Reset() {
+	*m = QueryLatestValsetRequestBeforeNonceResponse{}
+}
+func (m *QueryLatestValsetRequestBeforeNonceResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryLatestValsetRequestBeforeNonceResponse) ProtoMessage() {}
+func (*QueryLatestValsetRequestBeforeNonceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_199ea7b7663f6b25, []int{17}
 }
-func (m *QueryEvmAddressResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEvmAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEvmAddressResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -806,1252 +888,3181 @@ func (m *QueryEvmAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryEvmAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEvmAddressResponse.Merge(m, src)
+func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse.Merge(m, src)
 }
-func (m *QueryEvmAddressResponse) XXX_Size() int {
+func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEvmAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEvmAddressResponse.DiscardUnknown(m)
+func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEvmAddressResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse proto.InternalMessageInfo
 
-func (m *QueryEvmAddressResponse) GetEvmAddress() string {
+func (m *QueryLatestValsetRequestBeforeNonceResponse) GetValset() *Valset {
 	if m != nil {
-		return m.EvmAddress
+		return m.Valset
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "sunrise.blobstream.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "sunrise.blobstream.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryAttestationRequestByNonceRequest)(nil), "sunrise.blobstream.v1.QueryAttestationRequestByNonceRequest")
-	proto.RegisterType((*QueryAttestationRequestByNonceResponse)(nil), "sunrise.blobstream.v1.QueryAttestationRequestByNonceResponse")
-	proto.RegisterType((*QueryLatestAttestationNonceRequest)(nil), "sunrise.blobstream.v1.QueryLatestAttestationNonceRequest")
-	proto.RegisterType((*QueryLatestAttestationNonceResponse)(nil), "sunrise.blobstream.v1.QueryLatestAttestationNonceResponse")
-	proto.RegisterType((*QueryEarliestAttestationNonceRequest)(nil), "sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest")
-	proto.RegisterType((*QueryEarliestAttestationNonceResponse)(nil), "sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse")
-	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceRequest)(nil), "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest")
-	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceResponse)(nil), "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse")
-	proto.RegisterType((*QueryLatestUnbondingHeightRequest)(nil), "sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest")
-	proto.RegisterType((*QueryLatestUnbondingHeightResponse)(nil), "sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse")
-	proto.RegisterType((*QueryLatestDataCommitmentRequest)(nil), "sunrise.blobstream.v1.QueryLatestDataCommitmentRequest")
-	proto.RegisterType((*QueryLatestDataCommitmentResponse)(nil), "sunrise.blobstream.v1.QueryLatestDataCommitmentResponse")
-	proto.RegisterType((*QueryDataCommitmentRangeForHeightRequest)(nil), "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest")
-	proto.RegisterType((*QueryDataCommitmentRangeForHeightResponse)(nil), "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse")
-	proto.RegisterType((*QueryEvmAddressRequest)(nil), "sunrise.blobstream.v1.QueryEvmAddressRequest")
-	proto.RegisterType((*QueryEvmAddressResponse)(nil), "sunrise.blobstream.v1.QueryEvmAddressResponse")
+// QueryLatestUnbondingHeightRequest
+type QueryLatestUnbondingHeightRequest struct {
 }
 
-func init() { proto.RegisterFile("sunrise/blobstream/v1/query.proto", fileDescriptor_199ea7b7663f6b25) }
-
-var fileDescriptor_199ea7b7663f6b25 = []byte{
-	// 947 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x22, 0x6a, 0xa9, 0x2f, 0xe2, 0x47, 0xa7, 0x6e, 0xda, 0xae, 0x5a, 0x27, 0x9d, 0x26,
-	0x55, 0xea, 0x2a, 0x3b, 0x24, 0x6d, 0x4a, 0x9a, 0xa4, 0xa2, 0x31, 0x14, 0x81, 0x84, 0x4a, 0xb1,
-	0x04, 0x48, 0x5c, 0xa2, 0xd9, 0x78, 0xba, 0x59, 0xc9, 0x3b, 0xe3, 0xee, 0xae, 0x2d, 0x4c, 0xc5,
-	0x85, 0x03, 0x67, 0x24, 0x0e, 0xfc, 0x0b, 0x1c, 0x39, 0x20, 0xce, 0x1c, 0x0b, 0xa7, 0x22, 0x24,
-	0xc4, 0x29, 0x42, 0x09, 0x08, 0xfe, 0x0c, 0xe4, 0x99, 0xb7, 0xce, 0xda, 0xf1, 0xfe, 0xc0, 0xa8,
-	0x97, 0xc8, 0x6f, 0xe6, 0x7b, 0xdf, 0xfb, 0xbe, 0xe7, 0xc9, 0x7b, 0x09, 0x5c, 0x89, 0xba, 0x32,
-	0xf4, 0x23, 0xc1, 0xdc, 0xb6, 0x72, 0xa3, 0x38, 0x14, 0x3c, 0x60, 0xbd, 0x55, 0xf6, 0xb8, 0x2b,
-	0xc2, 0xbe, 0xd3, 0x09, 0x55, 0xac, 0xc8, 0x39, 0x84, 0x38, 0xc7, 0x10, 0xa7, 0xb7, 0x6a, 0x9f,
-	0xe1, 0x81, 0x2f, 0x15, 0xd3, 0x3f, 0x0d, 0xd2, 0xae, 0x7a, 0xca, 0x53, 0xfa, 0x23, 0x1b, 0x7c,
-	0xc2, 0xd3, 0x4b, 0x9e, 0x52, 0x5e, 0x5b, 0x30, 0xde, 0xf1, 0x19, 0x97, 0x52, 0xc5, 0x3c, 0xf6,
-	0x95, 0x8c, 0xf0, 0xb6, 0xbe, 0xa7, 0xa2, 0x40, 0x45, 0xcc, 0xe5, 0x91, 0x30, 0x65, 0x59, 0x6f,
-	0xd5, 0x15, 0x31, 0x5f, 0x65, 0x1d, 0xee, 0xf9, 0x52, 0x83, 0x11, 0x4b, 0x27, 0x8b, 0xed, 0xf0,
-	0x90, 0x07, 0x09, 0xdf, 0x45, 0xac, 0xa6, 0x23, 0xb7, 0xfb, 0x88, 0x71, 0xd9, 0x4f, 0xae, 0x4c,
-	0xa9, 0x5d, 0xa3, 0xd0, 0x04, 0x78, 0x95, 0xd1, 0x86, 0xb8, 0xdf, 0x11, 0x08, 0xa1, 0x55, 0x20,
-	0x1f, 0x0c, 0xe4, 0x3d, 0xd4, 0xd5, 0x9a, 0xe2, 0x71, 0x57, 0x44, 0x31, 0xfd, 0x18, 0xce, 0x8e,
-	0x9c, 0x46, 0x1d, 0x25, 0x23, 0x41, 0xee, 0x41, 0xc5, 0xa8, 0xba, 0x60, 0x2d, 0x58, 0xcb, 0xb3,
-	0x6b, 0x97, 0x9d, 0x89, 0x4d, 0x74, 0x4c, 0x5a, 0xe3, 0xf4, 0xd3, 0x83, 0xf9, 0x99, 0x6f, 0xff,
-	0xfe, 0xae, 0x6e, 0x35, 0x31, 0x8f, 0xde, 0x85, 0x25, 0x4d, 0xbc, 0x13, 0xc7, 0x22, 0x32, 0x2d,
-	0xc3, 0x9a, 0x8d, 0xfe, 0x03, 0x25, 0xf7, 0x04, 0x46, 0xa4, 0x0a, 0xa7, 0xe4, 0x20, 0xd6, 0x95,
-	0x5e, 0x6c, 0x9a, 0x80, 0xf6, 0xe1, 0x5a, 0x51, 0x3a, 0x4a, 0x7d, 0x1f, 0x66, 0xf9, 0x31, 0x08,
-	0xf5, 0x56, 0x1d, 0xd3, 0x46, 0x27, 0x69, 0xa3, 0xb3, 0x23, 0xfb, 0x8d, 0xf3, 0x3f, 0x7f, 0xbf,
-	0x72, 0xf6, 0x24, 0xe3, 0xbb, 0xcd, 0x34, 0x03, 0x5d, 0x04, 0xaa, 0x4b, 0xbf, 0xc7, 0x07, 0x67,
-	0x29, 0x78, 0x5a, 0x36, 0xdd, 0x82, 0xab, 0xb9, 0x28, 0x54, 0x37, 0xd9, 0xdd, 0x35, 0x58, 0xd4,
-	0xc9, 0xf7, 0x79, 0xd8, 0xf6, 0x73, 0x8a, 0x24, 0x4d, 0xcc, 0xc6, 0xe5, 0x96, 0x69, 0x40, 0x3d,
-	0xa5, 0xf1, 0x23, 0xde, 0x8e, 0x44, 0x9c, 0x74, 0x51, 0x3c, 0x52, 0xa1, 0x28, 0xf1, 0x45, 0xb4,
-	0xe0, 0x46, 0x29, 0x0e, 0x14, 0xb2, 0x0e, 0x95, 0x9e, 0xc6, 0x14, 0x3c, 0x1c, 0x24, 0x42, 0x30,
-	0xbd, 0x0a, 0x57, 0x52, 0x55, 0x3e, 0x94, 0xae, 0x92, 0x2d, 0x5f, 0x7a, 0xef, 0x08, 0xdf, 0xdb,
-	0x4f, 0xca, 0xd1, 0xed, 0x91, 0x2f, 0xe6, 0x04, 0x08, 0x15, 0xcc, 0x41, 0x65, 0x5f, 0x9f, 0xa0,
-	0x0f, 0x8c, 0x28, 0x85, 0x85, 0x54, 0xf6, 0x5b, 0x3c, 0xe6, 0x6f, 0xaa, 0x20, 0xf0, 0xe3, 0x40,
-	0xc8, 0x61, 0x85, 0x68, 0x44, 0xc6, 0x38, 0x06, 0x0b, 0x3c, 0x80, 0x57, 0x5a, 0x3c, 0xe6, 0xbb,
-	0x7b, 0xc3, 0x2b, 0xf4, 0xba, 0x94, 0xe1, 0x75, 0x8c, 0xe7, 0xe5, 0xd6, 0x48, 0x4c, 0x1b, 0xb0,
-	0xac, 0x8b, 0x8e, 0xc1, 0xb8, 0xf4, 0xc4, 0xdb, 0x2a, 0x1c, 0x69, 0x41, 0xa6, 0xb9, 0x27, 0x70,
-	0xbd, 0x04, 0xc7, 0x73, 0x32, 0x70, 0x1f, 0xe6, 0xcc, 0x2b, 0xed, 0x05, 0x3b, 0xad, 0x56, 0x28,
-	0xa2, 0x64, 0xba, 0x90, 0x1b, 0x70, 0xa6, 0xc7, 0xdb, 0x7e, 0x8b, 0xc7, 0x2a, 0xdc, 0xe5, 0xe6,
-	0x4e, 0xd7, 0x3a, 0xdd, 0x7c, 0x75, 0x78, 0x81, 0x39, 0x74, 0x13, 0xce, 0x9f, 0xa0, 0x41, 0xc5,
-	0xf3, 0x30, 0x2b, 0x7a, 0xc1, 0x18, 0x03, 0x88, 0x21, 0x70, 0xed, 0x9f, 0x97, 0xe0, 0x94, 0x4e,
-	0x26, 0x5f, 0x5a, 0x50, 0x31, 0x53, 0x89, 0x5c, 0xcf, 0xb0, 0x73, 0x72, 0x0c, 0xda, 0xf5, 0x32,
-	0x50, 0x23, 0x86, 0x2e, 0x7d, 0xf1, 0xeb, 0x9f, 0x5f, 0xbf, 0x30, 0x4f, 0x2e, 0xb3, 0xbc, 0x71,
-	0x4e, 0x7e, 0xb3, 0xe0, 0x62, 0xe6, 0xf4, 0x22, 0xdb, 0x79, 0x05, 0x8b, 0x66, 0xa6, 0x7d, 0x77,
-	0xca, 0x6c, 0x74, 0xb0, 0xad, 0x1d, 0xdc, 0x26, 0xb7, 0x32, 0x1c, 0xa4, 0xa6, 0x61, 0xc4, 0x42,
-	0xc3, 0x11, 0xb1, 0x27, 0x7a, 0x20, 0x7c, 0x4e, 0x7e, 0xb2, 0x60, 0x6e, 0xf2, 0xd4, 0x23, 0x77,
-	0xf2, 0x74, 0xe5, 0xce, 0x53, 0x7b, 0x73, 0x9a, 0x54, 0xf4, 0xb3, 0xa1, 0xfd, 0xac, 0x91, 0xd7,
-	0xca, 0xf8, 0xd1, 0x2e, 0x58, 0x5b, 0x33, 0x92, 0x5f, 0x2c, 0xb8, 0x90, 0x35, 0x5c, 0xc9, 0x56,
-	0x9e, 0xa4, 0x82, 0xd1, 0x6d, 0x6f, 0x4f, 0x97, 0x8c, 0x8e, 0x36, 0xb5, 0xa3, 0x5b, 0x64, 0xad,
-	0xbc, 0x23, 0x81, 0x9c, 0xe4, 0x2f, 0x0b, 0x6a, 0xf9, 0xd3, 0x9a, 0xec, 0x14, 0x37, 0xbb, 0x60,
-	0x5b, 0xd8, 0x8d, 0xff, 0x43, 0x51, 0xf2, 0x1d, 0x9a, 0xe5, 0x90, 0xbc, 0x40, 0xe6, 0x6a, 0x8a,
-	0xe1, 0x3b, 0xfc, 0xc1, 0x82, 0x73, 0x13, 0x57, 0x01, 0xd9, 0x28, 0xd6, 0x36, 0x79, 0xc5, 0xd8,
-	0x77, 0xa6, 0xc8, 0x44, 0x33, 0xcb, 0xda, 0x0c, 0x25, 0x0b, 0x19, 0x66, 0xba, 0x49, 0x1e, 0x39,
-	0xb0, 0xe0, 0x52, 0xde, 0xa0, 0x26, 0x6f, 0xe4, 0xa9, 0x28, 0xb1, 0x26, 0xec, 0x7b, 0xd3, 0x13,
-	0xa0, 0x9b, 0x2d, 0xed, 0x66, 0x9d, 0xdc, 0xcc, 0x70, 0x33, 0xb6, 0x40, 0x58, 0x38, 0xa0, 0x61,
-	0x66, 0x1b, 0x91, 0x1f, 0x2d, 0xa8, 0x4e, 0x5a, 0xa1, 0xe4, 0xf5, 0xe2, 0xf6, 0x4e, 0x5c, 0xcc,
-	0xf6, 0xc6, 0x7f, 0x4f, 0x44, 0x23, 0xeb, 0xda, 0x08, 0x23, 0x2b, 0x25, 0x8d, 0xe0, 0x60, 0xf8,
-	0xc6, 0x02, 0x38, 0x5e, 0x44, 0x64, 0x25, 0xf7, 0xb7, 0x79, 0x7c, 0xef, 0xd9, 0x4e, 0x59, 0x38,
-	0x8a, 0xac, 0x6b, 0x91, 0x8b, 0x84, 0x66, 0x88, 0x4c, 0x2d, 0xbf, 0xc6, 0xc3, 0xa7, 0x87, 0x35,
-	0xeb, 0xd9, 0x61, 0xcd, 0xfa, 0xe3, 0xb0, 0x66, 0x7d, 0x75, 0x54, 0x9b, 0x79, 0x76, 0x54, 0x9b,
-	0xf9, 0xfd, 0xa8, 0x36, 0xf3, 0xc9, 0x6d, 0xcf, 0x8f, 0xf7, 0xbb, 0xae, 0xb3, 0xa7, 0x82, 0x84,
-	0x67, 0xe5, 0x33, 0x25, 0xc5, 0x30, 0xe0, 0x9d, 0x0e, 0xfb, 0x34, 0x4d, 0xad, 0xff, 0x3f, 0x70,
-	0x2b, 0xfa, 0x8f, 0xe4, 0x9b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x63, 0xae, 0x22, 0x34, 0x4c,
-	0x0d, 0x00, 0x00,
+func (m *QueryLatestUnbondingHeightRequest) Reset()         { *m = QueryLatestUnbondingHeightRequest{} }
+func (m *QueryLatestUnbondingHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestUnbondingHeightRequest) ProtoMessage()    {}
+func (*QueryLatestUnbondingHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_199ea7b7663f6b25, []int{18}
+}
+func (m *QueryLatestUnbondingHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestUnbondingHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestUnbondingHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestUnbondingHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestUnbondingHeightRequest.Merge(m, src)
+}
+func (m *QueryLatestUnbondingHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestUnbondingHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestUnbondingHeightRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryLatestUnbondingHeightRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryLatestUnbondingHeightResponse
+type QueryLatestUnbondingHeightResponse struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// AttestationRequestByNonce queries attestation request by nonce.
-	// Returns nil if not found.
-	AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error)
-	// LatestAttestationNonce queries latest attestation nonce.
-	LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error)
-	// EarliestAttestationNonce queries the earliest attestation nonce.
-	EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error)
-	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
-	// And, even if the current nonce is a valset, it will return the previous
-	// one.
-	// If the provided nonce is 1, it will return an error, because, there is
-	// no valset before nonce 1.
-	LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error)
-	// LatestUnbondingHeight returns the latest unbonding height
-	LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error)
-	// DataCommitmentRangeForHeight returns the data commitment window
-	// that includes the provided height
-	DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error)
-	// LatestDataCommitment returns the latest data commitment in store
-	LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error)
-	// EvmAddress returns the evm address associated with a supplied
-	// validator address
-	EvmAddress(ctx context.Context, in *QueryEvmAddressRequest, opts ...grpc.CallOption) (*QueryEvmAddressResponse, error)
-}
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryLatestUnbondingHeightResponse) Reset()         { *m = QueryLatestUnbondingHeightResponse{} }
+func (m *QueryLatestUnbondingHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestUnbondingHeightResponse) ProtoMessage()    {}
+func (*QueryLatestUnbondingHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_199ea7b7663f6b25, []int{19}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryLatestUnbondingHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLatestUnbondingHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestUnbondingHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error) {
-	out := new(QueryAttestationRequestByNonceResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/AttestationRequestByNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLatestUnbondingHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestUnbondingHeightResponse.Merge(m, src)
 }
-
-func (c *queryClient) LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error) {
-	out := new(QueryLatestAttestationNonceResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/LatestAttestationNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLatestUnbondingHeightResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error) {
-	out := new(QueryEarliestAttestationNonceResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/EarliestAttestationNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLatestUnbondingHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestUnbondingHeightResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
-	out := new(QueryLatestValsetRequestBeforeNonceResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/LatestValsetRequestBeforeNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryLatestUnbondingHeightResponse proto.InternalMessageInfo
 
-func (c *queryClient) LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error) {
-	out := new(QueryLatestUnbondingHeightResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/LatestUnbondingHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLatestUnbondingHeightResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error) {
-	out := new(QueryDataCommitmentRangeForHeightResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/DataCommitmentRangeForHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryLatestDataCommitmentRequest
+type QueryLatestDataCommitmentRequest struct {
 }
 
-func (c *queryClient) LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error) {
-	out := new(QueryLatestDataCommitmentResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/LatestDataCommitment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLatestDataCommitmentRequest) Reset()         { *m = QueryLatestDataCommitmentRequest{} }
+func (m *QueryLatestDataCommitmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestDataCommitmentRequest) ProtoMessage()    {}
+func (*QueryLatestDataCommitmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_199ea7b7663f6b25, []int{20}
 }
-
-func (c *queryClient) EvmAddress(ctx context.Context, in *QueryEvmAddressRequest, opts ...grpc.CallOption) (*QueryEvmAddressResponse, error) {
-	out := new(QueryEvmAddressResponse)
-	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/EvmAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLatestDataCommitmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// AttestationRequestByNonce queries attestation request by nonce.
-	// Returns nil if not found.
-	AttestationRequestByNonce(context.Context, *QueryAttestationRequestByNonceRequest) (*QueryAttestationRequestByNonceResponse, error)
-	// LatestAttestationNonce queries latest attestation nonce.
-	LatestAttestationNonce(context.Context, *QueryLatestAttestationNonceRequest) (*QueryLatestAttestationNonceResponse, error)
-	// EarliestAttestationNonce queries the earliest attestation nonce.
-	EarliestAttestationNonce(context.Context, *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error)
-	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
-	// And, even if the current nonce is a valset, it will return the previous
-	// one.
-	// If the provided nonce is 1, it will return an error, because, there is
-	// no valset before nonce 1.
-	LatestValsetRequestBeforeNonce(context.Context, *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error)
-	// LatestUnbondingHeight returns the latest unbonding height
-	LatestUnbondingHeight(context.Context, *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error)
-	// DataCommitmentRangeForHeight returns the data commitment window
-	// that includes the provided height
-	DataCommitmentRangeForHeight(context.Context, *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error)
-	// LatestDataCommitment returns the latest data commitment in store
-	LatestDataCommitment(context.Context, *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error)
-	// EvmAddress returns the evm address associated with a supplied
-	// validator address
-	EvmAddress(context.Context, *QueryEvmAddressRequest) (*QueryEvmAddressResponse, error)
+func (m *QueryLatestDataCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestDataCommitmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryLatestDataCommitmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestDataCommitmentRequest.Merge(m, src)
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryLatestDataCommitmentRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AttestationRequestByNonce(ctx context.Context, req *QueryAttestationRequestByNonceRequest) (*QueryAttestationRequestByNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AttestationRequestByNonce not implemented")
+func (m *QueryLatestDataCommitmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestDataCommitmentRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) LatestAttestationNonce(ctx context.Context, req *QueryLatestAttestationNonceRequest) (*QueryLatestAttestationNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestAttestationNonce not implemented")
+
+var xxx_messageInfo_QueryLatestDataCommitmentRequest proto.InternalMessageInfo
+
+// QueryLatestDataCommitmentResponse
+type QueryLatestDataCommitmentResponse struct {
+	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
 }
-func (*UnimplementedQueryServer) EarliestAttestationNonce(ctx context.Context, req *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EarliestAttestationNonce not implemented")
+
+func (m *QueryLatestDataCommitmentResponse) Reset()         { *m = QueryLatestDataCommitmentResponse{} }
+func (m *QueryLatestDataCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestDataCommitmentResponse) ProtoMessage()    {}
+func (*QueryLatestDataCommitmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_199ea7b7663f6b25, []int{21}
 }
-func (*UnimplementedQueryServer) LatestValsetRequestBeforeNonce(ctx context.Context, req *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestValsetRequestBeforeNonce not implemented")
+func (m *QueryLatestDataCommitmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LatestUnbondingHeight(ctx context.Context, req *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestUnbondingHeight not implemented")
+func (m *QueryLatestDataCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestDataCommitmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DataCommitmentRangeForHeight(ctx context.Context, req *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DataCommitmentRangeForHeight not implemented")
+func (m *QueryLatestDataCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestDataCommitmentResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LatestDataCommitment(ctx context.Context, req *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestDataCommitment not implemented")
+func (m *QueryLatestDataCommitmentResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) EvmAddress(ctx context.Context, req *QueryEvmAddressRequest) (*QueryEvmAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EvmAddress not implemented")
+func (m *QueryLatestDataCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestDataCommitmentResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryLatestDataCommitmentResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.blobstream.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryLatestDataCommitmentResponse) GetDataCommitment() *DataCommitment {
+	if m != nil {
+		return m.DataCommitment
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AttestationRequestByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAttestationRequestByNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AttestationRequestByNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.blobstream.v1.Query/AttestationRequestByNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AttestationRequestByNonce(ctx, req.(*QueryAttestationRequestByNonceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDataCommitmentRangeForHeightRequest
+type QueryDataCommitmentRangeForHeightRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func _Query_LatestAttestationNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestAttestationNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestAttestationNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.blobstream.v1.Query/LatestAttestationNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestAttestationNonce(ctx, req.(*QueryLatestAttestationNonceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryDataCommitmentRangeForHeightRequest) Reset() {
+	*m = QueryDataCommitmentRangeForHeightRequest{}
 }
-
-func _Query_EarliestAttestationNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEarliestAttestationNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EarliestAttestationNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.blobstream.v1.Query/EarliestAttestationNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EarliestAttestationNonce(ctx, req.(*QueryEarliestAttestationNonceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryDataCommitmentRangeForHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataCommitmentRangeForHeightRequest) ProtoMessage()    {}
+func (*QueryDataCommitmentRangeForHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_199ea7b7663f6b25, []int{22}
 }
-
-func _Query_LatestValsetRequestBeforeNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestValsetRequestBeforeNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestValsetRequestBeforeNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.blobstream.v1.Query/LatestValsetRequestBeforeNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestValsetRequestBeforeNonce(ctx, req.(*QueryLatestValsetRequestBeforeNonceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_LatestUnbondingHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestUnbondingHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestUnbondingHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.blobstream.v1.Query/LatestUnbondingHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestUnbondingHeight(ctx, req.(*QueryLatestUnbondingHeightRequest))
+func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.Merge(m, src)
+}
+func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataCommitmentRangeForHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.DiscardUnknown(m)
 }
 
-func _Query_DataCommitmentRangeForHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDataCommitmentRangeForHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DataCommitmentRangeForHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.blobstream.v1.Query/DataCommitmentRangeForHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DataCommitmentRangeForHeight(ctx, req.(*QueryDataCommitmentRangeForHeightRequest))
+var xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest proto.InternalMessageInfo
+
+func (m *QueryDataCommitmentRangeForHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_LatestDataCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestDataCommitmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestDataCommitment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.blobstream.v1.Query/LatestDataCommitment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestDataCommitment(ctx, req.(*QueryLatestDataCommitmentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDataCommitmentRangeForHeightResponse
+type QueryDataCommitmentRangeForHeightResponse struct {
+	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
 }
 
-func _Query_EvmAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEvmAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EvmAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sunrise.blobstream.v1.Query/EvmAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EvmAddress(ctx, req.(*QueryEvmAddressRequest))
+func (m *QueryDataCommitmentRangeForHeightResponse) Reset() {
+	*m = QueryDataCommitmentRangeForHeightResponse{}
+}
+func (m *QueryDataCommitmentRangeForHeightResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDataCommitmentRangeForHeightResponse) ProtoMessage() {}
+func (*QueryDataCommitmentRangeForHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_199ea7b7663f6b25, []int{23}
+}
+func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sunrise.blobstream.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "AttestationRequestByNonce",
-			Handler:    _Query_AttestationRequestByNonce_Handler,
-		},
-		{
-			MethodName: "LatestAttestationNonce",
-			Handler:    _Query_LatestAttestationNonce_Handler,
-		},
-		{
-			MethodName: "EarliestAttestationNonce",
-			Handler:    _Query_EarliestAttestationNonce_Handler,
-		},
-		{
-			MethodName: "LatestValsetRequestBeforeNonce",
-			Handler:    _Query_LatestValsetRequestBeforeNonce_Handler,
-		},
-		{
-			MethodName: "LatestUnbondingHeight",
-			Handler:    _Query_LatestUnbondingHeight_Handler,
-		},
-		{
-			MethodName: "DataCommitmentRangeForHeight",
-			Handler:    _Query_DataCommitmentRangeForHeight_Handler,
-		},
-		{
-			MethodName: "LatestDataCommitment",
-			Handler:    _Query_LatestDataCommitment_Handler,
-		},
-		{
-			MethodName: "EvmAddress",
-			Handler:    _Query_EvmAddress_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sunrise/blobstream/v1/query.proto",
+func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.Merge(m, src)
+}
+func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataCommitmentRangeForHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse proto.InternalMessageInfo
+
+func (m *QueryDataCommitmentRangeForHeightResponse) GetDataCommitment() *DataCommitment {
+	if m != nil {
+		return m.DataCommitment
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEvmAddressRequest
+type QueryEvmAddressRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEvmAddressRequest) Reset()         { *m = QueryEvmAddressRequest{} }
+func (m *QueryEvmAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvmAddressRequest) ProtoMessage()    {}
+func (*QueryEvmAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_199ea7b7663f6b25, []int{24}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEvmAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryEvmAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvmAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAttestationRequestByNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEvmAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvmAddressRequest.Merge(m, src)
 }
-
-func (m *QueryAttestationRequestByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEvmAddressRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAttestationRequestByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryEvmAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvmAddressRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAttestationRequestByNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEvmAddressRequest proto.InternalMessageInfo
+
+func (m *QueryEvmAddressRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAttestationRequestByNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEvmAddressResponse
+type QueryEvmAddressResponse struct {
+	EvmAddress string `protobuf:"bytes,1,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
 }
 
-func (m *QueryAttestationRequestByNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Attestation != nil {
-		{
-			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryEvmAddressResponse) Reset()         { *m = QueryEvmAddressResponse{} }
+func (m *QueryEvmAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvmAddressResponse) ProtoMessage()    {}
+func (*QueryEvmAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_199ea7b7663f6b25, []int{25}
+}
+func (m *QueryEvmAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvmAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvmAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryLatestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEvmAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvmAddressResponse.Merge(m, src)
 }
-
-func (m *QueryLatestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEvmAddressResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryLatestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEvmAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvmAddressResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLatestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEvmAddressResponse proto.InternalMessageInfo
+
+func (m *QueryEvmAddressResponse) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryLatestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEvmAddressForNonceRequest
+type QueryEvmAddressForNonceRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Nonce            uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *QueryLatestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryEvmAddressForNonceRequest) Reset()         { *m = QueryEvmAddressForNonceRequest{} }
+func (m *QueryEvmAddressForNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvmAddressForNonceRequest) ProtoMessage()    {}
+func (*QueryEvmAddressForNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_199ea7b7663f6b25, []int{26}
 }
-
-func (m *QueryEarliestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEvmAddressForNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvmAddressForNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvmAddressForNonceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryEarliestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEvmAddressForNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvmAddressForNonceRequest.Merge(m, src)
 }
-
-func (m *QueryEarliestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEvmAddressForNonceRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryEarliestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEvmAddressForNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvmAddressForNonceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEarliestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryEvmAddressForNonceRequest proto.InternalMessageInfo
 
-func (m *QueryEarliestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryEvmAddressForNonceRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEvmAddressForNonceRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEvmAddressForNonceResponse
+type QueryEvmAddressForNonceResponse struct {
+	EvmAddress string `protobuf:"bytes,1,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryEvmAddressForNonceResponse) Reset()         { *m = QueryEvmAddressForNonceResponse{} }
+func (m *QueryEvmAddressForNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvmAddressForNonceResponse) ProtoMessage()    {}
+func (*QueryEvmAddressForNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_199ea7b7663f6b25, []int{27}
+}
+func (m *QueryEvmAddressForNonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvmAddressForNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvmAddressForNonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryEvmAddressForNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvmAddressForNonceResponse.Merge(m, src)
+}
+func (m *QueryEvmAddressForNonceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvmAddressForNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvmAddressForNonceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEvmAddressForNonceResponse proto.InternalMessageInfo
+
+func (m *QueryEvmAddressForNonceResponse) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "sunrise.blobstream.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "sunrise.blobstream.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAttestationRequestByNonceRequest)(nil), "sunrise.blobstream.v1.QueryAttestationRequestByNonceRequest")
+	proto.RegisterType((*QueryAttestationRequestByNonceResponse)(nil), "sunrise.blobstream.v1.QueryAttestationRequestByNonceResponse")
+	proto.RegisterType((*QuerySignedAttestationRequest)(nil), "sunrise.blobstream.v1.QuerySignedAttestationRequest")
+	proto.RegisterType((*QuerySignedAttestationResponse)(nil), "sunrise.blobstream.v1.QuerySignedAttestationResponse")
+	proto.RegisterType((*QueryAttestationsRequest)(nil), "sunrise.blobstream.v1.QueryAttestationsRequest")
+	proto.RegisterType((*QueryAttestationsResponse)(nil), "sunrise.blobstream.v1.QueryAttestationsResponse")
+	proto.RegisterType((*QueryAttestationNonceForHeightRequest)(nil), "sunrise.blobstream.v1.QueryAttestationNonceForHeightRequest")
+	proto.RegisterType((*QueryAttestationNonceForHeightResponse)(nil), "sunrise.blobstream.v1.QueryAttestationNonceForHeightResponse")
+	proto.RegisterType((*QueryValsetsInRangeRequest)(nil), "sunrise.blobstream.v1.QueryValsetsInRangeRequest")
+	proto.RegisterType((*QueryValsetsInRangeResponse)(nil), "sunrise.blobstream.v1.QueryValsetsInRangeResponse")
+	proto.RegisterType((*QueryLatestAttestationNonceRequest)(nil), "sunrise.blobstream.v1.QueryLatestAttestationNonceRequest")
+	proto.RegisterType((*QueryLatestAttestationNonceResponse)(nil), "sunrise.blobstream.v1.QueryLatestAttestationNonceResponse")
+	proto.RegisterType((*QueryEarliestAttestationNonceRequest)(nil), "sunrise.blobstream.v1.QueryEarliestAttestationNonceRequest")
+	proto.RegisterType((*QueryEarliestAttestationNonceResponse)(nil), "sunrise.blobstream.v1.QueryEarliestAttestationNonceResponse")
+	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceRequest)(nil), "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceRequest")
+	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceResponse)(nil), "sunrise.blobstream.v1.QueryLatestValsetRequestBeforeNonceResponse")
+	proto.RegisterType((*QueryLatestUnbondingHeightRequest)(nil), "sunrise.blobstream.v1.QueryLatestUnbondingHeightRequest")
+	proto.RegisterType((*QueryLatestUnbondingHeightResponse)(nil), "sunrise.blobstream.v1.QueryLatestUnbondingHeightResponse")
+	proto.RegisterType((*QueryLatestDataCommitmentRequest)(nil), "sunrise.blobstream.v1.QueryLatestDataCommitmentRequest")
+	proto.RegisterType((*QueryLatestDataCommitmentResponse)(nil), "sunrise.blobstream.v1.QueryLatestDataCommitmentResponse")
+	proto.RegisterType((*QueryDataCommitmentRangeForHeightRequest)(nil), "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightRequest")
+	proto.RegisterType((*QueryDataCommitmentRangeForHeightResponse)(nil), "sunrise.blobstream.v1.QueryDataCommitmentRangeForHeightResponse")
+	proto.RegisterType((*QueryEvmAddressRequest)(nil), "sunrise.blobstream.v1.QueryEvmAddressRequest")
+	proto.RegisterType((*QueryEvmAddressResponse)(nil), "sunrise.blobstream.v1.QueryEvmAddressResponse")
+	proto.RegisterType((*QueryEvmAddressForNonceRequest)(nil), "sunrise.blobstream.v1.QueryEvmAddressForNonceRequest")
+	proto.RegisterType((*QueryEvmAddressForNonceResponse)(nil), "sunrise.blobstream.v1.QueryEvmAddressForNonceResponse")
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Valset != nil {
-		{
-			size, err := m.Valset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("sunrise/blobstream/v1/query.proto", fileDescriptor_199ea7b7663f6b25) }
+
+var fileDescriptor_199ea7b7663f6b25 = []byte{
+	// 1381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x36, 0x6d, 0x4a, 0x5e, 0xaa, 0x42, 0xa6, 0x6e, 0xeb, 0x2c, 0x8d, 0xe3, 0x6e, 0x9b,
+	0xc8, 0x4d, 0x14, 0x6f, 0xe3, 0xd6, 0xa5, 0x6d, 0x12, 0xda, 0x98, 0x36, 0x50, 0xa9, 0x2a, 0xad,
+	0x5b, 0x8a, 0xc4, 0xc5, 0x1a, 0xc7, 0x13, 0x77, 0x25, 0xef, 0x8e, 0xbb, 0x3b, 0xb6, 0x6a, 0xa2,
+	0x5e, 0x38, 0x70, 0x43, 0x42, 0xe2, 0x00, 0x07, 0x04, 0x57, 0x8e, 0x1c, 0x10, 0x27, 0x0e, 0x1c,
+	0x0b, 0xa7, 0x22, 0x24, 0x84, 0x84, 0x54, 0xa1, 0x04, 0xc4, 0x5f, 0xc0, 0x1d, 0xed, 0xcc, 0xac,
+	0xb3, 0xb6, 0xf7, 0x97, 0x8d, 0x7a, 0x69, 0x77, 0x76, 0xde, 0xf7, 0xde, 0xf7, 0xbd, 0x79, 0xfb,
+	0xe6, 0xc5, 0x70, 0xda, 0x69, 0x59, 0xb6, 0xe1, 0x10, 0xbd, 0xda, 0xa0, 0x55, 0x87, 0xd9, 0x04,
+	0x9b, 0x7a, 0x7b, 0x45, 0x7f, 0xdc, 0x22, 0x76, 0x27, 0xdf, 0xb4, 0x29, 0xa3, 0xe8, 0xb8, 0x34,
+	0xc9, 0xef, 0x9b, 0xe4, 0xdb, 0x2b, 0xea, 0x34, 0x36, 0x0d, 0x8b, 0xea, 0xfc, 0x5f, 0x61, 0xa9,
+	0xa6, 0xea, 0xb4, 0x4e, 0xf9, 0xa3, 0xee, 0x3e, 0xc9, 0xb7, 0xa7, 0xea, 0x94, 0xd6, 0x1b, 0x44,
+	0xc7, 0x4d, 0x43, 0xc7, 0x96, 0x45, 0x19, 0x66, 0x06, 0xb5, 0x1c, 0xb9, 0xbb, 0xb8, 0x45, 0x1d,
+	0x93, 0x3a, 0x7a, 0x15, 0x3b, 0x44, 0x84, 0xd5, 0xdb, 0x2b, 0x55, 0xc2, 0xf0, 0x8a, 0xde, 0xc4,
+	0x75, 0xc3, 0xe2, 0xc6, 0xd2, 0x56, 0x0b, 0x26, 0xdb, 0xc4, 0x36, 0x36, 0x3d, 0x7f, 0x33, 0x32,
+	0x1a, 0x5f, 0x55, 0x5b, 0xdb, 0x3a, 0xb6, 0x3a, 0xde, 0x96, 0x08, 0x55, 0x11, 0x0c, 0xc5, 0x42,
+	0x6e, 0x85, 0xa4, 0x81, 0x75, 0x9a, 0x44, 0x9a, 0x68, 0x29, 0x40, 0xf7, 0x5c, 0x7a, 0x77, 0x79,
+	0xb4, 0x32, 0x79, 0xdc, 0x22, 0x0e, 0xd3, 0xde, 0x87, 0x63, 0x3d, 0x6f, 0x9d, 0x26, 0xb5, 0x1c,
+	0x82, 0xae, 0xc3, 0x84, 0x60, 0x95, 0x56, 0xb2, 0x4a, 0x6e, 0xaa, 0x30, 0x9b, 0x0f, 0x4c, 0x62,
+	0x5e, 0xc0, 0x4a, 0x93, 0xcf, 0x5e, 0xcc, 0x8d, 0x7d, 0xf3, 0xcf, 0xb7, 0x8b, 0x4a, 0x59, 0xe2,
+	0xb4, 0x75, 0x98, 0xe7, 0x8e, 0x37, 0x18, 0x23, 0x8e, 0x48, 0x99, 0x8c, 0x59, 0xea, 0xdc, 0xa1,
+	0xd6, 0x16, 0x91, 0x2b, 0x94, 0x82, 0x43, 0x96, 0xbb, 0xe6, 0x91, 0x0e, 0x96, 0xc5, 0x42, 0xeb,
+	0xc0, 0x42, 0x1c, 0x5c, 0x52, 0x7d, 0x17, 0xa6, 0xf0, 0xbe, 0x91, 0xe4, 0x9b, 0xca, 0x8b, 0x34,
+	0xe6, 0xbd, 0x34, 0xe6, 0x37, 0xac, 0x4e, 0xe9, 0xe4, 0xcf, 0xdf, 0x2d, 0x1f, 0x1b, 0xf4, 0x78,
+	0xab, 0xec, 0xf7, 0xa0, 0x15, 0x61, 0x96, 0x87, 0xbe, 0x6f, 0xd4, 0x2d, 0x52, 0x1b, 0x34, 0x0f,
+	0x61, 0xfc, 0xaf, 0x02, 0x99, 0x30, 0xdc, 0x4b, 0xa2, 0x8a, 0x74, 0x48, 0xd5, 0x30, 0xc3, 0x15,
+	0x9b, 0x52, 0x56, 0x61, 0xad, 0x66, 0x83, 0xf0, 0xc7, 0xf4, 0x81, 0xac, 0x92, 0x3b, 0x52, 0x9e,
+	0x76, 0xf7, 0xca, 0x94, 0xb2, 0x07, 0xee, 0x8e, 0xfb, 0x80, 0xee, 0x01, 0x38, 0x46, 0xdd, 0xc2,
+	0xac, 0x65, 0x13, 0x27, 0x3d, 0x9e, 0x1d, 0xcf, 0x4d, 0x15, 0x96, 0x42, 0xce, 0xd6, 0x17, 0xfd,
+	0xbe, 0x87, 0x29, 0x1d, 0x74, 0x4f, 0xba, 0xec, 0x73, 0xa2, 0x7d, 0xa1, 0x40, 0xba, 0xff, 0xa8,
+	0xbc, 0xf2, 0x42, 0xb3, 0x00, 0xdb, 0x36, 0x35, 0x2b, 0xfe, 0x7c, 0x4d, 0xba, 0x6f, 0xf8, 0x19,
+	0xba, 0x99, 0x6c, 0x18, 0xa6, 0x21, 0x08, 0x1f, 0x2c, 0x8b, 0x05, 0x7a, 0x1b, 0xa6, 0xdc, 0xc2,
+	0xad, 0x6c, 0x1b, 0x0d, 0x46, 0xec, 0xf4, 0x78, 0x56, 0xc9, 0x1d, 0x2d, 0x2c, 0xc4, 0xb3, 0x7c,
+	0xd0, 0x69, 0x92, 0x32, 0xb8, 0xd0, 0x4d, 0x8e, 0xd4, 0x3e, 0x51, 0x60, 0x26, 0x80, 0x9a, 0x3c,
+	0x8d, 0x7b, 0x70, 0xc4, 0x97, 0x4b, 0xb7, 0xd2, 0xc7, 0x87, 0x3f, 0x8e, 0x1e, 0x17, 0xae, 0x5c,
+	0x8b, 0x3c, 0x61, 0x52, 0xae, 0x10, 0x35, 0xe9, 0xbe, 0xe1, 0x72, 0xb5, 0x6b, 0x83, 0xdf, 0x04,
+	0xdf, 0xd8, 0xa4, 0xf6, 0x3b, 0xc4, 0xa8, 0x3f, 0x62, 0x5e, 0xda, 0x4e, 0xc0, 0xc4, 0x23, 0xfe,
+	0x42, 0xa6, 0x4c, 0xae, 0xb4, 0x37, 0x61, 0x21, 0xce, 0x81, 0x14, 0x17, 0x5c, 0xa3, 0x0f, 0x41,
+	0xe5, 0xf8, 0x87, 0xb8, 0xe1, 0x10, 0xe6, 0xdc, 0xb2, 0xca, 0xd8, 0xaa, 0x93, 0x84, 0x87, 0x35,
+	0x03, 0xaf, 0x30, 0xda, 0x23, 0xed, 0x30, 0xa3, 0x42, 0xd8, 0x0e, 0xbc, 0x1e, 0xe8, 0x57, 0x92,
+	0x59, 0x87, 0xc3, 0x6d, 0xb1, 0x23, 0x93, 0x1c, 0xd6, 0x4e, 0x04, 0x5e, 0x16, 0x99, 0x87, 0x89,
+	0xcb, 0xea, 0x59, 0xd0, 0x78, 0xf0, 0xdb, 0xd8, 0x4d, 0x4a, 0x7f, 0x6a, 0xbc, 0x46, 0xb7, 0x0a,
+	0x67, 0x22, 0xad, 0x22, 0xf3, 0xb6, 0x00, 0x67, 0x39, 0xf8, 0x26, 0xb6, 0x1b, 0x46, 0x44, 0x10,
+	0xaf, 0xe9, 0x85, 0xdb, 0x45, 0x86, 0x29, 0xc1, 0xa2, 0x8f, 0xa3, 0x48, 0x86, 0xd7, 0xf5, 0xc8,
+	0x36, 0xb5, 0x49, 0x82, 0xc6, 0x59, 0x83, 0xa5, 0x44, 0x3e, 0x24, 0x91, 0x22, 0x4c, 0x88, 0x34,
+	0xc7, 0x34, 0x7a, 0xe9, 0x48, 0x1a, 0x6b, 0x67, 0xe0, 0xb4, 0x2f, 0xca, 0x7b, 0x56, 0x95, 0x5a,
+	0x35, 0xc3, 0xaa, 0xf7, 0x54, 0xb1, 0xb6, 0x06, 0x5a, 0x94, 0x91, 0x64, 0x10, 0x56, 0xeb, 0x1a,
+	0x64, 0x7d, 0xe8, 0x1b, 0x98, 0xe1, 0xb7, 0xa8, 0x69, 0x1a, 0xcc, 0x24, 0x56, 0x37, 0x82, 0x03,
+	0xa7, 0x23, 0x6c, 0x64, 0x80, 0x3b, 0xf0, 0x2a, 0x6f, 0x92, 0x5b, 0xdd, 0x2d, 0xa9, 0x75, 0x3e,
+	0x44, 0x6b, 0x9f, 0x9f, 0xa3, 0xb5, 0x9e, 0xb5, 0x56, 0x82, 0x1c, 0x0f, 0xda, 0x67, 0xe6, 0x56,
+	0x7c, 0xe2, 0x0f, 0x79, 0x07, 0xce, 0x25, 0xf0, 0xf1, 0x92, 0x04, 0xdc, 0x84, 0x13, 0xa2, 0x4a,
+	0xdb, 0xe6, 0x46, 0xad, 0x66, 0x13, 0xa7, 0xdb, 0xae, 0x97, 0x60, 0xba, 0x8d, 0x1b, 0x46, 0x0d,
+	0x33, 0x6a, 0x57, 0xb0, 0xd8, 0xe3, 0xb1, 0x26, 0xcb, 0xaf, 0x75, 0x37, 0x24, 0x46, 0xbb, 0x0a,
+	0x27, 0x07, 0xdc, 0x48, 0xc6, 0x73, 0x30, 0x45, 0xda, 0x66, 0x9f, 0x07, 0x20, 0x5d, 0x43, 0x6d,
+	0x0b, 0x32, 0x7d, 0xd8, 0x4d, 0x6a, 0xf7, 0x54, 0xf7, 0x30, 0x54, 0xf6, 0x3f, 0x85, 0x03, 0xbd,
+	0x9f, 0xd3, 0x5c, 0x68, 0x90, 0x84, 0x44, 0x0b, 0x5f, 0x1e, 0x87, 0x43, 0xdc, 0x09, 0xfa, 0x58,
+	0x81, 0x09, 0x31, 0xee, 0xa0, 0x73, 0x21, 0x79, 0x1f, 0x9c, 0xaf, 0xd4, 0xc5, 0x24, 0xa6, 0x82,
+	0x8c, 0x36, 0xff, 0xd1, 0xaf, 0x7f, 0x7d, 0x76, 0x60, 0x0e, 0xcd, 0xea, 0x51, 0x73, 0x22, 0xfa,
+	0x4d, 0x81, 0x99, 0xd0, 0xb1, 0x08, 0xad, 0x45, 0x05, 0x8c, 0x1b, 0xc6, 0xd4, 0xf5, 0x11, 0xd1,
+	0x52, 0xc1, 0x1a, 0x57, 0x70, 0x09, 0x5d, 0x0c, 0x51, 0xe0, 0xbf, 0x2c, 0x75, 0x5b, 0xf8, 0x70,
+	0xf4, 0x1d, 0x7e, 0x5c, 0x4f, 0xd1, 0x4f, 0x0a, 0x9c, 0x08, 0x6e, 0xcf, 0xe8, 0x4a, 0x14, 0xaf,
+	0xc8, 0xc6, 0xaf, 0x5e, 0x1d, 0x05, 0x2a, 0xf5, 0x5c, 0xe6, 0x7a, 0x0a, 0xe8, 0x7c, 0x12, 0x3d,
+	0x5c, 0x85, 0xde, 0xe0, 0x1e, 0xd1, 0x2f, 0x0a, 0xa4, 0xc3, 0x6e, 0x01, 0xb4, 0x1a, 0x45, 0x29,
+	0xe6, 0x8e, 0x51, 0xd7, 0x46, 0x03, 0x4b, 0x45, 0x57, 0xb9, 0xa2, 0x8b, 0xa8, 0x90, 0x5c, 0x11,
+	0x91, 0x3e, 0xd1, 0xdf, 0x0a, 0x64, 0xa2, 0xaf, 0x15, 0xb4, 0x11, 0x9f, 0xec, 0x98, 0x6b, 0x4d,
+	0x2d, 0xfd, 0x1f, 0x17, 0x09, 0xeb, 0x50, 0xdc, 0x62, 0x5e, 0x05, 0xea, 0x55, 0xee, 0xa2, 0x5b,
+	0x87, 0x3f, 0x28, 0x30, 0x3d, 0x30, 0xc4, 0xa3, 0x8b, 0x51, 0xbc, 0xc2, 0xfe, 0x56, 0x50, 0x8b,
+	0x43, 0xa2, 0x46, 0x39, 0x26, 0x87, 0xbb, 0xe9, 0xd2, 0xff, 0x4a, 0x81, 0x23, 0x3e, 0x9f, 0x0e,
+	0xd2, 0x13, 0x7e, 0xd4, 0xdd, 0xa6, 0x75, 0x3e, 0x39, 0x40, 0xf2, 0x5d, 0xe2, 0x7c, 0xe7, 0xd1,
+	0x99, 0x04, 0x7c, 0xd1, 0x1f, 0xbd, 0x0d, 0xac, 0x77, 0x82, 0x4d, 0xdc, 0xc0, 0x02, 0x27, 0x67,
+	0x75, 0x7d, 0x44, 0xb4, 0xd4, 0xb1, 0xc1, 0x75, 0xac, 0xa2, 0x2b, 0xc9, 0x3f, 0x0f, 0x71, 0xa3,
+	0xeb, 0x3b, 0xe2, 0xff, 0xa7, 0xe8, 0x6b, 0x05, 0x8e, 0xf6, 0xce, 0xc1, 0x68, 0x25, 0x8a, 0x54,
+	0xe0, 0x2c, 0xae, 0x16, 0x86, 0x81, 0x48, 0xf2, 0x0b, 0x9c, 0x7c, 0x16, 0x65, 0x22, 0xab, 0xde,
+	0x41, 0xdf, 0x2b, 0x70, 0x3c, 0x70, 0x26, 0x43, 0x97, 0xe3, 0xbf, 0xbd, 0xe0, 0x59, 0x4f, 0xbd,
+	0x32, 0x02, 0x52, 0xd2, 0xce, 0x71, 0xda, 0x1a, 0xca, 0x86, 0xd0, 0x6e, 0x79, 0x38, 0xf4, 0x42,
+	0x81, 0x53, 0x51, 0x13, 0x13, 0xba, 0x16, 0xc5, 0x22, 0xc1, 0xbc, 0xa6, 0x5e, 0x1f, 0xdd, 0x81,
+	0x54, 0xb3, 0xca, 0xd5, 0x14, 0xd1, 0x85, 0x10, 0x35, 0x7d, 0x93, 0x9c, 0x6e, 0xbb, 0x6e, 0x64,
+	0x11, 0xa1, 0x1f, 0x15, 0x48, 0x05, 0xcd, 0xb2, 0xe8, 0x8d, 0xf8, 0xf4, 0x06, 0x4e, 0xc8, 0xea,
+	0xe5, 0xe1, 0x81, 0x52, 0x48, 0x91, 0x0b, 0xd1, 0xd1, 0x72, 0x42, 0x21, 0xf2, 0xe2, 0xfb, 0x5c,
+	0x01, 0xd8, 0x1f, 0xb8, 0xd0, 0x72, 0xe4, 0x6d, 0xd5, 0x3f, 0x80, 0xaa, 0xf9, 0xa4, 0xe6, 0x92,
+	0xe4, 0x22, 0x27, 0x79, 0x16, 0x69, 0x21, 0x24, 0x7d, 0xc3, 0x9d, 0x7b, 0x25, 0xa3, 0xc1, 0x51,
+	0x10, 0x15, 0x93, 0x85, 0xec, 0x9b, 0x4f, 0xd5, 0x4b, 0xc3, 0xc2, 0x24, 0xe3, 0xdb, 0x9c, 0xf1,
+	0x26, 0xba, 0x11, 0xcf, 0x58, 0xdf, 0x19, 0x98, 0x80, 0x9f, 0xca, 0xa6, 0x23, 0x7b, 0x7d, 0xe9,
+	0xee, 0xb3, 0xdd, 0x8c, 0xf2, 0x7c, 0x37, 0xa3, 0xfc, 0xb9, 0x9b, 0x51, 0x3e, 0xdd, 0xcb, 0x8c,
+	0x3d, 0xdf, 0xcb, 0x8c, 0xfd, 0xbe, 0x97, 0x19, 0xfb, 0xe0, 0x52, 0xdd, 0x60, 0x8f, 0x5a, 0xd5,
+	0xfc, 0x16, 0x35, 0xbd, 0x48, 0xcb, 0x1f, 0x52, 0x8b, 0x74, 0x17, 0xb8, 0xd9, 0xd4, 0x9f, 0xf8,
+	0x83, 0xf3, 0x1f, 0x0b, 0xab, 0x13, 0xfc, 0x77, 0x8f, 0x0b, 0xff, 0x0d, 0x00, 0x2c, 0xfc, 0xdb,
+	0xd0, 0x59, 0x15, 0x00, 0x00,
 }
 
-func (m *QueryLatestUnbondingHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AttestationRequestByNonce queries attestation request by nonce.
+	// Returns nil if not found.
+	AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error)
+	// LatestAttestationNonce queries latest attestation nonce.
+	LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error)
+	// EarliestAttestationNonce queries the earliest attestation nonce.
+	EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error)
+	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
+	// And, even if the current nonce is a valset, it will return the previous
+	// one.
+	// If the provided nonce is 1, it will return an error, because, there is
+	// no valset before nonce 1.
+	LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error)
+	// SignedAttestation queries the attestation with the provided nonce along
+	// with the signatures gathered via vote extensions. Returns an error if the
+	// attestation has not been signed yet by two thirds of the signing valset
+	// power.
+	SignedAttestation(ctx context.Context, in *QuerySignedAttestationRequest, opts ...grpc.CallOption) (*QuerySignedAttestationResponse, error)
+	// Attestations queries up to limit attestations, ordered by nonce, starting
+	// from the provided nonce. The attestations can be filtered by type.
+	Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error)
+	// AttestationNonceForHeight queries the nonce of the latest attestation
+	// requested at or before the provided height.
+	AttestationNonceForHeight(ctx context.Context, in *QueryAttestationNonceForHeightRequest, opts ...grpc.CallOption) (*QueryAttestationNonceForHeightResponse, error)
+	// ValsetsInRange queries the valsets whose nonces are between the provided
+	// nonces, inclusive.
+	ValsetsInRange(ctx context.Context, in *QueryValsetsInRangeRequest, opts ...grpc.CallOption) (*QueryValsetsInRangeResponse, error)
+	// LatestUnbondingHeight returns the latest unbonding height
+	LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error)
+	// DataCommitmentRangeForHeight returns the data commitment window
+	// that includes the provided height
+	DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error)
+	// LatestDataCommitment returns the latest data commitment in store
+	LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error)
+	// EvmAddress returns the evm address associated with a supplied
+	// validator address
+	EvmAddress(ctx context.Context, in *QueryEvmAddressRequest, opts ...grpc.CallOption) (*QueryEvmAddressResponse, error)
+	// EvmAddressForNonce returns the evm address that the supplied validator
+	// must use to sign the attestation with the provided nonce. It can differ
+	// from the registered one if the validator rotated its evm address.
+	EvmAddressForNonce(ctx context.Context, in *QueryEvmAddressForNonceRequest, opts ...grpc.CallOption) (*QueryEvmAddressForNonceResponse, error)
 }
 
-func (m *QueryLatestUnbondingHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryLatestUnbondingHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryLatestUnbondingHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLatestUnbondingHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryLatestUnbondingHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error) {
+	out := new(QueryAttestationRequestByNonceResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/AttestationRequestByNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLatestDataCommitmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error) {
+	out := new(QueryLatestAttestationNonceResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/LatestAttestationNonce", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLatestDataCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryLatestDataCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLatestDataCommitmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error) {
+	out := new(QueryEarliestAttestationNonceResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/EarliestAttestationNonce", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLatestDataCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryLatestDataCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DataCommitment != nil {
-		{
-			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
+	out := new(QueryLatestValsetRequestBeforeNonceResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/LatestValsetRequestBeforeNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDataCommitmentRangeForHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SignedAttestation(ctx context.Context, in *QuerySignedAttestationRequest, opts ...grpc.CallOption) (*QuerySignedAttestationResponse, error) {
+	out := new(QuerySignedAttestationResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/SignedAttestation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDataCommitmentRangeForHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error) {
+	out := new(QueryAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/Attestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryDataCommitmentRangeForHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) AttestationNonceForHeight(ctx context.Context, in *QueryAttestationNonceForHeightRequest, opts ...grpc.CallOption) (*QueryAttestationNonceForHeightResponse, error) {
+	out := new(QueryAttestationNonceForHeightResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/AttestationNonceForHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDataCommitmentRangeForHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValsetsInRange(ctx context.Context, in *QueryValsetsInRangeRequest, opts ...grpc.CallOption) (*QueryValsetsInRangeResponse, error) {
+	out := new(QueryValsetsInRangeResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/ValsetsInRange", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDataCommitmentRangeForHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDataCommitmentRangeForHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DataCommitment != nil {
-		{
-			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error) {
+	out := new(QueryLatestUnbondingHeightResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/LatestUnbondingHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryEvmAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error) {
+	out := new(QueryDataCommitmentRangeForHeightResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/DataCommitmentRangeForHeight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryEvmAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error) {
+	out := new(QueryLatestDataCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/LatestDataCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryEvmAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) EvmAddress(ctx context.Context, in *QueryEvmAddressRequest, opts ...grpc.CallOption) (*QueryEvmAddressResponse, error) {
+	out := new(QueryEvmAddressResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/EvmAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryEvmAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EvmAddressForNonce(ctx context.Context, in *QueryEvmAddressForNonceRequest, opts ...grpc.CallOption) (*QueryEvmAddressForNonceResponse, error) {
+	out := new(QueryEvmAddressForNonceResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.blobstream.v1.Query/EvmAddressForNonce", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryEvmAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AttestationRequestByNonce queries attestation request by nonce.
+	// Returns nil if not found.
+	AttestationRequestByNonce(context.Context, *QueryAttestationRequestByNonceRequest) (*QueryAttestationRequestByNonceResponse, error)
+	// LatestAttestationNonce queries latest attestation nonce.
+	LatestAttestationNonce(context.Context, *QueryLatestAttestationNonceRequest) (*QueryLatestAttestationNonceResponse, error)
+	// EarliestAttestationNonce queries the earliest attestation nonce.
+	EarliestAttestationNonce(context.Context, *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error)
+	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
+	// And, even if the current nonce is a valset, it will return the previous
+	// one.
+	// If the provided nonce is 1, it will return an error, because, there is
+	// no valset before nonce 1.
+	LatestValsetRequestBeforeNonce(context.Context, *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error)
+	// SignedAttestation queries the attestation with the provided nonce along
+	// with the signatures gathered via vote extensions. Returns an error if the
+	// attestation has not been signed yet by two thirds of the signing valset
+	// power.
+	SignedAttestation(context.Context, *QuerySignedAttestationRequest) (*QuerySignedAttestationResponse, error)
+	// Attestations queries up to limit attestations, ordered by nonce, starting
+	// from the provided nonce. The attestations can be filtered by type.
+	Attestations(context.Context, *QueryAttestationsRequest) (*QueryAttestationsResponse, error)
+	// AttestationNonceForHeight queries the nonce of the latest attestation
+	// requested at or before the provided height.
+	AttestationNonceForHeight(context.Context, *QueryAttestationNonceForHeightRequest) (*QueryAttestationNonceForHeightResponse, error)
+	// ValsetsInRange queries the valsets whose nonces are between the provided
+	// nonces, inclusive.
+	ValsetsInRange(context.Context, *QueryValsetsInRangeRequest) (*QueryValsetsInRangeResponse, error)
+	// LatestUnbondingHeight returns the latest unbonding height
+	LatestUnbondingHeight(context.Context, *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error)
+	// DataCommitmentRangeForHeight returns the data commitment window
+	// that includes the provided height
+	DataCommitmentRangeForHeight(context.Context, *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error)
+	// LatestDataCommitment returns the latest data commitment in store
+	LatestDataCommitment(context.Context, *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error)
+	// EvmAddress returns the evm address associated with a supplied
+	// validator address
+	EvmAddress(context.Context, *QueryEvmAddressRequest) (*QueryEvmAddressResponse, error)
+	// EvmAddressForNonce returns the evm address that the supplied validator
+	// must use to sign the attestation with the provided nonce. It can differ
+	// from the registered one if the validator rotated its evm address.
+	EvmAddressForNonce(context.Context, *QueryEvmAddressForNonceRequest) (*QueryEvmAddressForNonceResponse, error)
 }
 
-func (m *QueryEvmAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmAddress) > 0 {
-		i -= len(m.EvmAddress)
-		copy(dAtA[i:], m.EvmAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedQueryServer) AttestationRequestByNonce(ctx context.Context, req *QueryAttestationRequestByNonceRequest) (*QueryAttestationRequestByNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationRequestByNonce not implemented")
+}
+func (*UnimplementedQueryServer) LatestAttestationNonce(ctx context.Context, req *QueryLatestAttestationNonceRequest) (*QueryLatestAttestationNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestAttestationNonce not implemented")
+}
+func (*UnimplementedQueryServer) EarliestAttestationNonce(ctx context.Context, req *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EarliestAttestationNonce not implemented")
+}
+func (*UnimplementedQueryServer) LatestValsetRequestBeforeNonce(ctx context.Context, req *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestValsetRequestBeforeNonce not implemented")
+}
+func (*UnimplementedQueryServer) SignedAttestation(ctx context.Context, req *QuerySignedAttestationRequest) (*QuerySignedAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignedAttestation not implemented")
+}
+func (*UnimplementedQueryServer) Attestations(ctx context.Context, req *QueryAttestationsRequest) (*QueryAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestations not implemented")
+}
+func (*UnimplementedQueryServer) AttestationNonceForHeight(ctx context.Context, req *QueryAttestationNonceForHeightRequest) (*QueryAttestationNonceForHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationNonceForHeight not implemented")
+}
+func (*UnimplementedQueryServer) ValsetsInRange(ctx context.Context, req *QueryValsetsInRangeRequest) (*QueryValsetsInRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValsetsInRange not implemented")
+}
+func (*UnimplementedQueryServer) LatestUnbondingHeight(ctx context.Context, req *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestUnbondingHeight not implemented")
+}
+func (*UnimplementedQueryServer) DataCommitmentRangeForHeight(ctx context.Context, req *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataCommitmentRangeForHeight not implemented")
+}
+func (*UnimplementedQueryServer) LatestDataCommitment(ctx context.Context, req *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestDataCommitment not implemented")
+}
+func (*UnimplementedQueryServer) EvmAddress(ctx context.Context, req *QueryEvmAddressRequest) (*QueryEvmAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvmAddress not implemented")
+}
+func (*UnimplementedQueryServer) EvmAddressForNonce(ctx context.Context, req *QueryEvmAddressForNonceRequest) (*QueryEvmAddressForNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvmAddressForNonce not implemented")
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryAttestationRequestByNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryAttestationRequestByNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blobstream.v1.Query/Params",
 	}
-	var l int
-	_ = l
-	if m.Attestation != nil {
-		l = m.Attestation.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestAttestationNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AttestationRequestByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationRequestByNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryLatestAttestationNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationRequestByNonce(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blobstream.v1.Query/AttestationRequestByNonce",
 	}
-	return n
-}
-
-func (m *QueryEarliestAttestationNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationRequestByNonce(ctx, req.(*QueryAttestationRequestByNonceRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEarliestAttestationNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LatestAttestationNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestAttestationNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if interceptor == nil {
+		return srv.(QueryServer).LatestAttestationNonce(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryLatestValsetRequestBeforeNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blobstream.v1.Query/LatestAttestationNonce",
 	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestAttestationNonce(ctx, req.(*QueryLatestAttestationNonceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EarliestAttestationNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEarliestAttestationNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Valset != nil {
-		l = m.Valset.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).EarliestAttestationNonce(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blobstream.v1.Query/EarliestAttestationNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EarliestAttestationNonce(ctx, req.(*QueryEarliestAttestationNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestUnbondingHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LatestValsetRequestBeforeNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestValsetRequestBeforeNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).LatestValsetRequestBeforeNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blobstream.v1.Query/LatestValsetRequestBeforeNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestValsetRequestBeforeNonce(ctx, req.(*QueryLatestValsetRequestBeforeNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestUnbondingHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SignedAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignedAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if interceptor == nil {
+		return srv.(QueryServer).SignedAttestation(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blobstream.v1.Query/SignedAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignedAttestation(ctx, req.(*QuerySignedAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestDataCommitmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Attestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Attestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blobstream.v1.Query/Attestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestations(ctx, req.(*QueryAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestDataCommitmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AttestationNonceForHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationNonceForHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.DataCommitment != nil {
-		l = m.DataCommitment.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationNonceForHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blobstream.v1.Query/AttestationNonceForHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationNonceForHeight(ctx, req.(*QueryAttestationNonceForHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValsetsInRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValsetsInRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValsetsInRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blobstream.v1.Query/ValsetsInRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValsetsInRange(ctx, req.(*QueryValsetsInRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestUnbondingHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestUnbondingHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestUnbondingHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blobstream.v1.Query/LatestUnbondingHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestUnbondingHeight(ctx, req.(*QueryLatestUnbondingHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DataCommitmentRangeForHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataCommitmentRangeForHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataCommitmentRangeForHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blobstream.v1.Query/DataCommitmentRangeForHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataCommitmentRangeForHeight(ctx, req.(*QueryDataCommitmentRangeForHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestDataCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestDataCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestDataCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blobstream.v1.Query/LatestDataCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestDataCommitment(ctx, req.(*QueryLatestDataCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EvmAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvmAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvmAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blobstream.v1.Query/EvmAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvmAddress(ctx, req.(*QueryEvmAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EvmAddressForNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvmAddressForNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvmAddressForNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.blobstream.v1.Query/EvmAddressForNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvmAddressForNonce(ctx, req.(*QueryEvmAddressForNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sunrise.blobstream.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AttestationRequestByNonce",
+			Handler:    _Query_AttestationRequestByNonce_Handler,
+		},
+		{
+			MethodName: "LatestAttestationNonce",
+			Handler:    _Query_LatestAttestationNonce_Handler,
+		},
+		{
+			MethodName: "EarliestAttestationNonce",
+			Handler:    _Query_EarliestAttestationNonce_Handler,
+		},
+		{
+			MethodName: "LatestValsetRequestBeforeNonce",
+			Handler:    _Query_LatestValsetRequestBeforeNonce_Handler,
+		},
+		{
+			MethodName: "SignedAttestation",
+			Handler:    _Query_SignedAttestation_Handler,
+		},
+		{
+			MethodName: "Attestations",
+			Handler:    _Query_Attestations_Handler,
+		},
+		{
+			MethodName: "AttestationNonceForHeight",
+			Handler:    _Query_AttestationNonceForHeight_Handler,
+		},
+		{
+			MethodName: "ValsetsInRange",
+			Handler:    _Query_ValsetsInRange_Handler,
+		},
+		{
+			MethodName: "LatestUnbondingHeight",
+			Handler:    _Query_LatestUnbondingHeight_Handler,
+		},
+		{
+			MethodName: "DataCommitmentRangeForHeight",
+			Handler:    _Query_DataCommitmentRangeForHeight_Handler,
+		},
+		{
+			MethodName: "LatestDataCommitment",
+			Handler:    _Query_LatestDataCommitment_Handler,
+		},
+		{
+			MethodName: "EvmAddress",
+			Handler:    _Query_EvmAddress_Handler,
+		},
+		{
+			MethodName: "EvmAddressForNonce",
+			Handler:    _Query_EvmAddressForNonce_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sunrise/blobstream/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationRequestByNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationRequestByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationRequestByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationRequestByNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationRequestByNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationRequestByNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignedAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignedAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignedAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignedAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignedAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignedAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DataRootTupleRoot) > 0 {
+		i -= len(m.DataRootTupleRoot)
+		copy(dAtA[i:], m.DataRootTupleRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRootTupleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TypeFilter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TypeFilter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationNonceForHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationNonceForHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationNonceForHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationNonceForHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationNonceForHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationNonceForHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetsInRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetsInRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetsInRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetsInRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetsInRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetsInRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Valsets) > 0 {
+		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEarliestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEarliestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEarliestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEarliestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEarliestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEarliestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Valset != nil {
+		{
+			size, err := m.Valset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestUnbondingHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestUnbondingHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestUnbondingHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestUnbondingHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestUnbondingHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestUnbondingHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestDataCommitmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestDataCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestDataCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestDataCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestDataCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestDataCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		{
+			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataCommitmentRangeForHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataCommitmentRangeForHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataCommitmentRangeForHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataCommitmentRangeForHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataCommitmentRangeForHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataCommitmentRangeForHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		{
+			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvmAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvmAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvmAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvmAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvmAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvmAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvmAddressForNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvmAddressForNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvmAddressForNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvmAddressForNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvmAddressForNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvmAddressForNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAttestationRequestByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryAttestationRequestByNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignedAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QuerySignedAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DataRootTupleRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromNonce != 0 {
+		n += 1 + sovQuery(uint64(m.FromNonce))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	if m.TypeFilter != 0 {
+		n += 1 + sovQuery(uint64(m.TypeFilter))
+	}
+	return n
+}
+
+func (m *QueryAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextNonce != 0 {
+		n += 1 + sovQuery(uint64(m.NextNonce))
+	}
+	return n
+}
+
+func (m *QueryAttestationNonceForHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryAttestationNonceForHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryValsetsInRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromNonce != 0 {
+		n += 1 + sovQuery(uint64(m.FromNonce))
+	}
+	if m.ToNonce != 0 {
+		n += 1 + sovQuery(uint64(m.ToNonce))
+	}
+	return n
+}
+
+func (m *QueryValsetsInRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextNonce != 0 {
+		n += 1 + sovQuery(uint64(m.NextNonce))
+	}
+	return n
+}
+
+func (m *QueryLatestAttestationNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestAttestationNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryEarliestAttestationNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEarliestAttestationNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valset != nil {
+		l = m.Valset.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestUnbondingHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestUnbondingHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryLatestDataCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestDataCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		l = m.DataCommitment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataCommitmentRangeForHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryDataCommitmentRangeForHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		l = m.DataCommitment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEvmAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEvmAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEvmAddressForNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryEvmAddressForNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &types.Any{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignedAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignedAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignedAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignedAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignedAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignedAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &types.Any{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRootTupleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRootTupleRoot = append(m.DataRootTupleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRootTupleRoot == nil {
+				m.DataRootTupleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, AttestationSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryDataCommitmentRangeForHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryDataCommitmentRangeForHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DataCommitment != nil {
-		l = m.DataCommitment.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromNonce", wireType)
+			}
+			m.FromNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeFilter", wireType)
+			}
+			m.TypeFilter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TypeFilter |= AttestationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryEvmAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryEvmAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EvmAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAttestationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &types.Any{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextNonce", wireType)
+			}
+			m.NextNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationNonceForHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2074,12 +4085,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationNonceForHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationNonceForHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2101,7 +4131,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationNonceForHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2124,17 +4154,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationNonceForHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationNonceForHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2144,25 +4174,11 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2184,7 +4200,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValsetsInRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2207,17 +4223,17 @@ func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValsetsInRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValsetsInRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromNonce", wireType)
 			}
-			m.Nonce = 0
+			m.FromNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2227,7 +4243,26 @@ func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.FromNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToNonce", wireType)
+			}
+			m.ToNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2253,7 +4288,7 @@ func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValsetsInRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2276,15 +4311,15 @@ func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValsetsInRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValsetsInRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2311,13 +4346,30 @@ func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attestation == nil {
-				m.Attestation = &types.Any{}
-			}
-			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Valsets = append(m.Valsets, Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextNonce", wireType)
+			}
+			m.NextNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3306,6 +5358,189 @@ func (m *QueryEvmAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEvmAddressForNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvmAddressForNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvmAddressForNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvmAddressForNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvmAddressForNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvmAddressForNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/accounts"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MaxAttestationSignaturesPerVoteExtension is the maximum number of
+// attestation signatures a validator can include in a single vote extension.
+// It bounds the size of the extended commit that the proposer includes in the
+// block.
+const MaxAttestationSignaturesPerVoteExtension = 10
+
+// AttestationSignBytes returns the bytes that validators are required to sign
+// over for the provided attestation. The data root tuple root is only used for
+// data commitments and must be empty for valsets.
+func AttestationSignBytes(at AttestationRequestI, dataRootTupleRoot []byte) (ethcmn.Hash, error) {
+	switch at := at.(type) {
+	case *Valset:
+		if len(dataRootTupleRoot) != 0 {
+			return ethcmn.Hash{}, errors.Wrap(ErrInvalidAttestationSignature, "unexpected data root tuple root for valset")
+		}
+		return at.SignBytes()
+	case *DataCommitment:
+		signBytes, err := at.SignBytes(dataRootTupleRoot)
+		if err != nil {
+			return ethcmn.Hash{}, errors.Wrap(ErrInvalidAttestationSignature, err.Error())
+		}
+		return signBytes, nil
+	default:
+		return ethcmn.Hash{}, errors.Wrap(ErrUnknownAttestationType, fmt.Sprintf("%T", at))
+	}
+}
+
+// SignAttestation signs the provided sign bytes using the EVM private key. The
+// sign bytes are prefixed following EIP-191 before being signed, which is what
+// the Blobstream contracts expect.
+func SignAttestation(signBytes ethcmn.Hash, key *ecdsa.PrivateKey) ([]byte, error) {
+	return crypto.Sign(accounts.TextHash(signBytes.Bytes()), key)
+}
+
+// VerifyAttestationSignature checks that the signature over the provided sign
+// bytes was produced by the EVM address.
+func VerifyAttestationSignature(signBytes ethcmn.Hash, signature []byte, evmAddress ethcmn.Address) error {
+	if len(signature) != crypto.SignatureLength {
+		return errors.Wrapf(
+			ErrInvalidAttestationSignature,
+			"invalid signature length: expected %d, got %d",
+			crypto.SignatureLength,
+			len(signature),
+		)
+	}
+	pubKey, err := crypto.SigToPub(accounts.TextHash(signBytes.Bytes()), signature)
+	if err != nil {
+		return errors.Wrap(ErrInvalidAttestationSignature, err.Error())
+	}
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != evmAddress {
+		return errors.Wrapf(
+			ErrInvalidAttestationSignature,
+			"signed by %s, expected %s",
+			signer.Hex(),
+			evmAddress.Hex(),
+		)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless checks on the attestation signature.
+func (s AttestationSignature) ValidateBasic() error {
+	if s.Nonce == 0 {
+		return errors.Wrap(ErrInvalidAttestationSignature, "nonce cannot be 0")
+	}
+	if !ethcmn.IsHexAddress(s.EvmAddress) {
+		return errors.Wrapf(ErrEVMAddressNotHex, "nonce %d: %s", s.Nonce, s.EvmAddress)
+	}
+	if len(s.DataRootTupleRoot) != 0 && len(s.DataRootTupleRoot) != ethcmn.HashLength {
+		return errors.Wrapf(
+			ErrInvalidAttestationSignature,
+			"nonce %d: invalid data root tuple root length %d",
+			s.Nonce,
+			len(s.DataRootTupleRoot),
+		)
+	}
+	if len(s.Signature) != crypto.SignatureLength {
+		return errors.Wrapf(
+			ErrInvalidAttestationSignature,
+			"nonce %d: invalid signature length %d",
+			s.Nonce,
+			len(s.Signature),
+		)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless checks on the vote extension: the
+// signatures must be valid, all made by the same EVM address, and sign
+// different nonces.
+func (e AttestationVoteExtension) ValidateBasic() error {
+	if len(e.Signatures) > MaxAttestationSignaturesPerVoteExtension {
+		return errors.Wrapf(
+			ErrInvalidVoteExtension,
+			"too many signatures: max %d, got %d",
+			MaxAttestationSignaturesPerVoteExtension,
+			len(e.Signatures),
+		)
+	}
+	nonces := make(map[uint64]struct{}, len(e.Signatures))
+	for i, sig := range e.Signatures {
+		if err := sig.ValidateBasic(); err != nil {
+			return errors.Wrap(ErrInvalidVoteExtension, err.Error())
+		}
+		if ethcmn.HexToAddress(sig.EvmAddress) != ethcmn.HexToAddress(e.Signatures[0].EvmAddress) {
+			return errors.Wrapf(ErrInvalidVoteExtension, "signature %d was made by a different EVM address", i)
+		}
+		if _, exists := nonces[sig.Nonce]; exists {
+			return errors.Wrapf(ErrDuplicate, "signature for nonce %d", sig.Nonce)
+		}
+		nonces[sig.Nonce] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package types_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunrise-zone/sunrise-app/x/blobstream/types"
+)
+
+func TestSignAndVerifyAttestation(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	evmAddress := crypto.PubkeyToAddress(key.PublicKey)
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	dc := types.NewDataCommitment(2, 1, 11, time.Now())
+	root := bytes.Repeat([]byte{1}, 32)
+	signBytes, err := types.AttestationSignBytes(dc, root)
+	require.NoError(t, err)
+	signature, err := types.SignAttestation(signBytes, key)
+	require.NoError(t, err)
+
+	assert.NoError(t, types.VerifyAttestationSignature(signBytes, signature, evmAddress))
+	assert.ErrorIs(t, types.VerifyAttestationSignature(signBytes, signature, crypto.PubkeyToAddress(other.PublicKey)), types.ErrInvalidAttestationSignature)
+	assert.ErrorIs(t, types.VerifyAttestationSignature(signBytes, signature[:64], evmAddress), types.ErrInvalidAttestationSignature)
+
+	_, err = types.AttestationSignBytes(dc, nil)
+	assert.ErrorIs(t, err, types.ErrInvalidAttestationSignature)
+
+	valset, err := types.NewValset(1, 1, types.InternalBridgeValidators{{Power: 1, EVMAddress: evmAddress}}, time.Now())
+	require.NoError(t, err)
+	_, err = types.AttestationSignBytes(valset, root)
+	assert.ErrorIs(t, err, types.ErrInvalidAttestationSignature)
+	vsSignBytes, err := types.AttestationSignBytes(valset, nil)
+	require.NoError(t, err)
+	expected, err := valset.SignBytes()
+	require.NoError(t, err)
+	assert.Equal(t, expected, vsSignBytes)
+}
+
+func TestAttestationVoteExtension_ValidateBasic(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signature := func(nonce uint64, key *ecdsa.PrivateKey) types.AttestationSignature {
+		return types.AttestationSignature{
+			Nonce:      nonce,
+			EvmAddress: crypto.PubkeyToAddress(key.PublicKey).Hex(),
+			Signature:  make([]byte, crypto.SignatureLength),
+		}
+	}
+
+	tooMany := types.AttestationVoteExtension{}
+	for i := 0; i <= types.MaxAttestationSignaturesPerVoteExtension; i++ {
+		tooMany.Signatures = append(tooMany.Signatures, signature(uint64(i+1), key))
+	}
+
+	tests := []struct {
+		name  string
+		ext   types.AttestationVoteExtension
+		valid bool
+	}{
+		{
+			name:  "empty",
+			ext:   types.AttestationVoteExtension{},
+			valid: true,
+		},
+		{
+			name: "valid",
+			ext: types.AttestationVoteExtension{
+				Signatures: []types.AttestationSignature{signature(1, key), signature(2, key)},
+			},
+			valid: true,
+		},
+		{
+			name:  "too many signatures",
+			ext:   tooMany,
+			valid: false,
+		},
+		{
+			name: "duplicate nonce",
+			ext: types.AttestationVoteExtension{
+				Signatures: []types.AttestationSignature{signature(1, key), signature(1, key)},
+			},
+			valid: false,
+		},
+		{
+			name: "different signers",
+			ext: types.AttestationVoteExtension{
+				Signatures: []types.AttestationSignature{signature(1, key), signature(2, other)},
+			},
+			valid: false,
+		},
+		{
+			name: "zero nonce",
+			ext: types.AttestationVoteExtension{
+				Signatures: []types.AttestationSignature{signature(0, key)},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid data root tuple root",
+			ext: types.AttestationVoteExtension{
+				Signatures: []types.AttestationSignature{func() types.AttestationSignature {
+					sig := signature(1, key)
+					sig.DataRootTupleRoot = []byte{1}
+					return sig
+				}()},
+			},
+			valid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.ext.ValidateBasic()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -28,6 +28,39 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AttestationType defines the kinds of attestations that can be requested.
+type AttestationType int32
+
+const (
+	// ATTESTATION_TYPE_UNSPECIFIED matches any attestation type.
+	ATTESTATION_TYPE_UNSPECIFIED AttestationType = 0
+	// ATTESTATION_TYPE_VALSET is the type of valset attestations.
+	ATTESTATION_TYPE_VALSET AttestationType = 1
+	// ATTESTATION_TYPE_DATA_COMMITMENT is the type of data commitment
+	// attestations.
+	ATTESTATION_TYPE_DATA_COMMITMENT AttestationType = 2
+)
+
+var AttestationType_name = map[int32]string{
+	0: "ATTESTATION_TYPE_UNSPECIFIED",
+	1: "ATTESTATION_TYPE_VALSET",
+	2: "ATTESTATION_TYPE_DATA_COMMITMENT",
+}
+
+var AttestationType_value = map[string]int32{
+	"ATTESTATION_TYPE_UNSPECIFIED":     0,
+	"ATTESTATION_TYPE_VALSET":          1,
+	"ATTESTATION_TYPE_DATA_COMMITMENT": 2,
+}
+
+func (x AttestationType) String() string {
+	return proto.EnumName(AttestationType_name, int32(x))
+}
+
+func (AttestationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_70ffc32c9d5ed8da, []int{0}
+}
+
 // BridgeValidator represents a validator's ETH address and its power
 type BridgeValidator struct {
 	// Voting power of the validator.
@@ -240,43 +273,179 @@ func (m *DataCommitment) GetTime() time.Time {
 	return time.Time{}
 }
 
+// AttestationSignature is the signature of an attestation made by a validator
+// using its registered EVM key.
+type AttestationSignature struct {
+	// Universal nonce of the signed attestation.
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// HEX encoded EVM address of the signer.
+	EvmAddress string `protobuf:"bytes,2,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+	// data_root_tuple_root is the commitment over the data roots of the blocks
+	// defined by the data commitment range. It is empty for valsets.
+	DataRootTupleRoot []byte `protobuf:"bytes,3,opt,name=data_root_tuple_root,json=dataRootTupleRoot,proto3" json:"data_root_tuple_root,omitempty"`
+	// Signature over the attestation sign bytes prefixed following EIP-191.
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *AttestationSignature) Reset()         { *m = AttestationSignature{} }
+func (m *AttestationSignature) String() string { return proto.CompactTextString(m) }
+func (*AttestationSignature) ProtoMessage()    {}
+func (*AttestationSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70ffc32c9d5ed8da, []int{3}
+}
+func (m *AttestationSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationSignature.Merge(m, src)
+}
+func (m *AttestationSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationSignature proto.InternalMessageInfo
+
+func (m *AttestationSignature) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *AttestationSignature) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
+	}
+	return ""
+}
+
+func (m *AttestationSignature) GetDataRootTupleRoot() []byte {
+	if m != nil {
+		return m.DataRootTupleRoot
+	}
+	return nil
+}
+
+func (m *AttestationSignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// AttestationVoteExtension is the vote extension that validators attach to
+// their precommits to sign pending attestations.
+type AttestationVoteExtension struct {
+	// Signatures of the pending attestations.
+	Signatures []AttestationSignature `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures"`
+}
+
+func (m *AttestationVoteExtension) Reset()         { *m = AttestationVoteExtension{} }
+func (m *AttestationVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*AttestationVoteExtension) ProtoMessage()    {}
+func (*AttestationVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70ffc32c9d5ed8da, []int{4}
+}
+func (m *AttestationVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationVoteExtension.Merge(m, src)
+}
+func (m *AttestationVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationVoteExtension proto.InternalMessageInfo
+
+func (m *AttestationVoteExtension) GetSignatures() []AttestationSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("sunrise.blobstream.v1.AttestationType", AttestationType_name, AttestationType_value)
 	proto.RegisterType((*BridgeValidator)(nil), "sunrise.blobstream.v1.BridgeValidator")
 	proto.RegisterType((*Valset)(nil), "sunrise.blobstream.v1.Valset")
 	proto.RegisterType((*DataCommitment)(nil), "sunrise.blobstream.v1.DataCommitment")
+	proto.RegisterType((*AttestationSignature)(nil), "sunrise.blobstream.v1.AttestationSignature")
+	proto.RegisterType((*AttestationVoteExtension)(nil), "sunrise.blobstream.v1.AttestationVoteExtension")
 }
 
 func init() { proto.RegisterFile("sunrise/blobstream/v1/types.proto", fileDescriptor_70ffc32c9d5ed8da) }
 
 var fileDescriptor_70ffc32c9d5ed8da = []byte{
-	// 426 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x4d, 0x8b, 0x13, 0x41,
-	0x14, 0x4c, 0xbb, 0x63, 0xdc, 0xed, 0x80, 0xc2, 0xb8, 0x6a, 0x8c, 0x30, 0x13, 0x73, 0x90, 0x5c,
-	0xb6, 0x87, 0x5d, 0x41, 0xc4, 0xdb, 0x8e, 0x22, 0x7a, 0x93, 0x41, 0xf6, 0xe0, 0x25, 0xf4, 0x64,
-	0x9e, 0x9d, 0xc6, 0xe9, 0x7e, 0x63, 0x77, 0x27, 0x7e, 0xfc, 0x8a, 0xfd, 0x31, 0x82, 0x7f, 0x61,
-	0xf1, 0xb4, 0xde, 0x3c, 0xa9, 0x24, 0x7f, 0x44, 0xa6, 0x7b, 0xa2, 0x22, 0x7a, 0xf3, 0xd6, 0xf5,
-	0xaa, 0x5e, 0x51, 0xd5, 0x3c, 0x7a, 0xdb, 0x2e, 0xb5, 0x91, 0x16, 0xb2, 0xb2, 0xc6, 0xd2, 0x3a,
-	0x03, 0x5c, 0x65, 0xab, 0xc3, 0xcc, 0xbd, 0x6b, 0xc0, 0xb2, 0xc6, 0xa0, 0xc3, 0xf8, 0x5a, 0x27,
-	0x61, 0xbf, 0x24, 0x6c, 0x75, 0x38, 0xda, 0x17, 0x28, 0xd0, 0x2b, 0xb2, 0xf6, 0x15, 0xc4, 0xa3,
-	0x9b, 0x73, 0xb4, 0x0a, 0xed, 0x2c, 0x10, 0x01, 0x74, 0x54, 0x2a, 0x10, 0x45, 0x0d, 0x99, 0x47,
-	0xe5, 0xf2, 0x65, 0xe6, 0xa4, 0x02, 0xeb, 0xb8, 0x6a, 0x82, 0x60, 0xf2, 0x84, 0x5e, 0xc9, 0x8d,
-	0xac, 0x04, 0x9c, 0xf0, 0x5a, 0x56, 0xdc, 0xa1, 0x89, 0xf7, 0xe9, 0xc5, 0x06, 0xdf, 0x80, 0x19,
-	0x92, 0x31, 0x99, 0x46, 0x45, 0x00, 0x71, 0x4a, 0x07, 0xb0, 0x52, 0x33, 0x5e, 0x55, 0x06, 0xac,
-	0x1d, 0x5e, 0x18, 0x93, 0xe9, 0x5e, 0x41, 0x61, 0xa5, 0x8e, 0xc3, 0x64, 0xf2, 0x99, 0xd0, 0xfe,
-	0x09, 0xaf, 0x2d, 0xb8, 0xd6, 0x41, 0xa3, 0x9e, 0xc3, 0xd6, 0xc1, 0x83, 0xf8, 0x31, 0xbd, 0xa4,
-	0x40, 0x95, 0x60, 0xda, 0xed, 0x9d, 0xe9, 0xe0, 0xe8, 0x0e, 0xfb, 0x6b, 0x4b, 0xf6, 0x47, 0xa0,
-	0x3c, 0x3a, 0xfb, 0x9a, 0xf6, 0x8a, 0xed, 0x72, 0x7c, 0x9d, 0xf6, 0x17, 0x20, 0xc5, 0xc2, 0x0d,
-	0x77, 0xbc, 0x7d, 0x87, 0xe2, 0xfb, 0x34, 0x6a, 0xdb, 0x0d, 0xa3, 0x31, 0x99, 0x0e, 0x8e, 0x46,
-	0x2c, 0x54, 0x67, 0xdb, 0xea, 0xec, 0xf9, 0xb6, 0x7a, 0xbe, 0xdb, 0x1a, 0x9e, 0x7e, 0x4b, 0x49,
-	0xe1, 0x37, 0x1e, 0xdc, 0xf8, 0xf4, 0xe1, 0xe0, 0xea, 0xb1, 0x73, 0x2d, 0xed, 0x24, 0xea, 0x02,
-	0x5e, 0x2f, 0xc1, 0xba, 0xa7, 0x93, 0x8f, 0x84, 0x5e, 0x7e, 0xc4, 0x1d, 0x7f, 0x88, 0x4a, 0x49,
-	0xa7, 0x40, 0xff, 0xab, 0x5b, 0x4a, 0x07, 0x25, 0x08, 0xa9, 0x67, 0x65, 0x8d, 0xf3, 0x57, 0xfe,
-	0x77, 0xa2, 0x82, 0xfa, 0x51, 0xde, 0x4e, 0xe2, 0x5b, 0x74, 0x0f, 0x74, 0xd5, 0xd1, 0x21, 0xf7,
-	0x2e, 0xe8, 0x2a, 0x90, 0xff, 0x3f, 0x79, 0xfe, 0xec, 0x6c, 0x9d, 0x90, 0xf3, 0x75, 0x42, 0xbe,
-	0xaf, 0x13, 0x72, 0xba, 0x49, 0x7a, 0xe7, 0x9b, 0xa4, 0xf7, 0x65, 0x93, 0xf4, 0x5e, 0xdc, 0x13,
-	0xd2, 0x2d, 0x96, 0x25, 0x9b, 0xa3, 0xca, 0xba, 0xff, 0x3f, 0x78, 0x8f, 0x1a, 0x7e, 0x02, 0xde,
-	0x34, 0xd9, 0xdb, 0xdf, 0x6f, 0xd3, 0x1f, 0x66, 0xd9, 0xf7, 0x71, 0xee, 0xfe, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x31, 0xa7, 0xd8, 0x66, 0xbe, 0x02, 0x00, 0x00,
+	// 606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0xb6, 0xa1, 0xb4, 0x9b, 0x8a, 0x96, 0x25, 0xd0, 0x90, 0x56, 0x4e, 0x88, 0x10, 0x8a,
+	0x40, 0xb5, 0xd5, 0x22, 0x21, 0xc4, 0xcd, 0x69, 0x5d, 0x11, 0x89, 0xfe, 0xe0, 0x98, 0x48, 0x70,
+	0xb1, 0xec, 0x78, 0x70, 0x2d, 0xb2, 0xbb, 0xc6, 0xbb, 0x09, 0x2d, 0xe2, 0x01, 0x38, 0xf6, 0x01,
+	0xb8, 0xf1, 0x0a, 0x48, 0xbc, 0x42, 0xc5, 0xa9, 0xdc, 0x38, 0x01, 0x6a, 0x5f, 0x04, 0x79, 0xed,
+	0xb4, 0x11, 0x6d, 0xb9, 0x71, 0x9b, 0x6f, 0xbe, 0x6f, 0x26, 0xdf, 0x17, 0x8f, 0x16, 0xdf, 0x11,
+	0x03, 0x96, 0x44, 0x02, 0x0c, 0xbf, 0xcf, 0x7d, 0x21, 0x13, 0xf0, 0xa8, 0x31, 0x5c, 0x31, 0xe4,
+	0x7e, 0x0c, 0x42, 0x8f, 0x13, 0x2e, 0x39, 0xb9, 0x99, 0x4b, 0xf4, 0x33, 0x89, 0x3e, 0x5c, 0xa9,
+	0x96, 0x43, 0x1e, 0x72, 0xa5, 0x30, 0xd2, 0x2a, 0x13, 0x57, 0x6f, 0xf7, 0xb8, 0xa0, 0x5c, 0xb8,
+	0x19, 0x91, 0x81, 0x9c, 0xaa, 0x85, 0x9c, 0x87, 0x7d, 0x30, 0x14, 0xf2, 0x07, 0xaf, 0x0d, 0x19,
+	0x51, 0x10, 0xd2, 0xa3, 0x71, 0x26, 0x68, 0x3c, 0xc5, 0x73, 0xad, 0x24, 0x0a, 0x42, 0xe8, 0x7a,
+	0xfd, 0x28, 0xf0, 0x24, 0x4f, 0x48, 0x19, 0x5f, 0x89, 0xf9, 0x3b, 0x48, 0x2a, 0xa8, 0x8e, 0x9a,
+	0x45, 0x3b, 0x03, 0xa4, 0x86, 0x4b, 0x30, 0xa4, 0xae, 0x17, 0x04, 0x09, 0x08, 0x51, 0x99, 0xa8,
+	0xa3, 0xe6, 0x8c, 0x8d, 0x61, 0x48, 0xcd, 0xac, 0xd3, 0xf8, 0x8e, 0xf0, 0x54, 0xd7, 0xeb, 0x0b,
+	0x90, 0xe9, 0x06, 0xc6, 0x59, 0x0f, 0x46, 0x1b, 0x14, 0x20, 0x1b, 0xf8, 0x2a, 0x05, 0xea, 0x43,
+	0x92, 0x4e, 0x4f, 0x36, 0x4b, 0xab, 0xf7, 0xf4, 0x0b, 0x53, 0xea, 0x7f, 0x19, 0x6a, 0x15, 0x0f,
+	0x7f, 0xd6, 0x0a, 0xf6, 0x68, 0x98, 0xdc, 0xc2, 0x53, 0xbb, 0x10, 0x85, 0xbb, 0xb2, 0x32, 0xa9,
+	0xd6, 0xe7, 0x88, 0x3c, 0xc6, 0xc5, 0x34, 0x5d, 0xa5, 0x58, 0x47, 0xcd, 0xd2, 0x6a, 0x55, 0xcf,
+	0xa2, 0xeb, 0xa3, 0xe8, 0xba, 0x33, 0x8a, 0xde, 0x9a, 0x4e, 0x17, 0x1e, 0xfc, 0xaa, 0x21, 0x5b,
+	0x4d, 0x3c, 0x59, 0xf8, 0xf6, 0x65, 0xf9, 0x86, 0x29, 0x65, 0x4a, 0xcb, 0x88, 0x33, 0x1b, 0xde,
+	0x0e, 0x40, 0xc8, 0x76, 0xe3, 0x2b, 0xc2, 0xd7, 0xd6, 0x3d, 0xe9, 0xad, 0x71, 0x4a, 0x23, 0x49,
+	0x81, 0x5d, 0x96, 0xad, 0x86, 0x4b, 0x3e, 0x84, 0x11, 0x73, 0xfd, 0x3e, 0xef, 0xbd, 0x51, 0xff,
+	0x4e, 0xd1, 0xc6, 0xaa, 0xd5, 0x4a, 0x3b, 0x64, 0x11, 0xcf, 0x00, 0x0b, 0x72, 0x3a, 0xf3, 0x3d,
+	0x0d, 0x2c, 0xc8, 0xc8, 0xff, 0xe0, 0xfc, 0x13, 0xc2, 0xe5, 0xb1, 0x7e, 0x27, 0x0a, 0x99, 0x27,
+	0x07, 0x09, 0x5c, 0xee, 0xff, 0x9f, 0x5f, 0x97, 0x18, 0xb8, 0x1c, 0x78, 0xd2, 0x73, 0x13, 0xce,
+	0xa5, 0x2b, 0x07, 0x71, 0x1f, 0x54, 0xa9, 0xa2, 0xcc, 0xda, 0xd7, 0x53, 0xce, 0xe6, 0x5c, 0x3a,
+	0x29, 0x93, 0x16, 0x64, 0x09, 0xcf, 0x88, 0xd1, 0x8f, 0xaa, 0x60, 0xb3, 0xf6, 0x59, 0xa3, 0x41,
+	0x71, 0x65, 0xcc, 0x5d, 0x97, 0x4b, 0xb0, 0xf6, 0x24, 0x30, 0x11, 0x71, 0x46, 0x9e, 0x63, 0x7c,
+	0x2a, 0x14, 0x15, 0xa4, 0x4e, 0xe5, 0xc1, 0x25, 0xa7, 0x72, 0x51, 0xc4, 0xfc, 0x5e, 0xc6, 0x96,
+	0xdc, 0xff, 0x80, 0xe7, 0xc6, 0x94, 0xce, 0x7e, 0x0c, 0xa4, 0x8e, 0x97, 0x4c, 0xc7, 0xb1, 0x3a,
+	0x8e, 0xe9, 0xb4, 0xb7, 0xb7, 0x5c, 0xe7, 0xe5, 0x8e, 0xe5, 0xbe, 0xd8, 0xea, 0xec, 0x58, 0x6b,
+	0xed, 0x8d, 0xb6, 0xb5, 0x3e, 0x5f, 0x20, 0x8b, 0x78, 0xe1, 0x9c, 0xa2, 0x6b, 0x3e, 0xeb, 0x58,
+	0xce, 0x3c, 0x22, 0x77, 0x71, 0xfd, 0x1c, 0xb9, 0x6e, 0x3a, 0xa6, 0xbb, 0xb6, 0xbd, 0xb9, 0xd9,
+	0x76, 0x36, 0xad, 0x2d, 0x67, 0x7e, 0xa2, 0x5a, 0xfc, 0xf8, 0x59, 0x2b, 0xb4, 0x76, 0x0e, 0x8f,
+	0x35, 0x74, 0x74, 0xac, 0xa1, 0xdf, 0xc7, 0x1a, 0x3a, 0x38, 0xd1, 0x0a, 0x47, 0x27, 0x5a, 0xe1,
+	0xc7, 0x89, 0x56, 0x78, 0xf5, 0x28, 0x8c, 0xe4, 0xee, 0xc0, 0xd7, 0x7b, 0x9c, 0x1a, 0x79, 0xc0,
+	0xe5, 0xf7, 0x9c, 0xc1, 0x29, 0xf0, 0xe2, 0xd8, 0xd8, 0x1b, 0x7f, 0x27, 0xd4, 0x23, 0xe1, 0x4f,
+	0xa9, 0xd3, 0x78, 0xf8, 0x67, 0x00, 0x4e, 0x8a, 0xdc, 0x97, 0x4a, 0x04, 0x00, 0x00,
 }
 
 func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
@@ -415,6 +584,92 @@ func (m *DataCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttestationSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DataRootTupleRoot) > 0 {
+		i -= len(m.DataRootTupleRoot)
+		copy(dAtA[i:], m.DataRootTupleRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DataRootTupleRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -485,6 +740,45 @@ func (m *DataCommitment) Size() (n int) {
 	return n
 }
 
+func (m *AttestationSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.DataRootTupleRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AttestationVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -887,6 +1181,259 @@ func (m *DataCommitment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AttestationSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRootTupleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRootTupleRoot = append(m.DataRootTupleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRootTupleRoot == nil {
+				m.DataRootTupleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, AttestationSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,98 @@
+package ibchooks
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/sunrise-zone/sunrise-app/x/ibchooks/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/ibchooks/types"
+	ratelimittypes "github.com/sunrise-zone/sunrise-app/x/ratelimit/types"
+)
+
+// hooksMiddleware wraps the transfer module to execute the action requested
+// in the memo of an inbound transfer, once the transfer succeeded.
+type hooksMiddleware struct {
+	porttypes.IBCModule
+
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new instance of the hooks middleware for the
+// transfer module.
+func NewIBCMiddleware(ibcModule porttypes.IBCModule, k keeper.Keeper) porttypes.IBCModule {
+	return &hooksMiddleware{
+		IBCModule: ibcModule,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. The action of the memo is
+// executed with the received tokens on behalf of the receiver after the
+// transfer module credited them. If the memo is invalid or the action fails,
+// an ErrorAcknowledgement is returned, and the IBC core discards the state
+// changes of the packet, so that the transfer is reverted and the tokens are
+// refunded on the counterparty.
+func (m *hooksMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not a transfer, pass it on down the stack.
+		return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	action, err := types.ParseAction(data.Memo)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if action == nil {
+		return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	ack := m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	amount, err := receivedCoin(packet, data)
+	if err == nil {
+		var receiver sdk.AccAddress
+		receiver, err = sdk.AccAddressFromBech32(data.Receiver)
+		if err == nil {
+			err = m.keeper.ExecuteAction(ctx, receiver, amount, *action)
+		}
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyAction, action.Name()),
+		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+		sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeHook, attributes...))
+
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return ack
+}
+
+// receivedCoin returns the tokens credited to the receiver of the transfer.
+func receivedCoin(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) (sdk.Coin, error) {
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount %s", data.Amount)
+	}
+	return sdk.NewCoin(ratelimittypes.ReceivedDenom(packet, data), amount), nil
+}
@@ -0,0 +1,146 @@
+package ibchooks_test
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunrise-zone/sunrise-app/x/ibchooks"
+	"github.com/sunrise-zone/sunrise-app/x/ibchooks/keeper"
+)
+
+// mockTransferModule acknowledges the packets with the configured
+// acknowledgement.
+type mockTransferModule struct {
+	porttypes.IBCModule
+
+	ack      exported.Acknowledgement
+	received int
+}
+
+func (m *mockTransferModule) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) exported.Acknowledgement {
+	m.received++
+	return m.ack
+}
+
+// mockBlobGrantKeeper records the funded allowances.
+type mockBlobGrantKeeper struct {
+	err    error
+	funded map[string]sdk.Coins
+}
+
+func (m *mockBlobGrantKeeper) FundBlobAllowance(_ sdk.Context, granter, grantee sdk.AccAddress, amount sdk.Coins) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.funded[granter.String()+"/"+grantee.String()] = amount
+	return nil
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	receiver, grantee := sdk.AccAddress("receiver").String(), sdk.AccAddress("grantee").String()
+	fundMemo := `{"hooks":{"fund_blob_allowance":{"grantee":"` + grantee + `"}}}`
+	packet := func(denom, memo string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(denom, "100", "alice", receiver, memo)
+		return channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-9", "transfer", "channel-0", clienttypes.Height{}, 0)
+	}
+	voucher := transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+
+	testCases := []struct {
+		name     string
+		packet   channeltypes.Packet
+		ack      exported.Acknowledgement
+		fundErr  error
+		success  bool
+		received bool
+		funded   sdk.Coins
+	}{
+		{
+			name:     "transfer without memo",
+			packet:   packet("uatom", ""),
+			success:  true,
+			received: true,
+		},
+		{
+			name:     "transfer with a memo for another middleware",
+			packet:   packet("uatom", `{"wasm":{}}`),
+			success:  true,
+			received: true,
+		},
+		{
+			name:     "random packet",
+			packet:   channeltypes.NewPacket([]byte{1, 2, 3}, 1, "transfer", "channel-9", "transfer", "channel-0", clienttypes.Height{}, 0),
+			success:  true,
+			received: true,
+		},
+		{
+			name:     "returning native tokens",
+			packet:   packet("transfer/channel-9/usr", fundMemo),
+			success:  true,
+			received: true,
+			funded:   sdk.NewCoins(sdk.NewInt64Coin("usr", 100)),
+		},
+		{
+			name:     "voucher",
+			packet:   packet("uatom", fundMemo),
+			success:  true,
+			received: true,
+			funded:   sdk.NewCoins(sdk.NewInt64Coin(voucher, 100)),
+		},
+		{
+			name:     "invalid memo",
+			packet:   packet("transfer/channel-9/usr", `{"hooks":{"send":{}}}`),
+			success:  false,
+			received: false,
+		},
+		{
+			name:     "failed action",
+			packet:   packet("transfer/channel-9/usr", fundMemo),
+			fundErr:  errors.New("failed"),
+			success:  false,
+			received: true,
+		},
+		{
+			name:     "failed transfer",
+			packet:   packet("transfer/channel-9/usr", fundMemo),
+			ack:      channeltypes.NewErrorAcknowledgement(errors.New("failed")),
+			success:  false,
+			received: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ack := tc.ack
+			if ack == nil {
+				ack = channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+			}
+			transfer := &mockTransferModule{ack: ack}
+			blobGrantKeeper := &mockBlobGrantKeeper{err: tc.fundErr, funded: map[string]sdk.Coins{}}
+			middleware := ibchooks.NewIBCMiddleware(transfer, keeper.NewKeeper(nil, nil, blobGrantKeeper))
+			ctx := sdk.NewContext(store.NewCommitMultiStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics()), cmtproto.Header{}, false, log.NewNopLogger())
+
+			got := middleware.OnRecvPacket(ctx, tc.packet, nil)
+			require.Equal(t, tc.success, got.Success())
+			assert.Equal(t, tc.received, transfer.received == 1)
+			if tc.funded != nil {
+				assert.Equal(t, tc.funded, blobGrantKeeper.funded[receiver+"/"+grantee])
+			} else {
+				assert.Empty(t, blobGrantKeeper.funded)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/ibchooks/types"
+)
+
+// Keeper executes the actions requested in the memo of the inbound
+// transfers. It has no state of its own.
+type Keeper struct {
+	stakingKeeper       types.StakingKeeper
+	liquidStakingKeeper types.LiquidStakingKeeper
+	blobGrantKeeper     types.BlobGrantKeeper
+}
+
+// NewKeeper returns a new Keeper executing the actions with the provided
+// keepers.
+func NewKeeper(
+	stakingKeeper types.StakingKeeper,
+	liquidStakingKeeper types.LiquidStakingKeeper,
+	blobGrantKeeper types.BlobGrantKeeper,
+) Keeper {
+	return Keeper{
+		stakingKeeper:       stakingKeeper,
+		liquidStakingKeeper: liquidStakingKeeper,
+		blobGrantKeeper:     blobGrantKeeper,
+	}
+}
+
+// ExecuteAction executes the action on behalf of the receiver of a transfer,
+// with the received tokens only.
+func (k Keeper) ExecuteAction(ctx sdk.Context, receiver sdk.AccAddress, amount sdk.Coin, action types.Action) error {
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "invalid amount %s", amount)
+	}
+	switch {
+	case action.MintDerivative != nil:
+		return k.mintDerivative(ctx, receiver, amount, *action.MintDerivative)
+	case action.FundBlobAllowance != nil:
+		grantee, err := sdk.AccAddressFromBech32(action.FundBlobAllowance.Grantee)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidMemo, "invalid grantee address: %s", err)
+		}
+		return k.blobGrantKeeper.FundBlobAllowance(ctx, receiver, grantee, sdk.NewCoins(amount))
+	default:
+		return errorsmod.Wrap(types.ErrInvalidMemo, "no action")
+	}
+}
+
+// mintDerivative delegates the received tokens, and converts the delegation
+// into liquid staking derivatives, as minting derivatives requires an
+// existing delegation.
+func (k Keeper) mintDerivative(ctx sdk.Context, receiver sdk.AccAddress, amount sdk.Coin, action types.MintDerivativeAction) error {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	if amount.Denom != bondDenom {
+		return errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s, got %s", bondDenom, amount.Denom)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(action.Validator)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidMemo, "invalid validator address: %s", err)
+	}
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	if _, err := k.stakingKeeper.Delegate(ctx, receiver, amount.Amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return err
+	}
+	_, err = k.liquidStakingKeeper.MintDerivative(ctx, receiver, valAddr, amount)
+	return err
+}
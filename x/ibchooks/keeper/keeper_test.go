@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunrise-zone/sunrise-app/x/ibchooks/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/ibchooks/types"
+)
+
+// mockKeepers records the calls of the actions.
+type mockKeepers struct {
+	delegated math.Int
+	minted    sdk.Coin
+	funded    sdk.Coins
+}
+
+func (m *mockKeepers) BondDenom(context.Context) (string, error) {
+	return "usr", nil
+}
+
+func (m *mockKeepers) GetValidator(_ context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	return stakingtypes.Validator{OperatorAddress: addr.String()}, nil
+}
+
+func (m *mockKeepers) Delegate(
+	_ context.Context,
+	_ sdk.AccAddress,
+	bondAmt math.Int,
+	_ stakingtypes.BondStatus,
+	_ stakingtypes.Validator,
+	_ bool,
+) (math.LegacyDec, error) {
+	m.delegated = bondAmt
+	return math.LegacyNewDecFromInt(bondAmt), nil
+}
+
+func (m *mockKeepers) MintDerivative(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, amount sdk.Coin) (sdk.Coin, error) {
+	m.minted = amount
+	return amount, nil
+}
+
+func (m *mockKeepers) FundBlobAllowance(_ sdk.Context, _, _ sdk.AccAddress, amount sdk.Coins) error {
+	m.funded = amount
+	return nil
+}
+
+func TestExecuteAction(t *testing.T) {
+	mocks := &mockKeepers{}
+	k := keeper.NewKeeper(mocks, mocks, mocks)
+	ctx := sdk.NewContext(store.NewCommitMultiStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics()), cmtproto.Header{}, false, log.NewNopLogger())
+	receiver := sdk.AccAddress("receiver")
+	mintDerivative := types.Action{MintDerivative: &types.MintDerivativeAction{Validator: sdk.ValAddress("validator").String()}}
+
+	// the received tokens are delegated before being converted.
+	require.NoError(t, k.ExecuteAction(ctx, receiver, sdk.NewInt64Coin("usr", 100), mintDerivative))
+	assert.Equal(t, math.NewInt(100), mocks.delegated)
+	assert.Equal(t, sdk.NewInt64Coin("usr", 100), mocks.minted)
+
+	err := k.ExecuteAction(ctx, receiver, sdk.NewInt64Coin("uatom", 100), mintDerivative)
+	assert.ErrorIs(t, err, types.ErrInvalidDenom)
+	err = k.ExecuteAction(ctx, receiver, sdk.NewInt64Coin("usr", 0), mintDerivative)
+	assert.ErrorIs(t, err, types.ErrInvalidAmount)
+
+	fundBlobAllowance := types.Action{FundBlobAllowance: &types.FundBlobAllowanceAction{Grantee: sdk.AccAddress("grantee").String()}}
+	require.NoError(t, k.ExecuteAction(ctx, receiver, sdk.NewInt64Coin("usr", 50), fundBlobAllowance))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usr", 50)), mocks.funded)
+
+	err = k.ExecuteAction(ctx, receiver, sdk.NewInt64Coin("usr", 50), types.Action{})
+	assert.ErrorIs(t, err, types.ErrInvalidMemo)
+}
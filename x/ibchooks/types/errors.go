@@ -0,0 +1,14 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/ibchooks sentinel errors
+var (
+	ErrInvalidMemo   = sdkerrors.Register(ModuleName, 2, "invalid hooks memo")
+	ErrInvalidAmount = sdkerrors.Register(ModuleName, 3, "invalid transfer amount")
+	ErrInvalidDenom  = sdkerrors.Register(ModuleName, 4, "invalid denomination")
+)
@@ -0,0 +1,12 @@
+package types
+
+// ibchooks events
+const (
+	EventTypeHook = "ibc_hook"
+
+	AttributeKeyAction   = "action"
+	AttributeKeyReceiver = "receiver"
+	AttributeKeyAmount   = "amount"
+	AttributeKeySuccess  = "success"
+	AttributeKeyError    = "error"
+)
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper defines the expected interface for the Staking module.
+type StakingKeeper interface {
+	BondDenom(ctx context.Context) (string, error)
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+	Delegate(
+		ctx context.Context,
+		delAddr sdk.AccAddress,
+		bondAmt math.Int,
+		tokenSrc stakingtypes.BondStatus,
+		validator stakingtypes.Validator,
+		subtractAccount bool,
+	) (math.LegacyDec, error)
+}
+
+// LiquidStakingKeeper defines the expected interface for the Liquidstaking
+// module.
+type LiquidStakingKeeper interface {
+	MintDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (sdk.Coin, error)
+}
+
+// BlobGrantKeeper defines the expected interface for the Blobgrant module.
+type BlobGrantKeeper interface {
+	FundBlobAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, amount sdk.Coins) error
+}
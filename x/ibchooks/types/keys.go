@@ -0,0 +1,10 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "ibchooks"
+
+	// MemoKey is the key of the FungibleTokenPacketData memo holding the
+	// action executed on arrival of the transfer.
+	MemoKey = "hooks"
+)
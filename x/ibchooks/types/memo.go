@@ -0,0 +1,92 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ActionMintDerivative    = "mint_derivative"
+	ActionFundBlobAllowance = "fund_blob_allowance"
+)
+
+// Action is the action executed on behalf of the receiver of a transfer,
+// with the received tokens, once the transfer succeeded. Exactly one of its
+// fields must be set.
+type Action struct {
+	// MintDerivative delegates the received tokens to the validator, and
+	// mints the liquid staking derivative of the delegation.
+	MintDerivative *MintDerivativeAction `json:"mint_derivative,omitempty"`
+	// FundBlobAllowance increases by the received tokens the allowance
+	// granted by the receiver to the grantee to pay for blobs.
+	FundBlobAllowance *FundBlobAllowanceAction `json:"fund_blob_allowance,omitempty"`
+}
+
+// MintDerivativeAction holds the arguments of the mint_derivative action.
+type MintDerivativeAction struct {
+	Validator string `json:"validator"`
+}
+
+// FundBlobAllowanceAction holds the arguments of the fund_blob_allowance
+// action.
+type FundBlobAllowanceAction struct {
+	Grantee string `json:"grantee"`
+}
+
+// ParseAction returns the action held by the MemoKey of the memo of a
+// transfer, or nil if the memo has no such key. The memos that aren't JSON
+// objects are left to the other middlewares and return nil.
+func ParseAction(memo string) (*Action, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, nil
+	}
+	raw, ok := fields[MemoKey]
+	if !ok {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	var action Action
+	if err := decoder.Decode(&action); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidMemo, err.Error())
+	}
+	if err := action.Validate(); err != nil {
+		return nil, err
+	}
+	return &action, nil
+}
+
+// Name returns the name of the action.
+func (a Action) Name() string {
+	switch {
+	case a.MintDerivative != nil:
+		return ActionMintDerivative
+	case a.FundBlobAllowance != nil:
+		return ActionFundBlobAllowance
+	default:
+		return ""
+	}
+}
+
+// Validate checks that exactly one action is set, with valid arguments.
+func (a Action) Validate() error {
+	if (a.MintDerivative == nil) == (a.FundBlobAllowance == nil) {
+		return errorsmod.Wrap(ErrInvalidMemo, "exactly one action must be set")
+	}
+	if a.MintDerivative != nil {
+		if _, err := sdk.ValAddressFromBech32(a.MintDerivative.Validator); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMemo, "invalid validator address: %s", err)
+		}
+	}
+	if a.FundBlobAllowance != nil {
+		if _, err := sdk.AccAddressFromBech32(a.FundBlobAllowance.Grantee); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMemo, "invalid grantee address: %s", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunrise-zone/sunrise-app/x/ibchooks/types"
+)
+
+func TestParseAction(t *testing.T) {
+	valAddr := sdk.ValAddress("validator").String()
+	grantee := sdk.AccAddress("grantee").String()
+
+	for _, memo := range []string{"", "gm", `{"wasm":{}}`, `["hooks"]`} {
+		action, err := types.ParseAction(memo)
+		require.NoError(t, err, memo)
+		assert.Nil(t, action, memo)
+	}
+
+	action, err := types.ParseAction(`{"hooks":{"mint_derivative":{"validator":"` + valAddr + `"}},"note":"gm"}`)
+	require.NoError(t, err)
+	assert.Equal(t, &types.Action{MintDerivative: &types.MintDerivativeAction{Validator: valAddr}}, action)
+	assert.Equal(t, types.ActionMintDerivative, action.Name())
+
+	action, err = types.ParseAction(`{"hooks":{"fund_blob_allowance":{"grantee":"` + grantee + `"}}}`)
+	require.NoError(t, err)
+	assert.Equal(t, &types.Action{FundBlobAllowance: &types.FundBlobAllowanceAction{Grantee: grantee}}, action)
+	assert.Equal(t, types.ActionFundBlobAllowance, action.Name())
+
+	for name, memo := range map[string]string{
+		"no action":         `{"hooks":{}}`,
+		"two actions":       `{"hooks":{"mint_derivative":{"validator":"` + valAddr + `"},"fund_blob_allowance":{"grantee":"` + grantee + `"}}}`,
+		"unknown action":    `{"hooks":{"send":{"to":"` + grantee + `"}}}`,
+		"invalid validator": `{"hooks":{"mint_derivative":{"validator":"` + grantee + `"}}}`,
+		"invalid grantee":   `{"hooks":{"fund_blob_allowance":{"grantee":"gm"}}}`,
+		"not an object":     `{"hooks":"mint_derivative"}`,
+	} {
+		_, err := types.ParseAction(memo)
+		assert.ErrorIs(t, err, types.ErrInvalidMemo, name)
+	}
+}
@@ -0,0 +1,54 @@
+package intertx
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	intertxv1 "github.com/sunrise-zone/sunrise-app/api/sunrise/intertx/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: intertxv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "InterchainAccount",
+					Use:            "interchain-account [owner] [connection-id]",
+					Short:          "Shows the interchain account of an owner on the host chain of a connection",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}, {ProtoField: "connection_id"}},
+				},
+				{
+					RpcMethod:      "PacketResult",
+					Use:            "packet-result [owner] [channel-id] [sequence]",
+					Short:          "Shows the result of a packet sent by an owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}, {ProtoField: "channel_id"}, {ProtoField: "sequence"}},
+				},
+				{
+					RpcMethod:      "PacketResults",
+					Use:            "packet-results [owner]",
+					Short:          "Shows the results of the packets sent by an owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: intertxv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "RegisterInterchainAccount",
+					Use:            "register [connection-id]",
+					Short:          "Registers an interchain account of the sender on the host chain of a connection",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "connection_id"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"version": {Usage: "the interchain accounts metadata of the channel, defaults to the protobuf encoding"},
+					},
+				},
+				{
+					RpcMethod: "SendTx",
+					Skip:      true, // skipped because the messages are Any
+				},
+			},
+		},
+	}
+}
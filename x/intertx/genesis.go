@@ -0,0 +1,22 @@
+package intertx
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/intertx/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/intertx/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	for _, result := range genState.PacketResults {
+		k.SetPacketResult(ctx, result)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		PacketResults: k.GetAllPacketResults(ctx),
+	}
+}
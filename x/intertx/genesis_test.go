@@ -0,0 +1,63 @@
+package intertx_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunrise-zone/sunrise-app/testutil/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/intertx"
+	"github.com/sunrise-zone/sunrise-app/x/intertx/types"
+)
+
+func TestGenesis(t *testing.T) {
+	alice, bob := sdk.AccAddress("alice").String(), sdk.AccAddress("bob").String()
+	genesisState := types.GenesisState{
+		PacketResults: []types.PacketResult{
+			{Owner: alice, ConnectionId: "connection-0", ChannelId: "channel-0", Sequence: 1, Status: types.PACKET_STATUS_SUCCESS, Result: []byte{1}},
+			{Owner: alice, ConnectionId: "connection-0", ChannelId: "channel-0", Sequence: 2, Status: types.PACKET_STATUS_ERROR, Error: "failed"},
+			{Owner: bob, ConnectionId: "connection-1", ChannelId: "channel-3", Sequence: 1, Status: types.PACKET_STATUS_PENDING},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.InterTxKeeper(t, nil)
+	intertx.InitGenesis(ctx, k, genesisState)
+	got := intertx.ExportGenesis(ctx, k)
+	assert.ElementsMatch(t, genesisState.PacketResults, got.PacketResults)
+
+	res, err := k.PacketResults(ctx, &types.QueryPacketResultsRequest{Owner: alice})
+	require.NoError(t, err)
+	assert.Equal(t, genesisState.PacketResults[:2], res.Results)
+}
+
+func TestGenesisValidate(t *testing.T) {
+	owner := sdk.AccAddress("owner").String()
+	valid := types.PacketResult{Owner: owner, ConnectionId: "connection-0", ChannelId: "channel-0", Sequence: 1}
+
+	for _, tc := range []struct {
+		name    string
+		results []types.PacketResult
+		err     error
+	}{
+		{"default", nil, nil},
+		{"valid", []types.PacketResult{valid}, nil},
+		{"duplicate packet", []types.PacketResult{valid, valid}, types.ErrDuplicate},
+		{"invalid owner", []types.PacketResult{{ConnectionId: "connection-0", ChannelId: "channel-0", Sequence: 1}}, types.ErrInvalidPacketResult},
+		{"invalid connection", []types.PacketResult{{Owner: owner, ChannelId: "channel-0", Sequence: 1}}, types.ErrInvalidConnectionID},
+		{"invalid channel", []types.PacketResult{{Owner: owner, ConnectionId: "connection-0", Sequence: 1}}, types.ErrInvalidPacketResult},
+		{"zero sequence", []types.PacketResult{{Owner: owner, ConnectionId: "connection-0", ChannelId: "channel-0"}}, types.ErrInvalidPacketResult},
+		{"unknown status", []types.PacketResult{{Owner: owner, ConnectionId: "connection-0", ChannelId: "channel-0", Sequence: 1, Status: 7}}, types.ErrInvalidPacketResult},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.GenesisState{PacketResults: tc.results}.Validate()
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
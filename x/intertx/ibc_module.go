@@ -0,0 +1,103 @@
+package intertx
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/sunrise-zone/sunrise-app/x/intertx/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/intertx/types"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule is the authentication module of the interchain accounts
+// controller stack. It is called by the controller middleware for the
+// channels of the interchain accounts registered with this module, to record
+// the results of the packets.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper.
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{keeper: k}
+}
+
+// OnChanOpenInit implements the IBCModule interface. The version was
+// validated by the controller middleware.
+func (IBCModule) OnChanOpenInit(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_ string,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface. The controller channels
+// are never opened by the counterparty.
+func (IBCModule) OnChanOpenTry(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	_ string,
+) (string, error) {
+	return "", errorsmod.Wrap(types.ErrUnsupported, "channel handshake must be initiated by the controller chain")
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (IBCModule) OnChanOpenAck(_ sdk.Context, _, _, _, _ string) error {
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (IBCModule) OnChanOpenConfirm(_ sdk.Context, _, _ string) error {
+	return errorsmod.Wrap(types.ErrUnsupported, "channel handshake must be initiated by the controller chain")
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (IBCModule) OnChanCloseInit(_ sdk.Context, _, _ string) error {
+	return nil
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (IBCModule) OnChanCloseConfirm(_ sdk.Context, _, _ string) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. The controller chain never
+// receives packets.
+func (IBCModule) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(types.ErrUnsupported, "cannot receive packet on controller chain"))
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The result of
+// the transactions executed by the interchain account is recorded.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+) error {
+	return im.keeper.OnAcknowledgementPacket(ctx, packet, acknowledgement)
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The timeout of the
+// packet is recorded. The ordered channel of the interchain account is closed
+// by the controller middleware, and must be reopened by registering the
+// account again.
+func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) error {
+	return im.keeper.OnTimeoutPacket(ctx, packet)
+}
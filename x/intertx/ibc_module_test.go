@@ -0,0 +1,184 @@
+package intertx_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunrise-zone/sunrise-app/app"
+	"github.com/sunrise-zone/sunrise-app/x/intertx/types"
+)
+
+func init() {
+	cfg := sdk.GetConfig()
+	cfg.SetBech32PrefixForAccount(app.AccountAddressPrefix, app.AccountAddressPrefix+"pub")
+	cfg.SetBech32PrefixForValidator(app.AccountAddressPrefix+"valoper", app.AccountAddressPrefix+"valoperpub")
+	cfg.SetBech32PrefixForConsensusNode(app.AccountAddressPrefix+"valcons", app.AccountAddressPrefix+"valconspub")
+
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		a, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+		if err != nil {
+			panic(err)
+		}
+		return a, a.DefaultGenesis()
+	}
+}
+
+// setupInterchainAccount registers the interchain account of the sender of
+// the controller chain on the host chain with MsgRegisterInterchainAccount,
+// and relays the channel handshake.
+func setupInterchainAccount(t *testing.T) (*ibctesting.Coordinator, *ibctesting.Path) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	controller := coord.GetChain(ibctesting.GetChainID(1))
+	host := coord.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(controller, host)
+	coord.SetupConnections(path)
+
+	owner := controller.SenderAccount.GetAddress().String()
+	portID, err := icatypes.NewControllerPortID(owner)
+	require.NoError(t, err)
+	// the channels of the interchain accounts are fee enabled by default.
+	version := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{
+		FeeVersion: ibcfeetypes.Version,
+		AppVersion: icatypes.NewDefaultMetadataString(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID),
+	}))
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointB.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+
+	res, err := controller.SendMsgs(types.NewMsgRegisterInterchainAccount(owner, path.EndpointA.ConnectionID, ""))
+	require.NoError(t, err)
+	path.EndpointA.ChannelID, err = ibctesting.ParseChannelIDFromEvents(res.Events)
+	require.NoError(t, err)
+
+	require.NoError(t, path.EndpointB.ChanOpenTry())
+	require.NoError(t, path.EndpointA.ChanOpenAck())
+	require.NoError(t, path.EndpointB.ChanOpenConfirm())
+	return coord, path
+}
+
+func TestRegisterInterchainAccount(t *testing.T) {
+	_, path := setupInterchainAccount(t)
+	controller, host := path.EndpointA.Chain, path.EndpointB.Chain
+	controllerApp := controller.App.(*app.App)
+	hostApp := host.App.(*app.App)
+
+	res, err := controllerApp.InterTxKeeper.InterchainAccount(controller.GetContext(), &types.QueryInterchainAccountRequest{
+		Owner:        controller.SenderAccount.GetAddress().String(),
+		ConnectionId: path.EndpointA.ConnectionID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, path.EndpointA.ChannelConfig.PortID, res.PortId)
+	require.Equal(t, path.EndpointA.ChannelID, res.ChannelId)
+
+	// the account exists on the host chain.
+	hostAddress, found := hostApp.ICAHostKeeper.GetInterchainAccountAddress(host.GetContext(), path.EndpointB.ConnectionID, res.PortId)
+	require.True(t, found)
+	require.Equal(t, hostAddress, res.Address)
+
+	// the accounts of other owners aren't registered.
+	_, err = controllerApp.InterTxKeeper.InterchainAccount(controller.GetContext(), &types.QueryInterchainAccountRequest{
+		Owner:        controller.SenderAccounts[1].SenderAccount.GetAddress().String(),
+		ConnectionId: path.EndpointA.ConnectionID,
+	})
+	require.Error(t, err)
+}
+
+func TestSendTx(t *testing.T) {
+	coord, path := setupInterchainAccount(t)
+	controller, host := path.EndpointA.Chain, path.EndpointB.Chain
+	controllerApp := controller.App.(*app.App)
+	hostApp := host.App.(*app.App)
+	owner := controller.SenderAccount.GetAddress().String()
+
+	account, err := controllerApp.InterTxKeeper.InterchainAccount(controller.GetContext(), &types.QueryInterchainAccountRequest{
+		Owner:        owner,
+		ConnectionId: path.EndpointA.ConnectionID,
+	})
+	require.NoError(t, err)
+	icaAddress := sdk.MustAccAddressFromBech32(account.Address)
+	recipient := host.SenderAccounts[1].SenderAccount.GetAddress()
+
+	// fund the interchain account on the host chain.
+	funds := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+	_, err = host.SendMsgs(banktypes.NewMsgSend(host.SenderAccount.GetAddress(), icaAddress, funds))
+	require.NoError(t, err)
+
+	sendTx := func(amount int64, timeout time.Duration) channeltypes.Packet {
+		send := banktypes.NewMsgSend(icaAddress, recipient, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(amount))))
+		msg, err := types.NewMsgSendTx(owner, path.EndpointA.ConnectionID, []sdk.Msg{send}, "", timeout)
+		require.NoError(t, err)
+		res, err := controller.SendMsgs(msg)
+		require.NoError(t, err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+		require.NoError(t, err)
+
+		result, found := controllerApp.InterTxKeeper.GetPacketResult(controller.GetContext(), owner, packet.SourceChannel, packet.Sequence)
+		require.True(t, found)
+		require.Equal(t, types.PACKET_STATUS_PENDING, result.Status)
+		return packet
+	}
+	packetResult := func(packet channeltypes.Packet) types.PacketResult {
+		res, err := controllerApp.InterTxKeeper.PacketResult(controller.GetContext(), &types.QueryPacketResultRequest{
+			Owner:     owner,
+			ChannelId: packet.SourceChannel,
+			Sequence:  packet.Sequence,
+		})
+		require.NoError(t, err)
+		return res.Result
+	}
+
+	// the transactions succeeding on the host chain are recorded with their
+	// result.
+	before := hostApp.BankKeeper.GetBalance(host.GetContext(), recipient, sdk.DefaultBondDenom)
+	packet := sendTx(400, 0)
+	require.NoError(t, path.RelayPacket(packet))
+	result := packetResult(packet)
+	require.Equal(t, types.PACKET_STATUS_SUCCESS, result.Status)
+	require.NotEmpty(t, result.Result)
+	require.Empty(t, result.Error)
+	after := hostApp.BankKeeper.GetBalance(host.GetContext(), recipient, sdk.DefaultBondDenom)
+	require.Equal(t, before.Amount.AddRaw(400), after.Amount)
+
+	// the transactions failing on the host chain are recorded with the error.
+	packet = sendTx(5000, 0)
+	require.NoError(t, path.RelayPacket(packet))
+	result = packetResult(packet)
+	require.Equal(t, types.PACKET_STATUS_ERROR, result.Status)
+	require.NotEmpty(t, result.Error)
+	require.Equal(t, after, hostApp.BankKeeper.GetBalance(host.GetContext(), recipient, sdk.DefaultBondDenom))
+
+	// the packets timing out are recorded.
+	packet = sendTx(100, time.Second)
+	coord.IncrementTimeBy(time.Minute)
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+	require.Equal(t, types.PACKET_STATUS_TIMEOUT, packetResult(packet).Status)
+
+	results, err := controllerApp.InterTxKeeper.PacketResults(controller.GetContext(), &types.QueryPacketResultsRequest{Owner: owner})
+	require.NoError(t, err)
+	require.Len(t, results.Results, 3)
+
+	// the channel closed on timeout, so no transaction can be sent anymore.
+	send := banktypes.NewMsgSend(icaAddress, recipient, funds)
+	msg, err := types.NewMsgSendTx(owner, path.EndpointA.ConnectionID, []sdk.Msg{send}, "", 0)
+	require.NoError(t, err)
+	_, err = controller.SendMsgs(msg)
+	require.Error(t, err)
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/intertx/types"
+)
+
+// RegisterInterchainAccount opens the channel of the interchain account of
+// the owner on the host chain of the connection, and returns the controller
+// port of the owner. The account is created by the host chain once the
+// channel handshake completes.
+func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, owner, connectionID, version string) (string, error) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+	if err := k.icaControllerKeeper.RegisterInterchainAccount(ctx, connectionID, owner, version); err != nil {
+		return "", err
+	}
+	return portID, nil
+}
+
+// SendTx sends the messages to be executed by the interchain account of the
+// owner on the host chain of the connection, and stores the pending result of
+// the packet. The packet times out after the timeout, relative to the block
+// time, or DefaultRelativeTimeout if zero.
+func (k Keeper) SendTx(
+	ctx sdk.Context,
+	owner string,
+	connectionID string,
+	msgs []sdk.Msg,
+	memo string,
+	timeout time.Duration,
+) (string, uint64, error) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", 0, err
+	}
+	channelID, found := k.icaControllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return "", 0, errorsmod.Wrapf(types.ErrNoActiveChannel, "owner %s on %s", owner, connectionID)
+	}
+	// the messages are encoded as negotiated in the version of the channel,
+	// unwrapped from the versions of the middlewares.
+	version, found := k.icaControllerKeeper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return "", 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err != nil {
+		return "", 0, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal interchain accounts metadata: %s", err)
+	}
+
+	protoMsgs := make([]proto.Message, len(msgs))
+	for i, msg := range msgs {
+		protoMsgs[i] = msg
+	}
+	data, err := icatypes.SerializeCosmosTx(k.cdc, protoMsgs, metadata.Encoding)
+	if err != nil {
+		return "", 0, err
+	}
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	if timeout == 0 {
+		timeout = types.DefaultRelativeTimeout
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(timeout).UnixNano())
+	sequence, err := k.icaControllerKeeper.SendTx(ctx, nil, connectionID, portID, packetData, timeoutTimestamp)
+	if err != nil {
+		return "", 0, err
+	}
+
+	k.SetPacketResult(ctx, types.PacketResult{
+		Owner:        owner,
+		ConnectionId: connectionID,
+		ChannelId:    channelID,
+		Sequence:     sequence,
+		Status:       types.PACKET_STATUS_PENDING,
+	})
+	return channelID, sequence, nil
+}
+
+// OnAcknowledgementPacket stores the result of the acknowledged packet.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal interchain account acknowledgement: %s", err)
+	}
+	result, err := k.packetResult(ctx, packet)
+	if err != nil {
+		return err
+	}
+	if ack.Success() {
+		result.Status = types.PACKET_STATUS_SUCCESS
+		result.Result = ack.GetResult()
+	} else {
+		result.Status = types.PACKET_STATUS_ERROR
+		result.Error = ack.GetError()
+	}
+	k.SetPacketResult(ctx, result)
+	return nil
+}
+
+// OnTimeoutPacket stores the result of the timed out packet.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	result, err := k.packetResult(ctx, packet)
+	if err != nil {
+		return err
+	}
+	result.Status = types.PACKET_STATUS_TIMEOUT
+	k.SetPacketResult(ctx, result)
+	return nil
+}
+
+// packetResult returns the stored result of the packet. A new result is
+// returned for the packets sent over the channel without this module, such
+// as with the controller MsgSendTx.
+func (k Keeper) packetResult(ctx sdk.Context, packet channeltypes.Packet) (types.PacketResult, error) {
+	owner, ok := types.OwnerFromPortID(packet.GetSourcePort())
+	if !ok {
+		return types.PacketResult{}, errorsmod.Wrapf(icatypes.ErrInvalidControllerPort, "port %s", packet.GetSourcePort())
+	}
+	if result, found := k.GetPacketResult(ctx, owner, packet.GetSourceChannel(), packet.GetSequence()); found {
+		return result, nil
+	}
+	connectionID, err := k.icaControllerKeeper.GetConnectionID(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
+		return types.PacketResult{}, err
+	}
+	return types.PacketResult{
+		Owner:        owner,
+		ConnectionId: connectionID,
+		ChannelId:    packet.GetSourceChannel(),
+		Sequence:     packet.GetSequence(),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/sunrise-zone/sunrise-app/x/intertx/types"
+)
+
+// Keeper registers and drives the interchain accounts of the accounts of the
+// chain through the interchain accounts controller, and stores the results of
+// the packets they send. It is the authentication module of the controller
+// stack.
+type Keeper struct {
+	cdc          codec.Codec
+	storeService store.KVStoreService
+	logger       log.Logger
+
+	icaControllerKeeper types.ICAControllerKeeper
+}
+
+// NewKeeper creates a new intertx Keeper instance.
+func NewKeeper(
+	cdc codec.Codec,
+	storeService store.KVStoreService,
+	logger log.Logger,
+	icaControllerKeeper types.ICAControllerKeeper,
+) Keeper {
+	return Keeper{
+		cdc:                 cdc,
+		storeService:        storeService,
+		logger:              logger,
+		icaControllerKeeper: icaControllerKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger() log.Logger {
+	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/intertx/types"
+)
+
+func (k msgServer) RegisterInterchainAccount(goCtx context.Context, req *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	portID, err := k.Keeper.RegisterInterchainAccount(sdk.UnwrapSDKContext(goCtx), req.Owner, req.ConnectionId, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterInterchainAccountResponse{PortId: portID}, nil
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/intertx/types"
+)
+
+func (k msgServer) SendTx(goCtx context.Context, req *types.MsgSendTx) (*types.MsgSendTxResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	msgs, err := req.GetTxMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, sequence, err := k.Keeper.SendTx(sdk.UnwrapSDKContext(goCtx), req.Owner, req.ConnectionId, msgs, req.Memo, req.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendTxResponse{ChannelId: channelID, Sequence: sequence}, nil
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/intertx/types"
+)
+
+// SetPacketResult stores the packet result.
+func (k Keeper) SetPacketResult(ctx sdk.Context, result types.PacketResult) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.GetPacketResultKey(result.Owner, result.ChannelId, result.Sequence), k.cdc.MustMarshal(&result))
+}
+
+// GetPacketResult returns the result of the packet sent by the owner over the
+// channel with the provided sequence.
+func (k Keeper) GetPacketResult(ctx sdk.Context, owner, channelID string, sequence uint64) (types.PacketResult, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.GetPacketResultKey(owner, channelID, sequence))
+	if bz == nil {
+		return types.PacketResult{}, false
+	}
+	var result types.PacketResult
+	k.cdc.MustUnmarshal(bz, &result)
+	return result, true
+}
+
+// GetAllPacketResults returns the results of all the packets, ordered by
+// owner, channel and sequence.
+func (k Keeper) GetAllPacketResults(ctx sdk.Context) []types.PacketResult {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PacketResultKeyPrefix))
+	defer iterator.Close()
+
+	results := []types.PacketResult{}
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.PacketResult
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+		results = append(results, result)
+	}
+	return results
+}
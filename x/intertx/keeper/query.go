@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sunrise-zone/sunrise-app/x/intertx/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) InterchainAccount(
+	ctx context.Context,
+	req *types.QueryInterchainAccountRequest,
+) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	portID, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	address, found := k.icaControllerKeeper.GetInterchainAccountAddress(sdkCtx, req.ConnectionId, portID)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "owner %s on %s", req.Owner, req.ConnectionId).Error(),
+		)
+	}
+	channelID, _ := k.icaControllerKeeper.GetActiveChannelID(sdkCtx, req.ConnectionId, portID)
+	return &types.QueryInterchainAccountResponse{
+		Address:   address,
+		PortId:    portID,
+		ChannelId: channelID,
+	}, nil
+}
+
+func (k Keeper) PacketResult(
+	ctx context.Context,
+	req *types.QueryPacketResultRequest,
+) (*types.QueryPacketResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	result, found := k.GetPacketResult(sdk.UnwrapSDKContext(ctx), req.Owner, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no result for packet %d of %s on %s", req.Sequence, req.Owner, req.ChannelId)
+	}
+	return &types.QueryPacketResultResponse{Result: result}, nil
+}
+
+func (k Keeper) PacketResults(
+	ctx context.Context,
+	req *types.QueryPacketResultsRequest,
+) (*types.QueryPacketResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	store := prefix.NewStore(
+		runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)),
+		types.GetOwnerPacketResultsPrefix(req.Owner),
+	)
+	results := []types.PacketResult{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var result types.PacketResult
+		if err := k.cdc.Unmarshal(value, &result); err != nil {
+			return err
+		}
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPacketResultsResponse{
+		Results:    results,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/intertx module sentinel errors
+var (
+	ErrInvalidConnectionID = sdkerrors.Register(ModuleName, 2, "invalid connection identifier")
+	ErrNoMessages          = sdkerrors.Register(ModuleName, 3, "no messages")
+	ErrInvalidTimeout      = sdkerrors.Register(ModuleName, 4, "invalid timeout")
+	ErrNoActiveChannel     = sdkerrors.Register(ModuleName, 5, "no active interchain account channel")
+	ErrInvalidPacketResult = sdkerrors.Register(ModuleName, 6, "invalid packet result")
+	ErrDuplicate           = sdkerrors.Register(ModuleName, 7, "duplicate")
+	ErrUnsupported         = sdkerrors.Register(ModuleName, 8, "unsupported operation")
+)
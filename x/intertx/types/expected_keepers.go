@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+)
+
+// ICAControllerKeeper defines the expected interface for the interchain
+// accounts controller keeper.
+type ICAControllerKeeper interface {
+	RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, version string) error
+	SendTx(
+		ctx sdk.Context,
+		chanCap *capabilitytypes.Capability,
+		connectionID string,
+		portID string,
+		icaPacketData icatypes.InterchainAccountPacketData,
+		timeoutTimestamp uint64,
+	) (uint64, error)
+	GetActiveChannelID(ctx sdk.Context, connectionID, portID string) (string, bool)
+	GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool)
+	GetConnectionID(ctx sdk.Context, portID, channelID string) (string, error)
+	GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool)
+}
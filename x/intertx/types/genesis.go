@@ -0,0 +1,33 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		PacketResults: []PacketResult{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	type packetID struct {
+		owner, channelID string
+		sequence         uint64
+	}
+	results := make(map[packetID]struct{}, len(gs.PacketResults))
+	for _, result := range gs.PacketResults {
+		id := packetID{result.Owner, result.ChannelId, result.Sequence}
+		if _, ok := results[id]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "result of packet %d of %s on %s", result.Sequence, result.Owner, result.ChannelId)
+		}
+		results[id] = struct{}{}
+		if err := result.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
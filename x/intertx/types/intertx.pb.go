@@ -0,0 +1,651 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sunrise/intertx/v1/intertx.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PacketStatus is the status of a packet sent to an interchain account.
+type PacketStatus int32
+
+const (
+	// PACKET_STATUS_PENDING is the status of a packet that wasn't acknowledged
+	// yet.
+	PACKET_STATUS_PENDING PacketStatus = 0
+	// PACKET_STATUS_SUCCESS is the status of a packet whose messages were
+	// executed by the interchain account.
+	PACKET_STATUS_SUCCESS PacketStatus = 1
+	// PACKET_STATUS_ERROR is the status of a packet whose messages failed on
+	// the host chain.
+	PACKET_STATUS_ERROR PacketStatus = 2
+	// PACKET_STATUS_TIMEOUT is the status of a packet that timed out. The
+	// channel of the interchain account is then closed.
+	PACKET_STATUS_TIMEOUT PacketStatus = 3
+)
+
+var PacketStatus_name = map[int32]string{
+	0: "PACKET_STATUS_PENDING",
+	1: "PACKET_STATUS_SUCCESS",
+	2: "PACKET_STATUS_ERROR",
+	3: "PACKET_STATUS_TIMEOUT",
+}
+
+var PacketStatus_value = map[string]int32{
+	"PACKET_STATUS_PENDING": 0,
+	"PACKET_STATUS_SUCCESS": 1,
+	"PACKET_STATUS_ERROR":   2,
+	"PACKET_STATUS_TIMEOUT": 3,
+}
+
+func (x PacketStatus) String() string {
+	return proto.EnumName(PacketStatus_name, int32(x))
+}
+
+func (PacketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82a677bc39370488, []int{0}
+}
+
+// PacketResult is the result of a packet sent by an owner to its interchain
+// account.
+type PacketResult struct {
+	Owner        string       `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string       `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	ChannelId    string       `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence     uint64       `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Status       PacketStatus `protobuf:"varint,5,opt,name=status,proto3,enum=sunrise.intertx.v1.PacketStatus" json:"status,omitempty"`
+	// result holds the result of the acknowledgement of a successful packet,
+	// the proto encoded TxMsgData of the executed messages.
+	Result []byte `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+	// error holds the error of the acknowledgement of a failed packet.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *PacketResult) Reset()         { *m = PacketResult{} }
+func (m *PacketResult) String() string { return proto.CompactTextString(m) }
+func (*PacketResult) ProtoMessage()    {}
+func (*PacketResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a677bc39370488, []int{0}
+}
+func (m *PacketResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketResult.Merge(m, src)
+}
+func (m *PacketResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketResult proto.InternalMessageInfo
+
+func (m *PacketResult) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PacketResult) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *PacketResult) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PacketResult) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketResult) GetStatus() PacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PACKET_STATUS_PENDING
+}
+
+func (m *PacketResult) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *PacketResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("sunrise.intertx.v1.PacketStatus", PacketStatus_name, PacketStatus_value)
+	proto.RegisterType((*PacketResult)(nil), "sunrise.intertx.v1.PacketResult")
+}
+
+func init() { proto.RegisterFile("sunrise/intertx/v1/intertx.proto", fileDescriptor_82a677bc39370488) }
+
+var fileDescriptor_82a677bc39370488 = []byte{
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x3f, 0x6f, 0xda, 0x40,
+	0x18, 0xc6, 0x7d, 0xfc, 0x71, 0xcb, 0xc9, 0xad, 0xac, 0x2b, 0x6d, 0x5d, 0xa4, 0x5a, 0x56, 0xbb,
+	0x58, 0x95, 0x6a, 0x8b, 0xb2, 0x74, 0xa5, 0xd4, 0xaa, 0xac, 0x8a, 0x3f, 0x3a, 0x9b, 0xa5, 0x0b,
+	0x32, 0xe6, 0x04, 0x56, 0xc9, 0x9d, 0x73, 0x3e, 0x13, 0x92, 0x21, 0x73, 0xc6, 0x7c, 0x87, 0x7c,
+	0x99, 0x8c, 0x8c, 0x19, 0x23, 0x58, 0xf3, 0x21, 0x22, 0x6c, 0xe3, 0x24, 0x62, 0x7b, 0x9f, 0xe7,
+	0xf9, 0xdd, 0xe9, 0x7d, 0xf4, 0x42, 0x23, 0x49, 0x29, 0x8f, 0x12, 0x62, 0x47, 0x54, 0x10, 0x2e,
+	0xd6, 0xf6, 0xaa, 0x7d, 0x18, 0xad, 0x98, 0x33, 0xc1, 0x10, 0x2a, 0x08, 0xeb, 0x60, 0xaf, 0xda,
+	0xad, 0xe6, 0x9c, 0xcd, 0x59, 0x16, 0xdb, 0xfb, 0x29, 0x27, 0xbf, 0x3c, 0x00, 0xa8, 0x8c, 0x82,
+	0xf0, 0x3f, 0x11, 0x98, 0x24, 0xe9, 0x52, 0xa0, 0x26, 0xac, 0xb3, 0x33, 0x4a, 0xb8, 0x06, 0x0c,
+	0x60, 0x36, 0x70, 0x2e, 0xd0, 0x57, 0xf8, 0x26, 0x64, 0x94, 0x92, 0x50, 0x44, 0x8c, 0x4e, 0xa2,
+	0x99, 0x56, 0xc9, 0x52, 0xe5, 0xc9, 0x74, 0x67, 0xe8, 0x33, 0x84, 0xe1, 0x22, 0xa0, 0x94, 0x2c,
+	0xf7, 0x44, 0x35, 0x23, 0x1a, 0x85, 0xe3, 0xce, 0x50, 0x0b, 0xbe, 0x4e, 0xc8, 0x69, 0x4a, 0x68,
+	0x48, 0xb4, 0x9a, 0x01, 0xcc, 0x1a, 0x2e, 0x35, 0xfa, 0x09, 0xe5, 0x44, 0x04, 0x22, 0x4d, 0xb4,
+	0xba, 0x01, 0xcc, 0xb7, 0x3f, 0x0c, 0xeb, 0xb8, 0x81, 0x95, 0xef, 0xe9, 0x65, 0x1c, 0x2e, 0x78,
+	0xf4, 0x01, 0xca, 0x3c, 0xdb, 0x5c, 0x93, 0x0d, 0x60, 0x2a, 0x58, 0xe6, 0x65, 0x0f, 0xc2, 0x39,
+	0xe3, 0xda, 0xab, 0xbc, 0x47, 0x26, 0xbe, 0x5d, 0x42, 0xe5, 0xf9, 0x2f, 0xe8, 0x13, 0x7c, 0x3f,
+	0xea, 0xf6, 0xfe, 0x3a, 0xfe, 0xc4, 0xf3, 0xbb, 0xfe, 0xd8, 0x9b, 0x8c, 0x9c, 0xc1, 0x6f, 0x77,
+	0xf0, 0x47, 0x95, 0x8e, 0x23, 0x6f, 0xdc, 0xeb, 0x39, 0x9e, 0xa7, 0x02, 0xf4, 0x11, 0xbe, 0x7b,
+	0x19, 0x39, 0x18, 0x0f, 0xb1, 0x5a, 0x39, 0x7e, 0xe3, 0xbb, 0x7d, 0x67, 0x38, 0xf6, 0xd5, 0x6a,
+	0xab, 0x76, 0x75, 0xa3, 0x4b, 0xbf, 0xfa, 0xb7, 0x5b, 0x1d, 0x6c, 0xb6, 0x3a, 0xb8, 0xdf, 0xea,
+	0xe0, 0x7a, 0xa7, 0x4b, 0x9b, 0x9d, 0x2e, 0xdd, 0xed, 0x74, 0xe9, 0x5f, 0x67, 0x1e, 0x89, 0x45,
+	0x3a, 0xb5, 0x42, 0x76, 0x62, 0x17, 0xdd, 0xbf, 0x5f, 0x30, 0x4a, 0x4a, 0x11, 0xc4, 0xb1, 0xbd,
+	0x2e, 0x4f, 0x2e, 0xce, 0x63, 0x92, 0x4c, 0xe5, 0xec, 0x88, 0x9d, 0xc7, 0x01, 0x00, 0x79, 0xed,
+	0x7e, 0x1a, 0x12, 0x02, 0x00, 0x00,
+}
+
+func (m *PacketResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintIntertx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintIntertx(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintIntertx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintIntertx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIntertx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintIntertx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintIntertx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIntertx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIntertx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PacketResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovIntertx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovIntertx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIntertx(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovIntertx(uint64(m.Sequence))
+	}
+	if m.Status != 0 {
+		n += 1 + sovIntertx(uint64(m.Status))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovIntertx(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovIntertx(uint64(l))
+	}
+	return n
+}
+
+func sovIntertx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIntertx(x uint64) (n int) {
+	return sovIntertx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PacketResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIntertx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntertx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntertx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntertx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntertx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntertx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntertx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntertx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntertx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntertx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntertx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntertx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntertx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIntertx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntertx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntertx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntertx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntertx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIntertx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIntertx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIntertx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIntertx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIntertx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIntertx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIntertx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIntertx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIntertx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIntertx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIntertx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIntertx = fmt.Errorf("proto: unexpected end of group")
+)
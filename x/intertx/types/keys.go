@@ -0,0 +1,35 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "intertx"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+const (
+	// PacketResultKeyPrefix indexes the PacketResult of each packet by owner,
+	// channel identifier and sequence.
+	PacketResultKeyPrefix = "PacketResult/"
+)
+
+// DefaultRelativeTimeout is the timeout of the packets sent without timeout.
+const DefaultRelativeTimeout = 10 * time.Minute
+
+// GetOwnerPacketResultsPrefix returns the prefix of the keys of the packet
+// results of the owner.
+func GetOwnerPacketResultsPrefix(owner string) []byte {
+	return []byte(PacketResultKeyPrefix + owner + "/")
+}
+
+// GetPacketResultKey returns the key of the result of the packet sent by the
+// owner over the channel with the provided sequence.
+func GetPacketResultKey(owner, channelID string, sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64(append(GetOwnerPacketResultsPrefix(owner), channelID+"/"...), sequence)
+}
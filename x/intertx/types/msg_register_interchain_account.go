@@ -0,0 +1,30 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var _ sdk.Msg = &MsgRegisterInterchainAccount{}
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount
+// instance.
+func NewMsgRegisterInterchainAccount(owner, connectionID, version string) *MsgRegisterInterchainAccount {
+	return &MsgRegisterInterchainAccount{
+		Owner:        owner,
+		ConnectionId: connectionID,
+		Version:      version,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRegisterInterchainAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+	if err := host.ConnectionIdentifierValidator(m.ConnectionId); err != nil {
+		return errorsmod.Wrap(ErrInvalidConnectionID, err.Error())
+	}
+	return nil
+}
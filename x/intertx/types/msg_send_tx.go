@@ -0,0 +1,58 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg                          = &MsgSendTx{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgSendTx{}
+)
+
+// NewMsgSendTx creates a new MsgSendTx instance.
+func NewMsgSendTx(owner, connectionID string, msgs []sdk.Msg, memo string, timeout time.Duration) (*MsgSendTx, error) {
+	anys, err := tx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgSendTx{
+		Owner:        owner,
+		ConnectionId: connectionID,
+		Msgs:         anys,
+		Memo:         memo,
+		Timeout:      timeout,
+	}, nil
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSendTx) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+	if err := host.ConnectionIdentifierValidator(m.ConnectionId); err != nil {
+		return errorsmod.Wrap(ErrInvalidConnectionID, err.Error())
+	}
+	if len(m.Msgs) == 0 {
+		return ErrNoMessages
+	}
+	if m.Timeout < 0 {
+		return errorsmod.Wrapf(ErrInvalidTimeout, "negative timeout %s", m.Timeout)
+	}
+	return nil
+}
+
+// GetTxMsgs returns the unpacked messages executed by the interchain account.
+func (m *MsgSendTx) GetTxMsgs() ([]sdk.Msg, error) {
+	return tx.GetMsgs(m.Msgs, "MsgSendTx")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (m *MsgSendTx) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return tx.UnpackInterfaces(unpacker, m.Msgs)
+}
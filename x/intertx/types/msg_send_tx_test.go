@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sunrise-zone/sunrise-app/x/intertx/types"
+)
+
+func TestMsgSendTxValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("owner").String()
+	send := banktypes.NewMsgSend(sdk.AccAddress("ica"), sdk.AccAddress("recipient"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	for _, tc := range []struct {
+		name         string
+		owner        string
+		connectionID string
+		msgs         []sdk.Msg
+		timeout      time.Duration
+		err          error
+	}{
+		{"valid", owner, "connection-0", []sdk.Msg{send}, 0, nil},
+		{"invalid connection", owner, "channel-0", []sdk.Msg{send}, 0, types.ErrInvalidConnectionID},
+		{"no messages", owner, "connection-0", nil, 0, types.ErrNoMessages},
+		{"negative timeout", owner, "connection-0", []sdk.Msg{send}, -time.Second, types.ErrInvalidTimeout},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := types.NewMsgSendTx(tc.owner, tc.connectionID, tc.msgs, "", tc.timeout)
+			require.NoError(t, err)
+			err = msg.ValidateBasic()
+			if tc.err == nil {
+				require.NoError(t, err)
+				msgs, err := msg.GetTxMsgs()
+				require.NoError(t, err)
+				require.Equal(t, tc.msgs, msgs)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+
+	msg, err := types.NewMsgSendTx("invalid", "connection-0", []sdk.Msg{send}, "", 0)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// OwnerFromPortID returns the owner of an interchain account controller port.
+func OwnerFromPortID(portID string) (string, bool) {
+	if !strings.HasPrefix(portID, icatypes.ControllerPortPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(portID, icatypes.ControllerPortPrefix), true
+}
+
+// Validate performs a basic validation of the packet result.
+func (r PacketResult) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPacketResult, "invalid owner address: %s", err)
+	}
+	if err := host.ConnectionIdentifierValidator(r.ConnectionId); err != nil {
+		return errorsmod.Wrap(ErrInvalidConnectionID, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPacketResult, "invalid channel identifier: %s", err)
+	}
+	if r.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketResult, "sequence must be positive")
+	}
+	if _, ok := PacketStatus_name[int32(r.Status)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidPacketResult, "unknown status %d", r.Status)
+	}
+	return nil
+}
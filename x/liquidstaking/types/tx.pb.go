@@ -352,44 +352,45 @@ func init() {
 func init() { proto.RegisterFile("sunrise/liquidstaking/tx.proto", fileDescriptor_7aae18fe0b821bcc) }
 
 var fileDescriptor_7aae18fe0b821bcc = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x5b, 0x88, 0xf0, 0x81, 0x40, 0xb5, 0x8a, 0x9a, 0x04, 0x70, 0xaa, 0x0c, 0x28, 0x8a,
-	0x14, 0x5f, 0x53, 0x24, 0x10, 0xb0, 0x80, 0x9b, 0x91, 0x48, 0x28, 0x15, 0x08, 0xb1, 0xa0, 0x8b,
-	0x7d, 0xbd, 0x1c, 0x8d, 0xef, 0x8c, 0xef, 0x6c, 0x35, 0x4c, 0x88, 0x91, 0x89, 0x1f, 0xc0, 0x0f,
-	0x60, 0xcc, 0xd0, 0x1f, 0xd1, 0x31, 0xea, 0x84, 0x18, 0x2a, 0x94, 0x0c, 0xf9, 0x1b, 0xc8, 0xf6,
-	0x25, 0x21, 0x26, 0x2d, 0x11, 0x53, 0x17, 0xdb, 0xef, 0xbd, 0xef, 0xbd, 0xf7, 0x7d, 0xef, 0xdd,
-	0x19, 0x98, 0x22, 0x64, 0x01, 0x15, 0x18, 0xf6, 0xe8, 0x87, 0x90, 0xba, 0x42, 0xa2, 0x43, 0xca,
-	0x08, 0x94, 0x47, 0x96, 0x1f, 0x70, 0xc9, 0x8d, 0xdb, 0x2a, 0x6e, 0x2d, 0xc4, 0x4b, 0x1b, 0xc8,
-	0xa3, 0x8c, 0xc3, 0xe4, 0x99, 0x22, 0x4b, 0x5b, 0x0e, 0x17, 0x1e, 0x17, 0xd0, 0x13, 0x04, 0x46,
-	0x8d, 0xf8, 0xa5, 0x02, 0xc5, 0x34, 0xf0, 0x2e, 0xb1, 0x60, 0x6a, 0xa8, 0xd0, 0x26, 0xe1, 0x84,
-	0xa7, 0xfe, 0xf8, 0x4b, 0x79, 0x2b, 0xcb, 0x39, 0xf9, 0x28, 0x40, 0xde, 0x34, 0xd3, 0x54, 0xdd,
-	0x3a, 0x48, 0x60, 0x18, 0x35, 0x3a, 0x58, 0xa2, 0x06, 0x74, 0x38, 0x65, 0x2a, 0x5e, 0x26, 0x9c,
-	0x93, 0x1e, 0x86, 0x89, 0xd5, 0x09, 0x0f, 0xa0, 0xa4, 0x1e, 0x16, 0x12, 0x79, 0x7e, 0x0a, 0xa8,
-	0x8c, 0x35, 0x70, 0xab, 0x25, 0xc8, 0x2b, 0xdf, 0x45, 0x12, 0xbf, 0x4c, 0x4a, 0x1b, 0x0f, 0x81,
-	0x8e, 0x42, 0xd9, 0xe5, 0x01, 0x95, 0xfd, 0x82, 0xb6, 0xad, 0x55, 0x75, 0xbb, 0x70, 0x7a, 0x5c,
-	0xdf, 0x54, 0x9c, 0x9f, 0xbb, 0x6e, 0x80, 0x85, 0xd8, 0x97, 0x01, 0x65, 0xa4, 0x3d, 0x87, 0x1a,
-	0xcf, 0x40, 0x3e, 0x25, 0x57, 0x58, 0xdb, 0xd6, 0xaa, 0xd7, 0x77, 0xef, 0x59, 0x4b, 0xa7, 0x66,
-	0xa5, 0x6d, 0x6c, 0xfd, 0xe4, 0xac, 0x9c, 0xfb, 0x3e, 0x19, 0xd4, 0xb4, 0xb6, 0xca, 0x7b, 0xf2,
-	0xfa, 0xf3, 0x64, 0x50, 0x9b, 0x57, 0xfc, 0x32, 0x19, 0xd4, 0xf6, 0x08, 0x95, 0xdd, 0xb0, 0x63,
-	0x39, 0xdc, 0x83, 0xaa, 0x5c, 0xfd, 0x23, 0x67, 0x78, 0x66, 0x20, 0xdf, 0x87, 0x47, 0x99, 0x19,
-	0x65, 0x14, 0x55, 0x8a, 0x60, 0x2b, 0xe3, 0x6a, 0x63, 0xe1, 0x73, 0x26, 0x70, 0xe5, 0x9b, 0x06,
-	0x36, 0x5a, 0x82, 0xb4, 0x28, 0x93, 0x4d, 0x1c, 0xd0, 0x08, 0x49, 0x1a, 0x61, 0x63, 0x07, 0xe4,
-	0x05, 0x66, 0x2e, 0x0e, 0xfe, 0xa9, 0x5f, 0xe1, 0x8c, 0xbb, 0x40, 0x8f, 0x50, 0x8f, 0xba, 0x48,
-	0xf2, 0x20, 0xd1, 0xaf, 0xb7, 0xe7, 0x0e, 0xe3, 0x11, 0xc8, 0x23, 0x8f, 0x87, 0x4c, 0x16, 0xd6,
-	0x93, 0xd1, 0x14, 0x2d, 0x55, 0x2c, 0x5e, 0x9c, 0xa5, 0x16, 0x67, 0xed, 0x71, 0xca, 0xec, 0x2b,
-	0xf1, 0x58, 0xda, 0x0a, 0x5e, 0x79, 0x03, 0x8a, 0x7f, 0xb1, 0x9b, 0x72, 0x37, 0x9e, 0x82, 0x6b,
-	0x01, 0x76, 0x30, 0x8d, 0xb0, 0x9b, 0xf0, 0x5c, 0xa1, 0xee, 0x2c, 0x61, 0x2a, 0xdc, 0x0e, 0x03,
-	0x76, 0x19, 0x85, 0xbf, 0x4f, 0x84, 0x2f, 0xb2, 0x9b, 0x09, 0x6f, 0x65, 0x84, 0xeb, 0x76, 0x23,
-	0x4e, 0xfe, 0x79, 0x56, 0xbe, 0x93, 0x96, 0x17, 0xee, 0xa1, 0x45, 0x39, 0xf4, 0x90, 0xec, 0x5a,
-	0x2f, 0x30, 0x41, 0x4e, 0xbf, 0x89, 0x9d, 0xd3, 0xe3, 0x3a, 0x50, 0xdd, 0x9b, 0xd8, 0x99, 0x8f,
-	0x62, 0x77, 0xb8, 0x06, 0xd6, 0x5b, 0x82, 0x18, 0x07, 0xe0, 0xc6, 0xc2, 0x45, 0xb8, 0x7f, 0xce,
-	0x01, 0xce, 0x9c, 0xa5, 0x92, 0xb5, 0x1a, 0x6e, 0x46, 0xbf, 0x07, 0x6e, 0x66, 0xce, 0x5b, 0xf5,
-	0xfc, 0x0a, 0x8b, 0xc8, 0xd2, 0xce, 0xaa, 0xc8, 0x3f, 0xbb, 0x65, 0x96, 0x7c, 0x41, 0xb7, 0x45,
-	0xe4, 0x45, 0xdd, 0x96, 0xaf, 0xa6, 0x74, 0xf5, 0x53, 0x7c, 0xa3, 0xed, 0xfd, 0x93, 0x91, 0xa9,
-	0x0d, 0x47, 0xa6, 0xf6, 0x6b, 0x64, 0x6a, 0x5f, 0xc7, 0x66, 0x6e, 0x38, 0x36, 0x73, 0x3f, 0xc6,
-	0x66, 0xee, 0xed, 0xe3, 0xff, 0xb9, 0xd0, 0xb2, 0xef, 0x63, 0xd1, 0xc9, 0x27, 0xff, 0xac, 0x07,
-	0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x21, 0x48, 0x2e, 0x90, 0xae, 0x05, 0x00, 0x00,
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xb1, 0x6f, 0xd3, 0x4e,
+	0x14, 0x8e, 0xdb, 0xdf, 0x2f, 0xc2, 0x57, 0x04, 0xaa, 0x55, 0xd4, 0xc4, 0x80, 0x5b, 0x65, 0x40,
+	0x55, 0xa4, 0xf8, 0x92, 0x22, 0x81, 0x28, 0x0b, 0xb8, 0x19, 0x89, 0x84, 0x52, 0x81, 0x10, 0x0b,
+	0xba, 0xd8, 0xd7, 0xcb, 0xd1, 0xf8, 0xce, 0xf8, 0xce, 0x56, 0xc3, 0x84, 0x18, 0x99, 0xf8, 0x33,
+	0x60, 0xcb, 0xd0, 0x3f, 0xa2, 0x63, 0xd4, 0x09, 0x31, 0x54, 0x28, 0x19, 0xf2, 0x6f, 0x20, 0xdb,
+	0x97, 0x84, 0xb8, 0x2d, 0x44, 0x4c, 0x2c, 0xb6, 0xdf, 0x7b, 0xdf, 0xbd, 0xef, 0x7b, 0xdf, 0xdd,
+	0x19, 0x58, 0x22, 0x62, 0x21, 0x15, 0x18, 0xf6, 0xe8, 0xbb, 0x88, 0x7a, 0x42, 0xa2, 0x23, 0xca,
+	0x08, 0x94, 0xc7, 0x76, 0x10, 0x72, 0xc9, 0x8d, 0x5b, 0xaa, 0x6e, 0x2f, 0xd4, 0xcd, 0x75, 0xe4,
+	0x53, 0xc6, 0x61, 0xfa, 0xcc, 0x90, 0xe6, 0xa6, 0xcb, 0x85, 0xcf, 0x05, 0xf4, 0x05, 0x81, 0x71,
+	0x23, 0x79, 0xa9, 0x42, 0x39, 0x2b, 0xbc, 0x49, 0x23, 0x98, 0x05, 0xaa, 0xb4, 0x41, 0x38, 0xe1,
+	0x59, 0x3e, 0xf9, 0x52, 0xd9, 0xca, 0xe5, 0x9a, 0x02, 0x14, 0x22, 0x7f, 0xba, 0xd2, 0x52, 0x6c,
+	0x1d, 0x24, 0x30, 0x8c, 0x1b, 0x1d, 0x2c, 0x51, 0x03, 0xba, 0x9c, 0x32, 0x55, 0xdf, 0x22, 0x9c,
+	0x93, 0x1e, 0x86, 0x69, 0xd4, 0x89, 0x0e, 0xa1, 0xa4, 0x3e, 0x16, 0x12, 0xf9, 0x41, 0x06, 0xa8,
+	0x8c, 0x35, 0x70, 0xb3, 0x25, 0xc8, 0x8b, 0xc0, 0x43, 0x12, 0x3f, 0x4f, 0x5b, 0x1b, 0x0f, 0x80,
+	0x8e, 0x22, 0xd9, 0xe5, 0x21, 0x95, 0xfd, 0x92, 0xb6, 0xad, 0xed, 0xe8, 0x4e, 0xe9, 0xec, 0xa4,
+	0xb6, 0xa1, 0x34, 0x3f, 0xf5, 0xbc, 0x10, 0x0b, 0x71, 0x20, 0x43, 0xca, 0x48, 0x7b, 0x0e, 0x35,
+	0x9e, 0x80, 0x62, 0x26, 0xae, 0xb4, 0xb2, 0xad, 0xed, 0xac, 0xed, 0xde, 0xb5, 0x2f, 0x75, 0xcd,
+	0xce, 0x68, 0x1c, 0xfd, 0xf4, 0x7c, 0xab, 0xf0, 0x65, 0x32, 0xa8, 0x6a, 0x6d, 0xb5, 0x6e, 0xef,
+	0xe5, 0xc7, 0xc9, 0xa0, 0x3a, 0xef, 0xf8, 0x69, 0x32, 0xa8, 0xee, 0x13, 0x2a, 0xbb, 0x51, 0xc7,
+	0x76, 0xb9, 0x0f, 0x55, 0xbb, 0xda, 0x7b, 0xce, 0xf0, 0x2c, 0x40, 0x41, 0x00, 0x8f, 0x73, 0x1e,
+	0xe5, 0x26, 0xaa, 0x94, 0xc1, 0x66, 0x2e, 0xd5, 0xc6, 0x22, 0xe0, 0x4c, 0xe0, 0xca, 0x57, 0x0d,
+	0xac, 0xb7, 0x04, 0x69, 0x51, 0x26, 0x9b, 0x38, 0xa4, 0x31, 0x92, 0x34, 0xc6, 0x46, 0x1d, 0x14,
+	0x05, 0x66, 0x1e, 0x0e, 0xff, 0x38, 0xbf, 0xc2, 0x19, 0x77, 0x80, 0x1e, 0xa3, 0x1e, 0xf5, 0x90,
+	0xe4, 0x61, 0x3a, 0xbf, 0xde, 0x9e, 0x27, 0x8c, 0x87, 0xa0, 0x88, 0x7c, 0x1e, 0x31, 0x59, 0x5a,
+	0x4d, 0xad, 0x29, 0xdb, 0xaa, 0x59, 0xb2, 0x71, 0xb6, 0xda, 0x38, 0x7b, 0x9f, 0x53, 0xe6, 0xfc,
+	0x97, 0xd8, 0xd2, 0x56, 0xf0, 0xbd, 0xb5, 0xc4, 0x11, 0xc5, 0x51, 0x79, 0x05, 0xca, 0x17, 0xa4,
+	0x4e, 0x07, 0x31, 0x1e, 0x83, 0x6b, 0x21, 0x76, 0x31, 0x8d, 0xb1, 0x57, 0xd2, 0x96, 0x23, 0x99,
+	0x2d, 0x98, 0xba, 0xe0, 0x44, 0x21, 0xfb, 0xe7, 0x5d, 0x78, 0x0b, 0xca, 0x17, 0xa4, 0xce, 0x5c,
+	0x68, 0xe5, 0x5c, 0xd0, 0x9d, 0x46, 0xd2, 0xe9, 0xfb, 0xf9, 0xd6, 0xed, 0x8c, 0x4b, 0x78, 0x47,
+	0x36, 0xe5, 0xd0, 0x47, 0xb2, 0x6b, 0x3f, 0xc3, 0x04, 0xb9, 0xfd, 0x26, 0x76, 0xcf, 0x4e, 0x6a,
+	0x40, 0x49, 0x69, 0x62, 0x77, 0xee, 0xcb, 0xee, 0x70, 0x05, 0xac, 0xb6, 0x04, 0x31, 0x0e, 0xc1,
+	0xf5, 0x85, 0x2b, 0x72, 0xef, 0x8a, 0xa3, 0x9d, 0x3b, 0x65, 0xa6, 0xbd, 0x1c, 0x6e, 0x26, 0xbf,
+	0x07, 0x6e, 0xe4, 0x4e, 0xe2, 0xce, 0xd5, 0x1d, 0x16, 0x91, 0x66, 0x7d, 0x59, 0xe4, 0xaf, 0x6c,
+	0xb9, 0x1d, 0xff, 0x0d, 0xdb, 0x22, 0xd2, 0xac, 0x2f, 0x8b, 0x9c, 0xb2, 0x99, 0xff, 0x7f, 0x48,
+	0xee, 0xba, 0x73, 0x70, 0x3a, 0xb2, 0xb4, 0xe1, 0xc8, 0xd2, 0x7e, 0x8c, 0x2c, 0xed, 0xf3, 0xd8,
+	0x2a, 0x0c, 0xc7, 0x56, 0xe1, 0xdb, 0xd8, 0x2a, 0xbc, 0x7e, 0xf4, 0x37, 0x57, 0x5d, 0xf6, 0x03,
+	0x2c, 0x3a, 0xc5, 0xf4, 0x6f, 0x76, 0xff, 0xe7, 0x00, 0x16, 0x86, 0xe2, 0xb0, 0xc8, 0x05, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
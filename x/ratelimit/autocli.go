@@ -0,0 +1,49 @@
+package ratelimit
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	ratelimitv1 "github.com/sunrise-zone/sunrise-app/api/sunrise/ratelimit/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: ratelimitv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "RateLimit",
+					Use:       "rate-limit [channel-id] [denom]",
+					Short:     "Shows the rate limit of a denomination over a channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel_id"},
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "RateLimits",
+					Use:       "rate-limits",
+					Short:     "Shows all the rate limits",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: ratelimitv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "SetRateLimit",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveRateLimit",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "ResetRateLimit",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
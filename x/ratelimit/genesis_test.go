@@ -0,0 +1,46 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunrise-zone/sunrise-app/testutil/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit"
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit/types"
+)
+
+func TestGenesis(t *testing.T) {
+	windowStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	genesisState := types.GenesisState{
+		RateLimits: []types.RateLimit{{
+			ChannelId:   "channel-0",
+			Denom:       "usr",
+			Quota:       types.Quota{MaxPercentSend: 10, MaxPercentRecv: 5, Duration: 24 * time.Hour},
+			Flow:        types.Flow{Inflow: math.NewInt(1), Outflow: math.NewInt(2), ChannelValue: math.NewInt(100)},
+			WindowStart: windowStart,
+		}},
+		PendingSendPackets: []types.PendingSendPacket{
+			{ChannelId: "channel-0", Sequence: 2, WindowStart: windowStart},
+			{ChannelId: "channel-0", Sequence: 256, WindowStart: windowStart},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.RateLimitKeeper(t, nil, nil)
+	ratelimit.InitGenesis(ctx, k, genesisState)
+	got := ratelimit.ExportGenesis(ctx, k)
+	assert.Equal(t, genesisState, *got)
+
+	duplicate := genesisState
+	duplicate.RateLimits = append(duplicate.RateLimits, genesisState.RateLimits[0])
+	assert.ErrorIs(t, duplicate.Validate(), types.ErrDuplicate)
+	invalid := genesisState
+	invalid.RateLimits = []types.RateLimit{genesisState.RateLimits[0]}
+	invalid.RateLimits[0].Flow.Outflow = math.NewInt(-1)
+	assert.ErrorIs(t, invalid.Validate(), types.ErrInvalidAmount)
+	assert.NoError(t, types.DefaultGenesis().Validate())
+}
@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit/types"
+)
+
+// rateLimitMiddleware wraps the transfer module to enforce the inbound quotas
+// of the rate limited denominations, and to undo the outflow of the refunded
+// outbound transfers. The outbound quotas are enforced by the keeper, which is
+// the ICS4Wrapper of the transfer module.
+type rateLimitMiddleware struct {
+	porttypes.IBCModule
+
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new instance of the rate limit middleware for
+// the transfer module.
+func NewIBCMiddleware(ibcModule porttypes.IBCModule, k keeper.Keeper) porttypes.IBCModule {
+	return &rateLimitMiddleware{
+		IBCModule: ibcModule,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. The amount of an inbound
+// transfer is added to the inflow of its rate limit, and the packet is
+// rejected with an ErrorAcknowledgement if the quota would be exceeded. The
+// state changes of a packet whose acknowledgement is an error are discarded by
+// the IBC core, so the inflow only counts the successful transfers.
+func (m *rateLimitMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not a transfer, pass it on down the stack.
+		return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	denom := types.ReceivedDenom(packet, data)
+	if _, err := m.keeper.UpdateFlow(ctx, types.DirectionRecv, packet.GetDestChannel(), denom, amount); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The outflow of
+// the transfer is undone if it failed on the counterparty, as the tokens are
+// refunded.
+func (m *rateLimitMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil && !ack.Success() {
+		m.keeper.UndoSendPacket(ctx, packet)
+	} else {
+		m.keeper.RemovePendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	}
+	return m.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The outflow of the
+// transfer is undone, as the tokens are refunded.
+func (m *rateLimitMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	m.keeper.UndoSendPacket(ctx, packet)
+	return m.IBCModule.OnTimeoutPacket(ctx, packet, relayer)
+}
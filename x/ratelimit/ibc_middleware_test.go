@@ -0,0 +1,152 @@
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunrise-zone/sunrise-app/testutil/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit"
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit/types"
+)
+
+type mockBankKeeper map[string]math.Int
+
+func (b mockBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b[denom])
+}
+
+// mockIBCModule accepts every packet. Only the methods wrapped by the
+// middleware are implemented.
+type mockIBCModule struct {
+	porttypes.IBCModule
+
+	received int
+}
+
+func (m *mockIBCModule) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) exported.Acknowledgement {
+	m.received++
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func (m *mockIBCModule) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) error {
+	return nil
+}
+
+func (m *mockIBCModule) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) error {
+	return nil
+}
+
+type mockICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+}
+
+func (mockICS4Wrapper) SendPacket(sdk.Context, *capabilitytypes.Capability, string, string, clienttypes.Height, uint64, []byte) (uint64, error) {
+	return 1, nil
+}
+
+func setup(t *testing.T) (porttypes.IBCModule, *mockIBCModule, keeper.Keeper, sdk.Context) {
+	supply := mockBankKeeper{
+		"usr": math.NewInt(1000),
+		// the voucher of the uatom received over channel-0.
+		transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom(): math.NewInt(1000),
+	}
+	k, ctx := keepertest.RateLimitKeeper(t, supply, mockICS4Wrapper{})
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	quota := types.Quota{MaxPercentSend: 10, MaxPercentRecv: 10, Duration: time.Hour}
+	for denom := range supply {
+		require.NoError(t, k.AddRateLimit(ctx, "channel-0", denom, quota))
+	}
+	module := &mockIBCModule{}
+	return ratelimit.NewIBCMiddleware(module, k), module, k, ctx
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	middleware, module, k, ctx := setup(t)
+
+	// usr returning from the counterparty, and uatom sent by it.
+	returning := transfertypes.NewFungibleTokenPacketData("transfer/channel-9/usr", "100", "alice", "bob", "")
+	returningPacket := channeltypes.NewPacket(returning.GetBytes(), 1, transfertypes.PortID, "channel-9", transfertypes.PortID, "channel-0", clienttypes.Height{}, 0)
+	foreign := transfertypes.NewFungibleTokenPacketData("uatom", "60", "alice", "bob", "")
+	foreignPacket := channeltypes.NewPacket(foreign.GetBytes(), 2, transfertypes.PortID, "channel-9", transfertypes.PortID, "channel-0", clienttypes.Height{}, 0)
+
+	ack := middleware.OnRecvPacket(ctx, returningPacket, nil)
+	require.True(t, ack.Success())
+	ack = middleware.OnRecvPacket(ctx, foreignPacket, nil)
+	require.True(t, ack.Success())
+	// the quotas of the denominations are independent.
+	ack = middleware.OnRecvPacket(ctx, foreignPacket, nil)
+	require.False(t, ack.Success())
+	assert.Equal(t, 2, module.received)
+
+	usr, _ := k.GetRateLimit(ctx, "channel-0", "usr")
+	assert.Equal(t, math.NewInt(100), usr.Flow.Inflow)
+	atom, _ := k.GetRateLimit(ctx, "channel-0", transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom())
+	assert.Equal(t, math.NewInt(60), atom.Flow.Inflow)
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		found = found || event.Type == "sunrise.ratelimit.v1.EventRateLimitExceeded"
+	}
+	assert.True(t, found)
+}
+
+func TestRefundsUndoOutflow(t *testing.T) {
+	middleware, _, k, ctx := setup(t)
+	data := transfertypes.NewFungibleTokenPacketData("usr", "100", "alice", "bob", "")
+
+	for _, tc := range []struct {
+		name     string
+		refund   func(channeltypes.Packet) error
+		expected math.Int
+	}{
+		{
+			name: "timeout",
+			refund: func(packet channeltypes.Packet) error {
+				return middleware.OnTimeoutPacket(ctx, packet, nil)
+			},
+			expected: math.ZeroInt(),
+		},
+		{
+			name: "error acknowledgement",
+			refund: func(packet channeltypes.Packet) error {
+				ack := channeltypes.NewErrorAcknowledgement(errors.New("failed"))
+				return middleware.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+			},
+			expected: math.ZeroInt(),
+		},
+		{
+			name: "successful acknowledgement",
+			refund: func(packet channeltypes.Packet) error {
+				ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+				return middleware.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+			},
+			expected: math.NewInt(100),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, k.ResetRateLimit(ctx, "channel-0", "usr"))
+			sequence, err := k.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.Height{}, 0, data.GetBytes())
+			require.NoError(t, err)
+
+			packet := channeltypes.NewPacket(data.GetBytes(), sequence, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-9", clienttypes.Height{}, 0)
+			require.NoError(t, tc.refund(packet))
+			rateLimit, _ := k.GetRateLimit(ctx, "channel-0", "usr")
+			assert.Equal(t, tc.expected, rateLimit.Flow.Outflow)
+			assert.Empty(t, k.GetAllPendingSendPackets(ctx))
+		})
+	}
+}
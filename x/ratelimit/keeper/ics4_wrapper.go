@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit/types"
+)
+
+// SendPacket implements the ICS4Wrapper interface. The amount of an outbound
+// transfer is added to the outflow of its rate limit, failing the transfer if
+// the quota would be exceeded. The packet is then tracked until it is
+// acknowledged, so that its outflow is undone if the transfer is refunded.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
+		// not a transfer, nothing to rate limit.
+		return k.ICS4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+	amount, ok := math.NewIntFromString(packetData.Amount)
+	if !ok {
+		return 0, errorsmod.Wrapf(types.ErrInvalidAmount, "%q", packetData.Amount)
+	}
+
+	limited, err := k.UpdateFlow(ctx, types.DirectionSend, sourceChannel, types.SentDenom(packetData), amount)
+	if err != nil {
+		return 0, err
+	}
+	sequence, err := k.ICS4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+	if limited {
+		rateLimit, _ := k.GetRateLimit(ctx, sourceChannel, types.SentDenom(packetData))
+		k.SetPendingSendPacket(ctx, types.PendingSendPacket{
+			ChannelId:   sourceChannel,
+			Sequence:    sequence,
+			WindowStart: rateLimit.WindowStart,
+		})
+	}
+	return sequence, nil
+}
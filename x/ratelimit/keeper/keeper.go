@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit/types"
+)
+
+// Keeper tracks the flow of the rate limited denominations per channel. It is
+// also the ICS4Wrapper of the rate limit middleware in the transfer stack,
+// checking the outbound transfers before passing them to the wrapped
+// ICS4Wrapper.
+type Keeper struct {
+	porttypes.ICS4Wrapper
+
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	logger       log.Logger
+
+	// the address capable of executing the rate limit messages. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	bankKeeper types.BankKeeper
+}
+
+// NewKeeper creates a new ratelimit Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	logger log.Logger,
+	authority string,
+	bankKeeper types.BankKeeper,
+	wrapper porttypes.ICS4Wrapper,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
+	}
+
+	return Keeper{
+		ICS4Wrapper:  wrapper,
+		cdc:          cdc,
+		storeService: storeService,
+		logger:       logger,
+		authority:    authority,
+		bankKeeper:   bankKeeper,
+	}
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger() log.Logger {
+	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunrise-zone/sunrise-app/testutil/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit/types"
+)
+
+type mockBankKeeper map[string]math.Int
+
+func (b mockBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	supply, ok := b[denom]
+	if !ok {
+		return sdk.NewCoin(denom, math.ZeroInt())
+	}
+	return sdk.NewCoin(denom, supply)
+}
+
+// mockICS4Wrapper sends the packets with increasing sequences.
+type mockICS4Wrapper struct {
+	sequence uint64
+}
+
+func (w *mockICS4Wrapper) SendPacket(
+	_ sdk.Context,
+	_ *capabilitytypes.Capability,
+	_ string,
+	_ string,
+	_ clienttypes.Height,
+	_ uint64,
+	_ []byte,
+) (uint64, error) {
+	w.sequence++
+	return w.sequence, nil
+}
+
+func (w *mockICS4Wrapper) WriteAcknowledgement(
+	_ sdk.Context,
+	_ *capabilitytypes.Capability,
+	_ exported.PacketI,
+	_ exported.Acknowledgement,
+) error {
+	return nil
+}
+
+func (w *mockICS4Wrapper) GetAppVersion(_ sdk.Context, _, _ string) (string, bool) {
+	return "", false
+}
+
+var (
+	startTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dayQuota  = types.Quota{MaxPercentSend: 10, MaxPercentRecv: 20, Duration: 24 * time.Hour}
+)
+
+func setupKeeper(t *testing.T) (keeper.Keeper, sdk.Context, mockBankKeeper) {
+	bankKeeper := mockBankKeeper{"usr": math.NewInt(1000)}
+	k, ctx := keepertest.RateLimitKeeper(t, bankKeeper, &mockICS4Wrapper{})
+	return k, ctx.WithBlockTime(startTime), bankKeeper
+}
+
+func send(k keeper.Keeper, ctx sdk.Context, denom string, amount int64) (uint64, error) {
+	data := transfertypes.NewFungibleTokenPacketData(denom, math.NewInt(amount).String(), "alice", "bob", "")
+	return k.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.Height{}, 0, data.GetBytes())
+}
+
+func sentPacket(denom string, amount int64, sequence uint64) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, math.NewInt(amount).String(), "alice", "bob", "")
+	return channeltypes.NewPacket(data.GetBytes(), sequence, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-9", clienttypes.Height{}, 0)
+}
+
+func TestSendPacket(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	require.NoError(t, k.AddRateLimit(ctx, "channel-0", "usr", dayQuota))
+
+	// the quota is 10% of the supply of 1000usr.
+	sequence, err := send(k, ctx, "usr", 60)
+	require.NoError(t, err)
+	_, err = send(k, ctx, "usr", 41)
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+	_, err = send(k, ctx, "usr", 40)
+	require.NoError(t, err)
+
+	rateLimit, found := k.GetRateLimit(ctx, "channel-0", "usr")
+	require.True(t, found)
+	assert.Equal(t, math.NewInt(100), rateLimit.Flow.Outflow)
+	assert.Len(t, k.GetAllPendingSendPackets(ctx), 2)
+
+	// the outflow of a refunded transfer is undone.
+	k.UndoSendPacket(ctx, sentPacket("usr", 60, sequence))
+	rateLimit, _ = k.GetRateLimit(ctx, "channel-0", "usr")
+	assert.Equal(t, math.NewInt(40), rateLimit.Flow.Outflow)
+	assert.Len(t, k.GetAllPendingSendPackets(ctx), 1)
+	// only once.
+	k.UndoSendPacket(ctx, sentPacket("usr", 60, sequence))
+	rateLimit, _ = k.GetRateLimit(ctx, "channel-0", "usr")
+	assert.Equal(t, math.NewInt(40), rateLimit.Flow.Outflow)
+
+	// denominations without rate limit aren't tracked.
+	_, err = send(k, ctx, "transfer/channel-0/uatom", 1_000_000)
+	require.NoError(t, err)
+	assert.Len(t, k.GetAllPendingSendPackets(ctx), 1)
+}
+
+func TestWindowRollover(t *testing.T) {
+	k, ctx, bankKeeper := setupKeeper(t)
+	require.NoError(t, k.AddRateLimit(ctx, "channel-0", "usr", dayQuota))
+	sequence, err := send(k, ctx, "usr", 100)
+	require.NoError(t, err)
+	_, err = send(k, ctx, "usr", 1)
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+
+	// the window isn't over yet.
+	bankKeeper["usr"] = math.NewInt(2000)
+	ctx = ctx.WithBlockTime(startTime.Add(23 * time.Hour))
+	k.ResetExpiredRateLimits(ctx)
+	_, err = send(k, ctx, "usr", 1)
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+
+	// the flow is reset, and the channel value updated, at the rollover.
+	ctx = ctx.WithBlockTime(startTime.Add(24 * time.Hour))
+	k.ResetExpiredRateLimits(ctx)
+	rateLimit, _ := k.GetRateLimit(ctx, "channel-0", "usr")
+	assert.Equal(t, types.NewFlow(math.NewInt(2000)), rateLimit.Flow)
+	assert.Equal(t, ctx.BlockTime(), rateLimit.WindowStart)
+	_, err = send(k, ctx, "usr", 200)
+	require.NoError(t, err)
+
+	// refunds of the transfers sent during the previous window don't undo
+	// the outflow of the current one.
+	k.UndoSendPacket(ctx, sentPacket("usr", 100, sequence))
+	rateLimit, _ = k.GetRateLimit(ctx, "channel-0", "usr")
+	assert.Equal(t, math.NewInt(200), rateLimit.Flow.Outflow)
+	_, found := k.GetPendingSendPacket(ctx, "channel-0", sequence)
+	assert.False(t, found)
+}
+
+func TestMsgServer(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+
+	_, err := ms.SetRateLimit(ctx, types.NewMsgSetRateLimit("invalid", "channel-0", "usr", dayQuota))
+	assert.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.SetRateLimit(ctx, types.NewMsgSetRateLimit(authority, "channel-0", "usr", types.Quota{MaxPercentSend: 101, Duration: time.Hour}))
+	assert.ErrorIs(t, err, types.ErrInvalidQuota)
+	_, err = ms.SetRateLimit(ctx, types.NewMsgSetRateLimit(authority, "channel-0", "usr", types.Quota{MaxPercentSend: 10}))
+	assert.ErrorIs(t, err, types.ErrInvalidQuota)
+	_, err = ms.SetRateLimit(ctx, types.NewMsgSetRateLimit(authority, "channel-0", "uatom", dayQuota))
+	assert.ErrorIs(t, err, types.ErrZeroChannelValue)
+	_, err = ms.RemoveRateLimit(ctx, types.NewMsgRemoveRateLimit(authority, "channel-0", "usr"))
+	assert.ErrorIs(t, err, types.ErrRateLimitNotFound)
+	_, err = ms.ResetRateLimit(ctx, types.NewMsgResetRateLimit(authority, "channel-0", "usr"))
+	assert.ErrorIs(t, err, types.ErrRateLimitNotFound)
+
+	_, err = ms.SetRateLimit(ctx, types.NewMsgSetRateLimit(authority, "channel-0", "usr", dayQuota))
+	require.NoError(t, err)
+	_, err = send(k, ctx, "usr", 100)
+	require.NoError(t, err)
+
+	_, err = ms.ResetRateLimit(ctx, types.NewMsgResetRateLimit(authority, "channel-0", "usr"))
+	require.NoError(t, err)
+	rateLimit, found := k.GetRateLimit(ctx, "channel-0", "usr")
+	require.True(t, found)
+	assert.True(t, rateLimit.Flow.Outflow.IsZero())
+
+	_, err = ms.RemoveRateLimit(ctx, types.NewMsgRemoveRateLimit(authority, "channel-0", "usr"))
+	require.NoError(t, err)
+	_, found = k.GetRateLimit(ctx, "channel-0", "usr")
+	assert.False(t, found)
+}
+
+func TestQueryRateLimits(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	for _, channelID := range []string{"channel-0", "channel-1", "channel-2"} {
+		require.NoError(t, k.AddRateLimit(ctx, channelID, "usr", dayQuota))
+	}
+
+	resp, err := k.RateLimit(ctx, &types.QueryRateLimitRequest{ChannelId: "channel-1", Denom: "usr"})
+	require.NoError(t, err)
+	assert.Equal(t, dayQuota, resp.RateLimit.Quota)
+	_, err = k.RateLimit(ctx, &types.QueryRateLimitRequest{ChannelId: "channel-1", Denom: "uatom"})
+	assert.ErrorIs(t, err, types.ErrRateLimitNotFound)
+
+	page, err := k.RateLimits(ctx, &types.QueryRateLimitsRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, page.RateLimits, 2)
+	page, err = k.RateLimits(ctx, &types.QueryRateLimitsRequest{Pagination: &query.PageRequest{Key: page.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, page.RateLimits, 1)
+	assert.Equal(t, "channel-2", page.RateLimits[0].ChannelId)
+}
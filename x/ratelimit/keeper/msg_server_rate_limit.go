@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit/types"
+)
+
+func (k msgServer) checkAuthority(authority string) error {
+	if k.GetAuthority() != authority {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), authority)
+	}
+	return nil
+}
+
+func (k msgServer) SetRateLimit(goCtx context.Context, req *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+	if err := types.ValidatePath(req.ChannelId, req.Denom); err != nil {
+		return nil, err
+	}
+	if err := req.Quota.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.AddRateLimit(sdk.UnwrapSDKContext(goCtx), req.ChannelId, req.Denom, req.Quota); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+func (k msgServer) RemoveRateLimit(goCtx context.Context, req *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetRateLimit(ctx, req.ChannelId, req.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "%s over %s", req.Denom, req.ChannelId)
+	}
+	k.Keeper.RemoveRateLimit(ctx, req.ChannelId, req.Denom)
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+func (k msgServer) ResetRateLimit(goCtx context.Context, req *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.ResetRateLimit(sdk.UnwrapSDKContext(goCtx), req.ChannelId, req.Denom); err != nil {
+		return nil, err
+	}
+	return &types.MsgResetRateLimitResponse{}, nil
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit/types"
+)
+
+// SetPendingSendPacket stores the pending send packet.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, packet types.PendingSendPacket) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.GetPendingSendPacketKey(packet.ChannelId, packet.Sequence), k.cdc.MustMarshal(&packet))
+}
+
+// GetPendingSendPacket returns the pending send packet with the provided
+// channel and sequence.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (types.PendingSendPacket, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.GetPendingSendPacketKey(channelID, sequence))
+	if bz == nil {
+		return types.PendingSendPacket{}, false
+	}
+	var packet types.PendingSendPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// RemovePendingSendPacket deletes the pending send packet with the provided
+// channel and sequence.
+func (k Keeper) RemovePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.GetPendingSendPacketKey(channelID, sequence))
+}
+
+// GetAllPendingSendPackets returns all the pending send packets, ordered by
+// channel and sequence.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PendingSendPacketKeyPrefix))
+	defer iterator.Close()
+
+	packets := []types.PendingSendPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+	return packets
+}
+
+// UndoSendPacket subtracts the amount of a refunded transfer from the outflow
+// of its rate limit, provided the packet was sent during the current window.
+func (k Keeper) UndoSendPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	pending, found := k.GetPendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+	k.RemovePendingSendPacket(ctx, pending.ChannelId, pending.Sequence)
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return
+	}
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return
+	}
+	rateLimit, found := k.GetRateLimit(ctx, pending.ChannelId, types.SentDenom(data))
+	if !found || !rateLimit.WindowStart.Equal(pending.WindowStart) {
+		return
+	}
+	rateLimit.UndoOutflow(amount)
+	k.SetRateLimit(ctx, rateLimit)
+}
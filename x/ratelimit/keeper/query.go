@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) RateLimit(
+	ctx context.Context,
+	req *types.QueryRateLimitRequest,
+) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	rateLimit, found := k.GetRateLimit(sdk.UnwrapSDKContext(ctx), req.ChannelId, req.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "%s over %s", req.Denom, req.ChannelId)
+	}
+	return &types.QueryRateLimitResponse{RateLimit: rateLimit}, nil
+}
+
+func (k Keeper) RateLimits(
+	ctx context.Context,
+	req *types.QueryRateLimitsRequest,
+) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	store := prefix.NewStore(
+		runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)),
+		[]byte(types.RateLimitKeyPrefix),
+	)
+	rateLimits := []types.RateLimit{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pageRes,
+	}, nil
+}
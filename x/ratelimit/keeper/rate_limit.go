@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit/types"
+)
+
+// SetRateLimit stores the rate limit.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.GetRateLimitKey(rateLimit.ChannelId, rateLimit.Denom), k.cdc.MustMarshal(&rateLimit))
+}
+
+// GetRateLimit returns the rate limit of the denomination over the channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.GetRateLimitKey(channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// RemoveRateLimit deletes the rate limit of the denomination over the channel.
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, channelID, denom string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.GetRateLimitKey(channelID, denom))
+}
+
+// GetAllRateLimits returns all the rate limits, ordered by channel and
+// denomination.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.RateLimitKeyPrefix))
+	defer iterator.Close()
+
+	rateLimits := []types.RateLimit{}
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+	return rateLimits
+}
+
+// AddRateLimit adds, or replaces, the quota of the denomination over the
+// channel, starting a new window. The denomination must have a supply, as the
+// quota is a percentage of it.
+func (k Keeper) AddRateLimit(ctx sdk.Context, channelID, denom string, quota types.Quota) error {
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if supply.IsZero() {
+		return errorsmod.Wrapf(types.ErrZeroChannelValue, "no supply of %s", denom)
+	}
+	k.SetRateLimit(ctx, types.RateLimit{
+		ChannelId:   channelID,
+		Denom:       denom,
+		Quota:       quota,
+		Flow:        types.NewFlow(supply),
+		WindowStart: ctx.BlockTime(),
+	})
+	return nil
+}
+
+// ResetRateLimit starts a new window for the rate limit, resetting its flow
+// and taking the current supply of the denomination as channel value.
+func (k Keeper) ResetRateLimit(ctx sdk.Context, channelID, denom string) error {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "%s over %s", denom, channelID)
+	}
+	k.resetWindow(ctx, &rateLimit)
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+func (k Keeper) resetWindow(ctx sdk.Context, rateLimit *types.RateLimit) {
+	rateLimit.Flow = types.NewFlow(k.bankKeeper.GetSupply(ctx, rateLimit.Denom).Amount)
+	rateLimit.WindowStart = ctx.BlockTime()
+}
+
+// ResetExpiredRateLimits starts a new window for the rate limits whose window
+// rolled over.
+func (k Keeper) ResetExpiredRateLimits(ctx sdk.Context) {
+	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		if !rateLimit.IsExpired(ctx.BlockTime()) {
+			continue
+		}
+		k.resetWindow(ctx, &rateLimit)
+		k.SetRateLimit(ctx, rateLimit)
+	}
+}
+
+// UpdateFlow adds the amount transferred in the direction to the flow of the
+// rate limit of the denomination over the channel, if any. It fails, and
+// emits an EventRateLimitExceeded event, if the quota would be exceeded.
+// Returns whether the denomination is rate limited.
+func (k Keeper) UpdateFlow(ctx sdk.Context, direction, channelID, denom string, amount math.Int) (bool, error) {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return false, nil
+	}
+	if err := rateLimit.AddFlow(direction, amount); err != nil {
+		if emitErr := ctx.EventManager().EmitTypedEvent(&types.EventRateLimitExceeded{
+			ChannelId: channelID,
+			Denom:     denom,
+			Direction: direction,
+			Amount:    amount.String(),
+			Threshold: rateLimit.Threshold(direction).String(),
+		}); emitErr != nil {
+			k.Logger().Error("failed to emit the rate limit event", "err", emitErr.Error())
+		}
+		return true, err
+	}
+	k.SetRateLimit(ctx, rateLimit)
+	return true, nil
+}
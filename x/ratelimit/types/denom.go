@@ -0,0 +1,24 @@
+package types
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// SentDenom returns the denomination on this chain of the tokens of an
+// outbound transfer, whose packet holds the full trace of the denomination.
+func SentDenom(data transfertypes.FungibleTokenPacketData) string {
+	return transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+}
+
+// ReceivedDenom returns the denomination on this chain of the tokens of an
+// inbound transfer: the unwound denomination if the tokens return to this
+// chain, or the voucher denomination otherwise.
+func ReceivedDenom(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		prefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(data.Denom[len(prefix):]).IBCDenom()
+	}
+	prefixed := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+	return transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+}
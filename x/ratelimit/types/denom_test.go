@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"testing"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sunrise-zone/sunrise-app/x/ratelimit/types"
+)
+
+func TestSentDenom(t *testing.T) {
+	assert.Equal(t, "usr", types.SentDenom(transfertypes.FungibleTokenPacketData{Denom: "usr"}))
+	assert.Equal(
+		t,
+		transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom(),
+		types.SentDenom(transfertypes.FungibleTokenPacketData{Denom: "transfer/channel-0/uatom"}),
+	)
+}
+
+func TestReceivedDenom(t *testing.T) {
+	packet := channeltypes.NewPacket(nil, 1, "transfer", "channel-9", "transfer", "channel-0", clienttypes.Height{}, 0)
+	for denom, expected := range map[string]string{
+		// the native token returning.
+		"transfer/channel-9/usr": "usr",
+		// a voucher returning.
+		"transfer/channel-9/transfer/channel-3/uosmo": transfertypes.ParseDenomTrace("transfer/channel-3/uosmo").IBCDenom(),
+		// the counterparty native token.
+		"uatom": transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom(),
+	} {
+		data := transfertypes.FungibleTokenPacketData{Denom: denom}
+		assert.Equal(t, expected, types.ReceivedDenom(packet, data), denom)
+	}
+}
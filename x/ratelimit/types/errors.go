@@ -0,0 +1,20 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/ratelimit module sentinel errors
+var (
+	ErrInvalidSigner     = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidChannelID  = sdkerrors.Register(ModuleName, 2, "invalid channel identifier")
+	ErrInvalidDenom      = sdkerrors.Register(ModuleName, 3, "invalid denomination")
+	ErrInvalidQuota      = sdkerrors.Register(ModuleName, 4, "invalid quota")
+	ErrDuplicate         = sdkerrors.Register(ModuleName, 5, "duplicate")
+	ErrRateLimitNotFound = sdkerrors.Register(ModuleName, 6, "rate limit not found")
+	ErrZeroChannelValue  = sdkerrors.Register(ModuleName, 7, "zero channel value")
+	ErrQuotaExceeded     = sdkerrors.Register(ModuleName, 8, "quota exceeded")
+	ErrInvalidAmount     = sdkerrors.Register(ModuleName, 9, "invalid amount")
+)
@@ -0,0 +1,8 @@
+package types
+
+const (
+	// DirectionSend is the direction of the outbound transfers.
+	DirectionSend = "send"
+	// DirectionRecv is the direction of the inbound transfers.
+	DirectionRecv = "recv"
+)
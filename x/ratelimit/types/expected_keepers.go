@@ -0,0 +1,12 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the expected interface for the Bank module.
+type BankKeeper interface {
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+}
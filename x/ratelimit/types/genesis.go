@@ -0,0 +1,42 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// DefaultGenesis returns the default genesis state, without any rate limit.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		RateLimits:         []RateLimit{},
+		PendingSendPackets: []PendingSendPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	paths := make(map[string]struct{}, len(gs.RateLimits))
+	for _, rateLimit := range gs.RateLimits {
+		key := string(GetRateLimitKey(rateLimit.ChannelId, rateLimit.Denom))
+		if _, ok := paths[key]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "rate limit for %s over %s", rateLimit.Denom, rateLimit.ChannelId)
+		}
+		paths[key] = struct{}{}
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+	}
+	packets := make(map[string]struct{}, len(gs.PendingSendPackets))
+	for _, packet := range gs.PendingSendPackets {
+		if err := host.ChannelIdentifierValidator(packet.ChannelId); err != nil {
+			return errorsmod.Wrap(ErrInvalidChannelID, err.Error())
+		}
+		key := string(GetPendingSendPacketKey(packet.ChannelId, packet.Sequence))
+		if _, ok := packets[key]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "pending send packet %d over %s", packet.Sequence, packet.ChannelId)
+		}
+		packets[key] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "ratelimit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+const (
+	// RateLimitKeyPrefix indexes the RateLimit of each denomination by channel
+	// identifier and denomination.
+	RateLimitKeyPrefix = "RateLimit/"
+
+	// PendingSendPacketKeyPrefix indexes the PendingSendPacket by channel
+	// identifier and sequence.
+	PendingSendPacketKeyPrefix = "PendingSendPacket/"
+)
+
+// GetRateLimitKey returns the key of the rate limit of the denomination over
+// the channel. Channel identifiers can't contain a slash, so the key is
+// unambiguous even if the denomination does.
+func GetRateLimitKey(channelID, denom string) []byte {
+	return []byte(RateLimitKeyPrefix + channelID + "/" + denom)
+}
+
+// GetPendingSendPacketKey returns the key of the pending send packet with the
+// provided channel and sequence.
+func GetPendingSendPacketKey(channelID string, sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(PendingSendPacketKeyPrefix+channelID+"/"), sequence)
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgSetRateLimit{}
+	_ sdk.Msg = &MsgRemoveRateLimit{}
+	_ sdk.Msg = &MsgResetRateLimit{}
+)
+
+// NewMsgSetRateLimit creates a new MsgSetRateLimit instance.
+func NewMsgSetRateLimit(authority, channelID, denom string, quota Quota) *MsgSetRateLimit {
+	return &MsgSetRateLimit{
+		Authority: authority,
+		ChannelId: channelID,
+		Denom:     denom,
+		Quota:     quota,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if err := ValidatePath(m.ChannelId, m.Denom); err != nil {
+		return err
+	}
+	return m.Quota.Validate()
+}
+
+// NewMsgRemoveRateLimit creates a new MsgRemoveRateLimit instance.
+func NewMsgRemoveRateLimit(authority, channelID, denom string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Authority: authority,
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRemoveRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return ValidatePath(m.ChannelId, m.Denom)
+}
+
+// NewMsgResetRateLimit creates a new MsgResetRateLimit instance.
+func NewMsgResetRateLimit(authority, channelID, denom string) *MsgResetRateLimit {
+	return &MsgResetRateLimit{
+		Authority: authority,
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgResetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return ValidatePath(m.ChannelId, m.Denom)
+}
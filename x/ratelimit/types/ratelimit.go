@@ -0,0 +1,109 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// ValidatePath checks the channel identifier and the denomination of a rate
+// limit.
+func ValidatePath(channelID, denom string) error {
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return errorsmod.Wrap(ErrInvalidChannelID, err.Error())
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+	return nil
+}
+
+// Validate checks that the percentages are at most 100, and that the window
+// isn't empty.
+func (q Quota) Validate() error {
+	if q.MaxPercentSend > 100 || q.MaxPercentRecv > 100 {
+		return errorsmod.Wrapf(
+			ErrInvalidQuota,
+			"percentages must be at most 100, got %d and %d",
+			q.MaxPercentSend,
+			q.MaxPercentRecv,
+		)
+	}
+	if q.Duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidQuota, "non-positive duration %s", q.Duration)
+	}
+	return nil
+}
+
+// NewFlow returns an empty flow for the provided channel value.
+func NewFlow(channelValue math.Int) Flow {
+	return Flow{
+		Inflow:       math.ZeroInt(),
+		Outflow:      math.ZeroInt(),
+		ChannelValue: channelValue,
+	}
+}
+
+// Threshold returns the maximum flow allowed in the direction during a
+// window.
+func (r RateLimit) Threshold(direction string) math.Int {
+	percent := r.Quota.MaxPercentRecv
+	if direction == DirectionSend {
+		percent = r.Quota.MaxPercentSend
+	}
+	return r.Flow.ChannelValue.MulRaw(int64(percent)).QuoRaw(100)
+}
+
+// IsExpired returns true if the window of the rate limit rolled over at the
+// provided time.
+func (r RateLimit) IsExpired(now time.Time) bool {
+	return !now.Before(r.WindowStart.Add(r.Quota.Duration))
+}
+
+// AddFlow adds the amount to the flow in the direction, failing if that
+// exceeds the threshold.
+func (r *RateLimit) AddFlow(direction string, amount math.Int) error {
+	threshold := r.Threshold(direction)
+	flow := &r.Flow.Inflow
+	if direction == DirectionSend {
+		flow = &r.Flow.Outflow
+	}
+	updated := flow.Add(amount)
+	if updated.GT(threshold) {
+		return errorsmod.Wrapf(
+			ErrQuotaExceeded,
+			"%s %s%s over %s would reach %s, above the threshold %s",
+			direction,
+			amount,
+			r.Denom,
+			r.ChannelId,
+			updated,
+			threshold,
+		)
+	}
+	*flow = updated
+	return nil
+}
+
+// UndoOutflow subtracts the amount of a refunded transfer from the outflow.
+func (r *RateLimit) UndoOutflow(amount math.Int) {
+	r.Flow.Outflow = math.MaxInt(r.Flow.Outflow.Sub(amount), math.ZeroInt())
+}
+
+// Validate performs a basic validation of the rate limit.
+func (r RateLimit) Validate() error {
+	if err := ValidatePath(r.ChannelId, r.Denom); err != nil {
+		return err
+	}
+	if err := r.Quota.Validate(); err != nil {
+		return err
+	}
+	if r.Flow.Inflow.IsNil() || r.Flow.Outflow.IsNil() || r.Flow.ChannelValue.IsNil() ||
+		r.Flow.Inflow.IsNegative() || r.Flow.Outflow.IsNegative() || r.Flow.ChannelValue.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "invalid flow for %s over %s", r.Denom, r.ChannelId)
+	}
+	return nil
+}
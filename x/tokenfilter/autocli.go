@@ -0,0 +1,53 @@
+package tokenfilter
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	tokenfilterv1 "github.com/sunrise-zone/sunrise-app/api/sunrise/tokenfilter/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: tokenfilterv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "AllowedDenoms",
+					Use:            "allowed-denoms [channel-id]",
+					Short:          "Shows the non-native base denominations allowed to be received over a channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}},
+				},
+				{
+					RpcMethod: "Allowlists",
+					Use:       "allowlists",
+					Short:     "Shows the allowlists of all the channels",
+				},
+				{
+					RpcMethod:      "DerivativePolicy",
+					Use:            "derivative-policy [channel-id]",
+					Short:          "Shows the policy applied to the liquid staking derivatives sent over a channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}},
+				},
+				{
+					RpcMethod: "DerivativePolicies",
+					Use:       "derivative-policies",
+					Short:     "Shows the derivative policies of all the channels",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: tokenfilterv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "SetAllowedDenoms",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SetDerivativePolicy",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package tokenfilter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	for _, allowlist := range genState.Allowlists {
+		k.SetAllowedDenoms(ctx, allowlist.ChannelId, allowlist.Denoms)
+	}
+	for _, policy := range genState.DerivativePolicies {
+		k.SetDerivativePolicy(ctx, policy)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Allowlists:         k.GetAllAllowlists(ctx),
+		DerivativePolicies: k.GetAllDerivativePolicies(ctx),
+	}
+}
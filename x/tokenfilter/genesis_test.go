@@ -0,0 +1,71 @@
+package tokenfilter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunrise-zone/sunrise-app/testutil/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter"
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/types"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Allowlists: []types.ChannelAllowlist{
+			{ChannelId: "channel-0", Denoms: []string{"uatom", "uosmo"}},
+			{ChannelId: "channel-3", Denoms: []string{"ujuno"}},
+		},
+		DerivativePolicies: []types.DerivativePolicy{
+			{ChannelId: "channel-0", Mode: types.DERIVATIVE_SEND_MODE_ALLOWED, AttachMetadata: true},
+			{ChannelId: "channel-1"},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.TokenFilterKeeper(t, nil, nil)
+	tokenfilter.InitGenesis(ctx, k, genesisState)
+	got := tokenfilter.ExportGenesis(ctx, k)
+	assert.Equal(t, genesisState, *got)
+}
+
+func TestGenesisValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		allowlists []types.ChannelAllowlist
+		err        error
+	}{
+		{"default", nil, nil},
+		{"duplicate channel", []types.ChannelAllowlist{
+			{ChannelId: "channel-0", Denoms: []string{"uatom"}},
+			{ChannelId: "channel-0", Denoms: []string{"uosmo"}},
+		}, types.ErrDuplicate},
+		{"duplicate denom", []types.ChannelAllowlist{
+			{ChannelId: "channel-0", Denoms: []string{"uatom", "uatom"}},
+		}, types.ErrDuplicate},
+		{"empty allowlist", []types.ChannelAllowlist{{ChannelId: "channel-0"}}, types.ErrInvalidDenom},
+		{"invalid channel", []types.ChannelAllowlist{
+			{ChannelId: "", Denoms: []string{"uatom"}},
+		}, types.ErrInvalidChannelID},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.GenesisState{Allowlists: tc.allowlists}.Validate()
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidateDerivativePolicies(t *testing.T) {
+	genesisState := types.GenesisState{DerivativePolicies: []types.DerivativePolicy{
+		{ChannelId: "channel-0", Mode: types.DERIVATIVE_SEND_MODE_ALLOWED},
+		{ChannelId: "channel-0"},
+	}}
+	require.ErrorIs(t, genesisState.Validate(), types.ErrDuplicate)
+	genesisState.DerivativePolicies[1].ChannelId = "channel-1"
+	require.NoError(t, genesisState.Validate())
+}
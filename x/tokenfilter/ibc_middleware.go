@@ -3,30 +3,34 @@ package tokenfilter
 import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
-)
 
-const ModuleName = "tokenfilter"
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/types"
+)
 
 // tokenFilterMiddleware directly inherits the IBCModule and ICS4Wrapper interfaces.
 // Only with OnRecvPacket, does it wrap the underlying implementation with additional
-// stateless logic for rejecting the inbound transfer of non-native tokens. This
-// middleware is unilateral and no handshake is required. If using this middleware
-// on an existing chain, tokens that have been routed through this chain will still
-// be allowed to unwrap.
+// logic for rejecting the inbound transfer of non-native tokens, unless their base
+// denomination is allowlisted on the receiving channel. This middleware is
+// unilateral and no handshake is required. If using this middleware on an existing
+// chain, tokens that have been routed through this chain will still be allowed to
+// unwrap.
 type tokenFilterMiddleware struct {
 	porttypes.IBCModule
+
+	keeper keeper.Keeper
 }
 
 // NewIBCMiddleware creates a new instance of the token filter middleware for
 // the transfer module.
-func NewIBCMiddleware(ibcModule porttypes.IBCModule) porttypes.IBCModule {
+func NewIBCMiddleware(ibcModule porttypes.IBCModule, k keeper.Keeper) porttypes.IBCModule {
 	return &tokenFilterMiddleware{
 		IBCModule: ibcModule,
+		keeper:    k,
 	}
 }
 
@@ -34,7 +38,8 @@ func NewIBCMiddleware(ibcModule porttypes.IBCModule) porttypes.IBCModule {
 // from another chain is received on this chain. Here, the token filter middleware
 // unmarshals the FungibleTokenPacketData and checks to see if the denomination being
 // transferred to this chain originally came from this chain i.e. is a native token.
-// If not, it returns an ErrorAcknowledgement.
+// If not, the packet is only accepted if the base denomination is allowlisted on the
+// receiving channel, else it returns an ErrorAcknowledgement.
 func (m *tokenFilterMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -58,12 +63,19 @@ func (m *tokenFilterMiddleware) OnRecvPacket(
 		return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
 	}
 
-	ackErr := errors.Wrapf(sdkerrors.ErrInvalidType, "only native denom transfers accepted, got %s", data.Denom)
+	// Non-native tokens are accepted if governance allowed their base
+	// denomination on the channel they are received over.
+	baseDenom := transfertypes.ParseDenomTrace(data.Denom).BaseDenom
+	if m.keeper.IsDenomAllowed(ctx, packet.GetDestChannel(), baseDenom) {
+		return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	ackErr := errors.Wrapf(types.ErrDenomNotAllowed, "only native or allowlisted denom transfers accepted, got %s", data.Denom)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			transfertypes.EventTypePacket,
-			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
 			sdk.NewAttribute(transfertypes.AttributeKeyReceiver, data.Receiver),
 			sdk.NewAttribute(transfertypes.AttributeKeyDenom, data.Denom),
@@ -73,6 +85,15 @@ func (m *tokenFilterMiddleware) OnRecvPacket(
 			sdk.NewAttribute(transfertypes.AttributeKeyAckError, ackErr.Error()),
 		),
 	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPacketRejected{
+		ChannelId: packet.GetDestChannel(),
+		Sender:    data.Sender,
+		Receiver:  data.Receiver,
+		Denom:     data.Denom,
+		Amount:    data.Amount,
+	}); err != nil {
+		m.keeper.Logger().Error("failed to emit the packet rejection event", "err", err.Error())
+	}
 
 	return channeltypes.NewErrorAcknowledgement(ackErr)
 }
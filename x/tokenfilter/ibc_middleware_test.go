@@ -11,7 +11,9 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/stretchr/testify/require"
 
+	keepertest "github.com/sunrise-zone/sunrise-app/testutil/keeper"
 	"github.com/sunrise-zone/sunrise-app/x/tokenfilter"
 )
 
@@ -20,6 +22,15 @@ func TestOnRecvPacket(t *testing.T) {
 	packet := channeltypes.NewPacket(data.GetBytes(), 1, "portid", "channelid", "counterpartyportid", "counterpartychannelid", clienttypes.Height{}, 0)
 	packetFromOtherChain := channeltypes.NewPacket(data.GetBytes(), 1, "counterpartyportid", "counterpartychannelid", "portid", "channelid", clienttypes.Height{}, 0)
 	randomPacket := channeltypes.NewPacket([]byte{1, 2, 3, 4}, 1, "portid", "channelid", "counterpartyportid", "counterpartychannelid", clienttypes.Height{}, 0)
+	// the counterparty native denom, and a denom that went through another
+	// chain before the counterparty, both with the allowlisted base denom.
+	allowedData := transfertypes.NewFungibleTokenPacketData("uatom", math.NewInt(100).String(), "alice", "bob", "gm")
+	allowedPacket := channeltypes.NewPacket(allowedData.GetBytes(), 1, "counterpartyportid", "counterpartychannelid", "portid", "channel-0", clienttypes.Height{}, 0)
+	tracedData := transfertypes.NewFungibleTokenPacketData("transfer/channel-7/uatom", math.NewInt(100).String(), "alice", "bob", "gm")
+	tracedPacket := channeltypes.NewPacket(tracedData.GetBytes(), 1, "counterpartyportid", "counterpartychannelid", "portid", "channel-0", clienttypes.Height{}, 0)
+	otherChannelPacket := channeltypes.NewPacket(allowedData.GetBytes(), 1, "counterpartyportid", "counterpartychannelid", "portid", "channel-1", clienttypes.Height{}, 0)
+	notAllowedData := transfertypes.NewFungibleTokenPacketData("uosmo", math.NewInt(100).String(), "alice", "bob", "gm")
+	notAllowedPacket := channeltypes.NewPacket(notAllowedData.GetBytes(), 1, "counterpartyportid", "counterpartychannelid", "portid", "channel-0", clienttypes.Height{}, 0)
 
 	testCases := []struct {
 		name   string
@@ -41,14 +52,35 @@ func TestOnRecvPacket(t *testing.T) {
 			packet: randomPacket,
 			err:    false,
 		},
+		{
+			name:   "packet with allowlisted token",
+			packet: allowedPacket,
+			err:    false,
+		},
+		{
+			name:   "packet with allowlisted base denom through another chain",
+			packet: tracedPacket,
+			err:    false,
+		},
+		{
+			name:   "packet with token allowlisted on another channel",
+			packet: otherChannelPacket,
+			err:    true,
+		},
+		{
+			name:   "packet with token not allowlisted",
+			packet: notAllowedPacket,
+			err:    true,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := keepertest.TokenFilterKeeper(t, nil, nil)
+			k.SetAllowedDenoms(ctx, "channel-0", []string{"uatom"})
 			module := &MockIBCModule{t: t, called: false}
-			middleware := tokenfilter.NewIBCMiddleware(module)
+			middleware := tokenfilter.NewIBCMiddleware(module, k)
 
-			ctx := sdk.Context{}
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			ack := middleware.OnRecvPacket(
 				ctx,
@@ -62,11 +94,24 @@ func TestOnRecvPacket(t *testing.T) {
 				if ack.Success() {
 					t.Fatal("expected error acknowledgement but got success")
 				}
+				require.True(t, hasEvent(ctx.EventManager().Events(), "sunrise.tokenfilter.v1.EventPacketRejected"))
+			} else {
+				require.True(t, module.MethodCalled())
+				require.True(t, ack.Success())
 			}
 		})
 	}
 }
 
+func hasEvent(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
 type MockIBCModule struct {
 	t      *testing.T
 	called bool
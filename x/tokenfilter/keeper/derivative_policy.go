@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/types"
+)
+
+// SetDerivativePolicy replaces the derivative policy of its channel.
+func (k Keeper) SetDerivativePolicy(ctx sdk.Context, policy types.DerivativePolicy) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.GetDerivativePolicyKey(policy.ChannelId), k.cdc.MustMarshal(&policy))
+}
+
+// GetDerivativePolicy returns the derivative policy of the channel. The
+// channels without policy block all the derivatives.
+func (k Keeper) GetDerivativePolicy(ctx sdk.Context, channelID string) types.DerivativePolicy {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.GetDerivativePolicyKey(channelID))
+	if bz == nil {
+		return types.DerivativePolicy{
+			ChannelId: channelID,
+			Mode:      types.DERIVATIVE_SEND_MODE_BLOCKED,
+		}
+	}
+	var policy types.DerivativePolicy
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy
+}
+
+// GetAllDerivativePolicies returns the derivative policies of all the
+// channels, ordered by channel identifier.
+func (k Keeper) GetAllDerivativePolicies(ctx sdk.Context) []types.DerivativePolicy {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.DerivativePolicyKeyPrefix))
+	defer iterator.Close()
+
+	policies := []types.DerivativePolicy{}
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.DerivativePolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+		policies = append(policies, policy)
+	}
+	return policies
+}
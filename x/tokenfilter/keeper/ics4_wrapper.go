@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	liquidstakingtypes "github.com/sunrise-zone/sunrise-app/x/liquidstaking/types"
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/types"
+)
+
+// SendPacket implements the ICS4Wrapper interface. The liquid staking
+// derivatives minted on this chain may only be sent over the channels whose
+// derivative policy allows them, and the metadata of the derivative is added
+// to the memo for the channels attaching it.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil ||
+		!types.IsDerivativeDenom(packetData.Denom) {
+		// the derivatives sent back to this chain have a trace, so only the
+		// native ones are checked.
+		return k.ICS4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	policy := k.GetDerivativePolicy(ctx, sourceChannel)
+	if !policy.Allows(packetData.Denom) {
+		return 0, errorsmod.Wrapf(
+			types.ErrDenomNotAllowed,
+			"derivative %s can't be sent over %s (%s)",
+			packetData.Denom,
+			sourceChannel,
+			policy.Mode,
+		)
+	}
+	if policy.AttachMetadata {
+		metadata, err := k.GetDerivativeMetadata(ctx, packetData.Denom)
+		if err != nil {
+			return 0, err
+		}
+		if packetData.Memo, err = types.AttachDerivativeMetadata(packetData.Memo, metadata); err != nil {
+			return 0, err
+		}
+		data = packetData.GetBytes()
+	}
+	return k.ICS4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// GetDerivativeMetadata returns the current metadata of the derivative. The
+// derivatives are minted 1:1 for the delegation shares, so the exchange rate is
+// the amount of tokens per share of the validator.
+func (k Keeper) GetDerivativeMetadata(ctx sdk.Context, denom string) (types.DerivativeMetadata, error) {
+	valAddr, err := liquidstakingtypes.ParseLiquidStakingTokenDenom(denom)
+	if err != nil {
+		return types.DerivativeMetadata{}, errorsmod.Wrap(types.ErrInvalidDenom, err.Error())
+	}
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.DerivativeMetadata{}, errorsmod.Wrapf(err, "validator of %s", denom)
+	}
+	exchangeRate := math.LegacyZeroDec()
+	if !validator.GetDelegatorShares().IsZero() {
+		exchangeRate = validator.TokensFromShares(math.LegacyOneDec())
+	}
+	return types.DerivativeMetadata{
+		Denom:        denom,
+		Validator:    validator.GetOperator(),
+		ExchangeRate: exchangeRate,
+		Height:       ctx.BlockHeight(),
+	}, nil
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunrise-zone/sunrise-app/testutil/keeper"
+	liquidstakingtypes "github.com/sunrise-zone/sunrise-app/x/liquidstaking/types"
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/types"
+)
+
+type mockStakingKeeper map[string]stakingtypes.Validator
+
+func (s mockStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	validator, ok := s[addr.String()]
+	if !ok {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return validator, nil
+}
+
+// mockICS4Wrapper records the data of the sent packets.
+type mockICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+
+	sent [][]byte
+}
+
+func (w *mockICS4Wrapper) SendPacket(
+	_ sdk.Context,
+	_ *capabilitytypes.Capability,
+	_ string,
+	_ string,
+	_ clienttypes.Height,
+	_ uint64,
+	data []byte,
+) (uint64, error) {
+	w.sent = append(w.sent, data)
+	return uint64(len(w.sent)), nil
+}
+
+func TestSendDerivative(t *testing.T) {
+	valAddr := sdk.ValAddress("validator")
+	derivative := liquidstakingtypes.GetLiquidStakingTokenDenom(liquidstakingtypes.DefaultDerivativeDenom, valAddr)
+	otherDerivative := liquidstakingtypes.GetLiquidStakingTokenDenom(liquidstakingtypes.DefaultDerivativeDenom, sdk.ValAddress("other"))
+	// the validator was slashed, its tokens are worth half its shares.
+	stakingKeeper := mockStakingKeeper{valAddr.String(): {
+		OperatorAddress: valAddr.String(),
+		Tokens:          math.NewInt(50),
+		DelegatorShares: math.LegacyNewDec(100),
+	}}
+	wrapper := &mockICS4Wrapper{}
+	k, ctx := keepertest.TokenFilterKeeper(t, stakingKeeper, wrapper)
+	ctx = ctx.WithBlockHeight(7)
+
+	send := func(channelID, denom, memo string) (transfertypes.FungibleTokenPacketData, error) {
+		data := transfertypes.NewFungibleTokenPacketData(denom, "10", "alice", "bob", memo)
+		if _, err := k.SendPacket(ctx, nil, transfertypes.PortID, channelID, clienttypes.Height{}, 0, data.GetBytes()); err != nil {
+			return transfertypes.FungibleTokenPacketData{}, err
+		}
+		var sent transfertypes.FungibleTokenPacketData
+		require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(wrapper.sent[len(wrapper.sent)-1], &sent))
+		return sent, nil
+	}
+
+	// the channels without policy block the derivatives only.
+	_, err := send("channel-0", derivative, "")
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
+	_, err = send("channel-0", "usr", "")
+	require.NoError(t, err)
+	// derivatives returning to a counterparty aren't native.
+	_, err = send("channel-0", "transfer/channel-0/"+derivative, "")
+	require.NoError(t, err)
+
+	k.SetDerivativePolicy(ctx, types.DerivativePolicy{
+		ChannelId:     "channel-1",
+		Mode:          types.DERIVATIVE_SEND_MODE_ALLOWLIST,
+		AllowedDenoms: []string{derivative},
+	})
+	sent, err := send("channel-1", derivative, "gm")
+	require.NoError(t, err)
+	assert.Equal(t, "gm", sent.Memo)
+	_, err = send("channel-1", otherDerivative, "")
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
+
+	k.SetDerivativePolicy(ctx, types.DerivativePolicy{
+		ChannelId:      "channel-2",
+		Mode:           types.DERIVATIVE_SEND_MODE_ALLOWED,
+		AttachMetadata: true,
+	})
+	sent, err = send("channel-2", derivative, `{"note":"gm"}`)
+	require.NoError(t, err)
+	var memo struct {
+		Derivative types.DerivativeMetadata `json:"derivative"`
+		Note       string                   `json:"note"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(sent.Memo), &memo))
+	assert.Equal(t, "gm", memo.Note)
+	assert.Equal(t, types.DerivativeMetadata{
+		Denom:        derivative,
+		Validator:    valAddr.String(),
+		ExchangeRate: math.LegacyNewDecWithPrec(5, 1),
+		Height:       7,
+	}, memo.Derivative)
+	assert.Equal(t, derivative, sent.Denom)
+	assert.Equal(t, "10", sent.Amount)
+
+	// the metadata can't be attached to a text memo, nor for an unknown
+	// validator.
+	_, err = send("channel-2", derivative, "gm")
+	require.ErrorIs(t, err, types.ErrInvalidMemo)
+	_, err = send("channel-2", otherDerivative, "")
+	require.ErrorIs(t, err, stakingtypes.ErrNoValidatorFound)
+}
+
+func TestMsgSetDerivativePolicy(t *testing.T) {
+	k, ctx := keepertest.TokenFilterKeeper(t, nil, nil)
+	ms := keeper.NewMsgServerImpl(k)
+	policy := types.DerivativePolicy{ChannelId: "channel-0", Mode: types.DERIVATIVE_SEND_MODE_ALLOWED, AttachMetadata: true}
+
+	_, err := ms.SetDerivativePolicy(ctx, types.NewMsgSetDerivativePolicy("invalid", policy))
+	assert.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.SetDerivativePolicy(ctx, types.NewMsgSetDerivativePolicy(k.GetAuthority(), types.DerivativePolicy{ChannelId: "channel-0", Mode: 5}))
+	assert.ErrorIs(t, err, types.ErrInvalidPolicy)
+
+	resp, err := k.DerivativePolicy(ctx, &types.QueryDerivativePolicyRequest{ChannelId: "channel-0"})
+	require.NoError(t, err)
+	assert.Equal(t, types.DERIVATIVE_SEND_MODE_BLOCKED, resp.Policy.Mode)
+
+	_, err = ms.SetDerivativePolicy(ctx, types.NewMsgSetDerivativePolicy(k.GetAuthority(), policy))
+	require.NoError(t, err)
+	resp, err = k.DerivativePolicy(ctx, &types.QueryDerivativePolicyRequest{ChannelId: "channel-0"})
+	require.NoError(t, err)
+	assert.Equal(t, policy, resp.Policy)
+	all, err := k.DerivativePolicies(ctx, &types.QueryDerivativePoliciesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []types.DerivativePolicy{policy}, all.Policies)
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/types"
+)
+
+// Keeper stores the allowlists of the non-native denominations that may be
+// received per channel, and the policies applied to the liquid staking
+// derivatives sent per channel. It is also the ICS4Wrapper of the token filter
+// in the transfer stack, enforcing the derivative policies on the outgoing
+// packets before passing them to the wrapped ICS4Wrapper.
+type Keeper struct {
+	porttypes.ICS4Wrapper
+
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	logger       log.Logger
+
+	// the address capable of executing a MsgSetAllowedDenoms message.
+	// Typically, this should be the x/gov module account.
+	authority string
+
+	stakingKeeper types.StakingKeeper
+}
+
+// NewKeeper creates a new tokenfilter Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	logger log.Logger,
+	authority string,
+	stakingKeeper types.StakingKeeper,
+	wrapper porttypes.ICS4Wrapper,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
+	}
+
+	return Keeper{
+		ICS4Wrapper:   wrapper,
+		cdc:           cdc,
+		storeService:  storeService,
+		logger:        logger,
+		authority:     authority,
+		stakingKeeper: stakingKeeper,
+	}
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger() log.Logger {
+	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetAllowedDenoms replaces the allowlist of the channel. The denominations
+// are stored sorted, and an empty list removes the allowlist.
+func (k Keeper) SetAllowedDenoms(ctx sdk.Context, channelID string, denoms []string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if len(denoms) == 0 {
+		store.Delete(types.GetAllowlistKey(channelID))
+		return
+	}
+	sorted := make([]string, len(denoms))
+	copy(sorted, denoms)
+	sort.Strings(sorted)
+	store.Set(types.GetAllowlistKey(channelID), k.cdc.MustMarshal(&types.ChannelAllowlist{
+		ChannelId: channelID,
+		Denoms:    sorted,
+	}))
+}
+
+// GetAllowedDenoms returns the base denominations allowed to be received over
+// the channel.
+func (k Keeper) GetAllowedDenoms(ctx sdk.Context, channelID string) []string {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.GetAllowlistKey(channelID))
+	if bz == nil {
+		return nil
+	}
+	var allowlist types.ChannelAllowlist
+	k.cdc.MustUnmarshal(bz, &allowlist)
+	return allowlist.Denoms
+}
+
+// IsDenomAllowed returns true if the base denomination is allowlisted on the
+// channel.
+func (k Keeper) IsDenomAllowed(ctx sdk.Context, channelID, baseDenom string) bool {
+	denoms := k.GetAllowedDenoms(ctx, channelID)
+	i := sort.SearchStrings(denoms, baseDenom)
+	return i < len(denoms) && denoms[i] == baseDenom
+}
+
+// GetAllAllowlists returns the allowlists of all the channels, ordered by
+// channel identifier.
+func (k Keeper) GetAllAllowlists(ctx sdk.Context) []types.ChannelAllowlist {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.AllowlistKeyPrefix))
+	defer iterator.Close()
+
+	allowlists := []types.ChannelAllowlist{}
+	for ; iterator.Valid(); iterator.Next() {
+		var allowlist types.ChannelAllowlist
+		k.cdc.MustUnmarshal(iterator.Value(), &allowlist)
+		allowlists = append(allowlists, allowlist)
+	}
+	return allowlists
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/sunrise-zone/sunrise-app/testutil/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/keeper"
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/types"
+)
+
+func TestAllowedDenoms(t *testing.T) {
+	k, ctx := keepertest.TokenFilterKeeper(t, nil, nil)
+
+	k.SetAllowedDenoms(ctx, "channel-1", []string{"uosmo", "uatom"})
+	assert.Equal(t, []string{"uatom", "uosmo"}, k.GetAllowedDenoms(ctx, "channel-1"))
+	assert.True(t, k.IsDenomAllowed(ctx, "channel-1", "uatom"))
+	assert.False(t, k.IsDenomAllowed(ctx, "channel-1", "ujuno"))
+	assert.False(t, k.IsDenomAllowed(ctx, "channel-0", "uatom"))
+
+	k.SetAllowedDenoms(ctx, "channel-0", []string{"ujuno"})
+	assert.Equal(t, []types.ChannelAllowlist{
+		{ChannelId: "channel-0", Denoms: []string{"ujuno"}},
+		{ChannelId: "channel-1", Denoms: []string{"uatom", "uosmo"}},
+	}, k.GetAllAllowlists(ctx))
+
+	// an empty list removes the allowlist.
+	k.SetAllowedDenoms(ctx, "channel-1", nil)
+	assert.Empty(t, k.GetAllowedDenoms(ctx, "channel-1"))
+	assert.Len(t, k.GetAllAllowlists(ctx), 1)
+}
+
+func TestMsgSetAllowedDenoms(t *testing.T) {
+	k, ctx := keepertest.TokenFilterKeeper(t, nil, nil)
+	ms := keeper.NewMsgServerImpl(k)
+
+	_, err := ms.SetAllowedDenoms(ctx, types.NewMsgSetAllowedDenoms("invalid", "channel-0", []string{"uatom"}))
+	assert.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.SetAllowedDenoms(ctx, types.NewMsgSetAllowedDenoms(k.GetAuthority(), "invalid channel", []string{"uatom"}))
+	assert.ErrorIs(t, err, types.ErrInvalidChannelID)
+	_, err = ms.SetAllowedDenoms(ctx, types.NewMsgSetAllowedDenoms(k.GetAuthority(), "channel-0", []string{"uatom", "uatom"}))
+	assert.ErrorIs(t, err, types.ErrDuplicate)
+	_, err = ms.SetAllowedDenoms(ctx, types.NewMsgSetAllowedDenoms(k.GetAuthority(), "channel-0", []string{"1atom"}))
+	assert.ErrorIs(t, err, types.ErrInvalidDenom)
+	assert.Empty(t, k.GetAllAllowlists(ctx))
+
+	_, err = ms.SetAllowedDenoms(ctx, types.NewMsgSetAllowedDenoms(k.GetAuthority(), "channel-0", []string{"uatom"}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"uatom"}, k.GetAllowedDenoms(ctx, "channel-0"))
+	events := ctx.EventManager().Events()
+	require.NotEmpty(t, events)
+	assert.Equal(t, "sunrise.tokenfilter.v1.EventAllowedDenomsUpdated", events[len(events)-1].Type)
+
+	// the list replaces the previous one.
+	_, err = ms.SetAllowedDenoms(ctx, types.NewMsgSetAllowedDenoms(k.GetAuthority(), "channel-0", []string{"uosmo"}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"uosmo"}, k.GetAllowedDenoms(ctx, "channel-0"))
+}
+
+func TestQueryAllowlists(t *testing.T) {
+	k, ctx := keepertest.TokenFilterKeeper(t, nil, nil)
+	k.SetAllowedDenoms(ctx, "channel-0", []string{"uatom"})
+	k.SetAllowedDenoms(ctx, "channel-1", []string{"uosmo"})
+	k.SetAllowedDenoms(ctx, "channel-2", []string{"ujuno"})
+
+	resp, err := k.AllowedDenoms(ctx, &types.QueryAllowedDenomsRequest{ChannelId: "channel-1"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"uosmo"}, resp.Denoms)
+
+	page, err := k.Allowlists(ctx, &types.QueryAllowlistsRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, page.Allowlists, 2)
+	assert.Equal(t, "channel-0", page.Allowlists[0].ChannelId)
+	require.NotNil(t, page.Pagination.NextKey)
+
+	page, err = k.Allowlists(ctx, &types.QueryAllowlistsRequest{Pagination: &query.PageRequest{Key: page.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, page.Allowlists, 1)
+	assert.Equal(t, "channel-2", page.Allowlists[0].ChannelId)
+}
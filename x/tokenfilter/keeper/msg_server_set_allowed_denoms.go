@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/types"
+)
+
+func (k msgServer) SetAllowedDenoms(goCtx context.Context, req *types.MsgSetAllowedDenoms) (*types.MsgSetAllowedDenomsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if err := types.ValidateAllowlist(req.ChannelId, req.Denoms); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.Keeper.SetAllowedDenoms(ctx, req.ChannelId, req.Denoms)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAllowedDenomsUpdated{
+		ChannelId: req.ChannelId,
+		Denoms:    k.GetAllowedDenoms(ctx, req.ChannelId),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAllowedDenomsResponse{}, nil
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/types"
+)
+
+func (k msgServer) SetDerivativePolicy(goCtx context.Context, req *types.MsgSetDerivativePolicy) (*types.MsgSetDerivativePolicyResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if err := req.Policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SetDerivativePolicy(sdk.UnwrapSDKContext(goCtx), req.Policy)
+
+	return &types.MsgSetDerivativePolicyResponse{}, nil
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) AllowedDenoms(
+	ctx context.Context,
+	req *types.QueryAllowedDenomsRequest,
+) (*types.QueryAllowedDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	return &types.QueryAllowedDenomsResponse{
+		Denoms: k.GetAllowedDenoms(sdk.UnwrapSDKContext(ctx), req.ChannelId),
+	}, nil
+}
+
+func (k Keeper) Allowlists(
+	ctx context.Context,
+	req *types.QueryAllowlistsRequest,
+) (*types.QueryAllowlistsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	store := prefix.NewStore(
+		runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)),
+		[]byte(types.AllowlistKeyPrefix),
+	)
+	allowlists := []types.ChannelAllowlist{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var allowlist types.ChannelAllowlist
+		if err := k.cdc.Unmarshal(value, &allowlist); err != nil {
+			return err
+		}
+		allowlists = append(allowlists, allowlist)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAllowlistsResponse{
+		Allowlists: allowlists,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) DerivativePolicy(
+	ctx context.Context,
+	req *types.QueryDerivativePolicyRequest,
+) (*types.QueryDerivativePolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	return &types.QueryDerivativePolicyResponse{
+		Policy: k.GetDerivativePolicy(sdk.UnwrapSDKContext(ctx), req.ChannelId),
+	}, nil
+}
+
+func (k Keeper) DerivativePolicies(
+	ctx context.Context,
+	req *types.QueryDerivativePoliciesRequest,
+) (*types.QueryDerivativePoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	store := prefix.NewStore(
+		runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)),
+		[]byte(types.DerivativePolicyKeyPrefix),
+	)
+	policies := []types.DerivativePolicy{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var policy types.DerivativePolicy
+		if err := k.cdc.Unmarshal(value, &policy); err != nil {
+			return err
+		}
+		policies = append(policies, policy)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryDerivativePoliciesResponse{
+		Policies:   policies,
+		Pagination: pageRes,
+	}, nil
+}
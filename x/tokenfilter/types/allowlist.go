@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// ValidateAllowlist checks the channel identifier and the denominations of an
+// allowlist, which must not contain duplicates.
+func ValidateAllowlist(channelID string, denoms []string) error {
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return errorsmod.Wrap(ErrInvalidChannelID, err.Error())
+	}
+	seen := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrap(ErrInvalidDenom, err.Error())
+		}
+		if _, ok := seen[denom]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "denom %s on channel %s", denom, channelID)
+		}
+		seen[denom] = struct{}{}
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the allowlist.
+func (a ChannelAllowlist) Validate() error {
+	if len(a.Denoms) == 0 {
+		return errorsmod.Wrapf(ErrInvalidDenom, "empty allowlist for channel %s", a.ChannelId)
+	}
+	return ValidateAllowlist(a.ChannelId, a.Denoms)
+}
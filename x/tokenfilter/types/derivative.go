@@ -0,0 +1,99 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	liquidstakingtypes "github.com/sunrise-zone/sunrise-app/x/liquidstaking/types"
+)
+
+// DerivativeMemoKey is the key of the memo JSON object holding the
+// DerivativeMetadata of the derivatives sent over the channels attaching it.
+const DerivativeMemoKey = "derivative"
+
+// DerivativeMetadata describes a liquid staking derivative at send time, for
+// the counterparties to assess its slashing risk.
+type DerivativeMetadata struct {
+	Denom     string `json:"denom"`
+	Validator string `json:"validator"`
+	// ExchangeRate is the amount of staked tokens a derivative token is worth.
+	ExchangeRate math.LegacyDec `json:"exchange_rate"`
+	Height       int64          `json:"height"`
+}
+
+// IsDerivativeDenom returns true if the denomination is a liquid staking
+// derivative minted on this chain.
+func IsDerivativeDenom(denom string) bool {
+	_, err := liquidstakingtypes.ParseLiquidStakingTokenDenom(denom)
+	return err == nil
+}
+
+// Validate performs a basic validation of the derivative policy.
+func (p DerivativePolicy) Validate() error {
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return errorsmod.Wrap(ErrInvalidChannelID, err.Error())
+	}
+	if _, ok := DerivativeSendMode_name[int32(p.Mode)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidPolicy, "unknown mode %d", p.Mode)
+	}
+	if p.Mode != DERIVATIVE_SEND_MODE_ALLOWLIST && len(p.AllowedDenoms) > 0 {
+		return errorsmod.Wrapf(ErrInvalidPolicy, "allowed denoms are only used by the allowlist mode, got %s", p.Mode)
+	}
+	seen := make(map[string]struct{}, len(p.AllowedDenoms))
+	for _, denom := range p.AllowedDenoms {
+		if !IsDerivativeDenom(denom) {
+			return errorsmod.Wrapf(ErrInvalidDenom, "%s is not a derivative denom", denom)
+		}
+		if _, ok := seen[denom]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "denom %s on channel %s", denom, p.ChannelId)
+		}
+		seen[denom] = struct{}{}
+	}
+	return nil
+}
+
+// Allows returns true if the policy allows the derivative to be sent.
+func (p DerivativePolicy) Allows(denom string) bool {
+	switch p.Mode {
+	case DERIVATIVE_SEND_MODE_ALLOWED:
+		return true
+	case DERIVATIVE_SEND_MODE_ALLOWLIST:
+		for _, allowed := range p.AllowedDenoms {
+			if allowed == denom {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AttachDerivativeMetadata adds the metadata to the memo under the
+// DerivativeMemoKey. The memo must be empty or a JSON object, so that the
+// other keys it holds are kept.
+func AttachDerivativeMetadata(memo string, metadata DerivativeMetadata) (string, error) {
+	fields := make(map[string]json.RawMessage)
+	if strings.TrimSpace(memo) != "" {
+		if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+			return "", errorsmod.Wrap(ErrInvalidMemo, "the memo must be empty or a JSON object to attach the derivative metadata")
+		}
+	}
+	if _, ok := fields[DerivativeMemoKey]; ok {
+		return "", errorsmod.Wrapf(ErrInvalidMemo, "the memo already holds a %q key", DerivativeMemoKey)
+	}
+	bz, err := json.Marshal(metadata)
+	if err != nil {
+		return "", err
+	}
+	fields[DerivativeMemoKey] = bz
+	// the keys of a map are sorted when marshaled, so the memo is
+	// deterministic.
+	out, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
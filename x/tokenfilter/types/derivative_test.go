@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	liquidstakingtypes "github.com/sunrise-zone/sunrise-app/x/liquidstaking/types"
+	"github.com/sunrise-zone/sunrise-app/x/tokenfilter/types"
+)
+
+func derivativeDenom(name string) string {
+	return liquidstakingtypes.GetLiquidStakingTokenDenom(liquidstakingtypes.DefaultDerivativeDenom, sdk.ValAddress(name))
+}
+
+func TestDerivativePolicy(t *testing.T) {
+	allowed, other := derivativeDenom("allowed"), derivativeDenom("other")
+
+	blocked := types.DerivativePolicy{ChannelId: "channel-0"}
+	require.NoError(t, blocked.Validate())
+	assert.False(t, blocked.Allows(allowed))
+
+	allowlist := types.DerivativePolicy{
+		ChannelId:     "channel-0",
+		Mode:          types.DERIVATIVE_SEND_MODE_ALLOWLIST,
+		AllowedDenoms: []string{allowed},
+	}
+	require.NoError(t, allowlist.Validate())
+	assert.True(t, allowlist.Allows(allowed))
+	assert.False(t, allowlist.Allows(other))
+
+	all := types.DerivativePolicy{ChannelId: "channel-0", Mode: types.DERIVATIVE_SEND_MODE_ALLOWED}
+	require.NoError(t, all.Validate())
+	assert.True(t, all.Allows(other))
+
+	for name, policy := range map[string]types.DerivativePolicy{
+		"unknown mode":                   {ChannelId: "channel-0", Mode: 3},
+		"allowed denoms in another mode": {ChannelId: "channel-0", Mode: types.DERIVATIVE_SEND_MODE_ALLOWED, AllowedDenoms: []string{allowed}},
+		"not a derivative":               {ChannelId: "channel-0", Mode: types.DERIVATIVE_SEND_MODE_ALLOWLIST, AllowedDenoms: []string{"usr"}},
+		"duplicate denom":                {ChannelId: "channel-0", Mode: types.DERIVATIVE_SEND_MODE_ALLOWLIST, AllowedDenoms: []string{allowed, allowed}},
+		"invalid channel":                {ChannelId: "", Mode: types.DERIVATIVE_SEND_MODE_ALLOWED},
+	} {
+		assert.Error(t, policy.Validate(), name)
+	}
+}
+
+func TestAttachDerivativeMetadata(t *testing.T) {
+	metadata := types.DerivativeMetadata{
+		Denom:        "bsr-val",
+		Validator:    "val",
+		ExchangeRate: math.LegacyNewDecWithPrec(15, 1),
+		Height:       10,
+	}
+	expected := `{"denom":"bsr-val","validator":"val","exchange_rate":"1.500000000000000000","height":10}`
+
+	memo, err := types.AttachDerivativeMetadata("", metadata)
+	require.NoError(t, err)
+	assert.Equal(t, `{"derivative":`+expected+`}`, memo)
+
+	// the other keys of the memo are kept.
+	memo, err = types.AttachDerivativeMetadata(`{"wasm":{"contract":"addr"}}`, metadata)
+	require.NoError(t, err)
+	assert.Equal(t, `{"derivative":`+expected+`,"wasm":{"contract":"addr"}}`, memo)
+
+	_, err = types.AttachDerivativeMetadata("gm", metadata)
+	assert.ErrorIs(t, err, types.ErrInvalidMemo)
+	_, err = types.AttachDerivativeMetadata(`{"derivative":{}}`, metadata)
+	assert.ErrorIs(t, err, types.ErrInvalidMemo)
+}
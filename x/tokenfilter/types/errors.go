@@ -0,0 +1,18 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/tokenfilter module sentinel errors
+var (
+	ErrInvalidSigner    = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidChannelID = sdkerrors.Register(ModuleName, 2, "invalid channel identifier")
+	ErrInvalidDenom     = sdkerrors.Register(ModuleName, 3, "invalid denomination")
+	ErrDuplicate        = sdkerrors.Register(ModuleName, 4, "duplicate")
+	ErrDenomNotAllowed  = sdkerrors.Register(ModuleName, 5, "denomination not allowed")
+	ErrInvalidPolicy    = sdkerrors.Register(ModuleName, 6, "invalid derivative policy")
+	ErrInvalidMemo      = sdkerrors.Register(ModuleName, 7, "invalid memo")
+)
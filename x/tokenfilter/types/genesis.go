@@ -0,0 +1,40 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultGenesis returns the default genesis state, which allows no
+// non-native denomination.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Allowlists:         []ChannelAllowlist{},
+		DerivativePolicies: []DerivativePolicy{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	channels := make(map[string]struct{}, len(gs.Allowlists))
+	for _, allowlist := range gs.Allowlists {
+		if _, ok := channels[allowlist.ChannelId]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "allowlist for channel %s", allowlist.ChannelId)
+		}
+		channels[allowlist.ChannelId] = struct{}{}
+		if err := allowlist.Validate(); err != nil {
+			return err
+		}
+	}
+	policies := make(map[string]struct{}, len(gs.DerivativePolicies))
+	for _, policy := range gs.DerivativePolicies {
+		if _, ok := policies[policy.ChannelId]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "derivative policy for channel %s", policy.ChannelId)
+		}
+		policies[policy.ChannelId] = struct{}{}
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "tokenfilter"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+const (
+	// AllowlistKeyPrefix indexes the ChannelAllowlist of each channel by
+	// channel identifier.
+	AllowlistKeyPrefix = "Allowlist/"
+
+	// DerivativePolicyKeyPrefix indexes the DerivativePolicy of each channel
+	// by channel identifier.
+	DerivativePolicyKeyPrefix = "DerivativePolicy/"
+)
+
+// GetAllowlistKey returns the key of the allowlist of the provided channel.
+func GetAllowlistKey(channelID string) []byte {
+	return []byte(AllowlistKeyPrefix + channelID)
+}
+
+// GetDerivativePolicyKey returns the key of the derivative policy of the
+// provided channel.
+func GetDerivativePolicyKey(channelID string) []byte {
+	return []byte(DerivativePolicyKeyPrefix + channelID)
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSetAllowedDenoms{}
+
+// NewMsgSetAllowedDenoms creates a new MsgSetAllowedDenoms instance.
+func NewMsgSetAllowedDenoms(authority, channelID string, denoms []string) *MsgSetAllowedDenoms {
+	return &MsgSetAllowedDenoms{
+		Authority: authority,
+		ChannelId: channelID,
+		Denoms:    denoms,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetAllowedDenoms) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return ValidateAllowlist(m.ChannelId, m.Denoms)
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSetDerivativePolicy{}
+
+// NewMsgSetDerivativePolicy creates a new MsgSetDerivativePolicy instance.
+func NewMsgSetDerivativePolicy(authority string, policy DerivativePolicy) *MsgSetDerivativePolicy {
+	return &MsgSetDerivativePolicy{
+		Authority: authority,
+		Policy:    policy,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetDerivativePolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return m.Policy.Validate()
+}
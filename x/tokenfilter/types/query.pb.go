@@ -0,0 +1,1928 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sunrise/tokenfilter/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryAllowedDenomsRequest is the request type for the Query/AllowedDenoms
+// RPC method.
+type QueryAllowedDenomsRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryAllowedDenomsRequest) Reset()         { *m = QueryAllowedDenomsRequest{} }
+func (m *QueryAllowedDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedDenomsRequest) ProtoMessage()    {}
+func (*QueryAllowedDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aa006a510ea53d6, []int{0}
+}
+func (m *QueryAllowedDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedDenomsRequest.Merge(m, src)
+}
+func (m *QueryAllowedDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedDenomsRequest proto.InternalMessageInfo
+
+func (m *QueryAllowedDenomsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryAllowedDenomsResponse is the response type for the Query/AllowedDenoms
+// RPC method.
+type QueryAllowedDenomsResponse struct {
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *QueryAllowedDenomsResponse) Reset()         { *m = QueryAllowedDenomsResponse{} }
+func (m *QueryAllowedDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedDenomsResponse) ProtoMessage()    {}
+func (*QueryAllowedDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aa006a510ea53d6, []int{1}
+}
+func (m *QueryAllowedDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedDenomsResponse.Merge(m, src)
+}
+func (m *QueryAllowedDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedDenomsResponse) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+// QueryAllowlistsRequest is the request type for the Query/Allowlists RPC
+// method.
+type QueryAllowlistsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowlistsRequest) Reset()         { *m = QueryAllowlistsRequest{} }
+func (m *QueryAllowlistsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowlistsRequest) ProtoMessage()    {}
+func (*QueryAllowlistsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aa006a510ea53d6, []int{2}
+}
+func (m *QueryAllowlistsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowlistsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowlistsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowlistsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowlistsRequest.Merge(m, src)
+}
+func (m *QueryAllowlistsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowlistsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowlistsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowlistsRequest proto.InternalMessageInfo
+
+func (m *QueryAllowlistsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllowlistsResponse is the response type for the Query/Allowlists RPC
+// method.
+type QueryAllowlistsResponse struct {
+	Allowlists []ChannelAllowlist  `protobuf:"bytes,1,rep,name=allowlists,proto3" json:"allowlists"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowlistsResponse) Reset()         { *m = QueryAllowlistsResponse{} }
+func (m *QueryAllowlistsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowlistsResponse) ProtoMessage()    {}
+func (*QueryAllowlistsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aa006a510ea53d6, []int{3}
+}
+func (m *QueryAllowlistsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowlistsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowlistsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowlistsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowlistsResponse.Merge(m, src)
+}
+func (m *QueryAllowlistsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowlistsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowlistsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowlistsResponse proto.InternalMessageInfo
+
+func (m *QueryAllowlistsResponse) GetAllowlists() []ChannelAllowlist {
+	if m != nil {
+		return m.Allowlists
+	}
+	return nil
+}
+
+func (m *QueryAllowlistsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDerivativePolicyRequest is the request type for the
+// Query/DerivativePolicy RPC method.
+type QueryDerivativePolicyRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryDerivativePolicyRequest) Reset()         { *m = QueryDerivativePolicyRequest{} }
+func (m *QueryDerivativePolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivativePolicyRequest) ProtoMessage()    {}
+func (*QueryDerivativePolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aa006a510ea53d6, []int{4}
+}
+func (m *QueryDerivativePolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivativePolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivativePolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivativePolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivativePolicyRequest.Merge(m, src)
+}
+func (m *QueryDerivativePolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivativePolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivativePolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivativePolicyRequest proto.InternalMessageInfo
+
+func (m *QueryDerivativePolicyRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryDerivativePolicyResponse is the response type for the
+// Query/DerivativePolicy RPC method. The policy of a channel without policy
+// blocks all the derivatives.
+type QueryDerivativePolicyResponse struct {
+	Policy DerivativePolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *QueryDerivativePolicyResponse) Reset()         { *m = QueryDerivativePolicyResponse{} }
+func (m *QueryDerivativePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivativePolicyResponse) ProtoMessage()    {}
+func (*QueryDerivativePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aa006a510ea53d6, []int{5}
+}
+func (m *QueryDerivativePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivativePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivativePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivativePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivativePolicyResponse.Merge(m, src)
+}
+func (m *QueryDerivativePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivativePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivativePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivativePolicyResponse proto.InternalMessageInfo
+
+func (m *QueryDerivativePolicyResponse) GetPolicy() DerivativePolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return DerivativePolicy{}
+}
+
+// QueryDerivativePoliciesRequest is the request type for the
+// Query/DerivativePolicies RPC method.
+type QueryDerivativePoliciesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDerivativePoliciesRequest) Reset()         { *m = QueryDerivativePoliciesRequest{} }
+func (m *QueryDerivativePoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivativePoliciesRequest) ProtoMessage()    {}
+func (*QueryDerivativePoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aa006a510ea53d6, []int{6}
+}
+func (m *QueryDerivativePoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivativePoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivativePoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivativePoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivativePoliciesRequest.Merge(m, src)
+}
+func (m *QueryDerivativePoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivativePoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivativePoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivativePoliciesRequest proto.InternalMessageInfo
+
+func (m *QueryDerivativePoliciesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDerivativePoliciesResponse is the response type for the
+// Query/DerivativePolicies RPC method.
+type QueryDerivativePoliciesResponse struct {
+	Policies   []DerivativePolicy  `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDerivativePoliciesResponse) Reset()         { *m = QueryDerivativePoliciesResponse{} }
+func (m *QueryDerivativePoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivativePoliciesResponse) ProtoMessage()    {}
+func (*QueryDerivativePoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aa006a510ea53d6, []int{7}
+}
+func (m *QueryDerivativePoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivativePoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivativePoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivativePoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivativePoliciesResponse.Merge(m, src)
+}
+func (m *QueryDerivativePoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivativePoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivativePoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivativePoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryDerivativePoliciesResponse) GetPolicies() []DerivativePolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *QueryDerivativePoliciesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryAllowedDenomsRequest)(nil), "sunrise.tokenfilter.v1.QueryAllowedDenomsRequest")
+	proto.RegisterType((*QueryAllowedDenomsResponse)(nil), "sunrise.tokenfilter.v1.QueryAllowedDenomsResponse")
+	proto.RegisterType((*QueryAllowlistsRequest)(nil), "sunrise.tokenfilter.v1.QueryAllowlistsRequest")
+	proto.RegisterType((*QueryAllowlistsResponse)(nil), "sunrise.tokenfilter.v1.QueryAllowlistsResponse")
+	proto.RegisterType((*QueryDerivativePolicyRequest)(nil), "sunrise.tokenfilter.v1.QueryDerivativePolicyRequest")
+	proto.RegisterType((*QueryDerivativePolicyResponse)(nil), "sunrise.tokenfilter.v1.QueryDerivativePolicyResponse")
+	proto.RegisterType((*QueryDerivativePoliciesRequest)(nil), "sunrise.tokenfilter.v1.QueryDerivativePoliciesRequest")
+	proto.RegisterType((*QueryDerivativePoliciesResponse)(nil), "sunrise.tokenfilter.v1.QueryDerivativePoliciesResponse")
+}
+
+func init() {
+	proto.RegisterFile("sunrise/tokenfilter/v1/query.proto", fileDescriptor_7aa006a510ea53d6)
+}
+
+var fileDescriptor_7aa006a510ea53d6 = []byte{
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x6b, 0x13, 0x4f,
+	0x14, 0xc7, 0x33, 0xfd, 0xd1, 0xf2, 0xcb, 0x2b, 0x82, 0x0e, 0x52, 0xeb, 0xd2, 0x6e, 0xcb, 0x22,
+	0x1a, 0x2a, 0xdd, 0x21, 0x69, 0x6b, 0xa5, 0x22, 0x68, 0x2d, 0x8a, 0x78, 0xb0, 0x8d, 0x37, 0x2f,
+	0x75, 0x92, 0x8c, 0x9b, 0xc1, 0xcd, 0xcc, 0x36, 0xb3, 0x89, 0x46, 0xf1, 0xe2, 0x5f, 0x20, 0x78,
+	0xf2, 0x3f, 0xf0, 0x28, 0x78, 0x10, 0xf4, 0xe4, 0xad, 0xc7, 0x82, 0x17, 0xf1, 0x20, 0x92, 0x08,
+	0xfe, 0x1b, 0x92, 0xd9, 0xe9, 0x66, 0xd3, 0x66, 0x93, 0x06, 0x7a, 0x09, 0xbb, 0x2f, 0xef, 0xfb,
+	0xde, 0xe7, 0x3b, 0x6f, 0x5e, 0x02, 0x8e, 0x6a, 0x88, 0x3a, 0x57, 0x8c, 0x84, 0xf2, 0x19, 0x13,
+	0x4f, 0xb9, 0x1f, 0xb2, 0x3a, 0x69, 0xe6, 0xc9, 0x5e, 0x83, 0xd5, 0x5b, 0x6e, 0x50, 0x97, 0xa1,
+	0xc4, 0x33, 0x26, 0xc7, 0x4d, 0xe4, 0xb8, 0xcd, 0xbc, 0x75, 0x8e, 0xd6, 0xb8, 0x90, 0x44, 0x7f,
+	0x46, 0xa9, 0xd6, 0x79, 0x4f, 0x7a, 0x52, 0x3f, 0x92, 0xee, 0x93, 0x89, 0xce, 0x79, 0x52, 0x7a,
+	0x3e, 0x23, 0x34, 0xe0, 0x84, 0x0a, 0x21, 0x43, 0x1a, 0x72, 0x29, 0x94, 0xf9, 0x76, 0xa9, 0x2c,
+	0x55, 0x4d, 0x2a, 0x52, 0xa2, 0x8a, 0x45, 0x7d, 0x49, 0x33, 0x5f, 0x62, 0x21, 0xcd, 0x93, 0x80,
+	0x7a, 0x5c, 0xe8, 0x64, 0x93, 0x9b, 0x4b, 0xc1, 0x4d, 0x92, 0xe9, 0x4c, 0x67, 0x03, 0x2e, 0xee,
+	0x74, 0x6b, 0xdd, 0xf6, 0x7d, 0xf9, 0x9c, 0x55, 0xb6, 0x98, 0x90, 0x35, 0x55, 0x64, 0x7b, 0x0d,
+	0xa6, 0x42, 0x3c, 0x0f, 0x50, 0xae, 0x52, 0x21, 0x98, 0xbf, 0xcb, 0x2b, 0xb3, 0x68, 0x11, 0xe5,
+	0xb2, 0xc5, 0xac, 0x89, 0xdc, 0xaf, 0x38, 0xab, 0x60, 0x0d, 0xd2, 0xaa, 0x40, 0x0a, 0xc5, 0xf0,
+	0x0c, 0x4c, 0x55, 0x74, 0x64, 0x16, 0x2d, 0xfe, 0x97, 0xcb, 0x16, 0xcd, 0x9b, 0xf3, 0x04, 0x66,
+	0x7a, 0x2a, 0x9f, 0xab, 0x30, 0x6e, 0x77, 0x17, 0xa0, 0xe7, 0x44, 0xb7, 0x9b, 0x2e, 0x5c, 0x76,
+	0x23, 0xdb, 0x6e, 0xd7, 0xb6, 0x1b, 0x1d, 0xb7, 0xb1, 0xed, 0x6e, 0x53, 0x8f, 0x19, 0x6d, 0x31,
+	0xa1, 0x74, 0x3e, 0x23, 0xb8, 0x70, 0xac, 0x85, 0xa1, 0x7a, 0x04, 0x40, 0xe3, 0xa8, 0x26, 0x9b,
+	0x2e, 0xe4, 0xdc, 0xc1, 0x93, 0x73, 0xef, 0x44, 0x56, 0xe3, 0x32, 0x9b, 0xd9, 0xfd, 0x5f, 0x0b,
+	0x99, 0x0f, 0x7f, 0x3f, 0x2e, 0xa1, 0x62, 0xa2, 0x0c, 0xbe, 0xd7, 0x07, 0x3e, 0xa1, 0xc1, 0xaf,
+	0x8c, 0x04, 0x8f, 0x88, 0xfa, 0xc8, 0x6f, 0xc2, 0x9c, 0x06, 0xdf, 0x62, 0x75, 0xde, 0xa4, 0x21,
+	0x6f, 0xb2, 0x6d, 0xe9, 0xf3, 0x72, 0xeb, 0x84, 0x03, 0xf1, 0x61, 0x3e, 0x45, 0x6e, 0xdc, 0x3f,
+	0x80, 0xa9, 0x40, 0x47, 0xcc, 0xe9, 0xa6, 0x3a, 0x3f, 0x5a, 0x21, 0xe9, 0xdc, 0x94, 0x70, 0xaa,
+	0x60, 0x0f, 0xea, 0xc6, 0xd9, 0xa9, 0x0f, 0xf4, 0x2b, 0x82, 0x85, 0xd4, 0x56, 0xc6, 0xda, 0x43,
+	0xf8, 0x3f, 0x30, 0xb1, 0x51, 0x63, 0x1d, 0x66, 0x2e, 0x2e, 0x72, 0x6a, 0x43, 0x2d, 0xfc, 0x9c,
+	0x84, 0x49, 0x4d, 0x8f, 0x3f, 0x21, 0x38, 0xd3, 0xb7, 0x2c, 0x38, 0x9f, 0xc6, 0x98, 0xba, 0x94,
+	0x56, 0x61, 0x1c, 0x49, 0x84, 0xe3, 0xdc, 0x78, 0xf3, 0xfd, 0xcf, 0xbb, 0x89, 0x35, 0xbc, 0x42,
+	0x52, 0x7e, 0x18, 0x68, 0x24, 0xdb, 0x8d, 0x76, 0x94, 0xbc, 0xea, 0xdd, 0xb2, 0xd7, 0xf8, 0x3d,
+	0x02, 0xe8, 0x6d, 0x12, 0x76, 0x47, 0xf7, 0x4f, 0x6e, 0xb5, 0x45, 0x4e, 0x9c, 0x6f, 0x60, 0x97,
+	0x34, 0xec, 0x25, 0xec, 0x0c, 0x85, 0x8d, 0x60, 0xbe, 0x21, 0x38, 0x7b, 0x74, 0x9c, 0x78, 0x75,
+	0x68, 0xc7, 0x94, 0xdd, 0xb2, 0xd6, 0xc6, 0x54, 0x19, 0xda, 0x5b, 0x9a, 0x76, 0x03, 0x5f, 0x4f,
+	0xa3, 0xad, 0xc4, 0xca, 0xdd, 0xc3, 0xbb, 0xd5, 0x7f, 0xbe, 0x5f, 0x10, 0xe0, 0xe3, 0x17, 0x1b,
+	0x5f, 0x1b, 0x87, 0xa7, 0xb7, 0x74, 0xd6, 0xfa, 0xd8, 0x3a, 0xe3, 0x64, 0x45, 0x3b, 0x59, 0xc6,
+	0x57, 0xc7, 0x70, 0xb2, 0xb9, 0xb3, 0xdf, 0xb6, 0xd1, 0x41, 0xdb, 0x46, 0xbf, 0xdb, 0x36, 0x7a,
+	0xdb, 0xb1, 0x33, 0x07, 0x1d, 0x3b, 0xf3, 0xa3, 0x63, 0x67, 0x1e, 0xaf, 0x7b, 0x3c, 0xac, 0x36,
+	0x4a, 0x6e, 0x59, 0xd6, 0x0e, 0x0b, 0x2e, 0xbf, 0x94, 0x82, 0xc5, 0x2f, 0x34, 0x08, 0xc8, 0x8b,
+	0xbe, 0x1e, 0x61, 0x2b, 0x60, 0xaa, 0x34, 0xa5, 0xff, 0x99, 0x56, 0xfe, 0x0d, 0x00, 0x35, 0xa4,
+	0xb2, 0x49, 0x74, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// AllowedDenoms queries the non-native base denominations allowed to be
+	// received over a channel.
+	AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error)
+	// Allowlists queries the allowlists of all the channels.
+	Allowlists(ctx context.Context, in *QueryAllowlistsRequest, opts ...grpc.CallOption) (*QueryAllowlistsResponse, error)
+	// DerivativePolicy queries the policy applied to the liquid staking
+	// derivatives sent over a channel.
+	DerivativePolicy(ctx context.Context, in *QueryDerivativePolicyRequest, opts ...grpc.CallOption) (*QueryDerivativePolicyResponse, error)
+	// DerivativePolicies queries the derivative policies of all the channels.
+	DerivativePolicies(ctx context.Context, in *QueryDerivativePoliciesRequest, opts ...grpc.CallOption) (*QueryDerivativePoliciesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error) {
+	out := new(QueryAllowedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.tokenfilter.v1.Query/AllowedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Allowlists(ctx context.Context, in *QueryAllowlistsRequest, opts ...grpc.CallOption) (*QueryAllowlistsResponse, error) {
+	out := new(QueryAllowlistsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.tokenfilter.v1.Query/Allowlists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DerivativePolicy(ctx context.Context, in *QueryDerivativePolicyRequest, opts ...grpc.CallOption) (*QueryDerivativePolicyResponse, error) {
+	out := new(QueryDerivativePolicyResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.tokenfilter.v1.Query/DerivativePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DerivativePolicies(ctx context.Context, in *QueryDerivativePoliciesRequest, opts ...grpc.CallOption) (*QueryDerivativePoliciesResponse, error) {
+	out := new(QueryDerivativePoliciesResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.tokenfilter.v1.Query/DerivativePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// AllowedDenoms queries the non-native base denominations allowed to be
+	// received over a channel.
+	AllowedDenoms(context.Context, *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error)
+	// Allowlists queries the allowlists of all the channels.
+	Allowlists(context.Context, *QueryAllowlistsRequest) (*QueryAllowlistsResponse, error)
+	// DerivativePolicy queries the policy applied to the liquid staking
+	// derivatives sent over a channel.
+	DerivativePolicy(context.Context, *QueryDerivativePolicyRequest) (*QueryDerivativePolicyResponse, error)
+	// DerivativePolicies queries the derivative policies of all the channels.
+	DerivativePolicies(context.Context, *QueryDerivativePoliciesRequest) (*QueryDerivativePoliciesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) AllowedDenoms(ctx context.Context, req *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedDenoms not implemented")
+}
+func (*UnimplementedQueryServer) Allowlists(ctx context.Context, req *QueryAllowlistsRequest) (*QueryAllowlistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowlists not implemented")
+}
+func (*UnimplementedQueryServer) DerivativePolicy(ctx context.Context, req *QueryDerivativePolicyRequest) (*QueryDerivativePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DerivativePolicy not implemented")
+}
+func (*UnimplementedQueryServer) DerivativePolicies(ctx context.Context, req *QueryDerivativePoliciesRequest) (*QueryDerivativePoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DerivativePolicies not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_AllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.tokenfilter.v1.Query/AllowedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedDenoms(ctx, req.(*QueryAllowedDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Allowlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowlistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowlists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.tokenfilter.v1.Query/Allowlists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowlists(ctx, req.(*QueryAllowlistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DerivativePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDerivativePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DerivativePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.tokenfilter.v1.Query/DerivativePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DerivativePolicy(ctx, req.(*QueryDerivativePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DerivativePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDerivativePoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DerivativePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.tokenfilter.v1.Query/DerivativePolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DerivativePolicies(ctx, req.(*QueryDerivativePoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sunrise.tokenfilter.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AllowedDenoms",
+			Handler:    _Query_AllowedDenoms_Handler,
+		},
+		{
+			MethodName: "Allowlists",
+			Handler:    _Query_Allowlists_Handler,
+		},
+		{
+			MethodName: "DerivativePolicy",
+			Handler:    _Query_DerivativePolicy_Handler,
+		},
+		{
+			MethodName: "DerivativePolicies",
+			Handler:    _Query_DerivativePolicies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sunrise/tokenfilter/v1/query.proto",
+}
+
+func (m *QueryAllowedDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowlistsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowlistsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowlistsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowlistsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowlistsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowlistsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowlists) > 0 {
+		for iNdEx := len(m.Allowlists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowlists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDerivativePolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDerivativePolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDerivativePolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDerivativePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDerivativePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDerivativePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDerivativePoliciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDerivativePoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDerivativePoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDerivativePoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDerivativePoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDerivativePoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllowedDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllowlistsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowlistsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowlists) > 0 {
+		for _, e := range m.Allowlists {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDerivativePolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDerivativePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDerivativePoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDerivativePoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllowedDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowlistsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowlistsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowlistsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowlistsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowlistsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowlistsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlists = append(m.Allowlists, ChannelAllowlist{})
+			if err := m.Allowlists[len(m.Allowlists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDerivativePolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDerivativePolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDerivativePolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDerivativePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDerivativePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDerivativePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDerivativePoliciesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDerivativePoliciesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDerivativePoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDerivativePoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDerivativePoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDerivativePoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, DerivativePolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
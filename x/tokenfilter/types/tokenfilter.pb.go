@@ -0,0 +1,734 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sunrise/tokenfilter/v1/tokenfilter.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DerivativeSendMode defines which liquid staking derivatives may be sent
+// over a channel.
+type DerivativeSendMode int32
+
+const (
+	// DERIVATIVE_SEND_MODE_BLOCKED blocks all the derivatives. This is the mode
+	// of the channels without policy.
+	DERIVATIVE_SEND_MODE_BLOCKED DerivativeSendMode = 0
+	// DERIVATIVE_SEND_MODE_ALLOWLIST only allows the derivatives of the policy.
+	DERIVATIVE_SEND_MODE_ALLOWLIST DerivativeSendMode = 1
+	// DERIVATIVE_SEND_MODE_ALLOWED allows all the derivatives.
+	DERIVATIVE_SEND_MODE_ALLOWED DerivativeSendMode = 2
+)
+
+var DerivativeSendMode_name = map[int32]string{
+	0: "DERIVATIVE_SEND_MODE_BLOCKED",
+	1: "DERIVATIVE_SEND_MODE_ALLOWLIST",
+	2: "DERIVATIVE_SEND_MODE_ALLOWED",
+}
+
+var DerivativeSendMode_value = map[string]int32{
+	"DERIVATIVE_SEND_MODE_BLOCKED":   0,
+	"DERIVATIVE_SEND_MODE_ALLOWLIST": 1,
+	"DERIVATIVE_SEND_MODE_ALLOWED":   2,
+}
+
+func (x DerivativeSendMode) String() string {
+	return proto.EnumName(DerivativeSendMode_name, int32(x))
+}
+
+func (DerivativeSendMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c644e33f55bc0b37, []int{0}
+}
+
+// ChannelAllowlist is the set of non-native base denominations that may be
+// received over a channel.
+type ChannelAllowlist struct {
+	// channel_id is the identifier of the channel on this chain.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denoms are the base denominations, i.e. without their trace, allowed to
+	// be received over the channel.
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *ChannelAllowlist) Reset()         { *m = ChannelAllowlist{} }
+func (m *ChannelAllowlist) String() string { return proto.CompactTextString(m) }
+func (*ChannelAllowlist) ProtoMessage()    {}
+func (*ChannelAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e33f55bc0b37, []int{0}
+}
+func (m *ChannelAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelAllowlist.Merge(m, src)
+}
+func (m *ChannelAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelAllowlist proto.InternalMessageInfo
+
+func (m *ChannelAllowlist) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelAllowlist) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+// DerivativePolicy is the policy applied to the liquid staking derivatives,
+// the bsr-<valoper> denominations, sent over a channel.
+type DerivativePolicy struct {
+	// channel_id is the identifier of the channel on this chain.
+	ChannelId string             `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Mode      DerivativeSendMode `protobuf:"varint,2,opt,name=mode,proto3,enum=sunrise.tokenfilter.v1.DerivativeSendMode" json:"mode,omitempty"`
+	// allowed_denoms are the derivatives allowed in the allowlist mode.
+	AllowedDenoms []string `protobuf:"bytes,3,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// attach_metadata adds the validator and the exchange rate of the
+	// derivative at send time to the memo of the transfers, for the
+	// counterparties that opted in.
+	AttachMetadata bool `protobuf:"varint,4,opt,name=attach_metadata,json=attachMetadata,proto3" json:"attach_metadata,omitempty"`
+}
+
+func (m *DerivativePolicy) Reset()         { *m = DerivativePolicy{} }
+func (m *DerivativePolicy) String() string { return proto.CompactTextString(m) }
+func (*DerivativePolicy) ProtoMessage()    {}
+func (*DerivativePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c644e33f55bc0b37, []int{1}
+}
+func (m *DerivativePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DerivativePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DerivativePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DerivativePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivativePolicy.Merge(m, src)
+}
+func (m *DerivativePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DerivativePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivativePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivativePolicy proto.InternalMessageInfo
+
+func (m *DerivativePolicy) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *DerivativePolicy) GetMode() DerivativeSendMode {
+	if m != nil {
+		return m.Mode
+	}
+	return DERIVATIVE_SEND_MODE_BLOCKED
+}
+
+func (m *DerivativePolicy) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *DerivativePolicy) GetAttachMetadata() bool {
+	if m != nil {
+		return m.AttachMetadata
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("sunrise.tokenfilter.v1.DerivativeSendMode", DerivativeSendMode_name, DerivativeSendMode_value)
+	proto.RegisterType((*ChannelAllowlist)(nil), "sunrise.tokenfilter.v1.ChannelAllowlist")
+	proto.RegisterType((*DerivativePolicy)(nil), "sunrise.tokenfilter.v1.DerivativePolicy")
+}
+
+func init() {
+	proto.RegisterFile("sunrise/tokenfilter/v1/tokenfilter.proto", fileDescriptor_c644e33f55bc0b37)
+}
+
+var fileDescriptor_c644e33f55bc0b37 = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x41, 0xcb, 0xd3, 0x30,
+	0x1c, 0xc6, 0x9b, 0x6d, 0x0c, 0x17, 0x70, 0x96, 0x20, 0xa3, 0x0c, 0x0d, 0x65, 0x20, 0x96, 0x81,
+	0x2d, 0xd3, 0x83, 0x37, 0x61, 0x5b, 0x7b, 0x28, 0x76, 0x4e, 0xbb, 0x31, 0xc1, 0x4b, 0xc9, 0x9a,
+	0xb8, 0x05, 0xdb, 0xa6, 0xb4, 0x59, 0x75, 0x1e, 0x3d, 0x79, 0xf4, 0x3b, 0xf8, 0x49, 0xbc, 0x79,
+	0xdc, 0xd1, 0xa3, 0x6c, 0x5f, 0x44, 0xd6, 0x15, 0xdf, 0xbd, 0xbc, 0x7b, 0x79, 0x6f, 0x79, 0x7e,
+	0x79, 0xf2, 0xe4, 0x21, 0xf9, 0x43, 0x23, 0xdf, 0x24, 0x19, 0xcf, 0x99, 0x25, 0xc5, 0x27, 0x96,
+	0x7c, 0xe4, 0x91, 0x64, 0x99, 0x55, 0x0c, 0xce, 0xa5, 0x99, 0x66, 0x42, 0x0a, 0xd4, 0xa9, 0x9c,
+	0xe6, 0xf9, 0x56, 0x31, 0xe8, 0x3e, 0x5c, 0x89, 0x95, 0x28, 0x2d, 0xd6, 0x71, 0x75, 0x72, 0xf7,
+	0x5c, 0xa8, 0x8e, 0xd7, 0x24, 0x49, 0x58, 0x34, 0x8c, 0x22, 0xf1, 0x39, 0xe2, 0xb9, 0x44, 0x8f,
+	0x21, 0x0c, 0x4f, 0x2c, 0xe0, 0x54, 0x03, 0x3a, 0x30, 0x5a, 0x7e, 0xab, 0x22, 0x2e, 0x45, 0x1d,
+	0xd8, 0xa4, 0x2c, 0x11, 0x71, 0xae, 0xd5, 0xf4, 0xba, 0xd1, 0xf2, 0x2b, 0xd5, 0xfb, 0x05, 0xa0,
+	0x6a, 0xb3, 0x8c, 0x17, 0x44, 0xf2, 0x82, 0xbd, 0x15, 0x11, 0x0f, 0xb7, 0x77, 0x65, 0xbd, 0x82,
+	0x8d, 0x58, 0x50, 0xa6, 0xd5, 0x74, 0x60, 0xb4, 0x9f, 0xf7, 0xcd, 0xcb, 0xdd, 0xcd, 0xab, 0xd8,
+	0x19, 0x4b, 0xe8, 0x44, 0x50, 0xe6, 0x97, 0xe7, 0xd0, 0x13, 0xd8, 0x26, 0xc7, 0xde, 0x8c, 0x06,
+	0x55, 0xa7, 0x7a, 0xd9, 0xe9, 0x7e, 0x45, 0xed, 0x12, 0xa2, 0xa7, 0xf0, 0x01, 0x91, 0x92, 0x84,
+	0xeb, 0x20, 0x66, 0x92, 0x50, 0x22, 0x89, 0xd6, 0xd0, 0x81, 0x71, 0xcf, 0x6f, 0x9f, 0xf0, 0xa4,
+	0xa2, 0xfd, 0x6f, 0x00, 0xa2, 0x9b, 0x97, 0x21, 0x1d, 0x3e, 0xb2, 0x1d, 0xdf, 0x5d, 0x0c, 0xe7,
+	0xee, 0xc2, 0x09, 0x66, 0xce, 0x1b, 0x3b, 0x98, 0x4c, 0x6d, 0x27, 0x18, 0x79, 0xd3, 0xf1, 0x6b,
+	0xc7, 0x56, 0x15, 0xd4, 0x83, 0xf8, 0xa2, 0x63, 0xe8, 0x79, 0xd3, 0xf7, 0x9e, 0x3b, 0x9b, 0xab,
+	0xe0, 0xd6, 0x94, 0xd2, 0xe3, 0xd8, 0x6a, 0xad, 0xdb, 0xf8, 0xfe, 0x13, 0x2b, 0xa3, 0x77, 0xbf,
+	0xf7, 0x18, 0xec, 0xf6, 0x18, 0xfc, 0xdd, 0x63, 0xf0, 0xe3, 0x80, 0x95, 0xdd, 0x01, 0x2b, 0x7f,
+	0x0e, 0x58, 0xf9, 0xf0, 0x72, 0xc5, 0xe5, 0x7a, 0xb3, 0x34, 0x43, 0x11, 0x5b, 0xd5, 0x53, 0x3d,
+	0xfb, 0x2a, 0x12, 0xf6, 0x5f, 0x90, 0x34, 0xb5, 0xbe, 0x5c, 0x9b, 0x11, 0xb9, 0x4d, 0x59, 0xbe,
+	0x6c, 0x96, 0xbf, 0xfd, 0xe2, 0xdf, 0x00, 0xcb, 0x83, 0xf1, 0x30, 0x47, 0x02, 0x00, 0x00,
+}
+
+func (m *ChannelAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTokenfilter(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTokenfilter(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DerivativePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivativePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivativePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttachMetadata {
+		i--
+		if m.AttachMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintTokenfilter(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Mode != 0 {
+		i = encodeVarintTokenfilter(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTokenfilter(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTokenfilter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTokenfilter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChannelAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTokenfilter(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTokenfilter(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DerivativePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTokenfilter(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTokenfilter(uint64(m.Mode))
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovTokenfilter(uint64(l))
+		}
+	}
+	if m.AttachMetadata {
+		n += 2
+	}
+	return n
+}
+
+func sovTokenfilter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTokenfilter(x uint64) (n int) {
+	return sovTokenfilter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChannelAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTokenfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTokenfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTokenfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DerivativePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTokenfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivativePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivativePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= DerivativeSendMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttachMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AttachMetadata = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTokenfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTokenfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTokenfilter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTokenfilter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTokenfilter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTokenfilter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTokenfilter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTokenfilter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTokenfilter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTokenfilter = fmt.Errorf("proto: unexpected end of group")
+)
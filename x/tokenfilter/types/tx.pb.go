@@ -0,0 +1,1047 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sunrise/tokenfilter/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetAllowedDenoms is the Msg/SetAllowedDenoms request type.
+type MsgSetAllowedDenoms struct {
+	// authority is the address that controls the module (defaults to x/gov
+	// unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// channel_id is the identifier of the channel on this chain.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denoms replace the base denominations allowed to be received over the
+	// channel. An empty list removes the allowlist of the channel.
+	Denoms []string `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *MsgSetAllowedDenoms) Reset()         { *m = MsgSetAllowedDenoms{} }
+func (m *MsgSetAllowedDenoms) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAllowedDenoms) ProtoMessage()    {}
+func (*MsgSetAllowedDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f206e22863740eea, []int{0}
+}
+func (m *MsgSetAllowedDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAllowedDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAllowedDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAllowedDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAllowedDenoms.Merge(m, src)
+}
+func (m *MsgSetAllowedDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAllowedDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAllowedDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAllowedDenoms proto.InternalMessageInfo
+
+func (m *MsgSetAllowedDenoms) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetAllowedDenoms) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgSetAllowedDenoms) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+// MsgSetAllowedDenomsResponse defines the response structure for executing a
+// MsgSetAllowedDenoms message.
+type MsgSetAllowedDenomsResponse struct {
+}
+
+func (m *MsgSetAllowedDenomsResponse) Reset()         { *m = MsgSetAllowedDenomsResponse{} }
+func (m *MsgSetAllowedDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAllowedDenomsResponse) ProtoMessage()    {}
+func (*MsgSetAllowedDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f206e22863740eea, []int{1}
+}
+func (m *MsgSetAllowedDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAllowedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAllowedDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAllowedDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAllowedDenomsResponse.Merge(m, src)
+}
+func (m *MsgSetAllowedDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAllowedDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAllowedDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAllowedDenomsResponse proto.InternalMessageInfo
+
+// MsgSetDerivativePolicy is the Msg/SetDerivativePolicy request type.
+type MsgSetDerivativePolicy struct {
+	// authority is the address that controls the module (defaults to x/gov
+	// unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// policy replaces the policy of its channel.
+	Policy DerivativePolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgSetDerivativePolicy) Reset()         { *m = MsgSetDerivativePolicy{} }
+func (m *MsgSetDerivativePolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDerivativePolicy) ProtoMessage()    {}
+func (*MsgSetDerivativePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f206e22863740eea, []int{2}
+}
+func (m *MsgSetDerivativePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDerivativePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDerivativePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDerivativePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDerivativePolicy.Merge(m, src)
+}
+func (m *MsgSetDerivativePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDerivativePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDerivativePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDerivativePolicy proto.InternalMessageInfo
+
+func (m *MsgSetDerivativePolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetDerivativePolicy) GetPolicy() DerivativePolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return DerivativePolicy{}
+}
+
+// MsgSetDerivativePolicyResponse defines the response structure for executing
+// a MsgSetDerivativePolicy message.
+type MsgSetDerivativePolicyResponse struct {
+}
+
+func (m *MsgSetDerivativePolicyResponse) Reset()         { *m = MsgSetDerivativePolicyResponse{} }
+func (m *MsgSetDerivativePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDerivativePolicyResponse) ProtoMessage()    {}
+func (*MsgSetDerivativePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f206e22863740eea, []int{3}
+}
+func (m *MsgSetDerivativePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDerivativePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDerivativePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDerivativePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDerivativePolicyResponse.Merge(m, src)
+}
+func (m *MsgSetDerivativePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDerivativePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDerivativePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDerivativePolicyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetAllowedDenoms)(nil), "sunrise.tokenfilter.v1.MsgSetAllowedDenoms")
+	proto.RegisterType((*MsgSetAllowedDenomsResponse)(nil), "sunrise.tokenfilter.v1.MsgSetAllowedDenomsResponse")
+	proto.RegisterType((*MsgSetDerivativePolicy)(nil), "sunrise.tokenfilter.v1.MsgSetDerivativePolicy")
+	proto.RegisterType((*MsgSetDerivativePolicyResponse)(nil), "sunrise.tokenfilter.v1.MsgSetDerivativePolicyResponse")
+}
+
+func init() { proto.RegisterFile("sunrise/tokenfilter/v1/tx.proto", fileDescriptor_f206e22863740eea) }
+
+var fileDescriptor_f206e22863740eea = []byte{
+	// 462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x41, 0x6b, 0xd4, 0x40,
+	0x18, 0xcd, 0x74, 0x71, 0x21, 0xe3, 0x45, 0xd3, 0xb2, 0xc6, 0x48, 0xd3, 0x25, 0xa7, 0xb0, 0xd2,
+	0x0c, 0x6d, 0xa1, 0x42, 0x6f, 0x5d, 0x2a, 0x28, 0x52, 0xd0, 0xf4, 0x22, 0x7a, 0x28, 0x69, 0x32,
+	0xce, 0x0e, 0x26, 0x33, 0x21, 0x33, 0x1b, 0xbb, 0x82, 0xa0, 0x1e, 0x3d, 0xf9, 0x33, 0x3c, 0xee,
+	0xc1, 0x1f, 0xd1, 0x63, 0xf1, 0xe4, 0xa9, 0xc8, 0xee, 0x61, 0xff, 0x83, 0x27, 0x49, 0x32, 0xeb,
+	0xea, 0x36, 0x5b, 0xd8, 0xbd, 0x84, 0x7c, 0xdf, 0xf7, 0xf2, 0x5e, 0xde, 0xfb, 0x66, 0xe0, 0x96,
+	0xe8, 0xb3, 0x8c, 0x0a, 0x8c, 0x24, 0x7f, 0x8b, 0xd9, 0x1b, 0x1a, 0x4b, 0x9c, 0xa1, 0x7c, 0x07,
+	0xc9, 0x73, 0x2f, 0xcd, 0xb8, 0xe4, 0x46, 0x4b, 0x01, 0xbc, 0x7f, 0x00, 0x5e, 0xbe, 0x63, 0xdd,
+	0x0d, 0x12, 0xca, 0x38, 0x2a, 0x9f, 0x15, 0xd4, 0xba, 0x17, 0x72, 0x91, 0x70, 0x81, 0x12, 0x41,
+	0x0a, 0x8a, 0x44, 0x10, 0x35, 0xb8, 0x5f, 0x0d, 0x4e, 0xcb, 0x0a, 0x55, 0x85, 0x1a, 0x6d, 0x10,
+	0x4e, 0x78, 0xd5, 0x2f, 0xde, 0x54, 0xd7, 0x5d, 0xf4, 0x57, 0xb3, 0xb2, 0x42, 0x3a, 0x57, 0x00,
+	0xae, 0x1f, 0x0b, 0x72, 0x82, 0xe5, 0x61, 0x1c, 0xf3, 0x77, 0x38, 0x3a, 0xc2, 0x8c, 0x27, 0xc2,
+	0xd8, 0x87, 0x7a, 0xd0, 0x97, 0x3d, 0x9e, 0x51, 0x39, 0x30, 0x41, 0x1b, 0xb8, 0x7a, 0xd7, 0xfc,
+	0xf1, 0x7d, 0x7b, 0x43, 0x89, 0x1f, 0x46, 0x51, 0x86, 0x85, 0x38, 0x91, 0x19, 0x65, 0xc4, 0x9f,
+	0x41, 0x8d, 0x4d, 0x08, 0xc3, 0x5e, 0xc0, 0x18, 0x8e, 0x4f, 0x69, 0x64, 0xae, 0x15, 0x1f, 0xfa,
+	0xba, 0xea, 0x3c, 0x8d, 0x8c, 0x16, 0x6c, 0x46, 0xa5, 0x80, 0xd9, 0x68, 0x37, 0x5c, 0xdd, 0x57,
+	0xd5, 0xc1, 0xcb, 0xcf, 0x93, 0x61, 0x67, 0x46, 0xf3, 0x65, 0x32, 0xec, 0x3c, 0x26, 0x54, 0xf6,
+	0xfa, 0x67, 0x5e, 0xc8, 0x13, 0xa4, 0xec, 0x6c, 0xbf, 0xe7, 0x0c, 0xff, 0x2d, 0x82, 0x34, 0x45,
+	0xe7, 0xff, 0x39, 0xac, 0x31, 0xe2, 0x6c, 0xc2, 0x07, 0x35, 0x6d, 0x1f, 0x8b, 0x94, 0x33, 0x81,
+	0x9d, 0xdf, 0x00, 0xb6, 0xaa, 0xf9, 0x11, 0xce, 0x68, 0x1e, 0x48, 0x9a, 0xe3, 0xe7, 0x3c, 0xa6,
+	0xe1, 0x60, 0xe5, 0x08, 0x9e, 0xc1, 0x66, 0x5a, 0x32, 0x94, 0xf6, 0x6f, 0xef, 0xba, 0x5e, 0xfd,
+	0x11, 0xf0, 0xe6, 0x15, 0xbb, 0xfa, 0xc5, 0xd5, 0x96, 0xf6, 0x6d, 0x32, 0xec, 0x00, 0x5f, 0x51,
+	0x1c, 0xbc, 0xbe, 0x1e, 0xcc, 0x93, 0x55, 0x83, 0x99, 0xd7, 0x73, 0xda, 0xd0, 0xae, 0x9f, 0x4c,
+	0xe3, 0xd9, 0xfd, 0xb4, 0x06, 0x1b, 0xc7, 0x82, 0x18, 0x12, 0xde, 0xb9, 0x76, 0x44, 0x1e, 0x2e,
+	0xf2, 0x55, 0x93, 0xb7, 0xb5, 0xb7, 0x04, 0x78, 0xaa, 0x6e, 0x7c, 0x80, 0xeb, 0x75, 0x8b, 0xf1,
+	0x6e, 0xe6, 0x9a, 0xc7, 0x5b, 0xfb, 0xcb, 0xe1, 0xa7, 0xf2, 0xd6, 0xad, 0x8f, 0xc5, 0x2a, 0xba,
+	0x2f, 0x2e, 0x46, 0x36, 0xb8, 0x1c, 0xd9, 0xe0, 0xd7, 0xc8, 0x06, 0x5f, 0xc7, 0xb6, 0x76, 0x39,
+	0xb6, 0xb5, 0x9f, 0x63, 0x5b, 0x7b, 0xf5, 0x68, 0xf9, 0x4d, 0xc8, 0x41, 0x8a, 0xc5, 0x59, 0xb3,
+	0xbc, 0x7c, 0x7b, 0x7f, 0x06, 0x00, 0xc9, 0xa7, 0x43, 0xe6, 0x3e, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetAllowedDenoms defines a (governance) operation for replacing the
+	// non-native base denominations allowed to be received over a channel.
+	SetAllowedDenoms(ctx context.Context, in *MsgSetAllowedDenoms, opts ...grpc.CallOption) (*MsgSetAllowedDenomsResponse, error)
+	// SetDerivativePolicy defines a (governance) operation for replacing the
+	// policy applied to the liquid staking derivatives sent over a channel.
+	SetDerivativePolicy(ctx context.Context, in *MsgSetDerivativePolicy, opts ...grpc.CallOption) (*MsgSetDerivativePolicyResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetAllowedDenoms(ctx context.Context, in *MsgSetAllowedDenoms, opts ...grpc.CallOption) (*MsgSetAllowedDenomsResponse, error) {
+	out := new(MsgSetAllowedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.tokenfilter.v1.Msg/SetAllowedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDerivativePolicy(ctx context.Context, in *MsgSetDerivativePolicy, opts ...grpc.CallOption) (*MsgSetDerivativePolicyResponse, error) {
+	out := new(MsgSetDerivativePolicyResponse)
+	err := c.cc.Invoke(ctx, "/sunrise.tokenfilter.v1.Msg/SetDerivativePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetAllowedDenoms defines a (governance) operation for replacing the
+	// non-native base denominations allowed to be received over a channel.
+	SetAllowedDenoms(context.Context, *MsgSetAllowedDenoms) (*MsgSetAllowedDenomsResponse, error)
+	// SetDerivativePolicy defines a (governance) operation for replacing the
+	// policy applied to the liquid staking derivatives sent over a channel.
+	SetDerivativePolicy(context.Context, *MsgSetDerivativePolicy) (*MsgSetDerivativePolicyResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetAllowedDenoms(ctx context.Context, req *MsgSetAllowedDenoms) (*MsgSetAllowedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllowedDenoms not implemented")
+}
+func (*UnimplementedMsgServer) SetDerivativePolicy(ctx context.Context, req *MsgSetDerivativePolicy) (*MsgSetDerivativePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDerivativePolicy not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetAllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAllowedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAllowedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.tokenfilter.v1.Msg/SetAllowedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAllowedDenoms(ctx, req.(*MsgSetAllowedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDerivativePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDerivativePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDerivativePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sunrise.tokenfilter.v1.Msg/SetDerivativePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDerivativePolicy(ctx, req.(*MsgSetDerivativePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sunrise.tokenfilter.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetAllowedDenoms",
+			Handler:    _Msg_SetAllowedDenoms_Handler,
+		},
+		{
+			MethodName: "SetDerivativePolicy",
+			Handler:    _Msg_SetDerivativePolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sunrise/tokenfilter/v1/tx.proto",
+}
+
+func (m *MsgSetAllowedDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllowedDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllowedDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAllowedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllowedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllowedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDerivativePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDerivativePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDerivativePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDerivativePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDerivativePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDerivativePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetAllowedDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAllowedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDerivativePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDerivativePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetAllowedDenoms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllowedDenoms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllowedDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAllowedDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllowedDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllowedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDerivativePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDerivativePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDerivativePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDerivativePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDerivativePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDerivativePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)